@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func bugReportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bugreport",
+		Short: "Create a redacted bug report bundle",
+		Long:  "Create a redacted bug report bundle with the last crash recovery state and logs",
+		Run: func(cmd *cobra.Command, args []string) {
+			path, err := writeBugReport()
+			if err != nil {
+				fmt.Fprintln(os.Stderr, color.Colorize(err.Error(), color.Red))
+				os.Exit(1)
+			}
+			fmt.Printf("Bug report saved to %s. Please review it before sharing.\n", path)
+		},
+	}
+}
+
+func writeBugReport() (string, error) {
+	r, err := config.LoadRecovery(config.K9sRecoveryFile)
+	if err != nil {
+		return "", err
+	}
+	if r == nil {
+		if r, err = config.LoadRecovery(config.K9sLastRecoveryFile); err != nil {
+			return "", err
+		}
+	}
+
+	path := fmt.Sprintf("k9s-bugreport-%d.tar.gz", time.Now().Unix())
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, config.DefaultFileMod)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return path, config.WriteBugReport(f, r, config.K9sLogs)
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	bashShell = "bash"
+	zshShell  = "zsh"
+	fishShell = "fish"
+)
+
+// coreCommands tracks common resource commands that only get aliased once
+// the cluster resources are discovered.
+var coreCommands = []string{
+	"pods", "po", "services", "svc", "nodes", "no", "namespaces", "ns",
+	"configmaps", "cm", "secrets", "events", "ev", "deployments", "statefulsets", "sts",
+	"daemonsets", "ds", "replicasets", "rs", "jobs", "cronjobs", "cj",
+	"persistentvolumeclaims", "pvc", "persistentvolumes", "pv", "ingresses", "ing",
+	"serviceaccounts", "sa",
+}
+
+func completionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish]",
+		Short: "Generate shell completion scripts",
+		Long: `Generate shell completion scripts for K9s flags, contexts, namespaces and commands.
+
+  bash: source <(k9s completion bash)
+  zsh:  k9s completion zsh > "${fpath[1]}/_k9s"
+  fish: k9s completion fish > ~/.config/fish/completions/k9s.fish`,
+		ValidArgs: []string{bashShell, zshShell, fishShell},
+		Args:      cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case bashShell:
+				return cmd.Root().GenBashCompletion(os.Stdout)
+			case zshShell:
+				return cmd.Root().GenZshCompletion(os.Stdout)
+			case fishShell:
+				return cmd.Root().GenFishCompletion(os.Stdout, true)
+			default:
+				return errors.New("unsupported shell")
+			}
+		},
+	}
+}
+
+func registerCompletions(cmd *cobra.Command) {
+	ff := map[string]func(string) []string{
+		"context":   completeContexts,
+		"cluster":   completeClusters,
+		"user":      completeUsers,
+		"namespace": completeNamespaces,
+		"command":   completeCommands,
+		"logLevel": func(string) []string {
+			return []string{"info", "warn", "debug", "error", "fatal", "panic", "trace"}
+		},
+	}
+	for flag, f := range ff {
+		f := f
+		err := cmd.RegisterFlagCompletionFunc(flag, func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return prefixed(f(toComplete), toComplete), cobra.ShellCompDirectiveNoFileComp
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func completeContexts(string) []string {
+	cc, err := client.NewConfig(k8sFlags).ContextNames()
+	if err != nil {
+		return nil
+	}
+
+	return cc
+}
+
+func completeClusters(string) []string {
+	cc, err := client.NewConfig(k8sFlags).ClusterNames()
+	if err != nil {
+		return nil
+	}
+
+	return cc
+}
+
+func completeUsers(string) []string {
+	cfg, err := client.NewConfig(k8sFlags).RawConfig()
+	if err != nil {
+		return nil
+	}
+	uu := make([]string, 0, len(cfg.AuthInfos))
+	for u := range cfg.AuthInfos {
+		uu = append(uu, u)
+	}
+
+	return uu
+}
+
+// completeNamespaces lists the cluster namespaces, falling back to the
+// namespaces defined in the kubeconfig contexts when the cluster is out of
+// reach.
+func completeNamespaces(string) []string {
+	cfg := client.NewConfig(k8sFlags)
+	if conn, err := client.InitConnection(cfg); err == nil && conn.ConnectionOK() {
+		if nn, err := conn.ValidNamespaces(); err == nil {
+			return cfg.NamespaceNames(nn)
+		}
+	}
+	ctxs, err := cfg.Contexts()
+	if err != nil {
+		return nil
+	}
+	set := make(map[string]struct{}, len(ctxs))
+	for _, c := range ctxs {
+		if c.Namespace != "" {
+			set[c.Namespace] = struct{}{}
+		}
+	}
+	nn := make([]string, 0, len(set))
+	for n := range set {
+		nn = append(nn, n)
+	}
+
+	return nn
+}
+
+func completeCommands(string) []string {
+	aa := config.NewAliases()
+	if err := aa.Load(); err != nil {
+		return coreCommands
+	}
+
+	return append(aa.Keys(), coreCommands...)
+}
+
+// Helpers...
+
+func prefixed(ss []string, p string) []string {
+	set := make(map[string]struct{}, len(ss))
+	rr := make([]string, 0, len(ss))
+	for _, s := range ss {
+		if _, ok := set[s]; ok || !strings.HasPrefix(s, p) {
+			continue
+		}
+		set[s] = struct{}{}
+		rr = append(rr, s)
+	}
+	sort.Strings(rr)
+
+	return rr
+}
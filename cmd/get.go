@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+func getCmd() *cobra.Command {
+	var output string
+	command := cobra.Command{
+		Use:   "get RESOURCE",
+		Short: "Print a resource table and exit",
+		Long:  "Print a resource table using K9s aliases, custom views and columns without launching the UI",
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return prefixed(completeCommands(toComplete), toComplete), cobra.ShellCompDirectiveNoFileComp
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+			if err := view.RunHeadless(loadConfiguration(), args[0], output, os.Stdout); err != nil {
+				fmt.Fprintln(os.Stderr, color.Colorize(err.Error(), color.Red))
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVarP(
+		&output,
+		"output", "o",
+		render.TableOutput,
+		"Output format. One of table|wide|json",
+	)
+	command.Flags().AddFlagSet(rootCmd.Flags())
+
+	return &command
+}
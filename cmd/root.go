@@ -8,6 +8,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -38,9 +39,10 @@ var (
 )
 
 func init() {
-	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(versionCmd(), infoCmd(), getCmd(), serveCmd(), bugReportCmd(), completionCmd())
+	registerCompletions(rootCmd)
 
 	var flags flag.FlagSet
 	klog.InitFlags(&flags)
@@ -69,21 +71,58 @@ func Execute() {
 }
 
 func run(cmd *cobra.Command, args []string) {
+	var app *view.App
 	defer func() {
 		if err := recover(); err != nil {
+			stack := debug.Stack()
 			log.Error().Msgf("Boom! %v", err)
-			log.Error().Msg(string(debug.Stack()))
+			log.Error().Msg(string(stack))
 			printLogo(color.Red)
 			fmt.Printf("%s", color.Colorize("Boom!! ", color.Red))
 			fmt.Println(color.Colorize(fmt.Sprintf("%v.", err), color.LightGray))
+			if e := view.SaveRecovery(app, err, stack); e != nil {
+				log.Error().Err(e).Msg("Recovery save failed")
+				return
+			}
+			fmt.Println(color.Colorize("Recovery state saved. Run `k9s bugreport` to create a redacted bug report.", color.LightGray))
 		}
 	}()
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
-	app := view.NewApp(loadConfiguration())
+	log.Logger = log.Logger.Hook(view.RecentErrors)
+	if *k9sFlags.Replay != config.DefaultReplay {
+		if err := view.RunReplay(*k9sFlags.Replay); err != nil {
+			panic(fmt.Sprintf("replay failed -- %v", err))
+		}
+		return
+	}
+	var snap *client.Snapshot
+	if *k9sFlags.Snapshot != config.DefaultSnapshot {
+		var err error
+		if snap, err = startSnapshot(*k9sFlags.Snapshot); err != nil {
+			panic(fmt.Sprintf("snapshot load failed -- %v", err))
+		}
+	}
+	app = view.NewApp(loadConfiguration())
+	// BailOut exits the process so cleanups are registered on the app.
+	defer app.Cleanup()
+	if snap != nil {
+		app.OnExit(func() { snap.Close() })
+	}
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		panic(fmt.Sprintf("app init failed -- %v", err))
 	}
+	if *k9sFlags.Record != config.DefaultRecord {
+		if err := app.StartRecording(*k9sFlags.Record); err != nil {
+			panic(fmt.Sprintf("recording start failed -- %v", err))
+		}
+		app.OnExit(app.StopRecording)
+	}
+	if *k9sFlags.DebugServer != config.DefaultDebugServer {
+		srv := perf.NewDebugServer(*k9sFlags.DebugServer, app.InformerCounts)
+		srv.Start()
+		defer srv.Stop()
+	}
 	if err := app.Run(); err != nil {
 		panic(fmt.Sprintf("app run failed %v", err))
 	}
@@ -102,6 +141,15 @@ func loadConfiguration() *config.Config {
 	if err := k9sCfg.Load(config.K9sConfigFile); err != nil {
 		log.Warn().Msg("Unable to locate K9s config. Generating new configuration...")
 	}
+	if api := k9sCfg.K9s.API; api != nil {
+		k8sCfg.SetRateLimits(api.QPS, api.Burst)
+	}
+	if imp := k9sCfg.K9s.Impersonate; imp != nil {
+		imp.Validate(nil, nil)
+		if _, ok := k8sCfg.Impersonation(); imp.IsSet() && !ok {
+			k8sCfg.Impersonate(imp.User, imp.Groups)
+		}
+	}
 
 	if *k9sFlags.RefreshRate != config.DefaultRefreshRate {
 		k9sCfg.K9s.OverrideRefreshRate(*k9sFlags.RefreshRate)
@@ -111,7 +159,10 @@ func loadConfiguration() *config.Config {
 	k9sCfg.K9s.OverrideCrumbsless(*k9sFlags.Crumbsless)
 	k9sCfg.K9s.OverrideReadOnly(*k9sFlags.ReadOnly)
 	k9sCfg.K9s.OverrideWrite(*k9sFlags.Write)
+	k9sCfg.K9s.OverrideDryRun(*k9sFlags.DryRun)
+	k9sCfg.K9s.OverrideScreenReader(*k9sFlags.ScreenReader)
 	k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
+	k9sCfg.K9s.OverrideSelector(*k9sFlags.Selector)
 
 	if isBoolSet(k9sFlags.AllNamespaces) && k9sCfg.SetActiveNamespace(client.AllNamespaces) != nil {
 		log.Error().Msg("Setting active namespace")
@@ -141,6 +192,23 @@ func loadConfiguration() *config.Config {
 	return k9sCfg
 }
 
+// startSnapshot serves a cluster dump and points K9s to it in read-only mode.
+func startSnapshot(dir string) (*client.Snapshot, error) {
+	snap, err := client.LoadSnapshot(dir)
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, err := snap.Start()
+	if err != nil {
+		return nil, err
+	}
+	*k8sFlags.KubeConfig, *k8sFlags.Context = kubeconfig, client.SnapshotContext
+	*k8sFlags.ClusterName, *k8sFlags.AuthInfoName = "", ""
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+
+	return snap, nil
+}
+
 func isBoolSet(b *bool) bool {
 	return b != nil && *b
 }
@@ -198,6 +266,12 @@ func initK9sFlags() {
 		config.DefaultCommand,
 		"Specify the default command to view when the application launches",
 	)
+	rootCmd.Flags().StringVarP(
+		k9sFlags.Selector,
+		"selector", "L",
+		config.DefaultSelector,
+		"Filter the launch view using the given label selector, ie app=fred,tier!=db",
+	)
 	rootCmd.Flags().BoolVar(
 		k9sFlags.ReadOnly,
 		"readonly",
@@ -210,6 +284,45 @@ func initK9sFlags() {
 		false,
 		"Sets write mode by overriding the readOnly configuration setting",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.DryRun,
+		"dry-run",
+		false,
+		"Performs all mutating actions as server side dry-runs by overriding the dryRun configuration setting",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.ScreenReader,
+		"screen-reader",
+		false,
+		"Turns screen reader friendly mode on by overriding the screenReader configuration setting",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		config.DefaultSnapshot,
+		"Browse a directory of yaml/json resources dumps read-only, ie kubectl cluster-info dump output",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Record,
+		"record",
+		config.DefaultRecord,
+		"Records the session table snapshots, commands and logs into the given file",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Replay,
+		"replay",
+		config.DefaultReplay,
+		"Steps through a session recorded with --record. No cluster connection is needed",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.DebugServer,
+		"debug-server",
+		config.DefaultDebugServer,
+		"Starts a localhost pprof and runtime stats server on the given port",
+	)
+	if err := rootCmd.Flags().MarkHidden("debug-server"); err != nil {
+		panic(err)
+	}
 }
 
 func initK8sFlags() {
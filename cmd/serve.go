@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+)
+
+const defaultServeAddr = "localhost:8765"
+
+func serveCmd() *cobra.Command {
+	var addr string
+	command := cobra.Command{
+		Use:   "serve",
+		Short: "Serve resources over a local REST api",
+		Long:  "Serve resources list, describe and logs for the current context over a local REST api using K9s aliases, custom views and columns. Requests must carry the bearer token printed on startup",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+			if err := view.RunServer(loadConfiguration(), addr, os.Stdout); err != nil {
+				fmt.Fprintln(os.Stderr, color.Colorize(err.Error(), color.Red))
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().StringVar(
+		&addr,
+		"addr",
+		defaultServeAddr,
+		"Loopback address to serve the api on",
+	)
+	command.Flags().AddFlagSet(rootCmd.Flags())
+
+	return &command
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog/log"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
@@ -43,6 +44,7 @@ type APIClient struct {
 	mx           sync.Mutex
 	cache        *cache.LRUExpireCache
 	connOK       bool
+	unauthorized bool
 }
 
 // NewTestClient for testing ONLY!!
@@ -254,7 +256,9 @@ func (a *APIClient) CheckConnectivity() bool {
 	}
 
 	// Check connection
-	if _, err := client.ServerVersion(); err == nil {
+	_, err = client.ServerVersion()
+	a.unauthorized = kerrors.IsUnauthorized(err)
+	if err == nil {
 		if !a.connOK {
 			log.Debug().Msgf("RESETING CON!!")
 			a.reset()
@@ -417,6 +421,55 @@ func (a *APIClient) SwitchContext(name string) error {
 	return nil
 }
 
+// Impersonate reconnects as the given user and groups. A blank user reverts to
+// the kubeconfig credentials.
+func (a *APIClient) Impersonate(user string, groups []string) error {
+	log.Debug().Msgf("Impersonating %q %v", user, groups)
+	a.config.Impersonate(user, groups)
+	a.mx.Lock()
+	{
+		a.reset()
+	}
+	a.mx.Unlock()
+
+	if !a.CheckConnectivity() {
+		return fmt.Errorf("Unable to connect as %q", user)
+	}
+
+	return nil
+}
+
+// Unauthorized checks if the api server rejected the current credentials on
+// the last connectivity check.
+func (a *APIClient) Unauthorized() bool {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	return a.unauthorized
+}
+
+// Reauthenticate reconnects with refreshed credentials. Exec credential
+// plugins may reject the first call while their cached credentials get
+// renewed so the connection is checked twice.
+func (a *APIClient) Reauthenticate() error {
+	log.Debug().Msgf("Reauthenticating...")
+	for i := 0; i < 2; i++ {
+		a.mx.Lock()
+		{
+			a.reset()
+		}
+		a.mx.Unlock()
+		if a.CheckConnectivity() {
+			return nil
+		}
+		if !a.Unauthorized() {
+			return errors.New("Unable to connect to api server")
+		}
+	}
+
+	return errors.New("Credentials were rejected by the api server")
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
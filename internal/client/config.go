@@ -26,6 +26,10 @@ type Config struct {
 	clientConfig clientcmd.ClientConfig
 	rawConfig    *clientcmdapi.Config
 	restConfig   *restclient.Config
+	qps          float32
+	burst        int
+	throttle     *Throttle
+	stats        *APIStats
 	mutex        *sync.RWMutex
 }
 
@@ -33,10 +37,42 @@ type Config struct {
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
 		flags: f,
+		qps:   defaultQPS,
+		burst: defaultBurst,
+		stats: NewAPIStats(),
 		mutex: &sync.RWMutex{},
 	}
 }
 
+// SetRateLimits sets the api-server client queries per second and burst.
+func (c *Config) SetRateLimits(qps float32, burst int) {
+	if qps <= 0 || burst <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.qps, c.burst = qps, burst
+	if c.throttle != nil {
+		c.throttle.Stop()
+		c.throttle = nil
+	}
+	c.restConfig = nil
+}
+
+// Throttled returns the latest client side rate limiting wait if requests
+// were recently throttled.
+func (c *Config) Throttled() (time.Duration, bool) {
+	c.mutex.RLock()
+	t := c.throttle
+	c.mutex.RUnlock()
+	if t == nil {
+		return 0, false
+	}
+
+	return t.Throttled()
+}
+
 // CallTimeout returns the call timeout if set or the default if not set.
 func (c *Config) CallTimeout() time.Duration {
 	if c.flags.Timeout == nil {
@@ -70,6 +106,47 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ForContext returns a new k8s config targeting the given context. Kubeconfig
+// location, credentials overrides and rate limits carry over.
+func (c *Config) ForContext(name string) (*Config, error) {
+	if _, err := c.GetContext(name); err != nil {
+		return nil, fmt.Errorf("context %s does not exist", name)
+	}
+
+	f := genericclioptions.NewConfigFlags(false)
+	f.KubeConfig, f.CacheDir, f.Timeout = c.flags.KubeConfig, c.flags.CacheDir, c.flags.Timeout
+	f.Impersonate, f.ImpersonateGroup = c.flags.Impersonate, c.flags.ImpersonateGroup
+	f.Insecure, f.CAFile = c.flags.Insecure, c.flags.CAFile
+	f.Context = &name
+	cfg := NewConfig(f)
+	cfg.qps, cfg.burst = c.qps, c.burst
+
+	return cfg, nil
+}
+
+// Impersonate acts as the given user and groups. A blank user reverts to the
+// kubeconfig credentials.
+func (c *Config) Impersonate(user string, groups []string) {
+	if user == "" {
+		groups = nil
+	}
+	c.reset()
+	c.flags.Impersonate, c.flags.ImpersonateGroup = &user, &groups
+}
+
+// Impersonation returns the impersonated identity ie fred[g1,g2] if any.
+func (c *Config) Impersonation() (string, bool) {
+	u, err := c.ImpersonateUser()
+	if err != nil {
+		return "", false
+	}
+	if g, err := c.ImpersonateGroups(); err == nil {
+		u += "[" + g + "]"
+	}
+
+	return u, true
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -239,6 +316,32 @@ func (c *Config) CurrentUserName() (string, error) {
 	return "", errors.New("unable to locate current user")
 }
 
+// ExecCredential returns the kubeconfig user exec credential plugin if any.
+// Impersonation is ignored as plugins issue the kubeconfig user credentials.
+func (c *Config) ExecCredential() (*clientcmdapi.ExecConfig, bool) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return nil, false
+	}
+	current := cfg.CurrentContext
+	if isSet(c.flags.Context) {
+		current = *c.flags.Context
+	}
+	var user string
+	if ctx, ok := cfg.Contexts[current]; ok {
+		user = ctx.AuthInfo
+	}
+	if isSet(c.flags.AuthInfoName) {
+		user = *c.flags.AuthInfoName
+	}
+	info, ok := cfg.AuthInfos[user]
+	if !ok || info.Exec == nil {
+		return nil, false
+	}
+
+	return info.Exec, true
+}
+
 // CurrentNamespaceName retrieves the active namespace.
 func (c *Config) CurrentNamespaceName() (string, error) {
 	if isSet(c.flags.Namespace) {
@@ -301,8 +404,16 @@ func (c *Config) RawConfig() (clientcmdapi.Config, error) {
 	return *c.rawConfig, nil
 }
 
+// Stats returns the session api calls stats.
+func (c *Config) Stats() *APIStats {
+	return c.stats
+}
+
 // RESTConfig fetch the current REST api service connection.
 func (c *Config) RESTConfig() (*restclient.Config, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	if c.restConfig != nil {
 		return c.restConfig, nil
 	}
@@ -311,8 +422,12 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.restConfig, err = c.flags.ToRESTConfig(); err != nil {
 		return nil, err
 	}
-	c.restConfig.QPS = defaultQPS
-	c.restConfig.Burst = defaultBurst
+	if c.throttle == nil {
+		c.throttle = NewThrottle(c.qps, c.burst)
+	}
+	c.restConfig.QPS, c.restConfig.Burst = c.qps, c.burst
+	c.restConfig.RateLimiter = c.throttle
+	c.restConfig.Wrap(c.stats.Wrap)
 
 	return c.restConfig, nil
 }
@@ -3,6 +3,7 @@ package client_test
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -71,6 +72,43 @@ func TestConfigCurrentUser(t *testing.T) {
 	}
 }
 
+func TestConfigExecCredential(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	uu := map[string]struct {
+		user string
+		ok   bool
+		cmd  string
+		args []string
+	}{
+		"none": {},
+		"cert": {
+			user: "blee",
+		},
+		"exec": {
+			user: "sso",
+			ok:   true,
+			cmd:  "kubectl",
+			args: []string{"oidc-login", "get-token"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			flags := genericclioptions.ConfigFlags{KubeConfig: &kubeConfig}
+			if u.user != "" {
+				flags.AuthInfoName = &u.user
+			}
+			x, ok := client.NewConfig(&flags).ExecCredential()
+			assert.Equal(t, u.ok, ok)
+			if ok {
+				assert.Equal(t, u.cmd, x.Command)
+				assert.Equal(t, u.args, x.Args)
+			}
+		})
+	}
+}
+
 func TestConfigCurrentNamespace(t *testing.T) {
 	name, kubeConfig := "blee", "./testdata/config"
 	uu := []struct {
@@ -128,6 +166,68 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigImpersonate(t *testing.T) {
+	uu := map[string]struct {
+		user   string
+		groups []string
+		e      string
+		ok     bool
+	}{
+		"none": {},
+		"user": {
+			user: "fred",
+			e:    "fred",
+			ok:   true,
+		},
+		"groups": {
+			user:   "fred",
+			groups: []string{"g1", "g2"},
+			e:      "fred[g1,g2]",
+			ok:     true,
+		},
+		"groupsOnly": {
+			groups: []string{"g1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kubeConfig, user := "./testdata/config", "blee"
+			cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig, Impersonate: &user})
+			cfg.Impersonate(u.user, u.groups)
+			id, ok := cfg.Impersonation()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, id)
+		})
+	}
+}
+
+func TestConfigForContext(t *testing.T) {
+	cluster, kubeConfig := "duh", "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig:  &kubeConfig,
+		ClusterName: &cluster,
+	}
+
+	cfg := client.NewConfig(&flags)
+	peer, err := cfg.ForContext("blee")
+	assert.Nil(t, err)
+	ctx, err := peer.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+	cl, err := peer.CurrentClusterName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", cl)
+
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ctx)
+
+	_, err = cfg.ForContext("zorg")
+	assert.NotNil(t, err)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
@@ -220,6 +320,57 @@ func TestConfigRestConfig(t *testing.T) {
 	assert.Equal(t, "https://localhost:3000", rc.Host)
 }
 
+func TestConfigRateLimits(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	cfg.SetRateLimits(10, 20)
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, float32(10), rc.QPS)
+	assert.Equal(t, 20, rc.Burst)
+	assert.Equal(t, float32(10), rc.RateLimiter.QPS())
+
+	_, ok := cfg.Throttled()
+	assert.False(t, ok)
+}
+
+func TestConfigRateLimitsConcurrent(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig: &kubeConfig,
+	}
+
+	cfg := client.NewConfig(&flags)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	done := make(chan struct{})
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				_, _ = cfg.Throttled()
+			}
+		}
+	}()
+	for i := 1; i <= 50; i++ {
+		cfg.SetRateLimits(float32(i), i)
+		_, _ = cfg.RESTConfig()
+	}
+	close(done)
+	wg.Wait()
+
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, float32(50), rc.QPS)
+}
+
 func TestConfigBadConfig(t *testing.T) {
 	kubeConfig := "./testdata/bork_config"
 	flags := genericclioptions.ConfigFlags{
@@ -0,0 +1,42 @@
+package client
+
+import "sync"
+
+// flightGroup coalesces concurrent calls sharing the same key.
+type flightGroup struct {
+	calls map[string]*flightCall
+	mx    sync.Mutex
+}
+
+type flightCall struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// Do runs f once for all concurrent callers of a given key.
+func (g *flightGroup) Do(key string, f func() (interface{}, error)) (interface{}, error) {
+	g.mx.Lock()
+	if c, ok := g.calls[key]; ok {
+		g.mx.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := new(flightCall)
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mx.Unlock()
+
+	c.val, c.err = f()
+	c.wg.Done()
+
+	g.mx.Lock()
+	delete(g.calls, key)
+	g.mx.Unlock()
+
+	return c.val, c.err
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlightGroupCoalesce(t *testing.T) {
+	g := newFlightGroup()
+
+	var (
+		calls int32
+		wg    sync.WaitGroup
+	)
+	release := make(chan struct{})
+	f := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "blee", nil
+	}
+
+	wg.Add(5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			defer wg.Done()
+			v, err := g.Do("k1", f)
+			assert.Nil(t, err)
+			assert.Equal(t, "blee", v)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestFlightGroupSequential(t *testing.T) {
+	g := newFlightGroup()
+
+	var calls int
+	f := func() (interface{}, error) {
+		calls++
+		return nil, errors.New("boom")
+	}
+	_, err := g.Do("k1", f)
+	assert.Error(t, err)
+	_, err = g.Do("k1", f)
+	assert.Error(t, err)
+
+	assert.Equal(t, 2, calls)
+}
@@ -5,18 +5,14 @@ import (
 	"fmt"
 	"math"
 	"strconv"
-	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/cache"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
-const (
-	mxCacheSize   = 100
-	mxCacheExpiry = 1 * time.Minute
-)
+// nodesMetricsKey tracks the nodes metrics requests key.
+const nodesMetricsKey = "nodes"
 
 // MetricsDial tracks global metric server handle.
 var MetricsDial *MetricsServer
@@ -39,17 +35,33 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	flights *flightGroup
 }
 
 // NewMetricsServer return a metric server instance.
 func NewMetricsServer(c Connection) *MetricsServer {
 	return &MetricsServer{
 		Connection: c,
-		cache:      cache.NewLRUExpireCache(mxCacheSize),
+		flights:    newFlightGroup(),
 	}
 }
 
+// podsMetricsKey returns the pods metrics requests key for a given namespace.
+func podsMetricsKey(ns string) string {
+	if ns == NamespaceAll {
+		ns = AllNamespaces
+	}
+
+	return FQN(ns, "pods")
+}
+
+// fetch retrieves metrics. Concurrent requests for the same key are
+// coalesced into a single metrics-server call. Metrics are cached by the
+// model metrics cache.
+func (m *MetricsServer) fetch(key string, f func() (interface{}, error)) (interface{}, error) {
+	return m.flights.Do(key, f)
+}
+
 // ClusterLoad retrieves all cluster nodes metrics.
 func (m *MetricsServer) ClusterLoad(nos *v1.NodeList, nmx *mv1beta1.NodeMetricsList, mx *ClusterMetrics) error {
 	if nos == nil || nmx == nil {
@@ -139,24 +151,20 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mx, err
 	}
 
-	const key = "nodes"
-	if entry, ok := m.cache.Get(key); ok && entry != nil {
-		mxList, ok := entry.(*mv1beta1.NodeMetricsList)
-		if !ok {
-			return nil, fmt.Errorf("expected nodemetricslist but got %T", entry)
+	o, err := m.fetch(nodesMetricsKey, func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
 		}
-		return mxList, nil
-	}
-
-	client, err := m.MXDial()
+		return client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mxList, err := client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return mx, err
+	mxList, ok := o.(*mv1beta1.NodeMetricsList)
+	if !ok {
+		return nil, fmt.Errorf("expected nodemetricslist but got %T", o)
 	}
-	m.cache.Add(key, mxList, mxCacheExpiry)
 
 	return mxList, nil
 }
@@ -173,26 +181,22 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mx, err
 	}
 
-	key := FQN(ns, "pods")
-	if entry, ok := m.cache.Get(key); ok {
-		mxList, ok := entry.(*mv1beta1.PodMetricsList)
-		if !ok {
-			return mx, fmt.Errorf("expected podmetricslist but got %T", entry)
+	o, err := m.fetch(podsMetricsKey(ns), func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
 		}
-		return mxList, nil
-	}
-
-	client, err := m.MXDial()
+		return client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mxList, err := client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return mx, err
+	mxList, ok := o.(*mv1beta1.PodMetricsList)
+	if !ok {
+		return mx, fmt.Errorf("expected podmetricslist but got %T", o)
 	}
-	m.cache.Add(key, mxList, mxCacheExpiry)
 
-	return mxList, err
+	return mxList, nil
 }
 
 // FetchPodMetrics return all metrics for pods in a given namespace.
@@ -208,25 +212,22 @@ func (m *MetricsServer) FetchPodMetrics(ctx context.Context, fqn string) (*mv1be
 		return mx, err
 	}
 
-	if entry, ok := m.cache.Get(fqn); ok {
-		pmx, ok := entry.(*mv1beta1.PodMetrics)
-		if !ok {
-			return nil, fmt.Errorf("expecting podmetrics but got %T", entry)
+	o, err := m.fetch("pod:"+fqn, func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
 		}
-		return pmx, nil
-	}
-
-	client, err := m.MXDial()
+		return client.MetricsV1beta1().PodMetricses(ns).Get(ctx, n, metav1.GetOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mx, err = client.MetricsV1beta1().PodMetricses(ns).Get(ctx, n, metav1.GetOptions{})
-	if err != nil {
-		return mx, err
+	pmx, ok := o.(*mv1beta1.PodMetrics)
+	if !ok {
+		return nil, fmt.Errorf("expecting podmetrics but got %T", o)
 	}
-	m.cache.Add(fqn, mx, mxCacheExpiry)
 
-	return mx, nil
+	return pmx, nil
 }
 
 // PodsMetrics retrieves metrics for all pods in a given namespace.
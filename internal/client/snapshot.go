@@ -0,0 +1,436 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// SnapshotContext represents the kubeconfig context name of a snapshot.
+	SnapshotContext = "snapshot"
+
+	snapshotRV      = "1"
+	snapshotLogFile = "logs.txt"
+)
+
+var kubeletVersionRX = regexp.MustCompile(`\Av?(\d+)\.(\d+)`)
+
+// Snapshot serves a cluster resources dump, ie kubectl cluster-info dump or
+// a support bundle, thru a local read-only api server.
+type Snapshot struct {
+	dir        string
+	kubeconfig string
+	version    string
+	resources  map[schema.GroupVersion][]metav1.APIResource
+	objects    map[schema.GroupVersionResource]map[string]*unstructured.Unstructured
+	logs       map[string]string
+	srv        *http.Server
+}
+
+// LoadSnapshot loads all yaml and json manifests found in a directory.
+func LoadSnapshot(dir string) (*Snapshot, error) {
+	s := Snapshot{
+		dir:       dir,
+		resources: make(map[schema.GroupVersion][]metav1.APIResource),
+		objects:   make(map[schema.GroupVersionResource]map[string]*unstructured.Unstructured),
+		logs:      make(map[string]string),
+	}
+	for _, r := range snapshotResources {
+		s.addResource(r.gv, r.res)
+	}
+
+	var oo []*unstructured.Unstructured
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".json", ".yaml", ".yml":
+			o, err := loadSnapshotFile(p)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Skipping snapshot file %q", p)
+				return nil
+			}
+			oo = append(oo, o...)
+		case ".txt", ".log":
+			s.addLogs(p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, fmt.Errorf("no resources found in snapshot %q", dir)
+	}
+
+	// CRDs go first so custom resources pick up their names and scope.
+	for _, o := range oo {
+		if o.GroupVersionKind().GroupKind() == crdGK {
+			s.addCRD(o)
+		}
+	}
+	for _, o := range oo {
+		s.add(o)
+	}
+	s.addNamespaces()
+	log.Info().Msgf("Loaded %d resources from snapshot %q", len(oo), dir)
+
+	return &s, nil
+}
+
+// Start serves the snapshot on an ephemeral local port and returns the
+// path of a kubeconfig targeting it.
+func (s *Snapshot) Start() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	s.srv = &http.Server{Handler: s}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("Snapshot server failed")
+		}
+	}()
+
+	f, err := ioutil.TempFile("", "k9s-snapshot-*.yml")
+	if err != nil {
+		s.Close()
+		return "", err
+	}
+	f.Close()
+	s.kubeconfig = f.Name()
+	if err := clientcmd.WriteToFile(snapshotKubeConfig("http://"+l.Addr().String()), s.kubeconfig); err != nil {
+		s.Close()
+		return "", err
+	}
+
+	return s.kubeconfig, nil
+}
+
+// Close stops the snapshot server and removes its kubeconfig.
+func (s *Snapshot) Close() {
+	if s.srv != nil {
+		if err := s.srv.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing snapshot server")
+		}
+	}
+	if s.kubeconfig != "" {
+		if err := os.Remove(s.kubeconfig); err != nil {
+			log.Error().Err(err).Msg("Removing snapshot kubeconfig")
+		}
+	}
+}
+
+func (s *Snapshot) addResource(gv schema.GroupVersion, r metav1.APIResource) {
+	for _, res := range s.resources[gv] {
+		if res.Name == r.Name {
+			return
+		}
+	}
+	if r.SingularName == "" {
+		r.SingularName = strings.ToLower(r.Kind)
+	}
+	r.Verbs = metav1.Verbs(ReadAllAccess)
+	s.resources[gv] = append(s.resources[gv], r)
+}
+
+// resourceFor returns a kind resource, guessing it when not yet known.
+func (s *Snapshot) resourceFor(gvk schema.GroupVersionKind, namespaced bool) metav1.APIResource {
+	gv := gvk.GroupVersion()
+	for _, r := range s.resources[gv] {
+		if r.Kind == gvk.Kind {
+			return r
+		}
+	}
+	plural, _ := meta.UnsafeGuessKindToResource(gvk)
+	r := metav1.APIResource{Name: plural.Resource, Kind: gvk.Kind, Namespaced: namespaced}
+	s.addResource(gv, r)
+
+	return r
+}
+
+func (s *Snapshot) add(o *unstructured.Unstructured) {
+	gvk := o.GroupVersionKind()
+	if gvk.Kind == "" || gvk.Version == "" || o.GetName() == "" {
+		return
+	}
+	r := s.resourceFor(gvk, o.GetNamespace() != "")
+	gvr := gvk.GroupVersion().WithResource(r.Name)
+	if s.objects[gvr] == nil {
+		s.objects[gvr] = make(map[string]*unstructured.Unstructured)
+	}
+	if !r.Namespaced {
+		o.SetNamespace("")
+	}
+	s.objects[gvr][FQN(o.GetNamespace(), o.GetName())] = o
+
+	if gvk.Kind == "Node" && s.version == "" {
+		s.version, _, _ = unstructured.NestedString(o.Object, "status", "nodeInfo", "kubeletVersion")
+	}
+}
+
+// addCRD registers a custom resource definition served versions.
+func (s *Snapshot) addCRD(o *unstructured.Unstructured) {
+	group, _, _ := unstructured.NestedString(o.Object, "spec", "group")
+	scope, _, _ := unstructured.NestedString(o.Object, "spec", "scope")
+	names, _, _ := unstructured.NestedMap(o.Object, "spec", "names")
+	r := metav1.APIResource{
+		Name:       fmt.Sprintf("%v", names["plural"]),
+		Kind:       fmt.Sprintf("%v", names["kind"]),
+		Namespaced: scope != "Cluster",
+	}
+	if v, ok := names["singular"].(string); ok {
+		r.SingularName = v
+	}
+	if vv, ok := names["shortNames"].([]interface{}); ok {
+		for _, v := range vv {
+			r.ShortNames = append(r.ShortNames, fmt.Sprintf("%v", v))
+		}
+	}
+	for _, v := range crdVersions(o) {
+		s.addResource(schema.GroupVersion{Group: group, Version: v}, r)
+	}
+
+	// Serves definitions under all apiextensions versions.
+	for _, v := range []string{"v1", "v1beta1"} {
+		if o.GroupVersionKind().Version == v {
+			continue
+		}
+		c := o.DeepCopy()
+		c.SetAPIVersion(crdGK.Group + "/" + v)
+		s.add(c)
+	}
+}
+
+// addNamespaces synthesizes the namespaces missing from the snapshot.
+func (s *Snapshot) addNamespaces() {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	if s.objects[gvr] == nil {
+		s.objects[gvr] = make(map[string]*unstructured.Unstructured)
+	}
+	for _, oo := range s.objects {
+		for _, o := range oo {
+			ns := o.GetNamespace()
+			if ns == "" {
+				continue
+			}
+			if _, ok := s.objects[gvr][FQN("", ns)]; ok {
+				continue
+			}
+			var n unstructured.Unstructured
+			n.SetAPIVersion("v1")
+			n.SetKind("Namespace")
+			n.SetName(ns)
+			n.SetResourceVersion(snapshotRV)
+			_ = unstructured.SetNestedField(n.Object, "Active", "status", "phase")
+			s.objects[gvr][FQN("", ns)] = &n
+		}
+	}
+}
+
+// addLogs indexes captured pod logs. Logs are either a ns/pod/logs.txt file
+// holding all containers logs or a ns/pod/container.log file.
+func (s *Snapshot) addLogs(p string) {
+	rel, err := filepath.Rel(s.dir, p)
+	if err != nil {
+		return
+	}
+	tokens := strings.Split(filepath.ToSlash(rel), "/")
+	if len(tokens) != 3 {
+		return
+	}
+	if tokens[2] == snapshotLogFile {
+		s.logs[tokens[0]+"/"+tokens[1]] = p
+		return
+	}
+	if filepath.Ext(tokens[2]) == ".log" {
+		s.logs[strings.Join(tokens[:2], "/")+"/"+strings.TrimSuffix(tokens[2], ".log")] = p
+	}
+}
+
+// list returns a resource objects matching a namespace sorted by path.
+func (s *Snapshot) list(gvr schema.GroupVersionResource, ns string) []*unstructured.Unstructured {
+	oo := make([]*unstructured.Unstructured, 0, len(s.objects[gvr]))
+	for _, o := range s.objects[gvr] {
+		if ns == "" || o.GetNamespace() == ns {
+			oo = append(oo, o)
+		}
+	}
+	sort.Slice(oo, func(i, j int) bool {
+		return FQN(oo[i].GetNamespace(), oo[i].GetName()) < FQN(oo[j].GetNamespace(), oo[j].GetName())
+	})
+
+	return oo
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+var crdGK = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+func crdVersions(o *unstructured.Unstructured) []string {
+	var vv []string
+	if v, ok, _ := unstructured.NestedString(o.Object, "spec", "version"); ok && v != "" {
+		vv = append(vv, v)
+	}
+	versions, _, _ := unstructured.NestedSlice(o.Object, "spec", "versions")
+	for _, v := range versions {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if n, ok := m["name"].(string); ok && n != "" {
+			vv = append(vv, n)
+		}
+	}
+
+	return vv
+}
+
+func loadSnapshotFile(p string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeObjects(f)
+}
+
+// decodeObjects decodes a yaml or json stream, flattening lists.
+func decodeObjects(r io.Reader) ([]*unstructured.Unstructured, error) {
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	var oo []*unstructured.Unstructured
+	for {
+		var m map[string]interface{}
+		err := d.Decode(&m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(m) == 0 {
+			continue
+		}
+		oo = append(oo, flattenList(&unstructured.Unstructured{Object: m})...)
+	}
+
+	return oo, nil
+}
+
+// flattenList returns a list items, defaulting their api version and kind
+// from the list ones. Dumped list items often omit them.
+func flattenList(u *unstructured.Unstructured) []*unstructured.Unstructured {
+	items, ok := u.Object["items"].([]interface{})
+	if !ok {
+		return []*unstructured.Unstructured{u}
+	}
+	kind := strings.TrimSuffix(u.GetKind(), "List")
+	oo := make([]*unstructured.Unstructured, 0, len(items))
+	for _, it := range items {
+		m, ok := it.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		o := unstructured.Unstructured{Object: m}
+		if o.GetAPIVersion() == "" {
+			o.SetAPIVersion(u.GetAPIVersion())
+		}
+		if o.GetKind() == "" && kind != "" {
+			o.SetKind(kind)
+		}
+		oo = append(oo, flattenList(&o)...)
+	}
+
+	return oo
+}
+
+func snapshotKubeConfig(server string) clientcmdapi.Config {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[SnapshotContext] = &clientcmdapi.Cluster{Server: server}
+	cfg.AuthInfos[SnapshotContext] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[SnapshotContext] = &clientcmdapi.Context{
+		Cluster:  SnapshotContext,
+		AuthInfo: SnapshotContext,
+	}
+	cfg.CurrentContext = SnapshotContext
+
+	return *cfg
+}
+
+type snapshotResource struct {
+	gv  schema.GroupVersion
+	res metav1.APIResource
+}
+
+func snapRes(gv, name, kind string, namespaced bool, shortNames ...string) snapshotResource {
+	g, _ := schema.ParseGroupVersion(gv)
+	return snapshotResource{
+		gv: g,
+		res: metav1.APIResource{
+			Name:       name,
+			Kind:       kind,
+			Namespaced: namespaced,
+			ShortNames: shortNames,
+		},
+	}
+}
+
+// snapshotResources lists the built-in resources always served so k9s core
+// views load even when the snapshot does not include them.
+var snapshotResources = []snapshotResource{
+	snapRes("v1", "pods", "Pod", true, "po"),
+	snapRes("v1", "services", "Service", true, "svc"),
+	snapRes("v1", "nodes", "Node", false, "no"),
+	snapRes("v1", "namespaces", "Namespace", false, "ns"),
+	snapRes("v1", "events", "Event", true, "ev"),
+	snapRes("v1", "configmaps", "ConfigMap", true, "cm"),
+	snapRes("v1", "secrets", "Secret", true),
+	snapRes("v1", "serviceaccounts", "ServiceAccount", true, "sa"),
+	snapRes("v1", "endpoints", "Endpoints", true, "ep"),
+	snapRes("v1", "persistentvolumes", "PersistentVolume", false, "pv"),
+	snapRes("v1", "persistentvolumeclaims", "PersistentVolumeClaim", true, "pvc"),
+	snapRes("v1", "replicationcontrollers", "ReplicationController", true, "rc"),
+	snapRes("v1", "resourcequotas", "ResourceQuota", true, "quota"),
+	snapRes("v1", "limitranges", "LimitRange", true, "limits"),
+	snapRes("apps/v1", "deployments", "Deployment", true, "deploy"),
+	snapRes("apps/v1", "replicasets", "ReplicaSet", true, "rs"),
+	snapRes("apps/v1", "statefulsets", "StatefulSet", true, "sts"),
+	snapRes("apps/v1", "daemonsets", "DaemonSet", true, "ds"),
+	snapRes("batch/v1", "jobs", "Job", true),
+	snapRes("batch/v1beta1", "cronjobs", "CronJob", true, "cj"),
+	snapRes("autoscaling/v1", "horizontalpodautoscalers", "HorizontalPodAutoscaler", true, "hpa"),
+	snapRes("networking.k8s.io/v1", "networkpolicies", "NetworkPolicy", true, "netpol"),
+	snapRes("networking.k8s.io/v1beta1", "ingresses", "Ingress", true, "ing"),
+	snapRes("policy/v1beta1", "poddisruptionbudgets", "PodDisruptionBudget", true, "pdb"),
+	snapRes("rbac.authorization.k8s.io/v1", "roles", "Role", true),
+	snapRes("rbac.authorization.k8s.io/v1", "rolebindings", "RoleBinding", true),
+	snapRes("rbac.authorization.k8s.io/v1", "clusterroles", "ClusterRole", false),
+	snapRes("rbac.authorization.k8s.io/v1", "clusterrolebindings", "ClusterRoleBinding", false),
+	snapRes("storage.k8s.io/v1", "storageclasses", "StorageClass", false, "sc"),
+	snapRes("apiextensions.k8s.io/v1", "customresourcedefinitions", "CustomResourceDefinition", false, "crd", "crds"),
+	snapRes("apiextensions.k8s.io/v1beta1", "customresourcedefinitions", "CustomResourceDefinition", false, "crd", "crds"),
+}
@@ -0,0 +1,358 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+var errSnapshotReadOnly = errors.New("snapshot mode is read-only")
+
+// ServeHTTP serves the snapshot discovery and resources read requests.
+// Mutations are rejected.
+func (s *Snapshot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p := strings.Trim(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(p, "/selfsubjectaccessreviews"):
+		s.serveReview(w, r)
+	case r.Method != http.MethodGet:
+		writeStatus(w, apierrors.NewForbidden(schema.GroupResource{}, p, errSnapshotReadOnly))
+	case p == "version":
+		s.serveVersion(w)
+	case p == "api":
+		writeJSON(w, http.StatusOK, metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case p == "apis":
+		writeJSON(w, http.StatusOK, s.groups())
+	default:
+		s.serveResource(w, r, p)
+	}
+}
+
+func (s *Snapshot) serveVersion(w http.ResponseWriter) {
+	info := version.Info{
+		Major:      "1",
+		Minor:      "18",
+		GitVersion: "v1.18.0+snapshot",
+		Platform:   runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if m := kubeletVersionRX.FindStringSubmatch(s.version); m != nil {
+		info.Major, info.Minor, info.GitVersion = m[1], m[2], s.version
+	}
+	writeJSON(w, http.StatusOK, info)
+}
+
+// serveReview allows read access only.
+func (s *Snapshot) serveReview(w http.ResponseWriter, r *http.Request) {
+	var o unstructured.Unstructured
+	if err := json.NewDecoder(r.Body).Decode(&o.Object); err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	verb, _, _ := unstructured.NestedString(o.Object, "spec", "resourceAttributes", "verb")
+	allowed := isReadVerb(verb)
+	_ = unstructured.SetNestedField(o.Object, allowed, "status", "allowed")
+	if !allowed {
+		_ = unstructured.SetNestedField(o.Object, errSnapshotReadOnly.Error(), "status", "reason")
+	}
+	writeJSON(w, http.StatusCreated, o.Object)
+}
+
+func (s *Snapshot) groups() metav1.APIGroupList {
+	versions := make(map[string][]string)
+	for gv := range s.resources {
+		if gv.Group != "" {
+			versions[gv.Group] = append(versions[gv.Group], gv.Version)
+		}
+	}
+	gg := make([]string, 0, len(versions))
+	for g := range versions {
+		gg = append(gg, g)
+	}
+	sort.Strings(gg)
+
+	l := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	for _, g := range gg {
+		vv := versions[g]
+		sort.Slice(vv, func(i, j int) bool {
+			return version.CompareKubeAwareVersionStrings(vv[i], vv[j]) > 0
+		})
+		group := metav1.APIGroup{Name: g}
+		for _, v := range vv {
+			group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{
+				GroupVersion: g + "/" + v,
+				Version:      v,
+			})
+		}
+		group.PreferredVersion = group.Versions[0]
+		l.Groups = append(l.Groups, group)
+	}
+
+	return l
+}
+
+// serveResource serves paths ie /api/v1/namespaces/ns/pods/name/log.
+func (s *Snapshot) serveResource(w http.ResponseWriter, r *http.Request, p string) {
+	gv, rest, ok := parseAPIPath(p)
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{}, p))
+		return
+	}
+	rr, ok := s.resources[gv]
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Group: gv.Group}, gv.String()))
+		return
+	}
+	if len(rest) == 0 {
+		writeJSON(w, http.StatusOK, metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: gv.String(),
+			APIResources: rr,
+		})
+		return
+	}
+
+	var ns string
+	if rest[0] == "namespaces" && len(rest) >= 3 {
+		ns, rest = rest[1], rest[2:]
+	}
+	res, ok := findResource(rr, rest[0])
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(gv.WithResource(rest[0]).GroupResource(), ""))
+		return
+	}
+	gvr := gv.WithResource(res.Name)
+	switch len(rest) {
+	case 1:
+		s.serveList(w, r, gvr, res, ns)
+	case 2:
+		o, ok := s.objects[gvr][FQN(ns, rest[1])]
+		if !ok {
+			writeStatus(w, apierrors.NewNotFound(gvr.GroupResource(), rest[1]))
+			return
+		}
+		writeJSON(w, http.StatusOK, o.Object)
+	case 3:
+		if gvr.Resource == "pods" && rest[2] == "log" {
+			s.serveLogs(w, r, FQN(ns, rest[1]))
+			return
+		}
+		fallthrough
+	default:
+		writeStatus(w, apierrors.NewNotFound(gvr.GroupResource(), strings.Join(rest[1:], "/")))
+	}
+}
+
+func (s *Snapshot) serveList(w http.ResponseWriter, r *http.Request, gvr schema.GroupVersionResource, res metav1.APIResource, ns string) {
+	q := r.URL.Query()
+	if q.Get("watch") == "true" || q.Get("watch") == "1" {
+		serveWatch(w, r)
+		return
+	}
+	lsel, err := labels.Parse(q.Get("labelSelector"))
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	fsel, err := fields.ParseSelector(q.Get("fieldSelector"))
+	if err != nil {
+		writeStatus(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	items := make([]interface{}, 0, len(s.objects[gvr]))
+	for _, o := range s.list(gvr, ns) {
+		if lsel.Matches(labels.Set(o.GetLabels())) && matchFields(o, fsel) {
+			items = append(items, o.Object)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"apiVersion": gvr.GroupVersion().String(),
+		"kind":       res.Kind + "List",
+		"metadata":   map[string]interface{}{"resourceVersion": snapshotRV},
+		"items":      items,
+	})
+}
+
+// serveWatch holds watches open without ever sending events since a
+// snapshot does not change.
+func serveWatch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	timeout, err := strconv.Atoi(r.URL.Query().Get("timeoutSeconds"))
+	if err != nil || timeout <= 0 {
+		<-r.Context().Done()
+		return
+	}
+	select {
+	case <-r.Context().Done():
+	case <-time.After(time.Duration(timeout) * time.Second):
+	}
+}
+
+func (s *Snapshot) serveLogs(w http.ResponseWriter, r *http.Request, fqn string) {
+	q := r.URL.Query()
+	co := q.Get("container")
+	p, ok := s.logs[fqn+"/"+co]
+	if !ok {
+		p, ok = s.logs[fqn]
+	}
+	if !ok {
+		writeStatus(w, apierrors.NewNotFound(schema.GroupResource{Resource: "pods/log"}, fqn))
+		return
+	}
+	bb, err := ioutil.ReadFile(p)
+	if err != nil {
+		writeStatus(w, apierrors.NewInternalError(err))
+		return
+	}
+	logs := containerLogs(string(bb), co)
+	if n, err := strconv.Atoi(q.Get("tailLines")); err == nil {
+		logs = tailLines(logs, n)
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(logs)); err != nil {
+		log.Warn().Err(err).Msgf("Serving snapshot logs %q", fqn)
+		return
+	}
+	if q.Get("follow") == "true" {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		<-r.Context().Done()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseAPIPath splits an api path into its group version and resource tokens.
+func parseAPIPath(p string) (schema.GroupVersion, []string, bool) {
+	tokens := strings.Split(p, "/")
+	switch {
+	case tokens[0] == "api" && len(tokens) >= 2:
+		return schema.GroupVersion{Version: tokens[1]}, tokens[2:], true
+	case tokens[0] == "apis" && len(tokens) >= 3:
+		return schema.GroupVersion{Group: tokens[1], Version: tokens[2]}, tokens[3:], true
+	default:
+		return schema.GroupVersion{}, nil, false
+	}
+}
+
+func isReadVerb(verb string) bool {
+	for _, v := range ReadAllAccess {
+		if v == verb {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findResource(rr []metav1.APIResource, name string) (metav1.APIResource, bool) {
+	for _, r := range rr {
+		if r.Name == name {
+			return r, true
+		}
+	}
+
+	return metav1.APIResource{}, false
+}
+
+// matchFields checks an object against a field selector. Any object field
+// path is supported, not just the ones indexed by the api server.
+func matchFields(o *unstructured.Unstructured, sel fields.Selector) bool {
+	for _, r := range sel.Requirements() {
+		v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		var val string
+		if ok && v != nil {
+			val = fmt.Sprintf("%v", v)
+		}
+		switch r.Operator {
+		case selection.Equals, selection.DoubleEquals:
+			if val != r.Value {
+				return false
+			}
+		case selection.NotEquals:
+			if val == r.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// containerLogs extracts a container logs from a kubectl cluster-info dump
+// pod logs file holding all the pod containers logs.
+func containerLogs(logs, co string) string {
+	if co == "" {
+		return logs
+	}
+	start := strings.Index(logs, "==== START logs for container "+co+" of pod ")
+	if start < 0 {
+		return logs
+	}
+	logs = logs[start:]
+	if i := strings.Index(logs, "\n"); i >= 0 {
+		logs = logs[i+1:]
+	}
+	if end := strings.Index(logs, "==== END logs for container "+co+" of pod "); end >= 0 {
+		logs = logs[:end]
+	}
+
+	return logs
+}
+
+func tailLines(logs string, n int) string {
+	if n < 0 {
+		return logs
+	}
+	ll := strings.SplitAfter(strings.TrimSuffix(logs, "\n"), "\n")
+	if n >= len(ll) {
+		return logs
+	}
+	if n == 0 {
+		return ""
+	}
+
+	return strings.Join(ll[len(ll)-n:], "") + "\n"
+}
+
+func writeStatus(w http.ResponseWriter, err *apierrors.StatusError) {
+	st := err.Status()
+	st.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	writeJSON(w, int(st.Code), st)
+}
+
+func writeJSON(w http.ResponseWriter, code int, o interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(o); err != nil {
+		log.Error().Err(err).Msg("Serving snapshot")
+	}
+}
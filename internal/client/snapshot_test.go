@@ -0,0 +1,217 @@
+package client_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadSnapshotEmpty(t *testing.T) {
+	_, err := client.LoadSnapshot("testdata/config")
+
+	assert.Error(t, err)
+}
+
+func TestSnapshotList(t *testing.T) {
+	srv := newSnapshotServer(t)
+	defer srv.Close()
+
+	uu := map[string]struct {
+		path  string
+		names []string
+	}{
+		"allPods": {
+			path:  "/api/v1/pods",
+			names: []string{"nginx-1", "redis-1"},
+		},
+		"nsPods": {
+			path:  "/api/v1/namespaces/apps/pods",
+			names: []string{},
+		},
+		"nodes": {
+			path:  "/api/v1/nodes",
+			names: []string{"n1"},
+		},
+		"namespaces": {
+			path:  "/api/v1/namespaces",
+			names: []string{"apps", "default"},
+		},
+		"labels": {
+			path:  "/api/v1/pods?labelSelector=app%3Dnginx",
+			names: []string{"nginx-1"},
+		},
+		"fields": {
+			path:  "/api/v1/namespaces/default/pods?fieldSelector=status.phase!%3DRunning",
+			names: []string{"redis-1"},
+		},
+		"deployments": {
+			path:  "/apis/apps/v1/namespaces/apps/deployments",
+			names: []string{"blee"},
+		},
+		"crds": {
+			path:  "/apis/apiextensions.k8s.io/v1beta1/customresourcedefinitions",
+			names: []string{"freds.k9s.io"},
+		},
+		"customResources": {
+			path:  "/apis/k9s.io/v1/freds",
+			names: []string{"fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			code, o := getJSON(t, srv.URL+u.path)
+			assert.Equal(t, http.StatusOK, code)
+			items, _ := o["items"].([]interface{})
+			names := make([]string, 0, len(items))
+			for _, it := range items {
+				m := it.(map[string]interface{})
+				names = append(names, m["metadata"].(map[string]interface{})["name"].(string))
+				assert.NotEmpty(t, m["kind"])
+			}
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
+
+func TestSnapshotGet(t *testing.T) {
+	srv := newSnapshotServer(t)
+	defer srv.Close()
+
+	code, o := getJSON(t, srv.URL+"/api/v1/namespaces/default/pods/nginx-1")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Pod", o["kind"])
+
+	code, o = getJSON(t, srv.URL+"/api/v1/namespaces/default/pods/zorg")
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "NotFound", o["reason"])
+}
+
+func TestSnapshotDiscovery(t *testing.T) {
+	srv := newSnapshotServer(t)
+	defer srv.Close()
+
+	code, o := getJSON(t, srv.URL+"/version")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "v1.17.3", o["gitVersion"])
+	assert.Equal(t, "17", o["minor"])
+
+	code, o = getJSON(t, srv.URL+"/apis/k9s.io/v1")
+	assert.Equal(t, http.StatusOK, code)
+	rr := o["resources"].([]interface{})
+	assert.Equal(t, 1, len(rr))
+	r := rr[0].(map[string]interface{})
+	assert.Equal(t, "freds", r["name"])
+	assert.Equal(t, true, r["namespaced"])
+	assert.Equal(t, []interface{}{"fr"}, r["shortNames"])
+
+	_, o = getJSON(t, srv.URL+"/apis")
+	for _, g := range o["groups"].([]interface{}) {
+		m := g.(map[string]interface{})
+		if m["name"] == "apiextensions.k8s.io" {
+			assert.Equal(t, "v1", m["preferredVersion"].(map[string]interface{})["version"])
+		}
+	}
+}
+
+func TestSnapshotReadOnly(t *testing.T) {
+	srv := newSnapshotServer(t)
+	defer srv.Close()
+
+	req, err := http.NewRequest(http.MethodDelete, srv.URL+"/api/v1/namespaces/default/pods/nginx-1", nil)
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	uu := map[string]struct {
+		verb    string
+		allowed bool
+	}{
+		"get":    {verb: "get", allowed: true},
+		"watch":  {verb: "watch", allowed: true},
+		"delete": {verb: "delete", allowed: false},
+		"create": {verb: "create", allowed: false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			body := `{"kind":"SelfSubjectAccessReview","apiVersion":"authorization.k8s.io/v1","spec":{"resourceAttributes":{"verb":"` + u.verb + `"}}}`
+			resp, err := http.Post(srv.URL+"/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", "application/json", strings.NewReader(body))
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			var o map[string]interface{}
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&o))
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			assert.Equal(t, u.allowed, o["status"].(map[string]interface{})["allowed"])
+		})
+	}
+}
+
+func TestSnapshotLogs(t *testing.T) {
+	srv := newSnapshotServer(t)
+	defer srv.Close()
+
+	uu := map[string]struct {
+		query, e string
+		code     int
+	}{
+		"container": {
+			query: "?container=sidecar",
+			e:     "sidecar 1\n",
+			code:  http.StatusOK,
+		},
+		"tail": {
+			query: "?container=nginx&tailLines=2",
+			e:     "nginx 2\nnginx 3\n",
+			code:  http.StatusOK,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + "/api/v1/namespaces/default/pods/nginx-1/log" + u.query)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			bb, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, u.code, resp.StatusCode)
+			assert.Equal(t, u.e, string(bb))
+		})
+	}
+
+	resp, err := http.Get(srv.URL + "/api/v1/namespaces/default/pods/redis-1/log")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newSnapshotServer(t *testing.T) *httptest.Server {
+	snap, err := client.LoadSnapshot("testdata/snapshot")
+	assert.NoError(t, err)
+
+	return httptest.NewServer(snap)
+}
+
+func getJSON(t *testing.T, url string) (int, map[string]interface{}) {
+	resp, err := http.Get(url)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	var o map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&o))
+
+	return resp.StatusCode, o
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DiscoveryResource tracks api calls not targeting a resource.
+const DiscoveryResource = "discovery"
+
+// APICall represents an api call verb on a resource.
+type APICall struct {
+	Verb     string
+	Resource string
+}
+
+// APICount tracks api calls volumes.
+type APICount struct {
+	Calls  int
+	Errors int
+	Bytes  int64
+}
+
+// APIStats tracks api server calls made during a session.
+type APIStats struct {
+	calls map[APICall]*APICount
+	mx    sync.RWMutex
+}
+
+// NewAPIStats returns a new instance.
+func NewAPIStats() *APIStats {
+	return &APIStats{calls: make(map[APICall]*APICount)}
+}
+
+// Record records an api call.
+func (s *APIStats) Record(c APICall, failed bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	count := s.countFor(c)
+	count.Calls++
+	if failed {
+		count.Errors++
+	}
+}
+
+// RecordBytes records bytes received for an api call.
+func (s *APIStats) RecordBytes(c APICall, n int64) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.countFor(c).Bytes += n
+}
+
+// Snapshot returns a copy of the current counts.
+func (s *APIStats) Snapshot() map[APICall]APICount {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	m := make(map[APICall]APICount, len(s.calls))
+	for k, v := range s.calls {
+		m[k] = *v
+	}
+
+	return m
+}
+
+// Must be called while holding the lock.
+func (s *APIStats) countFor(c APICall) *APICount {
+	count, ok := s.calls[c]
+	if !ok {
+		count = &APICount{}
+		s.calls[c] = count
+	}
+
+	return count
+}
+
+// Wrap returns a round tripper recording calls in the stats.
+func (s *APIStats) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &statsTransport{next: rt, stats: s}
+}
+
+type statsTransport struct {
+	next  http.RoundTripper
+	stats *APIStats
+}
+
+// RoundTrip records an api call and the size of its response.
+func (t *statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := ToAPICall(req.Method, req.URL)
+	resp, err := t.next.RoundTrip(req)
+	t.stats.Record(call, err != nil || resp.StatusCode >= http.StatusBadRequest)
+	if err != nil || resp.Body == nil {
+		return resp, err
+	}
+	resp.Body = &countingBody{ReadCloser: resp.Body, done: func(n int64) {
+		t.stats.RecordBytes(call, n)
+	}}
+
+	return resp, nil
+}
+
+type countingBody struct {
+	io.ReadCloser
+
+	n      int64
+	closed int32
+	done   func(int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	atomic.AddInt64(&b.n, int64(n))
+
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	if atomic.CompareAndSwapInt32(&b.closed, 0, 1) {
+		b.done(atomic.LoadInt64(&b.n))
+	}
+
+	return b.ReadCloser.Close()
+}
+
+// ToAPICall returns the api verb and resource targeted by a request.
+func ToAPICall(method string, u *url.URL) APICall {
+	tokens := strings.Split(strings.Trim(u.Path, "/"), "/")
+	var gv []string
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		gv, tokens = tokens[1:2], tokens[2:]
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		gv, tokens = tokens[1:3], tokens[3:]
+	default:
+		return APICall{Verb: strings.ToLower(method), Resource: DiscoveryResource}
+	}
+	if len(tokens) >= 3 && tokens[0] == "namespaces" {
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 {
+		return APICall{Verb: strings.ToLower(method), Resource: DiscoveryResource}
+	}
+
+	res := strings.Join(gv, "/") + "/" + tokens[0]
+	if len(tokens) > 2 {
+		res += "/" + tokens[2]
+	}
+
+	return APICall{Verb: toVerb(method, len(tokens) > 1, u.Query().Get("watch")), Resource: res}
+}
+
+func toVerb(method string, named bool, watch string) string {
+	switch method {
+	case http.MethodGet:
+		switch {
+		case watch == "true" || watch == "1":
+			return "watch"
+		case named:
+			return "get"
+		default:
+			return "list"
+		}
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		if named {
+			return "delete"
+		}
+		return "deletecollection"
+	default:
+		return strings.ToLower(method)
+	}
+}
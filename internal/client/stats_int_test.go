@@ -0,0 +1,105 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToAPICall(t *testing.T) {
+	uu := map[string]struct {
+		method, url string
+		e           APICall
+	}{
+		"list": {
+			method: http.MethodGet,
+			url:    "/api/v1/namespaces/default/pods?limit=500",
+			e:      APICall{Verb: "list", Resource: "v1/pods"},
+		},
+		"watch": {
+			method: http.MethodGet,
+			url:    "/apis/apps/v1/deployments?watch=true",
+			e:      APICall{Verb: "watch", Resource: "apps/v1/deployments"},
+		},
+		"get": {
+			method: http.MethodGet,
+			url:    "/apis/apps/v1/namespaces/default/deployments/fred",
+			e:      APICall{Verb: "get", Resource: "apps/v1/deployments"},
+		},
+		"namespace": {
+			method: http.MethodGet,
+			url:    "/api/v1/namespaces/fred",
+			e:      APICall{Verb: "get", Resource: "v1/namespaces"},
+		},
+		"subresource": {
+			method: http.MethodGet,
+			url:    "/api/v1/namespaces/default/pods/fred/log",
+			e:      APICall{Verb: "get", Resource: "v1/pods/log"},
+		},
+		"patch": {
+			method: http.MethodPatch,
+			url:    "/apis/apps/v1/namespaces/default/deployments/fred/scale",
+			e:      APICall{Verb: "patch", Resource: "apps/v1/deployments/scale"},
+		},
+		"delete": {
+			method: http.MethodDelete,
+			url:    "/api/v1/namespaces/default/pods/fred",
+			e:      APICall{Verb: "delete", Resource: "v1/pods"},
+		},
+		"discovery": {
+			method: http.MethodGet,
+			url:    "/apis/apps/v1",
+			e:      APICall{Verb: "get", Resource: DiscoveryResource},
+		},
+		"version": {
+			method: http.MethodGet,
+			url:    "/version",
+			e:      APICall{Verb: "get", Resource: DiscoveryResource},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := url.Parse(u.url)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ToAPICall(u.method, p))
+		})
+	}
+}
+
+func TestAPIStatsTransport(t *testing.T) {
+	s := NewAPIStats()
+	rt := s.Wrap(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		code := http.StatusOK
+		if req.Method == http.MethodDelete {
+			code = http.StatusForbidden
+		}
+		return &http.Response{StatusCode: code, Body: ioutil.NopCloser(bytes.NewBufferString("fred"))}, nil
+	}))
+
+	for _, m := range []string{http.MethodGet, http.MethodGet, http.MethodDelete} {
+		req, err := http.NewRequest(m, "https://k8s/api/v1/namespaces/default/pods/fred", nil)
+		assert.Nil(t, err)
+		resp, err := rt.RoundTrip(req)
+		assert.Nil(t, err)
+		_, _ = ioutil.ReadAll(resp.Body)
+		assert.Nil(t, resp.Body.Close())
+	}
+
+	ss := s.Snapshot()
+	assert.Equal(t, APICount{Calls: 2, Bytes: 8}, ss[APICall{Verb: "get", Resource: "v1/pods"}])
+	assert.Equal(t, APICount{Calls: 1, Errors: 1, Bytes: 4}, ss[APICall{Verb: "delete", Resource: "v1/pods"}])
+}
+
+// Helpers...
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
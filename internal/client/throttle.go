@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// ThrottleThreshold represents the min client side wait to be reported.
+	ThrottleThreshold = 100 * time.Millisecond
+
+	throttleWindow = 5 * time.Second
+)
+
+// Throttle tracks client side rate limiting waits.
+type Throttle struct {
+	flowcontrol.RateLimiter
+
+	lastWait time.Duration
+	lastAt   time.Time
+	mx       sync.RWMutex
+}
+
+// NewThrottle returns a new rate limiter that records throttling waits.
+func NewThrottle(qps float32, burst int) *Throttle {
+	return &Throttle{
+		RateLimiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+	}
+}
+
+// Wait blocks until a request is allowed and records how long it waited.
+func (t *Throttle) Wait(ctx context.Context) error {
+	start := time.Now()
+	err := t.RateLimiter.Wait(ctx)
+	t.record(time.Since(start))
+
+	return err
+}
+
+// Accept blocks until a request is allowed and records how long it waited.
+func (t *Throttle) Accept() {
+	start := time.Now()
+	t.RateLimiter.Accept()
+	t.record(time.Since(start))
+}
+
+func (t *Throttle) record(d time.Duration) {
+	if d < ThrottleThreshold {
+		return
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.lastWait, t.lastAt = d, time.Now()
+}
+
+// Throttled returns the latest client side wait if throttling occurred recently.
+func (t *Throttle) Throttled() (time.Duration, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.lastAt.IsZero() || time.Since(t.lastAt) > throttleWindow {
+		return 0, false
+	}
+
+	return t.lastWait, true
+}
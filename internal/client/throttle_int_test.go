@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle(t *testing.T) {
+	th := NewThrottle(1, 1)
+	defer th.Stop()
+
+	_, ok := th.Throttled()
+	assert.False(t, ok)
+
+	th.record(10 * time.Millisecond)
+	_, ok = th.Throttled()
+	assert.False(t, ok)
+
+	th.record(time.Second)
+	d, ok := th.Throttled()
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, d)
+
+	th.lastAt = time.Now().Add(-2 * throttleWindow)
+	_, ok = th.Throttled()
+	assert.False(t, ok)
+}
+
+func TestThrottleWait(t *testing.T) {
+	th := NewThrottle(5, 1)
+	defer th.Stop()
+
+	assert.Nil(t, th.Wait(context.Background()))
+	assert.Nil(t, th.Wait(context.Background()))
+	d, ok := th.Throttled()
+	assert.True(t, ok)
+	assert.True(t, d >= ThrottleThreshold)
+}
@@ -65,6 +65,21 @@ type Authorizer interface {
 	CanI(ns, gvr string, verbs []string) (bool, error)
 }
 
+// Impersonator represents a connection acting as another identity.
+type Impersonator interface {
+	// Impersonate reconnects as the given user and groups.
+	Impersonate(user string, groups []string) error
+}
+
+// Reauthenticator represents a connection which credentials may expire.
+type Reauthenticator interface {
+	// Unauthorized checks if the api server rejected the current credentials.
+	Unauthorized() bool
+
+	// Reauthenticate reconnects with refreshed credentials.
+	Reauthenticate() error
+}
+
 // Connection represents a Kubenetes apiserver connection.
 type Connection interface {
 	Authorizer
@@ -3,6 +3,9 @@ package config
 import (
 	"io/ioutil"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/rs/zerolog/log"
@@ -18,16 +21,58 @@ type Alias map[string]string
 // ShortNames represents a collection of shortnames for aliases.
 type ShortNames map[string][]string
 
+// CommandAlias represents an alias expanding into a parametrized command
+// ie `pods -l app=$1`. Contexts and clusters glob patterns optionally scope
+// the alias.
+type CommandAlias struct {
+	Command  string   `yaml:"command"`
+	Contexts []string `yaml:"contexts,omitempty"`
+	Clusters []string `yaml:"clusters,omitempty"`
+}
+
+// UnmarshalYAML accepts a plain command as an unscoped alias.
+func (c *CommandAlias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err == nil {
+		c.Command = s
+		return nil
+	}
+	type raw CommandAlias
+	var r raw
+	if err := unmarshal(&r); err != nil {
+		return err
+	}
+	*c = CommandAlias(r)
+
+	return nil
+}
+
+// IsScoped checks if the alias is restricted to some contexts or clusters.
+func (c CommandAlias) IsScoped() bool {
+	return len(c.Contexts) > 0 || len(c.Clusters) > 0
+}
+
+// Matches checks if the alias applies to a given context and cluster.
+func (c CommandAlias) Matches(context, cluster string) bool {
+	return globMatch(c.Contexts, context) && globMatch(c.Clusters, cluster)
+}
+
+// CommandAliases tracks parametrized aliases definitions by name.
+type CommandAliases map[string][]CommandAlias
+
 // Aliases represents a collection of aliases.
 type Aliases struct {
-	Alias Alias `yaml:"alias"`
-	mx    sync.RWMutex
+	Alias    Alias          `yaml:"alias"`
+	Commands CommandAliases `yaml:"commands,omitempty"`
+	index    *aliasTrie
+	mx       sync.RWMutex
 }
 
 // NewAliases return a new alias.
 func NewAliases() *Aliases {
 	return &Aliases{
-		Alias: make(Alias, 50),
+		Alias:    make(Alias, 50),
+		Commands: make(CommandAliases),
 	}
 }
 
@@ -43,6 +88,39 @@ func (a *Aliases) Keys() []string {
 	return ss
 }
 
+// Prefixed returns all aliases starting with the given prefix in sorted order.
+func (a *Aliases) Prefixed(p string) []string {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	kk := a.ensureIndex().prefixed(p)
+	n := len(kk)
+	for k := range a.Commands {
+		if _, ok := a.Alias[k]; !ok && strings.HasPrefix(k, p) {
+			kk = append(kk, k)
+		}
+	}
+	if len(kk) > n {
+		sort.Strings(kk)
+	}
+
+	return kk
+}
+
+// ensureIndex rebuilds the prefix index when aliases keys were modified
+// outside of the aliases api. Must be called while holding the lock.
+func (a *Aliases) ensureIndex() *aliasTrie {
+	if a.index != nil && a.index.matches(a.Alias) {
+		return a.index
+	}
+	a.index = newAliasTrie()
+	for k := range a.Alias {
+		a.index.insert(k)
+	}
+
+	return a.index
+}
+
 // ShortNames return all shortnames.
 func (a *Aliases) ShortNames() ShortNames {
 	a.mx.RLock()
@@ -68,6 +146,10 @@ func (a *Aliases) Clear() {
 	for k := range a.Alias {
 		delete(a.Alias, k)
 	}
+	for k := range a.Commands {
+		delete(a.Commands, k)
+	}
+	a.index = nil
 }
 
 // Get retrieves an alias.
@@ -79,6 +161,31 @@ func (a *Aliases) Get(k string) (string, bool) {
 	return v, ok
 }
 
+// CommandFor returns a parametrized alias command for a given context and
+// cluster. Scoped definitions take precedence over unscoped ones.
+func (a *Aliases) CommandFor(k, context, cluster string) (string, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	var (
+		cmd   string
+		found bool
+	)
+	for _, c := range a.Commands[k] {
+		if !c.Matches(context, cluster) {
+			continue
+		}
+		if c.IsScoped() {
+			return c.Command, true
+		}
+		if !found {
+			cmd, found = c.Command, true
+		}
+	}
+
+	return cmd, found
+}
+
 // Define declares a new alias.
 func (a *Aliases) Define(gvr string, aliases ...string) {
 	a.mx.Lock()
@@ -89,6 +196,9 @@ func (a *Aliases) Define(gvr string, aliases ...string) {
 			continue
 		}
 		a.Alias[alias] = gvr
+		if a.index != nil {
+			a.index.insert(alias)
+		}
 	}
 }
 
@@ -112,13 +222,49 @@ func (a *Aliases) LoadFileAliases(path string) error {
 
 	a.mx.Lock()
 	defer a.mx.Unlock()
+	if a.Commands == nil {
+		a.Commands = make(CommandAliases)
+	}
+	cc := aa.Commands
+	if cc == nil {
+		cc = make(CommandAliases)
+	}
 	for k, v := range aa.Alias {
+		if isCommandAlias(v) {
+			cc[k] = append(cc[k], CommandAlias{Command: v})
+			continue
+		}
 		a.Alias[k] = v
 	}
+	for k, c := range cc {
+		a.Commands[k] = c
+	}
+	a.index = nil
 
 	return nil
 }
 
+// isCommandAlias checks if an alias expands into a command rather than a resource.
+func isCommandAlias(s string) bool {
+	return strings.ContainsAny(s, " $")
+}
+
+// globMatch checks if a value matches any of the patterns. A star matches
+// any characters including slashes. No patterns matches all values.
+func globMatch(patterns []string, s string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		rx := `\A` + strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(p)) + `\z`
+		if ok, err := regexp.MatchString(rx, s); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *Aliases) declare(key string, aliases ...string) {
 	a.Alias[key] = key
 	for _, alias := range aliases {
@@ -138,6 +284,7 @@ func (a *Aliases) loadDefaultAliases() {
 	a.Alias["ro"] = "rbac.authorization.k8s.io/v1/roles"
 	a.Alias["rb"] = "rbac.authorization.k8s.io/v1/rolebindings"
 	a.Alias["np"] = "networking.k8s.io/v1/networkpolicies"
+	a.index = nil
 
 	a.declare("help", "h", "?")
 	a.declare("quit", "q", "Q")
@@ -153,6 +300,8 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("screendumps", "screendump", "sd")
 	a.declare("pulses", "pulse", "pu", "hz")
 	a.declare("xrays", "xray", "x")
+	a.declare("pluginjobs", "pluginjob", "pj")
+	a.declare("audits", "audit", "au")
 }
 
 // Save alias to disk.
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
@@ -76,6 +77,67 @@ func TestAliasesLoad(t *testing.T) {
 	assert.Equal(t, 2, len(a.Alias))
 }
 
+func TestAliasesLoadCommands(t *testing.T) {
+	a := config.NewAliases()
+	assert.Nil(t, a.LoadFileAliases("testdata/alias_commands.yml"))
+	assert.Equal(t, 1, len(a.Alias))
+	assert.Equal(t, 3, len(a.Commands))
+
+	uu := map[string]struct {
+		alias, context, cluster string
+		e                       string
+		ok                      bool
+	}{
+		"plain": {
+			alias: "podsof",
+			e:     "pods -l app=$1",
+			ok:    true,
+		},
+		"unscoped": {
+			alias:   "logs",
+			context: "dev",
+			e:       "pods kube-system",
+			ok:      true,
+		},
+		"scoped": {
+			alias:   "logs",
+			context: "prod-us",
+			e:       "pods monitoring",
+			ok:      true,
+		},
+		"cluster": {
+			alias:   "fred",
+			cluster: "arn:aws:eks:us-east-1:1234:cluster/blee",
+			e:       "dp $1 /fred",
+			ok:      true,
+		},
+		"outOfScope": {
+			alias:   "fred",
+			cluster: "blee",
+		},
+		"missing": {
+			alias: "dp",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, ok := a.CommandFor(u.alias, u.context, u.cluster)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, cmd)
+		})
+	}
+}
+
+func TestAliasesPrefixedCommands(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("v1/pods", "po", "pod", "pods")
+	a.Commands["podsof"] = []config.CommandAlias{{Command: "pods -l app=$1"}}
+
+	assert.Equal(t, []string{"po", "pod", "pods", "podsof"}, a.Prefixed("po"))
+}
+
 func TestAliasesSave(t *testing.T) {
 	a := config.NewAliases()
 	a.Alias["test"] = "fred"
@@ -85,3 +147,66 @@ func TestAliasesSave(t *testing.T) {
 	assert.Nil(t, a.LoadFileAliases("/tmp/a.yml"))
 	assert.Equal(t, 2, len(a.Alias))
 }
+
+func TestAliasesPrefixed(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("apps/v1/deployments", "dp", "deploy", "deployments")
+	a.Define("v1/pods", "po", "pod", "pods")
+
+	uu := map[string]struct {
+		p string
+		e []string
+	}{
+		"all": {
+			e: []string{"deploy", "deployments", "dp", "po", "pod", "pods"},
+		},
+		"multi": {
+			p: "de",
+			e: []string{"deploy", "deployments"},
+		},
+		"exact": {
+			p: "pods",
+			e: []string{"pods"},
+		},
+		"none": {
+			p: "zorg",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, a.Prefixed(u.p))
+		})
+	}
+}
+
+func TestAliasesPrefixedUpdate(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("v1/pods", "po")
+	assert.Equal(t, []string{"po"}, a.Prefixed("p"))
+
+	a.Define("v1/persistentvolumes", "pv")
+	a.Alias["pvc"] = "v1/persistentvolumeclaims"
+	assert.Equal(t, []string{"po", "pv", "pvc"}, a.Prefixed("p"))
+
+	delete(a.Alias, "pv")
+	a.Alias["pdb"] = "policy/v1beta1/poddisruptionbudgets"
+	assert.Equal(t, []string{"pdb", "po", "pvc"}, a.Prefixed("p"))
+
+	a.Clear()
+	assert.Nil(t, a.Prefixed("p"))
+}
+
+func BenchmarkAliasesPrefixed(b *testing.B) {
+	a := config.NewAliases()
+	for i := 0; i < 1_000; i++ {
+		a.Define(fmt.Sprintf("blee.io/v1/crd%d", i), fmt.Sprintf("crd%d", i))
+	}
+	a.Prefixed("")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		a.Prefixed("crd99")
+	}
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"hash/fnv"
+	"sort"
+)
+
+// aliasTrie indexes aliases by prefix.
+type aliasTrie struct {
+	root *trieNode
+	size int
+	sum  uint64
+}
+
+type trieNode struct {
+	edges    []byte
+	children []*trieNode
+	terminal bool
+}
+
+func newAliasTrie() *aliasTrie {
+	return &aliasTrie{root: &trieNode{}}
+}
+
+func (t *aliasTrie) insert(k string) {
+	n := t.root
+	for i := 0; i < len(k); i++ {
+		n = n.child(k[i], true)
+	}
+	if !n.terminal {
+		t.size++
+		t.sum += keyHash(k)
+	}
+	n.terminal = true
+}
+
+// matches checks if the index holds the given keys.
+func (t *aliasTrie) matches(kk map[string]string) bool {
+	if t.size != len(kk) {
+		return false
+	}
+	var sum uint64
+	for k := range kk {
+		sum += keyHash(k)
+	}
+
+	return t.sum == sum
+}
+
+// prefixed returns all keys starting with the given prefix in sorted order.
+func (t *aliasTrie) prefixed(p string) []string {
+	n := t.find(p)
+	if n == nil {
+		return nil
+	}
+
+	var kk []string
+	buff := []byte(p)
+	n.walk(buff, func(k []byte) {
+		kk = append(kk, string(k))
+	})
+
+	return kk
+}
+
+func (t *aliasTrie) find(k string) *trieNode {
+	n := t.root
+	for i := 0; i < len(k) && n != nil; i++ {
+		n = n.child(k[i], false)
+	}
+
+	return n
+}
+
+func (n *trieNode) child(b byte, create bool) *trieNode {
+	i := sort.Search(len(n.edges), func(i int) bool { return n.edges[i] >= b })
+	if i < len(n.edges) && n.edges[i] == b {
+		return n.children[i]
+	}
+	if !create {
+		return nil
+	}
+
+	c := &trieNode{}
+	n.edges = append(n.edges, 0)
+	copy(n.edges[i+1:], n.edges[i:])
+	n.edges[i] = b
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = c
+
+	return c
+}
+
+func (n *trieNode) walk(k []byte, f func([]byte)) {
+	if n.terminal {
+		f(k)
+	}
+	for i, c := range n.children {
+		c.walk(append(k, n.edges[i]), f)
+	}
+}
+
+// keyHash hashes a key. Hashes are summed so the index fingerprint does not
+// depend on insertion order.
+func keyHash(k string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(k))
+
+	return h.Sum64()
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// DefaultAPIQPS tracks default api-server client queries per second.
+	DefaultAPIQPS = 50
+	// DefaultAPIBurst tracks default api-server client burst.
+	DefaultAPIBurst = 50
+	// DefaultRetryAttempts tracks default number of attempts for api operations.
+	DefaultRetryAttempts = 3
+	// DefaultRetryBackoffMillis tracks default initial backoff between attempts.
+	DefaultRetryBackoffMillis = 250
+)
+
+// DefaultRetryStatusCodes tracks api-server status codes that are retried by default.
+var DefaultRetryStatusCodes = []int{429, 500, 502, 503, 504}
+
+// API tracks api-server client options.
+type API struct {
+	QPS   float32 `yaml:"qps"`
+	Burst int     `yaml:"burst"`
+	Retry *Retry  `yaml:"retry"`
+}
+
+// Retry tracks api operations retry policy.
+type Retry struct {
+	Attempts      int   `yaml:"attempts"`
+	BackoffMillis int   `yaml:"backoffMillis"`
+	StatusCodes   []int `yaml:"statusCodes"`
+}
+
+// NewAPI returns a new instance.
+func NewAPI() *API {
+	return &API{
+		QPS:   DefaultAPIQPS,
+		Burst: DefaultAPIBurst,
+		Retry: NewRetry(),
+	}
+}
+
+// NewRetry returns a new instance.
+func NewRetry() *Retry {
+	return &Retry{
+		Attempts:      DefaultRetryAttempts,
+		BackoffMillis: DefaultRetryBackoffMillis,
+		StatusCodes:   DefaultRetryStatusCodes,
+	}
+}
+
+// Validate checks client options and make sure we're cool. If not use defaults.
+func (a *API) Validate(_ client.Connection, _ KubeSettings) {
+	if a.QPS <= 0 {
+		a.QPS = DefaultAPIQPS
+	}
+	if a.Burst <= 0 {
+		a.Burst = DefaultAPIBurst
+	}
+	if a.Retry == nil {
+		a.Retry = NewRetry()
+	}
+	a.Retry.Validate()
+}
+
+// Validate checks retry options and make sure we're cool. If not use defaults.
+func (r *Retry) Validate() {
+	if r.Attempts <= 0 {
+		r.Attempts = DefaultRetryAttempts
+	}
+	if r.BackoffMillis <= 0 {
+		r.BackoffMillis = DefaultRetryBackoffMillis
+	}
+	if r.StatusCodes == nil {
+		r.StatusCodes = DefaultRetryStatusCodes
+	}
+}
@@ -0,0 +1,35 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIValidate(t *testing.T) {
+	uu := map[string]struct {
+		api, e config.API
+	}{
+		"default": {
+			api: config.API{},
+			e:   *config.NewAPI(),
+		},
+		"custom": {
+			api: config.API{QPS: 10, Burst: 20, Retry: &config.Retry{Attempts: 1, BackoffMillis: 10, StatusCodes: []int{503}}},
+			e:   config.API{QPS: 10, Burst: 20, Retry: &config.Retry{Attempts: 1, BackoffMillis: 10, StatusCodes: []int{503}}},
+		},
+		"negative": {
+			api: config.API{QPS: -1, Burst: 5, Retry: &config.Retry{Attempts: -1, StatusCodes: []int{}}},
+			e:   config.API{QPS: config.DefaultAPIQPS, Burst: 5, Retry: &config.Retry{Attempts: config.DefaultRetryAttempts, BackoffMillis: config.DefaultRetryBackoffMillis, StatusCodes: []int{}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.api.Validate(nil, nil)
+			assert.Equal(t, u.e, u.api)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// K9sAuditFile represents the location of the mutating actions audit log.
+var K9sAuditFile = filepath.Join(K9sHome(), "audit.log")
+
+// Audit tracks mutating actions audit options.
+type Audit struct {
+	Enabled bool   `yaml:"enabled"`
+	Webhook string `yaml:"webhook,omitempty"`
+}
+
+// NewAudit returns a new instance. Auditing is opt-in.
+func NewAudit() *Audit {
+	return &Audit{}
+}
+
+// Validate checks audit options and make sure we're cool.
+func (a *Audit) Validate(_ client.Connection, _ KubeSettings) {
+	a.Webhook = strings.TrimSpace(a.Webhook)
+}
@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditValidate(t *testing.T) {
+	uu := map[string]struct {
+		a, e config.Audit
+	}{
+		"default": {
+			a: *config.NewAudit(),
+			e: config.Audit{},
+		},
+		"webhook": {
+			a: config.Audit{Enabled: true, Webhook: " http://blee/audit "},
+			e: config.Audit{Enabled: true, Webhook: "http://blee/audit"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.a.Validate(nil, nil)
+			assert.Equal(t, u.e, u.a)
+		})
+	}
+}
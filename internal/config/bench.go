@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -47,13 +48,36 @@ type (
 		Headers http.Header `yaml:"headers"`
 	}
 
+	// GRPC represents a grpc unary call.
+	GRPC struct {
+		// Method is the fully qualified method ie /grpc.health.v1.Health/Check.
+		Method string `yaml:"method"`
+
+		// Body is the base64 encoded protobuf request message. Defaults to an
+		// empty message.
+		Body     string            `yaml:"body"`
+		Metadata map[string]string `yaml:"metadata"`
+		TLS      bool              `yaml:"tls"`
+	}
+
+	// Load represents a benchmark load profile.
+	Load struct {
+		// Profile is one of constant, ramp or step.
+		Profile string `yaml:"profile"`
+
+		// Steps tracks the step profile stages count.
+		Steps int `yaml:"steps"`
+	}
+
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
 		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		C    int   `yaml:"concurrency"`
+		N    int   `yaml:"requests"`
+		Auth Auth  `yaml:"auth"`
+		HTTP HTTP  `yaml:"http"`
+		GRPC *GRPC `yaml:"grpc"`
+		Load Load  `yaml:"load"`
 	}
 )
 
@@ -64,6 +88,17 @@ const (
 	DefaultN = 200
 	// DefaultMethod default http verb.
 	DefaultMethod = "GET"
+	// DefaultGRPCMethod default grpc method.
+	DefaultGRPCMethod = "/grpc.health.v1.Health/Check"
+	// DefaultSteps default step profile stages count.
+	DefaultSteps = 4
+
+	// ConstantLoad runs all requests at full concurrency.
+	ConstantLoad = "constant"
+	// RampLoad adds one concurrent worker per stage up to the concurrency.
+	RampLoad = "ramp"
+	// StepLoad raises the concurrency in even steps up to the concurrency.
+	StepLoad = "step"
 )
 
 func newBenchmark() Benchmark {
@@ -106,6 +141,48 @@ func (s *Bench) load(path string) error {
 	return yaml.Unmarshal(f, &s)
 }
 
+// IsGRPC checks if the benchmark targets a grpc service.
+func (b BenchConfig) IsGRPC() bool {
+	return b.GRPC != nil
+}
+
+// GRPCMethod returns the grpc method to call.
+func (b BenchConfig) GRPCMethod() string {
+	if b.GRPC == nil || b.GRPC.Method == "" {
+		return DefaultGRPCMethod
+	}
+
+	return b.GRPC.Method
+}
+
+// Validate checks the load profile.
+func (l Load) Validate() error {
+	switch l.Profile {
+	case "", ConstantLoad, RampLoad, StepLoad:
+	default:
+		return fmt.Errorf("invalid load profile %q, expecting one of constant, ramp or step", l.Profile)
+	}
+	if l.Steps < 0 {
+		return fmt.Errorf("invalid load steps %d", l.Steps)
+	}
+
+	return nil
+}
+
+// IsConstant checks if the load is constant.
+func (l Load) IsConstant() bool {
+	return l.Profile == "" || l.Profile == ConstantLoad
+}
+
+// StepsCount returns the step profile stages count.
+func (l Load) StepsCount() int {
+	if l.Steps <= 0 {
+		return DefaultSteps
+	}
+
+	return l.Steps
+}
+
 // DefaultBenchSpec returns a default bench spec.
 func DefaultBenchSpec() BenchConfig {
 	return BenchConfig{
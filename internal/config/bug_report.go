@@ -0,0 +1,90 @@
+package config
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// maxBugReportLogs tracks how much of the K9s logs tail gets bundled.
+const maxBugReportLogs = 256 * 1024
+
+// WriteBugReport writes a compressed bundle with the redacted recovery
+// state and the tail of the K9s logs.
+func WriteBugReport(w io.Writer, r *Recovery, logPath string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	if r != nil {
+		raw, err := yaml.Marshal(r.Redact())
+		if err != nil {
+			return err
+		}
+		if err := addBugReportFile(tw, "recovery.yml", raw); err != nil {
+			return err
+		}
+	}
+	logs, err := tailFile(logPath, maxBugReportLogs)
+	if err != nil {
+		return err
+	}
+	if err := addBugReportFile(tw, "k9s.log", []byte(Redact(string(logs)))); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// Helpers...
+
+func addBugReportFile(tw *tar.Writer, name string, raw []byte) error {
+	hdr := tar.Header{
+		Name:    name,
+		Mode:    int64(DefaultFileMod),
+		Size:    int64(len(raw)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(&hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, bytes.NewReader(raw))
+
+	return err
+}
+
+func tailFile(path string, max int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() > max {
+		if _, err := f.Seek(-max, io.SeekEnd); err != nil {
+			return nil, err
+		}
+	}
+
+	var buff bytes.Buffer
+	_, err = io.Copy(&buff, f)
+
+	return buff.Bytes(), err
+}
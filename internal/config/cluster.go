@@ -12,6 +12,7 @@ type Cluster struct {
 	FeatureGates       *FeatureGates `yaml:"featureGates"`
 	ShellPod           *ShellPod     `yaml:"shellPod"`
 	PortForwardAddress string        `yaml:"portForwardAddress"`
+	Prometheus         *Prometheus   `yaml:"prometheus,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -48,4 +49,8 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 		c.ShellPod = NewShellPod()
 	}
 	c.ShellPod.Validate(conn, ks)
+
+	if c.Prometheus != nil {
+		c.Prometheus.Validate()
+	}
 }
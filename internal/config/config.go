@@ -26,6 +26,8 @@ var (
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
 	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sSnapshotDir represents a directory where K9s table snapshots will be persisted.
+	K9sSnapshotDir = filepath.Join(K9sHome(), "snapshots")
 )
 
 type (
@@ -49,16 +51,17 @@ type (
 
 	// Config tracks K9s configuration options.
 	Config struct {
-		K9s      *K9s `yaml:"k9s"`
-		client   client.Connection
-		settings KubeSettings
+		K9s       *K9s `yaml:"k9s"`
+		client    client.Connection
+		settings  KubeSettings
+		ephemeral bool
 	}
 )
 
 // K9sHome returns k9s configs home directory.
 func K9sHome() string {
 	if env := os.Getenv(K9sConfig); env != "" {
-		return env
+		return filepath.Clean(ExpandHome(env))
 	}
 
 	return DefaultK9sHome
@@ -69,6 +72,15 @@ func NewConfig(ks KubeSettings) *Config {
 	return &Config{K9s: NewK9s(), settings: ks}
 }
 
+// ForContext returns a configuration sharing K9s settings but targeting
+// another context. Such configurations are never saved.
+func (c *Config) ForContext(context, cluster string, conn client.Connection) *Config {
+	k := *c.K9s
+	k.CurrentContext, k.CurrentCluster = context, cluster
+
+	return &Config{K9s: &k, client: conn, settings: conn.Config(), ephemeral: true}
+}
+
 // Refine the configuration based on cli args.
 func (c *Config) Refine(flags *genericclioptions.ConfigFlags) error {
 	cfg, err := flags.ToRawKubeConfigLoader().RawConfig()
@@ -213,11 +225,38 @@ func (c *Config) Load(path string) error {
 	if c.K9s.Logger == nil {
 		c.K9s.Logger = NewLogger()
 	}
+	if c.K9s.API == nil {
+		c.K9s.API = NewAPI()
+	}
+	if c.K9s.Snapshots == nil {
+		c.K9s.Snapshots = NewSnapshots()
+	}
+	if c.K9s.Audit == nil {
+		c.K9s.Audit = NewAudit()
+	}
+	if c.K9s.Notifications == nil {
+		c.K9s.Notifications = NewNotifications()
+	}
+	if c.K9s.Watchdog == nil {
+		c.K9s.Watchdog = NewWatchdog()
+	}
+	if c.K9s.Glyphs == nil {
+		c.K9s.Glyphs = NewGlyphs()
+	}
+	if c.K9s.Debugger == nil {
+		c.K9s.Debugger = NewDebugger()
+	}
+	if c.K9s.Edit == nil {
+		c.K9s.Edit = NewEdit()
+	}
 	return nil
 }
 
 // Save configuration to disk.
 func (c *Config) Save() error {
+	if c.ephemeral {
+		return nil
+	}
 	c.Validate()
 
 	return c.SaveFile(K9sConfigFile)
@@ -116,6 +116,22 @@ func TestConfigLoad(t *testing.T) {
 	assert.Equal(t, "ctx", cfg.K9s.Clusters["minikube"].View.Active)
 }
 
+func TestConfigForContext(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+
+	mc := NewMockConnection()
+	peer := cfg.ForContext("fred", "fred", mc)
+	assert.Equal(t, "fred", peer.K9s.CurrentContext)
+	assert.Equal(t, "fred", peer.K9s.CurrentCluster)
+	assert.Equal(t, mc, peer.GetConnection())
+	assert.Equal(t, "minikube", cfg.K9s.CurrentContext)
+	assert.Equal(t, "minikube", cfg.K9s.CurrentCluster)
+	assert.Equal(t, cfg.K9s.RefreshRate, peer.K9s.RefreshRate)
+	assert.Nil(t, peer.Save())
+}
+
 func TestConfigCurrentCluster(t *testing.T) {
 	mk := NewMockKubeSettings()
 	cfg := config.NewConfig(mk)
@@ -266,7 +282,13 @@ var expectedConfig = `k9s:
   headless: false
   crumbsless: false
   readOnly: true
+  dryRun: false
   noIcons: false
+  screenReader: false
+  colorBlind: ""
+  serverTables: false
+  listPageSize: 500
+  diffOnEdit: false
   logger:
     tail: 500
     buffer: 800
@@ -274,6 +296,7 @@ var expectedConfig = `k9s:
     fullScreenLogs: false
     textWrap: false
     showTime: false
+    maxStreams: 50
   currentContext: blee
   currentCluster: blee
   clusters:
@@ -340,6 +363,37 @@ var expectedConfig = `k9s:
     memory:
       critical: 90
       warn: 70
+  api:
+    qps: 50
+    burst: 50
+    retry:
+      attempts: 3
+      backoffMillis: 250
+      statusCodes:
+      - 429
+      - 500
+      - 502
+      - 503
+      - 504
+  snapshots:
+    enabled: false
+    intervalSecs: 60
+    retentionMins: 60
+  audit:
+    enabled: false
+  notifications:
+    desktop: true
+    pollSecs: 10
+  watchdog:
+    enabled: false
+    pollSecs: 15
+  glyphs:
+    set: emoji
+  debugger:
+    image: busybox:1.31
+  edit:
+    mode: update
+    fieldManager: k9s
 `
 
 var resetConfig = `k9s:
@@ -349,7 +403,13 @@ var resetConfig = `k9s:
   headless: false
   crumbsless: false
   readOnly: false
+  dryRun: false
   noIcons: false
+  screenReader: false
+  colorBlind: ""
+  serverTables: false
+  listPageSize: 500
+  diffOnEdit: false
   logger:
     tail: 200
     buffer: 2000
@@ -357,6 +417,7 @@ var resetConfig = `k9s:
     fullScreenLogs: false
     textWrap: false
     showTime: false
+    maxStreams: 50
   currentContext: blee
   currentCluster: blee
   clusters:
@@ -383,4 +444,35 @@ var resetConfig = `k9s:
     memory:
       critical: 90
       warn: 70
+  api:
+    qps: 50
+    burst: 50
+    retry:
+      attempts: 3
+      backoffMillis: 250
+      statusCodes:
+      - 429
+      - 500
+      - 502
+      - 503
+      - 504
+  snapshots:
+    enabled: false
+    intervalSecs: 60
+    retentionMins: 60
+  audit:
+    enabled: false
+  notifications:
+    desktop: true
+    pollSecs: 10
+  watchdog:
+    enabled: false
+    pollSecs: 15
+  glyphs:
+    set: emoji
+  debugger:
+    image: busybox:1.31
+  edit:
+    mode: update
+    fieldManager: k9s
 `
@@ -0,0 +1,26 @@
+package config
+
+import "github.com/derailed/k9s/internal/client"
+
+// Debugger represents ephemeral debug containers configuration.
+type Debugger struct {
+	// Image is the default debug container image.
+	Image string `yaml:"image"`
+
+	// Command overrides the debug image entrypoint.
+	Command []string `yaml:"command,omitempty"`
+}
+
+// NewDebugger returns a new instance.
+func NewDebugger() *Debugger {
+	return &Debugger{
+		Image: defaultDockerShellImage,
+	}
+}
+
+// Validate validates the configuration.
+func (d *Debugger) Validate(client.Connection, KubeSettings) {
+	if d.Image == "" {
+		d.Image = defaultDockerShellImage
+	}
+}
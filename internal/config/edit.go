@@ -0,0 +1,52 @@
+package config
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// EditUpdate saves edits by replacing the resource.
+	EditUpdate = "update"
+
+	// EditApply saves edits using a server side apply.
+	EditApply = "apply"
+
+	// EditPatch saves edits using a patch computed from the changes.
+	EditPatch = "patch"
+
+	// DefaultFieldManager tracks the fields owned by K9s edits.
+	DefaultFieldManager = "k9s"
+)
+
+// Edit represents how resources edits get saved.
+type Edit struct {
+	// Mode is one of update, apply or patch.
+	Mode string `yaml:"mode"`
+
+	// FieldManager names the manager owning the edited fields.
+	FieldManager string `yaml:"fieldManager"`
+}
+
+// NewEdit returns a new instance.
+func NewEdit() *Edit {
+	return &Edit{
+		Mode:         EditUpdate,
+		FieldManager: DefaultFieldManager,
+	}
+}
+
+// Validate validates the configuration.
+func (e *Edit) Validate(client.Connection, KubeSettings) {
+	switch e.Mode {
+	case EditUpdate, EditApply, EditPatch:
+	case "":
+		e.Mode = EditUpdate
+	default:
+		log.Warn().Msgf("Invalid edit mode %q. Must be one of update, apply or patch", e.Mode)
+		e.Mode = EditUpdate
+	}
+	if e.FieldManager == "" {
+		e.FieldManager = DefaultFieldManager
+	}
+}
@@ -0,0 +1,38 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditValidate(t *testing.T) {
+	uu := map[string]struct {
+		c, e config.Edit
+	}{
+		"default": {
+			e: *config.NewEdit(),
+		},
+		"apply": {
+			c: config.Edit{Mode: config.EditApply, FieldManager: "fred"},
+			e: config.Edit{Mode: config.EditApply, FieldManager: "fred"},
+		},
+		"patch": {
+			c: config.Edit{Mode: config.EditPatch},
+			e: config.Edit{Mode: config.EditPatch, FieldManager: config.DefaultFieldManager},
+		},
+		"invalid": {
+			c: config.Edit{Mode: "blee", FieldManager: "fred"},
+			e: config.Edit{Mode: config.EditUpdate, FieldManager: "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.c.Validate(nil, nil)
+			assert.Equal(t, u.e, u.c)
+		})
+	}
+}
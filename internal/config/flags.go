@@ -9,6 +9,21 @@ const (
 
 	// DefaultCommand represents the default command to run.
 	DefaultCommand = ""
+
+	// DefaultDebugServer represents the default debug server address.
+	DefaultDebugServer = ""
+
+	// DefaultSelector represents the default launch view label selector.
+	DefaultSelector = ""
+
+	// DefaultSnapshot represents the default cluster snapshot directory.
+	DefaultSnapshot = ""
+
+	// DefaultRecord represents the default session recording file.
+	DefaultRecord = ""
+
+	// DefaultReplay represents the default session recording to replay.
+	DefaultReplay = ""
 )
 
 // Flags represents K9s configuration flags.
@@ -17,10 +32,17 @@ type Flags struct {
 	LogLevel      *string
 	Headless      *bool
 	Command       *string
+	Selector      *string
 	AllNamespaces *bool
 	ReadOnly      *bool
 	Write         *bool
+	DryRun        *bool
+	ScreenReader  *bool
 	Crumbsless    *bool
+	DebugServer   *string
+	Snapshot      *string
+	Record        *string
+	Replay        *string
 }
 
 // NewFlags returns new configuration flags.
@@ -30,10 +52,17 @@ func NewFlags() *Flags {
 		LogLevel:      strPtr(DefaultLogLevel),
 		Headless:      boolPtr(false),
 		Command:       strPtr(DefaultCommand),
+		Selector:      strPtr(DefaultSelector),
 		AllNamespaces: boolPtr(false),
 		ReadOnly:      boolPtr(false),
 		Write:         boolPtr(false),
+		DryRun:        boolPtr(false),
+		ScreenReader:  boolPtr(false),
 		Crumbsless:    boolPtr(false),
+		DebugServer:   strPtr(DefaultDebugServer),
+		Snapshot:      strPtr(DefaultSnapshot),
+		Record:        strPtr(DefaultRecord),
+		Replay:        strPtr(DefaultReplay),
 	}
 }
 
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// GlyphEmoji tracks the stock glyph set.
+	GlyphEmoji = "emoji"
+
+	// GlyphUnicode tracks a narrow unicode glyph set with no emojis.
+	GlyphUnicode = "unicode"
+
+	// GlyphASCII tracks a plain ASCII glyph set for limited fonts.
+	GlyphASCII = "ascii"
+)
+
+// Glyph names.
+const (
+	GlyphOK        = "ok"
+	GlyphError     = "error"
+	GlyphDeleted   = "deleted"
+	GlyphPending   = "pending"
+	GlyphNew       = "new"
+	GlyphModified  = "modified"
+	GlyphCompleted = "completed"
+	GlyphHighlight = "highlight"
+	GlyphDelta     = "delta"
+	GlyphUp        = "up"
+	GlyphDown      = "down"
+	GlyphAllowed   = "allowed"
+	GlyphDenied    = "denied"
+	GlyphInfo      = "info"
+	GlyphWarn      = "warn"
+	GlyphFault     = "fault"
+)
+
+var glyphSets = map[string]map[string]string{
+	GlyphEmoji: {
+		GlyphOK:        "✓",
+		GlyphError:     "✗",
+		GlyphDeleted:   "−",
+		GlyphPending:   "◷",
+		GlyphNew:       "+",
+		GlyphModified:  "~",
+		GlyphCompleted: "■",
+		GlyphHighlight: "★",
+		GlyphDelta:     "Δ",
+		GlyphUp:        "↑",
+		GlyphDown:      "↓",
+		GlyphAllowed:   "✓",
+		GlyphDenied:    "×",
+		GlyphInfo:      "😎",
+		GlyphWarn:      "😗",
+		GlyphFault:     "😡",
+	},
+	GlyphUnicode: {
+		GlyphOK:        "✓",
+		GlyphError:     "✗",
+		GlyphDeleted:   "−",
+		GlyphPending:   "◷",
+		GlyphNew:       "+",
+		GlyphModified:  "~",
+		GlyphCompleted: "■",
+		GlyphHighlight: "★",
+		GlyphDelta:     "Δ",
+		GlyphUp:        "↑",
+		GlyphDown:      "↓",
+		GlyphAllowed:   "✓",
+		GlyphDenied:    "×",
+		GlyphInfo:      "●",
+		GlyphWarn:      "▲",
+		GlyphFault:     "✗",
+	},
+	GlyphASCII: {
+		GlyphOK:        "v",
+		GlyphError:     "x",
+		GlyphDeleted:   "-",
+		GlyphPending:   ".",
+		GlyphNew:       "+",
+		GlyphModified:  "~",
+		GlyphCompleted: "#",
+		GlyphHighlight: "*",
+		GlyphDelta:     "*",
+		GlyphUp:        "^",
+		GlyphDown:      "v",
+		GlyphAllowed:   "+",
+		GlyphDenied:    "-",
+		GlyphInfo:      "i",
+		GlyphWarn:      "!",
+		GlyphFault:     "x",
+	},
+}
+
+// Glyphs tracks the glyphs used for status indicators.
+type Glyphs struct {
+	Set       string            `yaml:"set"`
+	Overrides map[string]string `yaml:"overrides,omitempty"`
+}
+
+// NewGlyphs returns a new instance.
+func NewGlyphs() *Glyphs {
+	return &Glyphs{Set: GlyphEmoji}
+}
+
+// GlyphSetNames returns the available glyph sets.
+func GlyphSetNames() []string {
+	nn := make([]string, 0, len(glyphSets))
+	for n := range glyphSets {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Validate checks glyph options and make sure we're cool.
+func (g *Glyphs) Validate(_ client.Connection, _ KubeSettings) {
+	if g.Set == "" {
+		g.Set = GlyphEmoji
+	}
+	if _, ok := glyphSets[g.Set]; !ok {
+		log.Warn().Msgf("Unknown glyph set %q. Valid sets are %v", g.Set, GlyphSetNames())
+		g.Set = GlyphEmoji
+	}
+	for k := range g.Overrides {
+		if _, ok := glyphSets[GlyphEmoji][k]; !ok {
+			log.Warn().Msgf("Unknown glyph override %q", k)
+			delete(g.Overrides, k)
+		}
+	}
+}
+
+// Resolve returns the glyphs for the active set with overrides applied.
+func (g *Glyphs) Resolve() map[string]string {
+	set, ok := glyphSets[g.Set]
+	if !ok {
+		set = glyphSets[GlyphEmoji]
+	}
+	gg := make(map[string]string, len(set))
+	for k, v := range set {
+		gg[k] = v
+	}
+	for k, v := range g.Overrides {
+		if _, ok := gg[k]; ok {
+			gg[k] = v
+		}
+	}
+
+	return gg
+}
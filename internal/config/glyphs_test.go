@@ -0,0 +1,80 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlyphSetNames(t *testing.T) {
+	assert.Equal(t, []string{config.GlyphASCII, config.GlyphEmoji, config.GlyphUnicode}, config.GlyphSetNames())
+}
+
+func TestGlyphsValidate(t *testing.T) {
+	uu := map[string]struct {
+		g, e config.Glyphs
+	}{
+		"default": {
+			e: *config.NewGlyphs(),
+		},
+		"unknownSet": {
+			g: config.Glyphs{Set: "fred"},
+			e: *config.NewGlyphs(),
+		},
+		"overrides": {
+			g: config.Glyphs{Set: config.GlyphASCII, Overrides: map[string]string{config.GlyphOK: "o", "fred": "f"}},
+			e: config.Glyphs{Set: config.GlyphASCII, Overrides: map[string]string{config.GlyphOK: "o"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.g.Validate(nil, nil)
+			assert.Equal(t, u.e, u.g)
+		})
+	}
+}
+
+func TestGlyphsResolve(t *testing.T) {
+	uu := map[string]struct {
+		g        config.Glyphs
+		ok, info string
+	}{
+		"emoji": {
+			g:    *config.NewGlyphs(),
+			ok:   "✓",
+			info: "😎",
+		},
+		"unicode": {
+			g:    config.Glyphs{Set: config.GlyphUnicode},
+			ok:   "✓",
+			info: "●",
+		},
+		"ascii": {
+			g:    config.Glyphs{Set: config.GlyphASCII},
+			ok:   "v",
+			info: "i",
+		},
+		"overrides": {
+			g:    config.Glyphs{Set: config.GlyphASCII, Overrides: map[string]string{config.GlyphOK: "o"}},
+			ok:   "o",
+			info: "i",
+		},
+		"unknown": {
+			g:    config.Glyphs{Set: "fred"},
+			ok:   "✓",
+			info: "😎",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gg := u.g.Resolve()
+			assert.Equal(t, u.ok, gg[config.GlyphOK])
+			assert.Equal(t, u.info, gg[config.GlyphInfo])
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"os"
 	"os/user"
 	"path/filepath"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
@@ -37,6 +38,20 @@ func InNSList(nn []interface{}, ns string) bool {
 	return InList(ss, ns)
 }
 
+// ExpandHome expands a leading ~ to the user home directory. Both / and \
+// separators are accepted so paths work on Windows too.
+func ExpandHome(p string) string {
+	if p != "~" && !strings.HasPrefix(p, "~/") && !strings.HasPrefix(p, `~\`) {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.Join(home, filepath.FromSlash(strings.TrimLeft(p[1:], `/\`)))
+}
+
 func mustK9sHome() string {
 	usr, err := user.Current()
 	if err != nil {
@@ -82,3 +82,25 @@ func TestEnsurePathNoOpt(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "drwxr--r--", p.Mode().String())
 }
+
+func TestExpandHome(t *testing.T) {
+	home, err := os.UserHomeDir()
+	assert.Nil(t, err)
+
+	uu := map[string]struct {
+		p, e string
+	}{
+		"plain":    {p: "/tmp/fred", e: "/tmp/fred"},
+		"home":     {p: "~", e: home},
+		"slash":    {p: "~/.k9s/plugin.yml", e: filepath.Join(home, ".k9s", "plugin.yml")},
+		"tilde":    {p: "~fred/blee", e: "~fred/blee"},
+		"relative": {p: "bin/fred", e: "bin/fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.ExpandHome(u.p))
+		})
+	}
+}
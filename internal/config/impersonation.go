@@ -0,0 +1,42 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Impersonation represents the identity K9s acts as on startup unless the
+// --as flag is set.
+type Impersonation struct {
+	// User is the user or serviceaccount ie system:serviceaccount:ns:name.
+	User string `yaml:"user"`
+
+	// Groups lists the impersonated groups.
+	Groups []string `yaml:"groups,omitempty"`
+}
+
+// NewImpersonation returns a new instance.
+func NewImpersonation() *Impersonation {
+	return &Impersonation{}
+}
+
+// Validate validates the configuration.
+func (i *Impersonation) Validate(client.Connection, KubeSettings) {
+	i.User = strings.TrimSpace(i.User)
+	gg := make([]string, 0, len(i.Groups))
+	for _, g := range i.Groups {
+		if g = strings.TrimSpace(g); g != "" {
+			gg = append(gg, g)
+		}
+	}
+	if len(gg) == 0 {
+		gg = nil
+	}
+	i.Groups = gg
+}
+
+// IsSet checks if an identity is configured.
+func (i *Impersonation) IsSet() bool {
+	return i != nil && i.User != ""
+}
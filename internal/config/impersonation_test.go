@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImpersonationValidate(t *testing.T) {
+	uu := map[string]struct {
+		c, e config.Impersonation
+		set  bool
+	}{
+		"default": {
+			e: *config.NewImpersonation(),
+		},
+		"user": {
+			c:   config.Impersonation{User: " fred "},
+			e:   config.Impersonation{User: "fred"},
+			set: true,
+		},
+		"groups": {
+			c:   config.Impersonation{User: "fred", Groups: []string{"g1", " ", " g2"}},
+			e:   config.Impersonation{User: "fred", Groups: []string{"g1", "g2"}},
+			set: true,
+		},
+		"blankGroups": {
+			c: config.Impersonation{Groups: []string{" "}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.c.Validate(nil, nil)
+			assert.Equal(t, u.e, u.c)
+			assert.Equal(t, u.set, u.c.IsSet())
+		})
+	}
+}
@@ -1,43 +1,76 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
 )
 
 const (
 	defaultRefreshRate  = 2
 	defaultMaxConnRetry = 5
+	defaultListPageSize = 500
 )
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	RefreshRate       int                 `yaml:"refreshRate"`
-	MaxConnRetry      int                 `yaml:"maxConnRetry"`
-	EnableMouse       bool                `yaml:"enableMouse"`
-	Headless          bool                `yaml:"headless"`
-	Crumbsless        bool                `yaml:"crumbsless"`
-	ReadOnly          bool                `yaml:"readOnly"`
-	NoIcons           bool                `yaml:"noIcons"`
-	Logger            *Logger             `yaml:"logger"`
-	CurrentContext    string              `yaml:"currentContext"`
-	CurrentCluster    string              `yaml:"currentCluster"`
-	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
-	Thresholds        Threshold           `yaml:"thresholds"`
-	manualRefreshRate int
-	manualHeadless    *bool
-	manualCrumbsless  *bool
-	manualReadOnly    *bool
-	manualCommand     *string
+	RefreshRate        int                 `yaml:"refreshRate"`
+	MaxConnRetry       int                 `yaml:"maxConnRetry"`
+	EnableMouse        bool                `yaml:"enableMouse"`
+	Headless           bool                `yaml:"headless"`
+	Crumbsless         bool                `yaml:"crumbsless"`
+	ReadOnly           bool                `yaml:"readOnly"`
+	DryRun             bool                `yaml:"dryRun"`
+	NoIcons            bool                `yaml:"noIcons"`
+	ScreenReader       bool                `yaml:"screenReader"`
+	ColorBlind         string              `yaml:"colorBlind"`
+	ServerTables       bool                `yaml:"serverTables"`
+	ListPageSize       int                 `yaml:"listPageSize"`
+	DiffOnEdit         bool                `yaml:"diffOnEdit"`
+	Logger             *Logger             `yaml:"logger"`
+	CurrentContext     string              `yaml:"currentContext"`
+	CurrentCluster     string              `yaml:"currentCluster"`
+	Clusters           map[string]*Cluster `yaml:"clusters,omitempty"`
+	Thresholds         Threshold           `yaml:"thresholds"`
+	API                *API                `yaml:"api"`
+	Snapshots          *Snapshots          `yaml:"snapshots"`
+	Audit              *Audit              `yaml:"audit"`
+	Notifications      *Notifications      `yaml:"notifications"`
+	Watchdog           *Watchdog           `yaml:"watchdog"`
+	Glyphs             *Glyphs             `yaml:"glyphs"`
+	Debugger           *Debugger           `yaml:"debugger"`
+	Edit               *Edit               `yaml:"edit"`
+	Impersonate        *Impersonation      `yaml:"impersonate,omitempty"`
+	Trivy              *Trivy              `yaml:"trivy,omitempty"`
+	Scale              *Scale              `yaml:"scale,omitempty"`
+	manualRefreshRate  int
+	manualHeadless     *bool
+	manualCrumbsless   *bool
+	manualReadOnly     *bool
+	manualDryRun       *bool
+	manualScreenReader *bool
+	manualCommand      *string
+	manualSelector     string
 }
 
 // NewK9s create a new K9s configuration.
 func NewK9s() *K9s {
 	return &K9s{
-		RefreshRate:  defaultRefreshRate,
-		MaxConnRetry: defaultMaxConnRetry,
-		Logger:       NewLogger(),
-		Clusters:     make(map[string]*Cluster),
-		Thresholds:   NewThreshold(),
+		RefreshRate:   defaultRefreshRate,
+		MaxConnRetry:  defaultMaxConnRetry,
+		ListPageSize:  defaultListPageSize,
+		Logger:        NewLogger(),
+		Clusters:      make(map[string]*Cluster),
+		Thresholds:    NewThreshold(),
+		API:           NewAPI(),
+		Snapshots:     NewSnapshots(),
+		Audit:         NewAudit(),
+		Notifications: NewNotifications(),
+		Watchdog:      NewWatchdog(),
+		Glyphs:        NewGlyphs(),
+		Debugger:      NewDebugger(),
+		Edit:          NewEdit(),
 	}
 }
 
@@ -71,11 +104,35 @@ func (k *K9s) OverrideWrite(b bool) {
 	}
 }
 
+// OverrideDryRun set the dry-run mode manually.
+func (k *K9s) OverrideDryRun(b bool) {
+	if b {
+		k.manualDryRun = &b
+	}
+}
+
+// OverrideScreenReader set the screen reader mode manually.
+func (k *K9s) OverrideScreenReader(b bool) {
+	if b {
+		k.manualScreenReader = &b
+	}
+}
+
 // OverrideCommand set the command manually.
 func (k *K9s) OverrideCommand(cmd string) {
 	k.manualCommand = &cmd
 }
 
+// OverrideSelector set the launch view label selector manually.
+func (k *K9s) OverrideSelector(sel string) {
+	k.manualSelector = strings.TrimSpace(sel)
+}
+
+// GetSelector returns the label selector to apply to the launch view.
+func (k *K9s) GetSelector() string {
+	return k.manualSelector
+}
+
 // IsHeadless returns headless setting.
 func (k *K9s) IsHeadless() bool {
 	h := k.Headless
@@ -106,6 +163,16 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
+// GetListPageSize returns the number of items fetched per api list page.
+// Zero disables paginated lists.
+func (k *K9s) GetListPageSize() int64 {
+	if k.ListPageSize < 0 {
+		return 0
+	}
+
+	return int64(k.ListPageSize)
+}
+
 // IsReadOnly returns the readonly setting.
 func (k *K9s) IsReadOnly() bool {
 	readOnly := k.ReadOnly
@@ -116,6 +183,31 @@ func (k *K9s) IsReadOnly() bool {
 	return readOnly
 }
 
+// IsDryRun returns the dry-run setting.
+func (k *K9s) IsDryRun() bool {
+	dryRun := k.DryRun
+	if k.manualDryRun != nil {
+		dryRun = *k.manualDryRun
+	}
+
+	return dryRun
+}
+
+// IsScreenReader returns the screen reader setting.
+func (k *K9s) IsScreenReader() bool {
+	sr := k.ScreenReader
+	if k.manualScreenReader != nil {
+		sr = *k.manualScreenReader
+	}
+
+	return sr
+}
+
+// IsNoIcons returns true if icons should not be displayed.
+func (k *K9s) IsNoIcons() bool {
+	return k.NoIcons || k.IsScreenReader()
+}
+
 // ActiveCluster returns the currently active cluster.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
@@ -137,6 +229,13 @@ func (k *K9s) validateDefaults() {
 	if k.MaxConnRetry <= 0 {
 		k.MaxConnRetry = defaultMaxConnRetry
 	}
+	if k.ListPageSize == 0 {
+		k.ListPageSize = defaultListPageSize
+	}
+	if k.ColorBlind != "" && !IsPalette(k.ColorBlind) {
+		log.Warn().Msgf("Unknown color blind palette %q. Valid palettes are %v", k.ColorBlind, PaletteNames())
+		k.ColorBlind = ""
+	}
 }
 
 func (k *K9s) validateClusters(c client.Connection, ks KubeSettings) {
@@ -173,6 +272,52 @@ func (k *K9s) Validate(c client.Connection, ks KubeSettings) {
 		k.Thresholds = NewThreshold()
 	}
 	k.Thresholds.Validate(c, ks)
+	if k.API == nil {
+		k.API = NewAPI()
+	} else {
+		k.API.Validate(c, ks)
+	}
+	if k.Snapshots == nil {
+		k.Snapshots = NewSnapshots()
+	} else {
+		k.Snapshots.Validate(c, ks)
+	}
+	if k.Audit == nil {
+		k.Audit = NewAudit()
+	} else {
+		k.Audit.Validate(c, ks)
+	}
+	if k.Notifications == nil {
+		k.Notifications = NewNotifications()
+	} else {
+		k.Notifications.Validate(c, ks)
+	}
+	if k.Watchdog == nil {
+		k.Watchdog = NewWatchdog()
+	} else {
+		k.Watchdog.Validate(c, ks)
+	}
+	if k.Glyphs == nil {
+		k.Glyphs = NewGlyphs()
+	} else {
+		k.Glyphs.Validate(c, ks)
+	}
+	if k.Debugger == nil {
+		k.Debugger = NewDebugger()
+	} else {
+		k.Debugger.Validate(c, ks)
+	}
+	if k.Edit == nil {
+		k.Edit = NewEdit()
+	} else {
+		k.Edit.Validate(c, ks)
+	}
+	if k.Impersonate != nil {
+		k.Impersonate.Validate(c, ks)
+	}
+	if k.Trivy != nil {
+		k.Trivy.Validate(c, ks)
+	}
 
 	if ctx, err := ks.CurrentContextName(); err == nil && len(k.CurrentContext) == 0 {
 		k.CurrentContext = ctx
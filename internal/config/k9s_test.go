@@ -59,6 +59,57 @@ func TestIsReadOnly(t *testing.T) {
 	}
 }
 
+func TestIsDryRun(t *testing.T) {
+	uu := map[string]struct {
+		dryRun, override bool
+		e                bool
+	}{
+		"default":  {},
+		"config":   {dryRun: true, e: true},
+		"override": {override: true, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s()
+			k.DryRun = u.dryRun
+			k.OverrideDryRun(u.override)
+			assert.Equal(t, u.e, k.IsDryRun())
+		})
+	}
+}
+
+func TestGetSelector(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, "", k.GetSelector())
+	k.OverrideSelector(" app=fred ")
+	assert.Equal(t, "app=fred", k.GetSelector())
+}
+
+func TestIsScreenReader(t *testing.T) {
+	uu := map[string]struct {
+		screenReader, override, noIcons bool
+		e, icons                        bool
+	}{
+		"default":  {},
+		"config":   {screenReader: true, e: true, icons: true},
+		"override": {override: true, e: true, icons: true},
+		"noIcons":  {noIcons: true, icons: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			k := config.NewK9s()
+			k.ScreenReader, k.NoIcons = u.screenReader, u.noIcons
+			k.OverrideScreenReader(u.override)
+			assert.Equal(t, u.e, k.IsScreenReader())
+			assert.Equal(t, u.icons, k.IsNoIcons())
+		})
+	}
+}
+
 func TestK9sValidate(t *testing.T) {
 	mc := NewMockConnection()
 	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sKeyMaps manages K9s key bindings.
+var K9sKeyMaps = filepath.Join(K9sHome(), "keymaps.yml")
+
+// KeyMaps represents user defined key bindings.
+type KeyMaps struct {
+	KeyMap KeyMap `yaml:"keymaps"`
+}
+
+// KeyMap tracks actions key bindings. Actions are named after their menu
+// description and bound to a key ie `Shift-L` or a chord ie `g d`.
+type KeyMap struct {
+	Bindings map[string]string `yaml:"bindings"`
+
+	// Views tracks per view bindings keyed by resource name, alias or gvr.
+	Views map[string]map[string]string `yaml:"views"`
+}
+
+// KeyBinding represents an action key binding.
+type KeyBinding struct {
+	Action string
+	Keys   string
+
+	// View tracks the view the binding is scoped to if any.
+	View string
+}
+
+// NewKeyMaps returns new key bindings.
+func NewKeyMaps() *KeyMaps {
+	return &KeyMaps{
+		KeyMap: KeyMap{
+			Bindings: make(map[string]string),
+			Views:    make(map[string]map[string]string),
+		},
+	}
+}
+
+// Load K9s key bindings.
+func (k *KeyMaps) Load() error {
+	return k.LoadKeyMaps(K9sKeyMaps)
+}
+
+// LoadKeyMaps loads key bindings from a given file.
+func (k *KeyMaps) LoadKeyMaps(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var kk KeyMaps
+	if err := yaml.Unmarshal(f, &kk); err != nil {
+		return err
+	}
+	for a, keys := range kk.KeyMap.Bindings {
+		k.KeyMap.Bindings[a] = keys
+	}
+	for v, bb := range kk.KeyMap.Views {
+		k.KeyMap.Views[v] = bb
+	}
+
+	return nil
+}
+
+// For returns the bindings of a view matching any of the given aliases.
+// View bindings override global ones.
+func (k *KeyMaps) For(aliases []string) []KeyBinding {
+	set := make(map[string]KeyBinding, len(k.KeyMap.Bindings))
+	for a, keys := range k.KeyMap.Bindings {
+		set[a] = KeyBinding{Action: a, Keys: keys}
+	}
+	for _, alias := range aliases {
+		for a, keys := range k.KeyMap.Views[alias] {
+			set[a] = KeyBinding{Action: a, Keys: keys, View: alias}
+		}
+	}
+	bb := make([]KeyBinding, 0, len(set))
+	for _, b := range set {
+		bb = append(bb, b)
+	}
+	sort.Slice(bb, func(i, j int) bool {
+		return bb[i].Action < bb[j].Action
+	})
+
+	return bb
+}
@@ -0,0 +1,50 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapsLoad(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.Nil(t, k.LoadKeyMaps("testdata/keymaps.yml"))
+
+	assert.Equal(t, map[string]string{"Describe": "g d", "Delete": "Ctrl-K"}, k.KeyMap.Bindings)
+	assert.Equal(t, 2, len(k.KeyMap.Views))
+	assert.Error(t, k.LoadKeyMaps("testdata/blee.yml"))
+}
+
+func TestKeyMapsFor(t *testing.T) {
+	k := config.NewKeyMaps()
+	assert.Nil(t, k.LoadKeyMaps("testdata/keymaps.yml"))
+
+	uu := map[string]struct {
+		aliases []string
+		e       []config.KeyBinding
+	}{
+		"global": {
+			aliases: []string{"svc", "services"},
+			e: []config.KeyBinding{
+				{Action: "Delete", Keys: "Ctrl-K"},
+				{Action: "Describe", Keys: "g d"},
+			},
+		},
+		"overrides": {
+			aliases: []string{"po", "pod", "pods"},
+			e: []config.KeyBinding{
+				{Action: "Delete", Keys: "Ctrl-K"},
+				{Action: "Describe", Keys: "Shift-D", View: "po"},
+				{Action: "Logs", Keys: "Shift-O", View: "po"},
+			},
+		},
+	}
+
+	for k1 := range uu {
+		u := uu[k1]
+		t.Run(k1, func(t *testing.T) {
+			assert.Equal(t, u.e, k.For(u.aliases))
+		})
+	}
+}
@@ -11,6 +11,8 @@ const (
 	MaxLogThreshold = 5000
 	// DefaultSinceSeconds tracks default log age.
 	DefaultSinceSeconds = 60 // all logs
+	// DefaultMaxLogStreams tracks the default max number of pods tailed at once.
+	DefaultMaxLogStreams = 50
 )
 
 // Logger tracks logger options
@@ -21,6 +23,12 @@ type Logger struct {
 	FullScreenLogs bool  `yaml:"fullScreenLogs"`
 	TextWrap       bool  `yaml:"textWrap"`
 	ShowTime       bool  `yaml:"showTime"`
+	// MaxStreams caps the number of pods tailed when viewing logs of a
+	// workload.
+	MaxStreams int `yaml:"maxStreams"`
+	// JSONFields lists the fields displayed for structured logs. All fields are
+	// displayed if none are specified.
+	JSONFields []string `yaml:"jsonFields,omitempty"`
 }
 
 // NewLogger returns a new instance.
@@ -29,6 +37,7 @@ func NewLogger() *Logger {
 		TailCount:    DefaultLoggerTailCount,
 		BufferSize:   MaxLogThreshold,
 		SinceSeconds: DefaultSinceSeconds,
+		MaxStreams:   DefaultMaxLogStreams,
 	}
 }
 
@@ -46,4 +55,7 @@ func (l *Logger) Validate(_ client.Connection, _ KubeSettings) {
 	if l.SinceSeconds == 0 {
 		l.SinceSeconds = DefaultSinceSeconds
 	}
+	if l.MaxStreams <= 0 {
+		l.MaxStreams = DefaultMaxLogStreams
+	}
 }
@@ -13,6 +13,7 @@ func TestNewLogger(t *testing.T) {
 
 	assert.Equal(t, int64(100), l.TailCount)
 	assert.Equal(t, 5000, l.BufferSize)
+	assert.Equal(t, config.DefaultMaxLogStreams, l.MaxStreams)
 }
 
 func TestLoggerValidate(t *testing.T) {
@@ -21,4 +22,5 @@ func TestLoggerValidate(t *testing.T) {
 
 	assert.Equal(t, int64(100), l.TailCount)
 	assert.Equal(t, 5000, l.BufferSize)
+	assert.Equal(t, config.DefaultMaxLogStreams, l.MaxStreams)
 }
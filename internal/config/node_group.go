@@ -0,0 +1,84 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sNodeGroups manages K9s node group hooks.
+var K9sNodeGroups = filepath.Join(K9sHome(), "nodegroups.yml")
+
+// DefaultNodeGroupLabels tracks well known node labels carrying a cloud
+// provider node group or pool name.
+var DefaultNodeGroupLabels = []string{
+	"eks.amazonaws.com/nodegroup",
+	"alpha.eksctl.io/nodegroup-name",
+	"cloud.google.com/gke-nodepool",
+	"kubernetes.azure.com/agentpool",
+	"agentpool",
+	"kops.k8s.io/instancegroup",
+}
+
+// NodeGroups represents a collection of node group hooks.
+type NodeGroups struct {
+	Hook map[string]NodeGroupHook `yaml:"nodeGroupHooks"`
+}
+
+// NodeGroupHook describes a provider command run against a node group.
+type NodeGroupHook struct {
+	ShortCut    string   `yaml:"shortCut"`
+	Description string   `yaml:"description"`
+	Command     string   `yaml:"command"`
+	Args        []string `yaml:"args"`
+	Labels      []string `yaml:"labels"`
+	Prompt      string   `yaml:"prompt"`
+	Confirm     bool     `yaml:"confirm"`
+}
+
+// NewNodeGroups returns a new node group hooks collection.
+func NewNodeGroups() NodeGroups {
+	return NodeGroups{
+		Hook: make(map[string]NodeGroupHook),
+	}
+}
+
+// Load K9s node group hooks.
+func (n NodeGroups) Load() error {
+	return n.LoadNodeGroups(K9sNodeGroups)
+}
+
+// LoadNodeGroups loads node group hooks from a given file.
+func (n NodeGroups) LoadNodeGroups(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var nn NodeGroups
+	if err := yaml.Unmarshal(f, &nn); err != nil {
+		return err
+	}
+	for k, v := range nn.Hook {
+		n.Hook[k] = v
+	}
+
+	return nil
+}
+
+// PoolFor returns the node group name given a set of node labels.
+// Hook labels take precedence over the well known provider labels.
+func (h NodeGroupHook) PoolFor(labels map[string]string) (string, bool) {
+	ll := h.Labels
+	if len(ll) == 0 {
+		ll = DefaultNodeGroupLabels
+	}
+	for _, l := range ll {
+		if v, ok := labels[l]; ok && v != "" {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,69 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeGroupsLoad(t *testing.T) {
+	n := config.NewNodeGroups()
+	assert.Nil(t, n.LoadNodeGroups("testdata/nodegroups.yml"))
+
+	assert.Equal(t, 2, len(n.Hook))
+	h, ok := n.Hook["eks-scale"]
+	assert.True(t, ok)
+	assert.Equal(t, "Shift-J", h.ShortCut)
+	assert.Equal(t, "Scale NodeGroup", h.Description)
+	assert.Equal(t, "Desired Size", h.Prompt)
+	assert.True(t, h.Confirm)
+	assert.Equal(t, "aws", h.Command)
+	assert.Equal(t, "desiredSize=$INPUT", h.Args[len(h.Args)-1])
+	assert.Equal(t, []string{"cloud.google.com/gke-nodepool"}, n.Hook["gke-resize"].Labels)
+}
+
+func TestNodeGroupHookPoolFor(t *testing.T) {
+	uu := map[string]struct {
+		hook   config.NodeGroupHook
+		labels map[string]string
+		pool   string
+		ok     bool
+	}{
+		"eks": {
+			labels: map[string]string{"eks.amazonaws.com/nodegroup": "ng-1"},
+			pool:   "ng-1",
+			ok:     true,
+		},
+		"gke": {
+			labels: map[string]string{"cloud.google.com/gke-nodepool": "default-pool"},
+			pool:   "default-pool",
+			ok:     true,
+		},
+		"custom": {
+			hook:   config.NodeGroupHook{Labels: []string{"pool"}},
+			labels: map[string]string{"pool": "p1", "agentpool": "p2"},
+			pool:   "p1",
+			ok:     true,
+		},
+		"customMiss": {
+			hook:   config.NodeGroupHook{Labels: []string{"pool"}},
+			labels: map[string]string{"agentpool": "p2"},
+		},
+		"none": {
+			labels: map[string]string{"kubernetes.io/hostname": "n1"},
+		},
+		"blank": {
+			labels: map[string]string{"agentpool": ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pool, ok := u.hook.PoolFor(u.labels)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.pool, pool)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DefaultNotifyPollSecs tracks default delay between watched resources checks.
+const DefaultNotifyPollSecs = 10
+
+// Notifications tracks watched resources notifications options.
+type Notifications struct {
+	Desktop  bool   `yaml:"desktop"`
+	Webhook  string `yaml:"webhook,omitempty"`
+	PollSecs int    `yaml:"pollSecs"`
+}
+
+// NewNotifications returns a new instance.
+func NewNotifications() *Notifications {
+	return &Notifications{
+		Desktop:  true,
+		PollSecs: DefaultNotifyPollSecs,
+	}
+}
+
+// Validate checks notifications options and make sure we're cool. If not use defaults.
+func (n *Notifications) Validate(_ client.Connection, _ KubeSettings) {
+	n.Webhook = strings.TrimSpace(n.Webhook)
+	if n.PollSecs <= 0 {
+		n.PollSecs = DefaultNotifyPollSecs
+	}
+}
+
+// PollInterval returns the delay between watched resources checks.
+func (n *Notifications) PollInterval() time.Duration {
+	return time.Duration(n.PollSecs) * time.Second
+}
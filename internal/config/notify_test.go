@@ -0,0 +1,37 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsValidate(t *testing.T) {
+	uu := map[string]struct {
+		n, e config.Notifications
+	}{
+		"default": {
+			n: config.Notifications{Desktop: true},
+			e: *config.NewNotifications(),
+		},
+		"custom": {
+			n: config.Notifications{Webhook: " http://blee ", PollSecs: 30},
+			e: config.Notifications{Webhook: "http://blee", PollSecs: 30},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.n.Validate(nil, nil)
+			assert.Equal(t, u.e, u.n)
+		})
+	}
+}
+
+func TestNotificationsPollInterval(t *testing.T) {
+	n := config.Notifications{PollSecs: 5}
+	assert.Equal(t, 5*time.Second, n.PollInterval())
+}
@@ -0,0 +1,96 @@
+package config
+
+import "sort"
+
+const (
+	// Deuteranopia tracks a palette safe for green deficient vision.
+	Deuteranopia = "deuteranopia"
+
+	// Protanopia tracks a palette safe for red deficient vision.
+	Protanopia = "protanopia"
+
+	// Tritanopia tracks a palette safe for blue deficient vision.
+	Tritanopia = "tritanopia"
+)
+
+// Palette represents a set of color blind safe semantic colors.
+type Palette struct {
+	Status Status
+	// Charts tracks the ok/fault chart colors.
+	Charts Colors
+}
+
+// Palettes are derived from the Okabe-Ito color blind safe palette. Ok and
+// error colors are picked to remain distinct under each deficiency.
+var palettes = map[string]Palette{
+	Deuteranopia: {
+		Status: Status{
+			NewColor:       "#56b4e9",
+			ModifyColor:    "#f0e442",
+			AddColor:       "#0072b2",
+			PendingColor:   "#cc79a7",
+			ErrorColor:     "#e69f00",
+			HighlightColor: "white",
+			KillColor:      "#999999",
+			CompletedColor: "gray",
+		},
+		Charts: Colors{"#0072b2", "#e69f00"},
+	},
+	Protanopia: {
+		Status: Status{
+			NewColor:       "#56b4e9",
+			ModifyColor:    "#cc79a7",
+			AddColor:       "#0072b2",
+			PendingColor:   "#e69f00",
+			ErrorColor:     "#f0e442",
+			HighlightColor: "white",
+			KillColor:      "#999999",
+			CompletedColor: "gray",
+		},
+		Charts: Colors{"#0072b2", "#f0e442"},
+	},
+	Tritanopia: {
+		Status: Status{
+			NewColor:       "#009e73",
+			ModifyColor:    "#cc79a7",
+			AddColor:       "#56b4e9",
+			PendingColor:   "#e69f00",
+			ErrorColor:     "#d55e00",
+			HighlightColor: "white",
+			KillColor:      "#999999",
+			CompletedColor: "gray",
+		},
+		Charts: Colors{"#009e73", "#d55e00"},
+	},
+}
+
+// PaletteNames returns the available color blind palettes.
+func PaletteNames() []string {
+	nn := make([]string, 0, len(palettes))
+	for n := range palettes {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// IsPalette checks if a palette is known.
+func IsPalette(n string) bool {
+	_, ok := palettes[n]
+	return ok
+}
+
+// ApplyPalette remaps the semantic status and chart colors to a color blind
+// safe palette. Returns false if the palette is unknown.
+func (s *Styles) ApplyPalette(n string) bool {
+	p, ok := palettes[n]
+	if !ok {
+		return false
+	}
+	s.K9s.Frame.Status = p.Status
+	s.K9s.Views.Charts.DefaultDialColors = p.Charts
+	s.K9s.Views.Charts.DefaultChartColors = p.Charts
+
+	return true
+}
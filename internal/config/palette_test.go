@@ -0,0 +1,67 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	m "github.com/petergtz/pegomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteNames(t *testing.T) {
+	assert.Equal(t, []string{config.Deuteranopia, config.Protanopia, config.Tritanopia}, config.PaletteNames())
+}
+
+func TestApplyPalette(t *testing.T) {
+	uu := map[string]struct {
+		palette string
+		ok      bool
+		err     config.Color
+	}{
+		"deuteranopia": {palette: config.Deuteranopia, ok: true, err: "#e69f00"},
+		"protanopia":   {palette: config.Protanopia, ok: true, err: "#f0e442"},
+		"tritanopia":   {palette: config.Tritanopia, ok: true, err: "#d55e00"},
+		"unknown":      {palette: "fred", err: "orangered"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewStyles()
+			assert.Equal(t, u.ok, s.ApplyPalette(u.palette))
+			assert.Equal(t, u.ok, config.IsPalette(u.palette))
+			assert.Equal(t, u.err, s.Frame().Status.ErrorColor)
+			if u.ok {
+				assert.Equal(t, u.err, s.Charts().DefaultChartColors[1])
+			}
+		})
+	}
+}
+
+func TestK9sValidateColorBlind(t *testing.T) {
+	uu := map[string]struct {
+		palette, e string
+	}{
+		"none":    {},
+		"valid":   {palette: config.Tritanopia, e: config.Tritanopia},
+		"invalid": {palette: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mc := NewMockConnection()
+			m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+			mk := NewMockKubeSettings()
+			m.When(mk.CurrentContextName()).ThenReturn("ctx1", nil)
+			m.When(mk.CurrentClusterName()).ThenReturn("c1", nil)
+			m.When(mk.ClusterNames()).ThenReturn([]string{"c1"}, nil)
+			m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"default"})
+
+			c := config.NewK9s()
+			c.ColorBlind = u.palette
+			c.Validate(mc, mk)
+			assert.Equal(t, u.e, c.ColorBlind)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 
@@ -10,6 +11,15 @@ import (
 // K9sPlugins manages K9s plugins.
 var K9sPlugins = filepath.Join(K9sHome(), "plugin.yml")
 
+// Plugin output modes.
+const (
+	// PluginTableOutput renders a plugin columnar stdout as a table.
+	PluginTableOutput = "table"
+
+	// PluginYAMLOutput renders a plugin stdout in a yaml viewer.
+	PluginYAMLOutput = "yaml"
+)
+
 // Plugins represents a collection of plugins.
 type Plugins struct {
 	Plugin map[string]Plugin `yaml:"plugin"`
@@ -17,13 +27,38 @@ type Plugins struct {
 
 // Plugin describes a K9s plugin
 type Plugin struct {
-	Scopes      []string `yaml:"scopes"`
-	Args        []string `yaml:"args"`
-	ShortCut    string   `yaml:"shortCut"`
-	Description string   `yaml:"description"`
-	Command     string   `yaml:"command"`
-	Confirm     bool     `yaml:"confirm"`
-	Background  bool     `yaml:"background"`
+	Scopes         []string `yaml:"scopes"`
+	Args           []string `yaml:"args"`
+	ShortCut       string   `yaml:"shortCut"`
+	Description    string   `yaml:"description"`
+	Command        string   `yaml:"command"`
+	Confirm        bool     `yaml:"confirm"`
+	ConfirmMessage string   `yaml:"confirmMessage"`
+	Background     bool     `yaml:"background"`
+	Output         string   `yaml:"output"`
+	Job            bool     `yaml:"job"`
+}
+
+// NeedsConfirm returns true if the plugin must be confirmed before running.
+func (p Plugin) NeedsConfirm() bool {
+	return p.Confirm || p.ConfirmMessage != ""
+}
+
+// Validate checks the plugin options are compatible.
+func (p Plugin) Validate() error {
+	switch p.Output {
+	case "", PluginTableOutput, PluginYAMLOutput:
+	default:
+		return fmt.Errorf("invalid plugin output %q. Must be one of table or yaml", p.Output)
+	}
+	if p.Output != "" && (p.Job || p.Background) {
+		return fmt.Errorf("plugin output %q can not be used in the background", p.Output)
+	}
+	if p.Job && p.Background {
+		return fmt.Errorf("plugin can not be both a job and a background command")
+	}
+
+	return nil
 }
 
 // NewPlugins returns a new plugin.
@@ -22,3 +22,41 @@ func TestPluginLoad(t *testing.T) {
 	assert.False(t, k.Background)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
 }
+
+func TestPluginLoadV2(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins("testdata/plugin_v2.yml"))
+
+	assert.Equal(t, 2, len(p.Plugin))
+	top := p.Plugin["pods"]
+	assert.Equal(t, config.PluginTableOutput, top.Output)
+	assert.False(t, top.NeedsConfirm())
+	assert.Nil(t, top.Validate())
+
+	purge := p.Plugin["purge"]
+	assert.True(t, purge.Job)
+	assert.Equal(t, "Purge $NAME in $NAMESPACE?", purge.ConfirmMessage)
+	assert.True(t, purge.NeedsConfirm())
+	assert.Nil(t, purge.Validate())
+}
+
+func TestPluginValidate(t *testing.T) {
+	uu := map[string]struct {
+		p   config.Plugin
+		err bool
+	}{
+		"shell":         {p: config.Plugin{Command: "fred"}},
+		"yaml":          {p: config.Plugin{Command: "fred", Output: config.PluginYAMLOutput}},
+		"job":           {p: config.Plugin{Command: "fred", Job: true}},
+		"badOutput":     {p: config.Plugin{Command: "fred", Output: "json"}, err: true},
+		"outputJob":     {p: config.Plugin{Command: "fred", Output: config.PluginTableOutput, Job: true}, err: true},
+		"jobBackground": {p: config.Plugin{Command: "fred", Job: true, Background: true}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, u.p.Validate() != nil)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sPortForwardsDir represents the location of persisted port-forwards.
+var K9sPortForwardsDir = filepath.Join(K9sHome(), "portforwards")
+
+// PortForwards tracks the port-forwards to re-establish for a given context.
+type PortForwards struct {
+	Context  string           `yaml:"context"`
+	Forwards []SessionForward `yaml:"forwards"`
+}
+
+// PortForwardsFile returns the port-forwards location for a given context.
+func PortForwardsFile(context string) string {
+	return filepath.Join(K9sPortForwardsDir, sessionNameRX.ReplaceAllString(context, "-")+".yml")
+}
+
+// LoadPortForwards loads port-forwards from a given file. A missing file
+// yields no port-forwards.
+func LoadPortForwards(path string) (*PortForwards, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var pp PortForwards
+	if err := yaml.Unmarshal(raw, &pp); err != nil {
+		return nil, err
+	}
+
+	return &pp, nil
+}
+
+// Save persists port-forwards to a given file.
+func (p *PortForwards) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
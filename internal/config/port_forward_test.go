@@ -0,0 +1,39 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortForwardsFile(t *testing.T) {
+	assert.Equal(t, filepath.Join(config.K9sPortForwardsDir, "arn-aws-eks-fred.yml"), config.PortForwardsFile("arn:aws:eks/fred"))
+}
+
+func TestPortForwardsSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-pf")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fred.yml")
+	pp, err := config.LoadPortForwards(path)
+	assert.Nil(t, err)
+	assert.Nil(t, pp)
+
+	pp = &config.PortForwards{
+		Context: "fred",
+		Forwards: []config.SessionForward{
+			{Path: "default/p1", Container: "c1", Address: "localhost", Ports: []string{"8080:80"}, Selector: "app=p1"},
+			{Path: "default/p2", Container: "c1", Address: "localhost", Ports: []string{"9090:90"}},
+		},
+	}
+	assert.Nil(t, pp.Save(path))
+
+	l, err := config.LoadPortForwards(path)
+	assert.Nil(t, err)
+	assert.Equal(t, pp, l)
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sPulsesFile represents the location of the Prometheus panels configuration.
+var K9sPulsesFile = filepath.Join(K9sHome(), "pulses.yml")
+
+// Prometheus tracks a cluster Prometheus data source.
+type Prometheus struct {
+	// URL is the Prometheus server url ie http://localhost:9090.
+	URL string `yaml:"url"`
+
+	// BearerTokenFile optionally authenticates the queries.
+	BearerTokenFile string `yaml:"bearerTokenFile,omitempty"`
+
+	// Insecure skips the server certificate verification.
+	Insecure bool `yaml:"insecure,omitempty"`
+}
+
+// IsEnabled checks if a data source is configured.
+func (p *Prometheus) IsEnabled() bool {
+	return p != nil && p.URL != ""
+}
+
+// Validate checks the data source and make sure we're cool.
+func (p *Prometheus) Validate() {
+	p.URL = strings.TrimSuffix(strings.TrimSpace(p.URL), "/")
+	p.BearerTokenFile = strings.TrimSpace(p.BearerTokenFile)
+}
+
+// PromPanel represents a graph fed by a PromQL query. Queries may reference
+// $NAMESPACE and $NAME for the selected resource.
+type PromPanel struct {
+	Name  string `yaml:"name"`
+	Query string `yaml:"query"`
+	Unit  string `yaml:"unit,omitempty"`
+}
+
+// Expand returns the panel query for a given resource.
+func (p PromPanel) Expand(ns, n string) string {
+	if ns == "" {
+		ns = ".*"
+	}
+
+	return strings.NewReplacer("$NAMESPACE", ns, "$NAME", n).Replace(p.Query)
+}
+
+// PromPanels tracks the panels of the pulses, pod and node views.
+type PromPanels struct {
+	Pulses []PromPanel `yaml:"pulses"`
+	Pods   []PromPanel `yaml:"pods"`
+	Nodes  []PromPanel `yaml:"nodes"`
+}
+
+// Pulses represents the Prometheus panels configuration.
+type Pulses struct {
+	K9s PromPanels `yaml:"k9s"`
+}
+
+// NewPulses returns the default panels.
+func NewPulses() *Pulses {
+	return &Pulses{
+		K9s: PromPanels{
+			Pulses: []PromPanel{
+				{Name: "API Requests", Query: `sum(rate(apiserver_request_total[5m]))`, Unit: "req/s"},
+				{Name: "API Errors", Query: `sum(rate(apiserver_request_total{code=~"5.."}[5m]))`, Unit: "req/s"},
+				{Name: "Restarts", Query: `sum(increase(kube_pod_container_status_restarts_total{namespace=~"$NAMESPACE"}[1h]))`},
+			},
+			Pods: []PromPanel{
+				{Name: "Restarts", Query: `sum(increase(kube_pod_container_status_restarts_total{namespace="$NAMESPACE",pod="$NAME"}[1h]))`},
+				{Name: "Net RX", Query: `sum(rate(container_network_receive_bytes_total{namespace="$NAMESPACE",pod="$NAME"}[5m]))`, Unit: "B/s"},
+				{Name: "Net TX", Query: `sum(rate(container_network_transmit_bytes_total{namespace="$NAMESPACE",pod="$NAME"}[5m]))`, Unit: "B/s"},
+			},
+			Nodes: []PromPanel{
+				{Name: "Pods", Query: `sum(kube_pod_info{node="$NAME"})`},
+				{Name: "Restarts", Query: `sum(increase(kube_pod_container_status_restarts_total[1h]) * on(namespace, pod) group_left kube_pod_info{node="$NAME"})`},
+			},
+		},
+	}
+}
+
+// Load loads the panels configuration. Views not listed in the file retain
+// their default panels.
+func (p *Pulses) Load(path string) error {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var in Pulses
+	if err := yaml.Unmarshal(raw, &in); err != nil {
+		return err
+	}
+	if in.K9s.Pulses != nil {
+		p.K9s.Pulses = validPanels(in.K9s.Pulses)
+	}
+	if in.K9s.Pods != nil {
+		p.K9s.Pods = validPanels(in.K9s.Pods)
+	}
+	if in.K9s.Nodes != nil {
+		p.K9s.Nodes = validPanels(in.K9s.Nodes)
+	}
+
+	return nil
+}
+
+// PanelsFor returns the panels for a given resource.
+func (p *Pulses) PanelsFor(gvr string) []PromPanel {
+	switch gvr {
+	case "v1/pods":
+		return p.K9s.Pods
+	case "v1/nodes":
+		return p.K9s.Nodes
+	default:
+		return p.K9s.Pulses
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// validPanels drops panels without a query and names the anonymous ones.
+func validPanels(pp []PromPanel) []PromPanel {
+	vv := make([]PromPanel, 0, len(pp))
+	for _, p := range pp {
+		p.Query = strings.TrimSpace(p.Query)
+		if p.Query == "" {
+			continue
+		}
+		if p.Name = strings.TrimSpace(p.Name); p.Name == "" {
+			p.Name = p.Query
+		}
+		vv = append(vv, p)
+	}
+
+	return vv
+}
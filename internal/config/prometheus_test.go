@@ -0,0 +1,61 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusValidate(t *testing.T) {
+	var p *config.Prometheus
+	assert.False(t, p.IsEnabled())
+
+	p = &config.Prometheus{URL: " http://localhost:9090/ "}
+	p.Validate()
+	assert.True(t, p.IsEnabled())
+	assert.Equal(t, "http://localhost:9090", p.URL)
+}
+
+func TestPromPanelExpand(t *testing.T) {
+	uu := map[string]struct {
+		ns, n, q string
+		e        string
+	}{
+		"pod": {
+			ns: "default",
+			n:  "fred",
+			q:  `up{namespace="$NAMESPACE",pod="$NAME"}`,
+			e:  `up{namespace="default",pod="fred"}`,
+		},
+		"allNamespaces": {
+			q: `up{namespace=~"$NAMESPACE"}`,
+			e: `up{namespace=~".*"}`,
+		},
+		"none": {
+			ns: "default",
+			q:  "up",
+			e:  "up",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.PromPanel{Query: u.q}.Expand(u.ns, u.n))
+		})
+	}
+}
+
+func TestPulsesLoad(t *testing.T) {
+	p := config.NewPulses()
+	nodes := p.PanelsFor("v1/nodes")
+	assert.Nil(t, p.Load("testdata/pulses.yml"))
+
+	assert.Equal(t, []config.PromPanel{
+		{Name: "Requests", Query: `sum(rate(http_requests_total{namespace="$NAMESPACE",pod="$NAME"}[5m]))`, Unit: "req/s"},
+		{Name: "up", Query: "up"},
+	}, p.PanelsFor("v1/pods"))
+	assert.Equal(t, nodes, p.PanelsFor("v1/nodes"))
+	assert.Equal(t, 3, len(p.PanelsFor("pulses")))
+}
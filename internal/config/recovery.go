@@ -0,0 +1,132 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const redacted = "<redacted>"
+
+var (
+	// K9sRecoveryFile represents the location of the crash recovery state.
+	K9sRecoveryFile = filepath.Join(K9sHome(), "recovery.yml")
+	// K9sLastRecoveryFile represents the location of the last handled crash recovery state.
+	K9sLastRecoveryFile = filepath.Join(K9sHome(), "recovery-last.yml")
+)
+
+var redactRXs = []struct {
+	rx   *regexp.Regexp
+	repl string
+}{
+	{
+		rx:   regexp.MustCompile(`(?i)((?:token|password|passwd|secret|authorization)["']?\s*[:= ]\s*["']?)(?:bearer\s+)?[^\s"',]+`),
+		repl: "${1}" + redacted,
+	},
+	{
+		rx:   regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`),
+		repl: "${1}" + redacted,
+	},
+	{
+		rx:   regexp.MustCompile(`(://)[^/\s:@]+:[^/\s@]+@`),
+		repl: "${1}" + redacted + "@",
+	},
+}
+
+// Recovery tracks K9s state at the time of a crash.
+type Recovery struct {
+	Time     time.Time `yaml:"time"`
+	Version  string    `yaml:"version"`
+	Panic    string    `yaml:"panic"`
+	Stack    string    `yaml:"stack,omitempty"`
+	Session  *Session  `yaml:"session,omitempty"`
+	Commands []string  `yaml:"commands,omitempty"`
+	Errors   []string  `yaml:"errors,omitempty"`
+}
+
+// LoadRecovery loads a recovery state from a given file. A missing file
+// yield no recovery.
+func LoadRecovery(path string) (*Recovery, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var r Recovery
+	if err := yaml.Unmarshal(raw, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ArchiveRecovery moves a handled recovery state aside so it is no longer
+// offered on launch but can still be bundled in bug reports.
+func ArchiveRecovery(path, archive string) error {
+	if err := os.Rename(path, archive); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Save persists a recovery state to a given file.
+func (r *Recovery) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// Redact returns a copy of the recovery state with credentials and cluster
+// identifiers masked so it can be shared in bug reports.
+func (r *Recovery) Redact() *Recovery {
+	c := *r
+	c.Panic, c.Stack = Redact(r.Panic), Redact(r.Stack)
+	c.Commands, c.Errors = redactAll(r.Commands), redactAll(r.Errors)
+	if r.Session != nil {
+		s := *r.Session
+		s.Context = redacted
+		s.Views = append([]SessionView(nil), r.Session.Views...)
+		for i := range s.Views {
+			s.Views[i].Filter = Redact(s.Views[i].Filter)
+		}
+		s.Forwards = nil
+		c.Session = &s
+	}
+
+	return &c
+}
+
+// Redact masks credentials in a given text.
+func Redact(s string) string {
+	for _, r := range redactRXs {
+		s = r.rx.ReplaceAllString(s, r.repl)
+	}
+
+	return s
+}
+
+// Helpers...
+
+func redactAll(ss []string) []string {
+	if ss == nil {
+		return nil
+	}
+	out := make([]string, 0, len(ss))
+	for _, s := range ss {
+		out = append(out, Redact(s))
+	}
+
+	return out
+}
@@ -0,0 +1,12 @@
+package config
+
+// Scale represents the workloads scale dialog options.
+type Scale struct {
+	// BlockOnHPA prevents manual scaling of workloads managed by an HPA.
+	BlockOnHPA bool `yaml:"blockOnHPA"`
+}
+
+// IsBlockingOnHPA checks if manual scaling is disabled for autoscaled workloads.
+func (s *Scale) IsBlockingOnHPA() bool {
+	return s != nil && s.BlockOnHPA
+}
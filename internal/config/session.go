@@ -0,0 +1,81 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sSessionsDir represents the location of saved sessions.
+var K9sSessionsDir = filepath.Join(K9sHome(), "sessions")
+
+var sessionNameRX = regexp.MustCompile(`[^\w.-]+`)
+
+// SessionView tracks a view opened during a session.
+type SessionView struct {
+	Command string `yaml:"command"`
+	Path    string `yaml:"path,omitempty"`
+	Filter  string `yaml:"filter,omitempty"`
+	SortCol string `yaml:"sortCol,omitempty"`
+	SortAsc bool   `yaml:"sortAsc"`
+}
+
+// SessionForward tracks a port-forward active during a session.
+type SessionForward struct {
+	Path      string   `yaml:"path"`
+	Container string   `yaml:"container"`
+	Address   string   `yaml:"address"`
+	Ports     []string `yaml:"ports"`
+	// Selector tracks the pods the forward follows when its pod is replaced.
+	Selector string `yaml:"selector,omitempty"`
+}
+
+// Session tracks K9s state so it can be restored on the next launch.
+type Session struct {
+	Context   string           `yaml:"context"`
+	Namespace string           `yaml:"namespace"`
+	Views     []SessionView    `yaml:"views"`
+	Forwards  []SessionForward `yaml:"forwards,omitempty"`
+}
+
+// SessionFile returns the session location for a given context.
+func SessionFile(context string) string {
+	return filepath.Join(K9sSessionsDir, sessionNameRX.ReplaceAllString(context, "-")+".yml")
+}
+
+// Empty returns true if there is nothing to restore.
+func (s *Session) Empty() bool {
+	return len(s.Views) == 0 && len(s.Forwards) == 0
+}
+
+// LoadSession loads a session from a given file. A missing file yield no session.
+func LoadSession(path string) (*Session, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var s Session
+	if err := yaml.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Save persists a session to a given file.
+func (s *Session) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
@@ -0,0 +1,47 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionFile(t *testing.T) {
+	assert.Equal(t, filepath.Join(config.K9sSessionsDir, "arn-aws-eks-fred.yml"), config.SessionFile("arn:aws:eks/fred"))
+}
+
+func TestSessionSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-session")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fred.yml")
+	s, err := config.LoadSession(path)
+	assert.Nil(t, err)
+	assert.Nil(t, s)
+
+	s = &config.Session{
+		Context:   "fred",
+		Namespace: "default",
+		Views: []config.SessionView{
+			{Command: "v1/pods default", Filter: "blee", SortCol: "AGE", SortAsc: true},
+		},
+		Forwards: []config.SessionForward{
+			{Path: "default/p1", Container: "c1", Address: "localhost", Ports: []string{"8080:80"}},
+		},
+	}
+	assert.False(t, s.Empty())
+	assert.Nil(t, s.Save(path))
+
+	l, err := config.LoadSession(path)
+	assert.Nil(t, err)
+	assert.Equal(t, s, l)
+}
+
+func TestSessionEmpty(t *testing.T) {
+	assert.True(t, (&config.Session{Context: "fred"}).Empty())
+}
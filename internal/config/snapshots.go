@@ -0,0 +1,55 @@
+package config
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// DefaultSnapshotIntervalSecs tracks default delay between table snapshots.
+	DefaultSnapshotIntervalSecs = 60
+	// DefaultSnapshotRetentionMins tracks default table snapshots retention.
+	DefaultSnapshotRetentionMins = 60
+)
+
+// Snapshots tracks table snapshots options. Snapshots are disabled unless
+// enabled with a non zero interval.
+type Snapshots struct {
+	Enabled       bool `yaml:"enabled"`
+	IntervalSecs  int  `yaml:"intervalSecs"`
+	RetentionMins int  `yaml:"retentionMins"`
+}
+
+// NewSnapshots returns a new instance.
+func NewSnapshots() *Snapshots {
+	return &Snapshots{
+		IntervalSecs:  DefaultSnapshotIntervalSecs,
+		RetentionMins: DefaultSnapshotRetentionMins,
+	}
+}
+
+// Validate checks snapshots options and make sure we're cool. If not use defaults.
+func (s *Snapshots) Validate(_ client.Connection, _ KubeSettings) {
+	if s.IntervalSecs < 0 {
+		s.IntervalSecs = DefaultSnapshotIntervalSecs
+	}
+	if s.RetentionMins <= 0 {
+		s.RetentionMins = DefaultSnapshotRetentionMins
+	}
+}
+
+// Active returns true if snapshots should be taken.
+func (s *Snapshots) Active() bool {
+	return s.Enabled && s.IntervalSecs > 0
+}
+
+// Interval returns the delay between snapshots.
+func (s *Snapshots) Interval() time.Duration {
+	return time.Duration(s.IntervalSecs) * time.Second
+}
+
+// Retention returns how long snapshots are kept around.
+func (s *Snapshots) Retention() time.Duration {
+	return time.Duration(s.RetentionMins) * time.Minute
+}
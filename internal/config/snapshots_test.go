@@ -0,0 +1,51 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotsValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e    config.Snapshots
+		enabled bool
+	}{
+		"default": {
+			s: config.Snapshots{IntervalSecs: -1},
+			e: *config.NewSnapshots(),
+		},
+		"enabled": {
+			s:       config.Snapshots{Enabled: true, IntervalSecs: -1},
+			e:       config.Snapshots{Enabled: true, IntervalSecs: config.DefaultSnapshotIntervalSecs, RetentionMins: config.DefaultSnapshotRetentionMins},
+			enabled: true,
+		},
+		"disabled": {
+			s: config.Snapshots{Enabled: true},
+			e: config.Snapshots{Enabled: true, RetentionMins: config.DefaultSnapshotRetentionMins},
+		},
+		"custom": {
+			s:       config.Snapshots{Enabled: true, IntervalSecs: 10, RetentionMins: 5},
+			e:       config.Snapshots{Enabled: true, IntervalSecs: 10, RetentionMins: 5},
+			enabled: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate(nil, nil)
+			assert.Equal(t, u.e, u.s)
+			assert.Equal(t, u.enabled, u.s.Active())
+		})
+	}
+}
+
+func TestSnapshotsDurations(t *testing.T) {
+	s := config.Snapshots{IntervalSecs: 30, RetentionMins: 10}
+
+	assert.Equal(t, 30*time.Second, s.Interval())
+	assert.Equal(t, 10*time.Minute, s.Retention())
+}
@@ -0,0 +1,184 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// K9sTemplates tracks the user manifest templates directory.
+var K9sTemplates = filepath.Join(K9sHome(), "templates")
+
+var templateVarRX = regexp.MustCompile(`\$\{([A-Z][A-Z0-9_]*)\}`)
+
+// builtinTemplates tracks default manifest templates keyed by singular kind.
+var builtinTemplates = map[string]string{
+	"deployment": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+  labels:
+    app: ${NAME}
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: ${NAME}
+  template:
+    metadata:
+      labels:
+        app: ${NAME}
+    spec:
+      containers:
+      - name: ${NAME}
+        image: ${IMAGE}
+`,
+	"service": `apiVersion: v1
+kind: Service
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+spec:
+  selector:
+    app: ${NAME}
+  ports:
+  - port: ${PORT}
+    targetPort: ${PORT}
+`,
+	"configmap": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+data: {}
+`,
+	"secret": `apiVersion: v1
+kind: Secret
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+type: Opaque
+stringData: {}
+`,
+	"job": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: ${NAME}
+        image: ${IMAGE}
+`,
+	"cronjob": `apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+spec:
+  schedule: "${SCHEDULE}"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+          - name: ${NAME}
+            image: ${IMAGE}
+`,
+}
+
+// Template represents a resource manifest template.
+type Template struct {
+	Kind     string
+	Manifest string
+}
+
+// LoadTemplate returns the template for a given kind. User templates located
+// in dir as <kind>.yml or <kind>.yaml take precedence over the builtin ones.
+func LoadTemplate(dir, kind string) (*Template, error) {
+	kind = strings.ToLower(kind)
+	for _, ext := range []string{".yml", ".yaml"} {
+		raw, err := ioutil.ReadFile(filepath.Join(dir, kind+ext))
+		if err == nil {
+			return &Template{Kind: kind, Manifest: string(raw)}, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	if m, ok := builtinTemplates[kind]; ok {
+		return &Template{Kind: kind, Manifest: m}, nil
+	}
+
+	return nil, fmt.Errorf("no template found for kind %q. Available: %s", kind, strings.Join(TemplateKinds(dir), ", "))
+}
+
+// TemplateKinds returns all available template kinds.
+func TemplateKinds(dir string) []string {
+	kk := make(map[string]struct{}, len(builtinTemplates))
+	for k := range builtinTemplates {
+		kk[k] = struct{}{}
+	}
+	if ff, err := ioutil.ReadDir(dir); err == nil {
+		for _, f := range ff {
+			ext := filepath.Ext(f.Name())
+			if f.IsDir() || (ext != ".yml" && ext != ".yaml") {
+				continue
+			}
+			kk[strings.TrimSuffix(f.Name(), ext)] = struct{}{}
+		}
+	}
+
+	ss := make([]string, 0, len(kk))
+	for k := range kk {
+		ss = append(ss, k)
+	}
+	sort.Strings(ss)
+
+	return ss
+}
+
+// Vars returns the template variables in order of appearance.
+func (t Template) Vars() []string {
+	var vv []string
+	seen := make(map[string]struct{})
+	for _, m := range templateVarRX.FindAllStringSubmatch(t.Manifest, -1) {
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		vv = append(vv, m[1])
+	}
+
+	return vv
+}
+
+// Render substitutes the template variables with the given values.
+func (t Template) Render(vv map[string]string) (string, error) {
+	var missing []string
+	res := templateVarRX.ReplaceAllStringFunc(t.Manifest, func(s string) string {
+		k := templateVarRX.FindStringSubmatch(s)[1]
+		v, ok := vv[k]
+		if !ok || v == "" {
+			if !InList(missing, k) {
+				missing = append(missing, k)
+			}
+			return s
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing template values for %s", strings.Join(missing, ", "))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	uu := map[string]struct {
+		kind string
+		vars []string
+		err  bool
+	}{
+		"user": {
+			kind: "fred",
+			vars: []string{"NAME", "NAMESPACE", "OWNER"},
+		},
+		"builtin": {
+			kind: "Deployment",
+			vars: []string{"NAME", "NAMESPACE", "IMAGE"},
+		},
+		"unknown": {
+			kind: "blee",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tpl, err := config.LoadTemplate("testdata/templates", u.kind)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.vars, tpl.Vars())
+		})
+	}
+}
+
+func TestTemplateKinds(t *testing.T) {
+	assert.Equal(t, []string{"configmap", "cronjob", "deployment", "fred", "job", "secret", "service"}, config.TemplateKinds("testdata/templates"))
+}
+
+func TestTemplateRender(t *testing.T) {
+	tpl := config.Template{Manifest: "name: ${NAME}\nns: ${NAMESPACE}\nalias: ${NAME}\nowner: ${OWNER}"}
+
+	s, err := tpl.Render(map[string]string{"NAME": "fred", "NAMESPACE": "default", "OWNER": "blee"})
+	assert.Nil(t, err)
+	assert.Equal(t, "name: fred\nns: default\nalias: fred\nowner: blee", s)
+
+	_, err = tpl.Render(map[string]string{"NAMESPACE": "default", "OWNER": ""})
+	assert.Equal(t, "missing template values for NAME, OWNER", err.Error())
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// DefaultTrivyBinary tracks the default Trivy client executable.
+	DefaultTrivyBinary = "trivy"
+
+	// DefaultTrivyTTLSecs tracks how long images scan reports are cached.
+	DefaultTrivyTTLSecs = 3600
+)
+
+// Trivy represents a Trivy server used to overlay images vulnerabilities.
+type Trivy struct {
+	// Server is the Trivy server url ie http://trivy.trivy-system:4954.
+	Server string `yaml:"server"`
+
+	// Binary is the Trivy client executable used to submit scans.
+	Binary string `yaml:"binary,omitempty"`
+
+	// Token authenticates the client against the Trivy server.
+	Token string `yaml:"token,omitempty"`
+
+	// TTLSecs tracks how long images scan reports are cached.
+	TTLSecs int `yaml:"ttlSecs,omitempty"`
+}
+
+// NewTrivy returns a new instance.
+func NewTrivy() *Trivy {
+	return &Trivy{
+		Binary:  DefaultTrivyBinary,
+		TTLSecs: DefaultTrivyTTLSecs,
+	}
+}
+
+// Validate validates the configuration.
+func (t *Trivy) Validate(client.Connection, KubeSettings) {
+	t.Server = strings.TrimSuffix(strings.TrimSpace(t.Server), "/")
+	if t.Binary = strings.TrimSpace(t.Binary); t.Binary == "" {
+		t.Binary = DefaultTrivyBinary
+	}
+	if t.TTLSecs <= 0 {
+		t.TTLSecs = DefaultTrivyTTLSecs
+	}
+}
+
+// IsEnabled checks if a Trivy server is configured.
+func (t *Trivy) IsEnabled() bool {
+	return t != nil && t.Server != ""
+}
+
+// TTL returns how long images scan reports are cached.
+func (t *Trivy) TTL() time.Duration {
+	return time.Duration(t.TTLSecs) * time.Second
+}
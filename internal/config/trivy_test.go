@@ -0,0 +1,28 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrivyValidate(t *testing.T) {
+	var tr *config.Trivy
+	assert.False(t, tr.IsEnabled())
+
+	tr = &config.Trivy{Server: " http://trivy:4954/ ", Binary: " "}
+	tr.Validate(nil, nil)
+	assert.True(t, tr.IsEnabled())
+	assert.Equal(t, "http://trivy:4954", tr.Server)
+	assert.Equal(t, config.DefaultTrivyBinary, tr.Binary)
+	assert.Equal(t, time.Hour, tr.TTL())
+}
+
+func TestTrivyDisabled(t *testing.T) {
+	tr := config.NewTrivy()
+	tr.Validate(nil, nil)
+
+	assert.False(t, tr.IsEnabled())
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sViewStatesDir represents the location of persisted view states.
+var K9sViewStatesDir = filepath.Join(K9sHome(), "viewstates")
+
+// ViewState tracks a view sort column, wide mode and filter.
+type ViewState struct {
+	SortCol string `yaml:"sortCol,omitempty"`
+	SortAsc bool   `yaml:"sortAsc"`
+	Wide    bool   `yaml:"wide,omitempty"`
+	Filter  string `yaml:"filter,omitempty"`
+}
+
+// ViewStates tracks the views states of a given context keyed by GVR.
+type ViewStates struct {
+	Context string               `yaml:"context"`
+	Views   map[string]ViewState `yaml:"views"`
+
+	dirty bool
+}
+
+// NewViewStates returns new view states for a given context.
+func NewViewStates(context string) *ViewStates {
+	return &ViewStates{
+		Context: context,
+		Views:   make(map[string]ViewState),
+	}
+}
+
+// ViewStatesFile returns the view states location for a given context.
+func ViewStatesFile(context string) string {
+	return filepath.Join(K9sViewStatesDir, sessionNameRX.ReplaceAllString(context, "-")+".yml")
+}
+
+// LoadViewStates loads view states from a given file. A missing file yields
+// no states.
+func LoadViewStates(path string) (*ViewStates, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var s ViewStates
+	if err := yaml.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	if s.Views == nil {
+		s.Views = make(map[string]ViewState)
+	}
+
+	return &s, nil
+}
+
+// Get returns the state of a given view.
+func (s *ViewStates) Get(gvr string) (ViewState, bool) {
+	v, ok := s.Views[gvr]
+
+	return v, ok
+}
+
+// Set records the state of a given view.
+func (s *ViewStates) Set(gvr string, v ViewState) {
+	if old, ok := s.Views[gvr]; ok && old == v {
+		return
+	}
+	s.Views[gvr] = v
+	s.dirty = true
+}
+
+// Dirty returns true if states changed since they were last saved.
+func (s *ViewStates) Dirty() bool {
+	return s.dirty
+}
+
+// Save persists view states to a given file.
+func (s *ViewStates) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, raw, DefaultFileMod); err != nil {
+		return err
+	}
+	s.dirty = false
+
+	return nil
+}
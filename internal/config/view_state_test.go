@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewStatesFile(t *testing.T) {
+	assert.Equal(t, filepath.Join(config.K9sViewStatesDir, "arn-aws-eks-fred.yml"), config.ViewStatesFile("arn:aws:eks/fred"))
+}
+
+func TestViewStatesSet(t *testing.T) {
+	s := config.NewViewStates("fred")
+	assert.False(t, s.Dirty())
+
+	_, ok := s.Get("v1/pods")
+	assert.False(t, ok)
+
+	v := config.ViewState{SortCol: "AGE", SortAsc: true, Wide: true, Filter: "blee"}
+	s.Set("v1/pods", v)
+	assert.True(t, s.Dirty())
+	st, ok := s.Get("v1/pods")
+	assert.True(t, ok)
+	assert.Equal(t, v, st)
+}
+
+func TestViewStatesSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-viewstates")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "fred.yml")
+	s, err := config.LoadViewStates(path)
+	assert.Nil(t, err)
+	assert.Nil(t, s)
+
+	s = config.NewViewStates("fred")
+	s.Set("v1/pods", config.ViewState{SortCol: "NAME", Filter: "-l app=blee"})
+	s.Set("apps/v1/deployments", config.ViewState{SortCol: "AGE", SortAsc: true, Wide: true})
+	assert.Nil(t, s.Save(path))
+	assert.False(t, s.Dirty())
+
+	l, err := config.LoadViewStates(path)
+	assert.Nil(t, err)
+	assert.Equal(t, s, l)
+
+	l.Set("v1/pods", config.ViewState{SortCol: "NAME", Filter: "-l app=blee"})
+	assert.False(t, l.Dirty())
+}
@@ -18,7 +18,24 @@ type ViewConfigListener interface {
 
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
-	Columns []string `yaml:"columns"`
+	Columns       []string       `yaml:"columns"`
+	CustomColumns []CustomColumn `yaml:"customColumns,omitempty"`
+
+	// Condition names the status condition type summarized in the READY and
+	// STATUS columns of custom resources ie Ready. Use none to hide them.
+	Condition string `yaml:"condition,omitempty"`
+}
+
+// CustomColumn represents a user defined column.
+type CustomColumn struct {
+	// Name is the column header.
+	Name string `yaml:"name"`
+
+	// JSONPath extracts the column value from the resource ie .spec.nodeName.
+	JSONPath string `yaml:"jsonPath"`
+
+	// Wide only shows the column in wide mode.
+	Wide bool `yaml:"wide"`
 }
 
 // ViewSettings represent a collection of view configurations.
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// DefaultWatchdogPollSecs tracks default delay between watchdog checks.
+	DefaultWatchdogPollSecs = 15
+
+	// DefaultWarningEventsThreshold tracks the default event count raising an alert.
+	DefaultWarningEventsThreshold = 5
+
+	// DefaultFailedJobsThreshold tracks the default failed pods count raising a job alert.
+	DefaultFailedJobsThreshold = 1
+)
+
+// Watchdog tracks cluster alerts detection options.
+type Watchdog struct {
+	Enabled    bool        `yaml:"enabled"`
+	Namespaces []string    `yaml:"namespaces,omitempty"`
+	Selectors  []string    `yaml:"selectors,omitempty"`
+	PollSecs   int         `yaml:"pollSecs"`
+	Rules      *AlertRules `yaml:"rules,omitempty"`
+}
+
+// AlertRule tracks a watchdog alert rule options.
+type AlertRule struct {
+	Disabled bool `yaml:"disabled,omitempty"`
+
+	// Threshold tracks the level raising an alert ie container restarts,
+	// event counts or job failed pods. Zero means the rule default.
+	Threshold int `yaml:"threshold,omitempty"`
+}
+
+// AlertRules tracks the watchdog alert rules.
+type AlertRules struct {
+	CrashLoop     AlertRule `yaml:"crashLoop"`
+	ImagePull     AlertRule `yaml:"imagePull"`
+	OOMKilled     AlertRule `yaml:"oomKilled"`
+	WarningEvents AlertRule `yaml:"warningEvents"`
+	FailedJobs    AlertRule `yaml:"failedJobs"`
+	NodePressure  AlertRule `yaml:"nodePressure"`
+}
+
+// NewWatchdog returns a new instance.
+func NewWatchdog() *Watchdog {
+	return &Watchdog{
+		PollSecs: DefaultWatchdogPollSecs,
+	}
+}
+
+// Validate checks watchdog options and make sure we're cool. If not use defaults.
+func (w *Watchdog) Validate(_ client.Connection, _ KubeSettings) {
+	if w.PollSecs <= 0 {
+		w.PollSecs = DefaultWatchdogPollSecs
+	}
+	w.Namespaces = trimAll(w.Namespaces)
+	w.Selectors = trimAll(w.Selectors)
+	if w.Rules == nil {
+		return
+	}
+	for _, r := range []*AlertRule{
+		&w.Rules.CrashLoop,
+		&w.Rules.ImagePull,
+		&w.Rules.OOMKilled,
+		&w.Rules.WarningEvents,
+		&w.Rules.FailedJobs,
+		&w.Rules.NodePressure,
+	} {
+		if r.Threshold < 0 {
+			r.Threshold = 0
+		}
+	}
+}
+
+// AlertRules returns the alert rules with their default thresholds.
+func (w *Watchdog) AlertRules() AlertRules {
+	var rr AlertRules
+	if w != nil && w.Rules != nil {
+		rr = *w.Rules
+	}
+	if rr.WarningEvents.Threshold == 0 {
+		rr.WarningEvents.Threshold = DefaultWarningEventsThreshold
+	}
+	if rr.FailedJobs.Threshold == 0 {
+		rr.FailedJobs.Threshold = DefaultFailedJobsThreshold
+	}
+
+	return rr
+}
+
+// PollInterval returns the delay between watchdog checks.
+func (w *Watchdog) PollInterval() time.Duration {
+	return time.Duration(w.PollSecs) * time.Second
+}
+
+// Scopes returns the namespaces and label selectors pairs to monitor.
+// No namespaces means all namespaces. No selectors means all pods.
+func (w *Watchdog) Scopes() [][2]string {
+	nss, sels := w.Namespaces, w.Selectors
+	if len(nss) == 0 {
+		nss = []string{client.AllNamespaces}
+	}
+	if len(sels) == 0 {
+		sels = []string{""}
+	}
+	ss := make([][2]string, 0, len(nss)*len(sels))
+	for _, ns := range nss {
+		for _, sel := range sels {
+			ss = append(ss, [2]string{ns, sel})
+		}
+	}
+
+	return ss
+}
+
+// Helpers...
+
+func trimAll(ss []string) []string {
+	var res []string
+	for _, s := range ss {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,101 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchdogValidate(t *testing.T) {
+	uu := map[string]struct {
+		w, e config.Watchdog
+	}{
+		"default": {
+			e: *config.NewWatchdog(),
+		},
+		"custom": {
+			w: config.Watchdog{Enabled: true, Namespaces: []string{" fred ", ""}, Selectors: []string{"app=blee"}, PollSecs: 5},
+			e: config.Watchdog{Enabled: true, Namespaces: []string{"fred"}, Selectors: []string{"app=blee"}, PollSecs: 5},
+		},
+		"rules": {
+			w: config.Watchdog{PollSecs: 5, Rules: &config.AlertRules{CrashLoop: config.AlertRule{Threshold: -1}}},
+			e: config.Watchdog{PollSecs: 5, Rules: &config.AlertRules{}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.w.Validate(nil, nil)
+			assert.Equal(t, u.e, u.w)
+		})
+	}
+}
+
+func TestWatchdogScopes(t *testing.T) {
+	uu := map[string]struct {
+		w config.Watchdog
+		e [][2]string
+	}{
+		"all": {
+			e: [][2]string{{"", ""}},
+		},
+		"namespaces": {
+			w: config.Watchdog{Namespaces: []string{"fred", "blee"}},
+			e: [][2]string{{"fred", ""}, {"blee", ""}},
+		},
+		"cross": {
+			w: config.Watchdog{Namespaces: []string{"fred"}, Selectors: []string{"app=a", "app=b"}},
+			e: [][2]string{{"fred", "app=a"}, {"fred", "app=b"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.Scopes())
+		})
+	}
+}
+
+func TestWatchdogAlertRules(t *testing.T) {
+	uu := map[string]struct {
+		w *config.Watchdog
+		e config.AlertRules
+	}{
+		"none": {
+			e: config.AlertRules{
+				WarningEvents: config.AlertRule{Threshold: config.DefaultWarningEventsThreshold},
+				FailedJobs:    config.AlertRule{Threshold: config.DefaultFailedJobsThreshold},
+			},
+		},
+		"default": {
+			w: config.NewWatchdog(),
+			e: config.AlertRules{
+				WarningEvents: config.AlertRule{Threshold: config.DefaultWarningEventsThreshold},
+				FailedJobs:    config.AlertRule{Threshold: config.DefaultFailedJobsThreshold},
+			},
+		},
+		"custom": {
+			w: &config.Watchdog{Rules: &config.AlertRules{
+				CrashLoop:     config.AlertRule{Threshold: 3},
+				ImagePull:     config.AlertRule{Disabled: true},
+				WarningEvents: config.AlertRule{Threshold: 10},
+			}},
+			e: config.AlertRules{
+				CrashLoop:     config.AlertRule{Threshold: 3},
+				ImagePull:     config.AlertRule{Disabled: true},
+				WarningEvents: config.AlertRule{Threshold: 10},
+				FailedJobs:    config.AlertRule{Threshold: config.DefaultFailedJobsThreshold},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.w.AlertRules())
+		})
+	}
+}
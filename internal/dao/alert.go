@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// AlertLister represents a source of watchdog alerts.
+type AlertLister interface {
+	// Alerts returns all current alerts.
+	Alerts() []render.ClusterAlert
+}
+
+// Alert represents watchdog alerts.
+type Alert struct {
+	NonResource
+}
+
+// List returns a collection of alerts.
+func (a *Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	l, ok := ctx.Value(internal.KeyAlerts).(AlertLister)
+	if !ok || l == nil {
+		return nil, errors.New("watchdog is not enabled")
+	}
+
+	aa := l.Alerts()
+	oo := make([]runtime.Object, 0, len(aa))
+	for _, a := range aa {
+		oo = append(oo, a)
+	}
+
+	return oo, nil
+}
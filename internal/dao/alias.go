@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -14,7 +16,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-var _ Accessor = (*Alias)(nil)
+var (
+	_ Accessor = (*Alias)(nil)
+
+	aliasVarRX = regexp.MustCompile(`\$(?:\{(\d|\*|[A-Z]+)(:-[^}]*)?\}|(\d|\*|[A-Z]+))`)
+)
+
+// AliasScope represents the environment parametrized aliases expand in.
+type AliasScope struct {
+	Context, Cluster, Namespace string
+}
 
 // Alias tracks standard and custom command aliases.
 type Alias struct {
@@ -61,6 +72,22 @@ func (a *Alias) AsGVR(cmd string) (client.GVR, bool) {
 	return client.GVR{}, false
 }
 
+// Expand expands a parametrized alias command line using its arguments and
+// the scope variables. Commands not matching a parametrized alias are
+// returned as is.
+func (a *Alias) Expand(cmd string, scope AliasScope) (string, error) {
+	args := strings.Fields(cmd)
+	if len(args) == 0 {
+		return cmd, nil
+	}
+	tpl, ok := a.CommandFor(args[0], scope.Context, scope.Cluster)
+	if !ok {
+		return cmd, nil
+	}
+
+	return expandAlias(args[0], tpl, args[1:], scope)
+}
+
 // Get fetch a resource.
 func (a *Alias) Get(_ context.Context, _ string) (runtime.Object, error) {
 	return nil, errors.New("NYI!!")
@@ -100,3 +127,67 @@ func (a *Alias) load() error {
 
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// expandAlias substitutes positional arguments $1..$9, all arguments $* and
+// the $CONTEXT, $CLUSTER and $NAMESPACE variables in an alias template.
+// ${1:-fred} provides a default value.
+func expandAlias(name, tpl string, args []string, scope AliasScope) (string, error) {
+	vars := map[string]string{
+		"CONTEXT":   scope.Context,
+		"CLUSTER":   scope.Cluster,
+		"NAMESPACE": scope.Namespace,
+	}
+	var (
+		err      error
+		used     int
+		variadic bool
+		subst    func(string) string
+	)
+	subst = func(m string) string {
+		mm := aliasVarRX.FindStringSubmatch(m)
+		v, def := mm[1]+mm[3], strings.TrimPrefix(mm[2], ":-")
+		switch {
+		case v == "*":
+			variadic = true
+			return strings.Join(args, " ")
+		case v[0] >= '0' && v[0] <= '9':
+			i, _ := strconv.Atoi(v)
+			if i == 0 {
+				return name
+			}
+			if i > used {
+				used = i
+			}
+			if i <= len(args) {
+				return args[i-1]
+			}
+			if mm[2] != "" {
+				return aliasVarRX.ReplaceAllStringFunc(def, subst)
+			}
+			if err == nil {
+				err = fmt.Errorf("alias %q expects argument $%d", name, i)
+			}
+			return m
+		}
+		val, ok := vars[v]
+		if !ok {
+			return m
+		}
+		if val == "" && mm[2] != "" {
+			return aliasVarRX.ReplaceAllStringFunc(def, subst)
+		}
+		return val
+	}
+	out := aliasVarRX.ReplaceAllStringFunc(tpl, subst)
+	if err != nil {
+		return "", err
+	}
+	if !variadic && len(args) > used {
+		return "", fmt.Errorf("alias %q takes %d argument(s) but got %d", name, used, len(args))
+	}
+
+	return strings.Join(strings.Fields(out), " "), nil
+}
@@ -28,6 +28,46 @@ func TestAliasList(t *testing.T) {
 	assert.Equal(t, 2, len(oo[0].(render.AliasRes).Aliases))
 }
 
+func TestAliasExpand(t *testing.T) {
+	a := dao.Alias{Aliases: config.NewAliases()}
+	a.Commands["podsof"] = []config.CommandAlias{{Command: "pods -l app=$1"}}
+	a.Commands["nspods"] = []config.CommandAlias{{Command: "pods ${2:-$NAMESPACE} -l app=$1"}}
+	a.Commands["find"] = []config.CommandAlias{{Command: "pods /$*"}}
+	a.Commands["here"] = []config.CommandAlias{
+		{Command: "ctx"},
+		{Command: "pods $CLUSTER", Contexts: []string{"prod-*"}},
+	}
+	scope := dao.AliasScope{Context: "prod-us", Cluster: "blee", Namespace: "fred"}
+
+	uu := map[string]struct {
+		cmd, e string
+		err    bool
+	}{
+		"notAlias":     {cmd: "pods fred", e: "pods fred"},
+		"arg":          {cmd: "podsof blee", e: "pods -l app=blee"},
+		"missingArg":   {cmd: "podsof", err: true},
+		"tooManyArgs":  {cmd: "podsof blee duh", err: true},
+		"defaultArg":   {cmd: "nspods blee", e: "pods fred -l app=blee"},
+		"overridenArg": {cmd: "nspods blee kube-system", e: "pods kube-system -l app=blee"},
+		"allArgs":      {cmd: "find blee duh", e: "pods /blee duh"},
+		"scoped":       {cmd: "here", e: "pods blee"},
+		"empty":        {cmd: "", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, err := a.Expand(u.cmd, scope)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, cmd)
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
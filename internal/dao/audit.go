@@ -0,0 +1,264 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Audit)(nil)
+
+const (
+	// AuditSuccess tracks a successful action outcome.
+	AuditSuccess = render.AuditSuccess
+
+	// AuditFailure tracks a failed action outcome.
+	AuditFailure = render.AuditFailure
+
+	webhookTimeout = 5 * time.Second
+
+	// webhookQueueSize tracks the number of entries waiting to be forwarded.
+	webhookQueueSize = 100
+
+	// maxAuditEntries tracks the number of most recent entries listed.
+	maxAuditEntries = 1000
+)
+
+// AuditEntry represents a recorded mutating action.
+type AuditEntry struct {
+	Time    time.Time `json:"time"`
+	Context string    `json:"context"`
+	Cluster string    `json:"cluster"`
+	User    string    `json:"user,omitempty"`
+	Action  string    `json:"action"`
+	GVR     string    `json:"gvr"`
+	Path    string    `json:"path"`
+	Details string    `json:"details,omitempty"`
+	DryRun  bool      `json:"dryRun,omitempty"`
+	Outcome string    `json:"outcome"`
+	Error   string    `json:"error,omitempty"`
+	Diff    string    `json:"diff,omitempty"`
+}
+
+// NewAuditEntry returns a new audit entry with an outcome matching the given error.
+func NewAuditEntry(action, gvr, path string, err error) AuditEntry {
+	e := AuditEntry{
+		Time:    time.Now(),
+		Action:  action,
+		GVR:     gvr,
+		Path:    path,
+		DryRun:  IsDryRun(),
+		Outcome: AuditSuccess,
+	}
+	if err != nil {
+		e.Outcome, e.Error = AuditFailure, err.Error()
+	}
+
+	return e
+}
+
+// Auditor records mutating actions into an append-only log and optionally
+// forwards them to a webhook. Entries are forwarded one at a time by a single
+// worker and dropped when the webhook falls behind.
+type Auditor struct {
+	path    string
+	webhook string
+	client  *http.Client
+	queue   chan []byte
+	once    sync.Once
+	mx      sync.Mutex
+}
+
+// NewAuditor returns a new auditor.
+func NewAuditor(path, webhook string) *Auditor {
+	return &Auditor{
+		path:    path,
+		webhook: webhook,
+		client:  &http.Client{Timeout: webhookTimeout},
+		queue:   make(chan []byte, webhookQueueSize),
+	}
+}
+
+// Record appends an entry to the audit log.
+func (a *Auditor) Record(e AuditEntry) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if a.webhook != "" {
+		a.enqueue(raw)
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	if err := os.MkdirAll(filepath.Dir(a.path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(a.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	_, err = f.Write(append(raw, '\n'))
+
+	return err
+}
+
+func (a *Auditor) enqueue(raw []byte) {
+	a.once.Do(func() {
+		go a.forward()
+	})
+	select {
+	case a.queue <- raw:
+	default:
+		log.Warn().Msgf("Audit webhook queue full. Dropping entry")
+	}
+}
+
+func (a *Auditor) forward() {
+	for raw := range a.queue {
+		if err := postWebhook(a.client, a.webhook, raw); err != nil {
+			log.Warn().Err(err).Msgf("Audit webhook failed")
+		}
+	}
+}
+
+// Audit represents the audit log entries.
+type Audit struct {
+	NonResource
+}
+
+// List returns the most recent audit log entries.
+func (a *Audit) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ee, err := readAuditFile(ctx, maxAuditEntries)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+
+	return oo, nil
+}
+
+// Get returns an audit log entry given its line number.
+func (a *Audit) Get(ctx context.Context, id string) (runtime.Object, error) {
+	if _, err := strconv.Atoi(id); err != nil {
+		return nil, fmt.Errorf("invalid audit entry id %q", id)
+	}
+	ee, err := readAuditFile(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range ee {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no audit entry found for %q", id)
+}
+
+// ReadAuditLog returns the last max audit entries, all entries when max is 0.
+// Entries are identified by their line number in the log. Malformed lines
+// are skipped.
+func ReadAuditLog(r io.Reader, max int) ([]render.AuditRes, error) {
+	var (
+		ee   []render.AuditRes
+		line int
+	)
+	br := bufio.NewReader(r)
+	for {
+		raw, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(raw)) > 0 {
+			line++
+			var e AuditEntry
+			if jerr := json.Unmarshal(raw, &e); jerr != nil {
+				log.Warn().Err(jerr).Msgf("Skipping invalid audit entry on line %d", line)
+			} else {
+				ee = append(ee, e.res(strconv.Itoa(line)))
+				if max > 0 && len(ee) > max {
+					ee = ee[1:]
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return ee, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (e AuditEntry) res(id string) render.AuditRes {
+	return render.AuditRes{
+		ID:      id,
+		Time:    e.Time,
+		Context: e.Context,
+		Cluster: e.Cluster,
+		User:    e.User,
+		Action:  e.Action,
+		GVR:     e.GVR,
+		Path:    e.Path,
+		Details: e.Details,
+		Outcome: e.Outcome,
+		Error:   e.Error,
+		Diff:    e.Diff,
+		DryRun:  e.DryRun,
+	}
+}
+
+// Helpers...
+
+func readAuditFile(ctx context.Context, max int) ([]render.AuditRes, error) {
+	path, ok := ctx.Value(internal.KeyAudit).(string)
+	if !ok || path == "" {
+		return nil, errors.New("expecting a context audit log path")
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	return ReadAuditLog(f, max)
+}
+
+func postWebhook(c *http.Client, url string, raw []byte) error {
+	resp, err := c.Post(url, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s returned status %d", url, resp.StatusCode)
+	}
+
+	return nil
+}
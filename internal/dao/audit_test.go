@@ -0,0 +1,195 @@
+package dao_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	uu := map[string]struct {
+		err     error
+		outcome string
+		msg     string
+	}{
+		"success": {
+			outcome: dao.AuditSuccess,
+		},
+		"failure": {
+			err:     errors.New("boom"),
+			outcome: dao.AuditFailure,
+			msg:     "boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := dao.NewAuditEntry("delete", "v1/pods", "default/fred", u.err)
+			assert.Equal(t, "delete", e.Action)
+			assert.Equal(t, u.outcome, e.Outcome)
+			assert.Equal(t, u.msg, e.Error)
+		})
+	}
+}
+
+func TestAuditorRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit", "audit.log")
+	a := dao.NewAuditor(path, "")
+	assert.Nil(t, a.Record(dao.NewAuditEntry("scale", "apps/v1/deployments", "default/fred", nil)))
+	assert.Nil(t, a.Record(dao.NewAuditEntry("delete", "v1/pods", "default/blee", errors.New("boom"))))
+
+	ee := readAudit(t, path)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "scale", ee[0].Action)
+	assert.Equal(t, dao.AuditFailure, ee[1].Outcome)
+}
+
+func TestAuditorWebhook(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	got := make(chan dao.AuditEntry, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e dao.AuditEntry
+		_ = json.NewDecoder(r.Body).Decode(&e)
+		got <- e
+	}))
+	defer srv.Close()
+
+	a := dao.NewAuditor(filepath.Join(dir, "audit.log"), srv.URL)
+	assert.Nil(t, a.Record(dao.NewAuditEntry("drain", "v1/nodes", "n1", nil)))
+
+	select {
+	case e := <-got:
+		assert.Equal(t, "drain", e.Action)
+		assert.Equal(t, "n1", e.Path)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "webhook not called")
+	}
+}
+
+func TestAuditorWebhookBacklog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		hits    int32
+		release = make(chan struct{})
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	a := dao.NewAuditor(filepath.Join(dir, "audit.log"), srv.URL)
+	for i := 0; i < 200; i++ {
+		assert.Nil(t, a.Record(dao.NewAuditEntry("drain", "v1/nodes", "n1", nil)))
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+}
+
+func TestReadAuditLog(t *testing.T) {
+	log := `{"action":"scale","gvr":"apps/v1/deployments","path":"default/fred","user":"fred","outcome":"success"}
+
+not json
+{"action":"edit","gvr":"v1/configmaps","path":"default/blee","diff":"- a\n+ b\n","outcome":"success"}
+{"action":"delete","gvr":"v1/pods","path":"default/zorg","outcome":"failure","error":"boom"}`
+
+	uu := map[string]struct {
+		max int
+		ids []string
+	}{
+		"all": {
+			ids: []string{"1", "3", "4"},
+		},
+		"max": {
+			max: 2,
+			ids: []string{"3", "4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := dao.ReadAuditLog(strings.NewReader(log), u.max)
+			assert.Nil(t, err)
+			ids := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ids = append(ids, e.ID)
+			}
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
+func TestAuditGet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	a := dao.NewAuditor(path, "")
+	e := dao.NewAuditEntry("edit", "v1/configmaps", "default/fred", nil)
+	e.User, e.Diff = "fred", "- a\n+ b\n"
+	assert.Nil(t, a.Record(e))
+
+	var res dao.Audit
+	ctx := context.WithValue(context.Background(), internal.KeyAudit, path)
+	oo, err := res.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	o, err := res.Get(ctx, "1")
+	assert.Nil(t, err)
+	r, ok := o.(render.AuditRes)
+	assert.True(t, ok)
+	assert.Equal(t, "fred", r.User)
+	assert.Equal(t, "- a\n+ b\n", r.Diff)
+
+	_, err = res.Get(ctx, "2")
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func readAudit(t *testing.T, path string) []dao.AuditEntry {
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+
+	var ee []dao.AuditEntry
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e dao.AuditEntry
+		assert.Nil(t, json.Unmarshal(s.Bytes(), &e))
+		ee = append(ee, e)
+	}
+
+	return ee
+}
@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+const (
+	// DefaultBulkWorkers tracks the number of concurrent bulk workers.
+	DefaultBulkWorkers = 5
+
+	// DefaultBulkQPS tracks the max number of bulk operations per second.
+	DefaultBulkQPS = 10
+
+	// DefaultBulkBurst tracks the bulk operations burst size.
+	DefaultBulkBurst = 10
+)
+
+// BulkFunc performs an operation on a single resource.
+type BulkFunc func(ctx context.Context, path string) error
+
+// BulkResult represents the outcome of an operation on a single resource.
+type BulkResult struct {
+	Path string
+	Err  error
+}
+
+// BulkOptions tracks bulk operations settings.
+type BulkOptions struct {
+	Workers int
+	QPS     float32
+	Burst   int
+}
+
+// NewBulkOptions returns default bulk settings.
+func NewBulkOptions() BulkOptions {
+	return BulkOptions{
+		Workers: DefaultBulkWorkers,
+		QPS:     DefaultBulkQPS,
+		Burst:   DefaultBulkBurst,
+	}
+}
+
+// Bulk runs an operation across a collection of resources using a rate
+// limited worker pool. Each outcome is reported on the returned channel
+// which gets closed once all resources were processed or the context is
+// canceled.
+func Bulk(ctx context.Context, paths []string, opts BulkOptions, f BulkFunc) <-chan BulkResult {
+	if opts.Workers <= 0 {
+		opts.Workers = DefaultBulkWorkers
+	}
+	if opts.QPS <= 0 {
+		opts.QPS, opts.Burst = DefaultBulkQPS, DefaultBulkBurst
+	}
+	if opts.Burst <= 0 {
+		opts.Burst = 1
+	}
+
+	limiter := flowcontrol.NewTokenBucketRateLimiter(opts.QPS, opts.Burst)
+	jobs, out := make(chan string), make(chan BulkResult, len(paths))
+	go func() {
+		defer close(jobs)
+		for _, p := range paths {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- p:
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(opts.Workers)
+	for i := 0; i < opts.Workers; i++ {
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				if err := limiter.Wait(ctx); err != nil {
+					out <- BulkResult{Path: p, Err: ctx.Err()}
+					continue
+				}
+				out <- BulkResult{Path: p, Err: f(ctx, p)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		limiter.Stop()
+		close(out)
+	}()
+
+	return out
+}
@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulk(t *testing.T) {
+	uu := map[string]struct {
+		paths []string
+		opts  dao.BulkOptions
+		fails int
+	}{
+		"empty": {
+			opts: dao.NewBulkOptions(),
+		},
+		"all": {
+			paths: []string{"ns1/a", "ns1/b", "ns1/c", "ns1/d"},
+			opts:  dao.BulkOptions{Workers: 2, QPS: 100, Burst: 4},
+		},
+		"fails": {
+			paths: []string{"ns1/a", "ns1/c", "ns1/fail"},
+			opts:  dao.BulkOptions{},
+			fails: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var calls int32
+			f := func(_ context.Context, path string) error {
+				atomic.AddInt32(&calls, 1)
+				if path == "ns1/fail" {
+					return errors.New("boom")
+				}
+				return nil
+			}
+
+			var (
+				pp    []string
+				fails int
+			)
+			for r := range dao.Bulk(context.Background(), u.paths, u.opts, f) {
+				pp = append(pp, r.Path)
+				if r.Err != nil {
+					fails++
+				}
+			}
+			sort.Strings(pp)
+			assert.Equal(t, u.paths, pp)
+			assert.Equal(t, u.fails, fails)
+			assert.Equal(t, int32(len(u.paths)), calls)
+		})
+	}
+}
+
+func TestBulkCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var calls int32
+	f := func(context.Context, string) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	}
+	for r := range dao.Bulk(ctx, []string{"a", "b", "c"}, dao.BulkOptions{Workers: 1, QPS: 1, Burst: 1}, f) {
+		assert.NotNil(t, r.Err)
+	}
+	assert.Equal(t, int32(0), calls)
+}
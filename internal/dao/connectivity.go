@@ -0,0 +1,441 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// HopPass tracks a successful connectivity check.
+	HopPass HopStatus = "PASS"
+
+	// HopFail tracks a failed connectivity check.
+	HopFail HopStatus = "FAIL"
+
+	// HopSkip tracks a connectivity check that does not apply.
+	HopSkip HopStatus = "SKIP"
+
+	// backendTimeout tracks the backend reachability dial timeout.
+	backendTimeout = 5 * time.Second
+)
+
+// HopStatus represents a connectivity check outcome.
+type HopStatus string
+
+// Hop represents a connectivity check along a service or ingress route.
+type Hop struct {
+	// Name tracks the check ie service, endpoints, readiness or backend.
+	Name string
+
+	// Target tracks the checked resource.
+	Target string
+
+	Status HopStatus
+	Detail string
+}
+
+// String returns the hop summary.
+func (h Hop) String() string {
+	s := fmt.Sprintf("%-4s %-9s %s", h.Status, h.Name, h.Target)
+	if h.Detail != "" {
+		s += " (" + h.Detail + ")"
+	}
+
+	return s
+}
+
+// Hops represents a connectivity report.
+type Hops []Hop
+
+// Failed returns the number of failed checks.
+func (hh Hops) Failed() int {
+	var n int
+	for _, h := range hh {
+		if h.Status == HopFail {
+			n++
+		}
+	}
+
+	return n
+}
+
+// String returns a connectivity report.
+func (hh Hops) String() string {
+	if len(hh) == 0 {
+		return "No checks performed."
+	}
+	ss := make([]string, 0, len(hh))
+	var pass int
+	for _, h := range hh {
+		if h.Status == HopPass {
+			pass++
+		}
+		ss = append(ss, h.String())
+	}
+	summary := fmt.Sprintf("%d check(s) passed, %d failed", pass, hh.Failed())
+
+	return summary + "\n\n" + strings.Join(ss, "\n")
+}
+
+// Connectivity checks a service resolves to ready endpoints and that its
+// backends accept connections thru a temporary port-forward.
+func (s *Service) Connectivity(ctx context.Context, path string) (Hops, error) {
+	svc, err := s.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceConnectivity(ctx, s.Client(), svc, svc.Spec.Ports)
+}
+
+// Ingress represents a k8s ingress.
+type Ingress struct {
+	Resource
+}
+
+// GetInstance returns an ingress instance.
+func (i *Ingress) GetInstance(fqn string) (*v1beta1.Ingress, error) {
+	o, err := i.Get(context.Background(), fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	var ing v1beta1.Ingress
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ing)
+	if err != nil {
+		return nil, fmt.Errorf("expecting Ingress resource")
+	}
+
+	return &ing, nil
+}
+
+// Connectivity checks each ingress host/path routes to an existing service
+// port, then runs the service checks for the routed ports.
+func (i *Ingress) Connectivity(ctx context.Context, path string) (Hops, error) {
+	ing, err := i.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+	rr := IngressRoutes(ing)
+	if len(rr) == 0 {
+		return nil, fmt.Errorf("ingress %s defines no backends", path)
+	}
+	dial, err := i.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	svcs, routed := make(map[string]*v1.Service), make(map[string][]v1.ServicePort)
+	var names []string
+	hh := make(Hops, 0, len(rr))
+	for _, r := range rr {
+		svc, ok := svcs[r.Service]
+		if !ok {
+			svc, err = dial.CoreV1().Services(ing.Namespace).Get(ctx, r.Service, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				svc, err = nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			svcs[r.Service] = svc
+		}
+		h, sp := routeHop(r, ing.Namespace, svc)
+		hh = append(hh, h)
+		if sp == nil {
+			continue
+		}
+		if _, ok := routed[r.Service]; !ok {
+			names = append(names, r.Service)
+		}
+		if !hasServicePort(routed[r.Service], *sp) {
+			routed[r.Service] = append(routed[r.Service], *sp)
+		}
+	}
+
+	for _, n := range names {
+		sh, err := serviceConnectivity(ctx, i.Client(), svcs[n], routed[n])
+		if err != nil {
+			return nil, err
+		}
+		hh = append(hh, sh...)
+	}
+
+	return hh, nil
+}
+
+// IngressRoute represents an ingress host/path to service port route.
+type IngressRoute struct {
+	Host, Path string
+	Service    string
+	Port       intstr.IntOrString
+}
+
+// String returns the route host and path.
+func (r IngressRoute) String() string {
+	host, path := r.Host, r.Path
+	if host == "" {
+		host = "*"
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	return host + path
+}
+
+// IngressRoutes returns an ingress routes, the default backend first.
+func IngressRoutes(ing *v1beta1.Ingress) []IngressRoute {
+	var rr []IngressRoute
+	if b := ing.Spec.Backend; b != nil {
+		rr = append(rr, IngressRoute{Path: "/*", Service: b.ServiceName, Port: b.ServicePort})
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			rr = append(rr, IngressRoute{
+				Host:    rule.Host,
+				Path:    p.Path,
+				Service: p.Backend.ServiceName,
+				Port:    p.Backend.ServicePort,
+			})
+		}
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// backend represents a ready endpoint to probe.
+type backend struct {
+	pod  string
+	port int
+}
+
+func serviceConnectivity(ctx context.Context, conn client.Connection, svc *v1.Service, pp []v1.ServicePort) (Hops, error) {
+	hh := Hops{serviceHop(svc)}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return append(hh, Hop{
+			Name:   "endpoints",
+			Target: client.MetaFQN(svc.ObjectMeta),
+			Status: HopSkip,
+			Detail: "ExternalName service resolves to " + svc.Spec.ExternalName,
+		}), nil
+	}
+
+	dial, err := conn.Dial()
+	if err != nil {
+		return nil, err
+	}
+	ep, err := dial.CoreV1().Endpoints(svc.Namespace).Get(ctx, svc.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		ep, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, sp := range pp {
+		ph, b := portHops(svc, ep, sp)
+		hh = append(hh, ph...)
+		if b != nil {
+			hh = append(hh, probeBackend(conn, svcPortTarget(svc, sp), *b))
+		}
+	}
+
+	return hh, nil
+}
+
+func serviceHop(svc *v1.Service) Hop {
+	ip := svc.Spec.ClusterIP
+	if ip == v1.ClusterIPNone {
+		ip = "headless"
+	}
+	detail := string(svc.Spec.Type)
+	if ip != "" {
+		detail += " " + ip
+	}
+
+	return Hop{Name: "service", Target: client.MetaFQN(svc.ObjectMeta), Status: HopPass, Detail: detail}
+}
+
+// portHops checks a service port resolves to ready endpoints. It returns
+// the backend to probe when a ready pod endpoint is found.
+func portHops(svc *v1.Service, ep *v1.Endpoints, sp v1.ServicePort) (Hops, *backend) {
+	target := svcPortTarget(svc, sp)
+	var (
+		ready, notReady []v1.EndpointAddress
+		b               *backend
+	)
+	if ep != nil {
+		for _, ss := range ep.Subsets {
+			port, ok := endpointPort(ss, sp)
+			if !ok {
+				continue
+			}
+			ready, notReady = append(ready, ss.Addresses...), append(notReady, ss.NotReadyAddresses...)
+			if b == nil {
+				b = podBackend(ss.Addresses, port)
+			}
+		}
+	}
+
+	total := len(ready) + len(notReady)
+	if total == 0 {
+		detail := "no endpoints resolved"
+		if len(svc.Spec.Selector) == 0 {
+			detail += ", service has no selector"
+		}
+		return Hops{{Name: "endpoints", Target: target, Status: HopFail, Detail: detail}}, nil
+	}
+	hh := Hops{
+		{Name: "endpoints", Target: target, Status: HopPass, Detail: fmt.Sprintf("%d address(es)", total)},
+	}
+
+	readiness := Hop{Name: "readiness", Target: target, Status: HopPass, Detail: fmt.Sprintf("%d/%d ready", len(ready), total)}
+	if len(ready) == 0 {
+		readiness.Status = HopFail
+	}
+	if len(notReady) > 0 {
+		readiness.Detail += ", not ready: " + strings.Join(addressNames(notReady), ",")
+	}
+	hh = append(hh, readiness)
+	if len(ready) == 0 {
+		return hh, nil
+	}
+
+	if proto := servicePortProtocol(sp); proto != v1.ProtocolTCP {
+		return append(hh, Hop{Name: "backend", Target: target, Status: HopSkip, Detail: string(proto) + " port not probed"}), nil
+	}
+	if b != nil {
+		return hh, b
+	}
+
+	return append(hh, Hop{Name: "backend", Target: target, Status: HopSkip, Detail: "no pod backs the ready endpoints"}), nil
+}
+
+// podBackend returns the first ready address backed by a pod.
+func podBackend(aa []v1.EndpointAddress, port int32) *backend {
+	for _, a := range aa {
+		if a.TargetRef != nil && a.TargetRef.Kind == "Pod" {
+			return &backend{pod: client.FQN(a.TargetRef.Namespace, a.TargetRef.Name), port: int(port)}
+		}
+	}
+
+	return nil
+}
+
+// probeBackend checks a backend pod port accepts connections.
+func probeBackend(conn client.Connection, target string, b backend) Hop {
+	h := Hop{Name: "backend", Target: target, Status: HopFail}
+	to := fmt.Sprintf("%s:%d", b.pod, b.port)
+	local, stop, err := forwardPort(conn, b.pod, b.port)
+	if err != nil {
+		h.Detail = to + ": " + err.Error()
+		return h
+	}
+	defer stop()
+
+	var r ProbeResult
+	tcpProbe(local, backendTimeout, &r)
+	if r.Err != nil {
+		h.Detail = to + ": " + r.Err.Error()
+		return h
+	}
+	h.Status, h.Detail = HopPass, fmt.Sprintf("%s connected in %s", to, r.Latency.Round(time.Millisecond))
+
+	return h
+}
+
+// routeHop checks an ingress route resolves to an existing service port.
+func routeHop(r IngressRoute, ns string, svc *v1.Service) (Hop, *v1.ServicePort) {
+	h := Hop{Name: "route", Target: r.String(), Status: HopFail}
+	to := fmt.Sprintf("%s:%s", client.FQN(ns, r.Service), r.Port.String())
+	if svc == nil {
+		h.Detail = "service " + client.FQN(ns, r.Service) + " not found"
+		return h, nil
+	}
+	for i, sp := range svc.Spec.Ports {
+		if (r.Port.Type == intstr.Int && sp.Port == r.Port.IntVal) || (r.Port.Type == intstr.String && sp.Name == r.Port.StrVal) {
+			h.Status, h.Detail = HopPass, "routes to "+to
+			return h, &svc.Spec.Ports[i]
+		}
+	}
+	h.Detail = "service " + client.FQN(ns, r.Service) + " exposes no port " + r.Port.String()
+
+	return h, nil
+}
+
+// endpointPort returns the endpoint port matching a service port.
+func endpointPort(ss v1.EndpointSubset, sp v1.ServicePort) (int32, bool) {
+	for _, p := range ss.Ports {
+		if p.Name != sp.Name {
+			continue
+		}
+		proto := p.Protocol
+		if proto == "" {
+			proto = v1.ProtocolTCP
+		}
+		if proto == servicePortProtocol(sp) {
+			return p.Port, true
+		}
+	}
+
+	return 0, false
+}
+
+func servicePortProtocol(sp v1.ServicePort) v1.Protocol {
+	if sp.Protocol == "" {
+		return v1.ProtocolTCP
+	}
+
+	return sp.Protocol
+}
+
+func hasServicePort(pp []v1.ServicePort, sp v1.ServicePort) bool {
+	for _, p := range pp {
+		if p.Name == sp.Name && p.Port == sp.Port {
+			return true
+		}
+	}
+
+	return false
+}
+
+func svcPortTarget(svc *v1.Service, sp v1.ServicePort) string {
+	port := fmt.Sprintf("%d", sp.Port)
+	if sp.Name != "" {
+		port = sp.Name
+	}
+
+	return client.MetaFQN(svc.ObjectMeta) + ":" + port
+}
+
+func addressNames(aa []v1.EndpointAddress) []string {
+	ss := make([]string, 0, len(aa))
+	for _, a := range aa {
+		if a.TargetRef != nil {
+			ss = append(ss, client.FQN(a.TargetRef.Namespace, a.TargetRef.Name))
+			continue
+		}
+		ss = append(ss, a.IP)
+	}
+	sort.Strings(ss)
+
+	return ss
+}
@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/extensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPortHops(t *testing.T) {
+	sp := v1.ServicePort{Name: "http", Port: 80}
+	uu := map[string]struct {
+		svc *v1.Service
+		ep  *v1.Endpoints
+		sp  v1.ServicePort
+		hh  Hops
+		b   *backend
+	}{
+		"no-endpoints": {
+			svc: makeConnSvc(nil),
+			sp:  sp,
+			hh: Hops{
+				{Name: "endpoints", Target: "default/fred:http", Status: HopFail, Detail: "no endpoints resolved, service has no selector"},
+			},
+		},
+		"not-ready": {
+			svc: makeConnSvc(map[string]string{"app": "fred"}),
+			ep: makeConnEndpoints(v1.EndpointSubset{
+				NotReadyAddresses: []v1.EndpointAddress{makeConnAddress("p1")},
+				Ports:             []v1.EndpointPort{{Name: "http", Port: 8080}},
+			}),
+			sp: sp,
+			hh: Hops{
+				{Name: "endpoints", Target: "default/fred:http", Status: HopPass, Detail: "1 address(es)"},
+				{Name: "readiness", Target: "default/fred:http", Status: HopFail, Detail: "0/1 ready, not ready: default/p1"},
+			},
+		},
+		"ready": {
+			svc: makeConnSvc(map[string]string{"app": "fred"}),
+			ep: makeConnEndpoints(
+				v1.EndpointSubset{
+					Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}},
+					Ports:     []v1.EndpointPort{{Name: "metrics", Port: 9090}},
+				},
+				v1.EndpointSubset{
+					Addresses:         []v1.EndpointAddress{makeConnAddress("p1")},
+					NotReadyAddresses: []v1.EndpointAddress{makeConnAddress("p2")},
+					Ports:             []v1.EndpointPort{{Name: "http", Port: 8080}},
+				},
+			),
+			sp: sp,
+			hh: Hops{
+				{Name: "endpoints", Target: "default/fred:http", Status: HopPass, Detail: "2 address(es)"},
+				{Name: "readiness", Target: "default/fred:http", Status: HopPass, Detail: "1/2 ready, not ready: default/p2"},
+			},
+			b: &backend{pod: "default/p1", port: 8080},
+		},
+		"udp": {
+			svc: makeConnSvc(map[string]string{"app": "fred"}),
+			ep: makeConnEndpoints(v1.EndpointSubset{
+				Addresses: []v1.EndpointAddress{makeConnAddress("p1")},
+				Ports:     []v1.EndpointPort{{Port: 53, Protocol: v1.ProtocolUDP}},
+			}),
+			sp: v1.ServicePort{Port: 53, Protocol: v1.ProtocolUDP},
+			hh: Hops{
+				{Name: "endpoints", Target: "default/fred:53", Status: HopPass, Detail: "1 address(es)"},
+				{Name: "readiness", Target: "default/fred:53", Status: HopPass, Detail: "1/1 ready"},
+				{Name: "backend", Target: "default/fred:53", Status: HopSkip, Detail: "UDP port not probed"},
+			},
+		},
+		"no-pod": {
+			svc: makeConnSvc(nil),
+			ep: makeConnEndpoints(v1.EndpointSubset{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}},
+				Ports:     []v1.EndpointPort{{Name: "http", Port: 8080}},
+			}),
+			sp: sp,
+			hh: Hops{
+				{Name: "endpoints", Target: "default/fred:http", Status: HopPass, Detail: "1 address(es)"},
+				{Name: "readiness", Target: "default/fred:http", Status: HopPass, Detail: "1/1 ready"},
+				{Name: "backend", Target: "default/fred:http", Status: HopSkip, Detail: "no pod backs the ready endpoints"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			hh, b := portHops(u.svc, u.ep, u.sp)
+			assert.Equal(t, u.hh, hh)
+			assert.Equal(t, u.b, b)
+		})
+	}
+}
+
+func TestIngressRoutes(t *testing.T) {
+	ing := v1beta1.Ingress{
+		Spec: v1beta1.IngressSpec{
+			Backend: &v1beta1.IngressBackend{ServiceName: "dflt", ServicePort: intstr.FromInt(80)},
+			Rules: []v1beta1.IngressRule{
+				{Host: "fred.io", IngressRuleValue: v1beta1.IngressRuleValue{HTTP: &v1beta1.HTTPIngressRuleValue{
+					Paths: []v1beta1.HTTPIngressPath{
+						{Path: "/api", Backend: v1beta1.IngressBackend{ServiceName: "api", ServicePort: intstr.FromString("http")}},
+						{Backend: v1beta1.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(8080)}},
+					},
+				}}},
+				{Host: "blee.io"},
+			},
+		},
+	}
+
+	rr := IngressRoutes(&ing)
+	assert.Equal(t, 3, len(rr))
+	assert.Equal(t, []string{"*/*", "fred.io/api", "fred.io/"}, []string{rr[0].String(), rr[1].String(), rr[2].String()})
+	assert.Equal(t, "api", rr[1].Service)
+	assert.Equal(t, intstr.FromString("http"), rr[1].Port)
+}
+
+func TestRouteHop(t *testing.T) {
+	svc := makeConnSvc(nil)
+	svc.Spec.Ports = []v1.ServicePort{{Name: "http", Port: 80}}
+	uu := map[string]struct {
+		r   IngressRoute
+		svc *v1.Service
+		h   Hop
+		ok  bool
+	}{
+		"port": {
+			r:   IngressRoute{Host: "fred.io", Service: "fred", Port: intstr.FromInt(80)},
+			svc: svc,
+			h:   Hop{Name: "route", Target: "fred.io/", Status: HopPass, Detail: "routes to default/fred:80"},
+			ok:  true,
+		},
+		"name": {
+			r:   IngressRoute{Path: "/api", Service: "fred", Port: intstr.FromString("http")},
+			svc: svc,
+			h:   Hop{Name: "route", Target: "*/api", Status: HopPass, Detail: "routes to default/fred:http"},
+			ok:  true,
+		},
+		"no-port": {
+			r:   IngressRoute{Service: "fred", Port: intstr.FromInt(8080)},
+			svc: svc,
+			h:   Hop{Name: "route", Target: "*/", Status: HopFail, Detail: "service default/fred exposes no port 8080"},
+		},
+		"no-service": {
+			r: IngressRoute{Service: "blee", Port: intstr.FromInt(80)},
+			h: Hop{Name: "route", Target: "*/", Status: HopFail, Detail: "service default/blee not found"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h, sp := routeHop(u.r, "default", u.svc)
+			assert.Equal(t, u.h, h)
+			assert.Equal(t, u.ok, sp != nil)
+		})
+	}
+}
+
+func TestHopsString(t *testing.T) {
+	hh := Hops{
+		{Name: "service", Target: "default/fred", Status: HopPass, Detail: "ClusterIP 10.0.0.1"},
+		{Name: "endpoints", Target: "default/fred:http", Status: HopFail, Detail: "no endpoints resolved"},
+		{Name: "backend", Target: "default/fred:dns", Status: HopSkip},
+	}
+
+	assert.Equal(t, 1, hh.Failed())
+	assert.Equal(t, "1 check(s) passed, 1 failed\n\n"+
+		"PASS service   default/fred (ClusterIP 10.0.0.1)\n"+
+		"FAIL endpoints default/fred:http (no endpoints resolved)\n"+
+		"SKIP backend   default/fred:dns", hh.String())
+	assert.Equal(t, "No checks performed.", Hops{}.String())
+}
+
+// Helpers...
+
+func makeConnSvc(sel map[string]string) *v1.Service {
+	return &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Spec:       v1.ServiceSpec{Type: v1.ServiceTypeClusterIP, ClusterIP: "10.0.0.1", Selector: sel},
+	}
+}
+
+func makeConnEndpoints(ss ...v1.EndpointSubset) *v1.Endpoints {
+	return &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+		Subsets:    ss,
+	}
+}
+
+func makeConnAddress(po string) v1.EndpointAddress {
+	return v1.EndpointAddress{
+		IP:        "10.0.0.2",
+		TargetRef: &v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: po},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -65,9 +66,108 @@ func (c *Container) TailLogs(ctx context.Context, logChan LogChan, opts LogOptio
 	return po.TailLogs(ctx, logChan, opts)
 }
 
+// Image inspects a pod container image using the pod pull secrets. When the
+// registry can not be reached, the returned info only tracks the container
+// running digest along with the error.
+func (c *Container) Image(ctx context.Context, fqn, co string) (*ImageInfo, error) {
+	po, err := c.fetchPod(fqn)
+	if err != nil {
+		return nil, err
+	}
+	image, ok := containerImage(po, co)
+	if !ok {
+		return nil, fmt.Errorf("no container %q found in pod %s", co, fqn)
+	}
+	ref, err := ParseImageRef(image)
+	if err != nil {
+		return nil, err
+	}
+	var running string
+	if s := getContainerStatus(co, po.Status); s != nil {
+		running = runningDigest(s.ImageID)
+	}
+
+	info, err := NewRegistry(c.pullAuths(ctx, po)).Inspect(ctx, ref, c.nodePlatform(ctx, po.Spec.NodeName))
+	if err != nil {
+		return &ImageInfo{Image: image, Ref: ref, Running: running}, err
+	}
+	info.Image, info.Running = image, running
+
+	return info, nil
+}
+
+// pullAuths collects the registries credentials from a pod pull secrets.
+func (c *Container) pullAuths(ctx context.Context, po *v1.Pod) map[string]RegistryAuth {
+	aa := make(map[string]RegistryAuth)
+	if len(po.Spec.ImagePullSecrets) == 0 {
+		return aa
+	}
+	dial, err := c.Client().Dial()
+	if err != nil {
+		return aa
+	}
+	for _, ref := range po.Spec.ImagePullSecrets {
+		sec, err := dial.CoreV1().Secrets(po.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to read pull secret %s/%s", po.Namespace, ref.Name)
+			continue
+		}
+		raw, ok := sec.Data[v1.DockerConfigJsonKey]
+		if !ok {
+			raw = sec.Data[v1.DockerConfigKey]
+		}
+		auths, err := ParseDockerConfig(raw)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid pull secret %s/%s", po.Namespace, ref.Name)
+			continue
+		}
+		for k, v := range auths {
+			aa[k] = v
+		}
+	}
+
+	return aa
+}
+
+// nodePlatform returns a node os/arch or the default platform if unknown.
+func (c *Container) nodePlatform(ctx context.Context, node string) string {
+	if node == "" {
+		return defaultPlatform
+	}
+	no, err := FetchNode(ctx, c.Factory, node)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to resolve node %q platform", node)
+		return defaultPlatform
+	}
+
+	info := no.Status.NodeInfo
+	if info.OperatingSystem == "" || info.Architecture == "" {
+		return defaultPlatform
+	}
+
+	return info.OperatingSystem + "/" + info.Architecture
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func containerImage(po *v1.Pod, co string) (string, bool) {
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, c := range cc {
+			if c.Name == co {
+				return c.Image, true
+			}
+		}
+	}
+	for _, c := range po.Spec.EphemeralContainers {
+		if c.Name == co {
+			return c.Image, true
+		}
+	}
+
+	return "", false
+}
+
 func makeContainerRes(co v1.Container, po *v1.Pod, pmx *mv1beta1.PodMetrics, isInit bool) render.ContainerRes {
 	cmx, err := containerMetrics(co.Name, pmx)
 	if err != nil {
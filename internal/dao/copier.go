@@ -0,0 +1,301 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// ErrDryRunUpload flags uploads refused while in dry-run mode.
+var ErrDryRunUpload = errors.New("uploads are disabled in dry-run mode")
+
+// CopyProgress reports a transfer progress in bytes. Total is zero when unknown.
+type CopyProgress func(done, total int64)
+
+// Copier transfers files to and from a container using a tar stream over exec.
+// The container image must provide a tar binary.
+type Copier struct {
+	NonResource
+}
+
+// Download copies a container file or directory to a local path. It returns
+// the count of bytes transferred.
+func (c *Copier) Download(ctx context.Context, fqn, co, src, dst string, progress CopyProgress) (int64, error) {
+	src = path.Clean(src)
+	if !path.IsAbs(src) || src == "/" {
+		return 0, fmt.Errorf("invalid container path %q", src)
+	}
+	if err := c.canExec(fqn); err != nil {
+		return 0, err
+	}
+
+	var du bytes.Buffer
+	if err := c.exec(fqn, co, []string{"du", "-sk", src}, nil, &du); err != nil {
+		log.Warn().Err(err).Msgf("Sizing %s:%s", fqn, src)
+	}
+	total := parseDu(du.String())
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			pr.CloseWithError(ctx.Err())
+		case <-done:
+		}
+	}()
+	go func() {
+		pw.CloseWithError(c.exec(fqn, co, []string{"tar", "cf", "-", "-C", path.Dir(src), path.Base(src)}, nil, pw))
+	}()
+
+	var n int64
+	err := untar(pr, path.Base(src), dst, func(c int64) {
+		n += c
+		progress(n, total)
+	})
+	pr.Close()
+
+	return n, err
+}
+
+// Upload copies a local file or directory to a container path. It returns
+// the count of bytes transferred.
+func (c *Copier) Upload(ctx context.Context, fqn, co, src, dst string, progress CopyProgress) (int64, error) {
+	dst = path.Clean(dst)
+	if !path.IsAbs(dst) || dst == "/" {
+		return 0, fmt.Errorf("invalid container path %q", dst)
+	}
+	total, err := localSize(src)
+	if err != nil {
+		return 0, err
+	}
+	if IsDryRun() {
+		return 0, ErrDryRunUpload
+	}
+	if err := c.canExec(fqn); err != nil {
+		return 0, err
+	}
+
+	pr, pw := io.Pipe()
+	var n int64
+	errc := make(chan error, 1)
+	go func() {
+		err := writeTar(ctx, pw, src, path.Base(dst), func(c int64) {
+			n += c
+			progress(n, total)
+		})
+		pw.CloseWithError(err)
+		errc <- err
+	}()
+	err = c.exec(fqn, co, []string{"tar", "xmf", "-", "-C", path.Dir(dst)}, pr, ioutil.Discard)
+	pr.Close()
+	if werr := <-errc; err == nil {
+		err = werr
+	}
+
+	return n, err
+}
+
+func (c *Copier) canExec(fqn string) error {
+	ns, _ := client.Namespaced(fqn)
+	auth, err := c.Client().CanI(ns, "v1/pods:exec", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec into pods")
+	}
+
+	return nil
+}
+
+// exec runs a command in a container streaming its stdin and stdout.
+func (c *Copier) exec(fqn, co string, cmd []string, in io.Reader, out io.Writer) error {
+	var stderr bytes.Buffer
+	err := execStream(c.Client(), fqn, co, cmd, in, out, &stderr)
+	if err != nil && stderr.Len() > 0 {
+		return fmt.Errorf("%s -- %w", strings.TrimSpace(stderr.String()), err)
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type progressReader struct {
+	io.Reader
+
+	count func(int64)
+}
+
+// Read reads the underlying reader and reports the bytes read.
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if n > 0 {
+		p.count(int64(n))
+	}
+
+	return n, err
+}
+
+// untar extracts a tar stream rooted at root into dst. Links and entries
+// escaping the archive root are skipped.
+func untar(r io.Reader, root, dst string, count func(int64)) error {
+	tr := tar.NewReader(r)
+	var found bool
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		rel, ok := archivePath(h.Name, root)
+		if !ok {
+			log.Warn().Msgf("Skipping archive entry %q", h.Name)
+			continue
+		}
+		found = true
+		target := filepath.Join(dst, rel)
+		switch h.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := writeFile(target, os.FileMode(h.Mode).Perm(), &progressReader{Reader: tr, count: count}); err != nil {
+				return err
+			}
+		default:
+			log.Warn().Msgf("Skipping archive entry %q of type %c", h.Name, h.Typeflag)
+		}
+	}
+	if !found {
+		return fmt.Errorf("no files found for %q", root)
+	}
+
+	return nil
+}
+
+func writeFile(target string, mode os.FileMode, r io.Reader) error {
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// archivePath returns an archive entry path relative to the archive root or
+// false if the entry lives outside of it.
+func archivePath(name, root string) (string, bool) {
+	name = path.Clean(name)
+	if name == root {
+		return ".", true
+	}
+	if !strings.HasPrefix(name, root+"/") {
+		return "", false
+	}
+
+	return filepath.FromSlash(strings.TrimPrefix(name, root+"/")), true
+}
+
+// writeTar archives a local file or directory as root. Links are skipped.
+func writeTar(ctx context.Context, w io.Writer, src, root string, count func(int64)) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(src, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !fi.IsDir() && !fi.Mode().IsRegular() {
+			log.Warn().Msgf("Skipping non regular file %q", p)
+			return nil
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		h, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		h.Name = path.Join(root, filepath.ToSlash(rel))
+		if fi.IsDir() {
+			h.Name += "/"
+		}
+		if err := tw.WriteHeader(h); err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, &progressReader{Reader: f, count: count})
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// localSize returns the size of a local file or directory regular files.
+func localSize(src string) (int64, error) {
+	var size int64
+	err := filepath.Walk(src, func(_ string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			size += fi.Size()
+		}
+		return nil
+	})
+
+	return size, err
+}
+
+// parseDu returns the size in bytes reported by du -sk or zero if unknown.
+func parseDu(out string) int64 {
+	ff := strings.Fields(out)
+	if len(ff) == 0 {
+		return 0
+	}
+	k, err := strconv.ParseInt(ff[0], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return k * 1024
+}
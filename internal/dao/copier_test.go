@@ -0,0 +1,132 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadDryRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-cp")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "fred.txt")
+	assert.Nil(t, ioutil.WriteFile(src, []byte("blee"), 0600))
+
+	SetDryRun(true)
+	defer SetDryRun(false)
+
+	var c Copier
+	_, err = c.Upload(context.Background(), "default/fred", "c1", src, "/tmp/fred.txt", func(int64, int64) {})
+	assert.Equal(t, ErrDryRunUpload, err)
+}
+
+func TestArchivePath(t *testing.T) {
+	uu := map[string]struct {
+		name, root, e string
+		ok            bool
+	}{
+		"root":    {name: "fred", root: "fred", e: ".", ok: true},
+		"rootDir": {name: "fred/", root: "fred", e: ".", ok: true},
+		"child":   {name: "fred/blee/zorg.txt", root: "fred", e: filepath.Join("blee", "zorg.txt"), ok: true},
+		"prefix":  {name: "freddy/zorg.txt", root: "fred"},
+		"escape":  {name: "fred/../../etc/passwd", root: "fred"},
+		"abs":     {name: "/etc/passwd", root: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, ok := archivePath(u.name, u.root)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	src, err := ioutil.TempDir("", "k9s-cp-src")
+	assert.Nil(t, err)
+	defer os.RemoveAll(src)
+	assert.Nil(t, os.MkdirAll(filepath.Join(src, "blee"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(src, "fred.txt"), []byte("fred"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(src, "blee", "zorg.txt"), []byte("zorg!"), 0600))
+
+	size, err := localSize(src)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), size)
+
+	var (
+		buff bytes.Buffer
+		in   int64
+	)
+	assert.Nil(t, writeTar(context.Background(), &buff, src, "conf", func(n int64) { in += n }))
+	assert.Equal(t, size, in)
+
+	dst, err := ioutil.TempDir("", "k9s-cp-dst")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dst)
+	var out int64
+	assert.Nil(t, untar(&buff, "conf", filepath.Join(dst, "conf"), func(n int64) { out += n }))
+	assert.Equal(t, size, out)
+
+	bb, err := ioutil.ReadFile(filepath.Join(dst, "conf", "blee", "zorg.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "zorg!", string(bb))
+	fi, err := os.Stat(filepath.Join(dst, "conf", "blee", "zorg.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+}
+
+func TestUntarSingleFile(t *testing.T) {
+	src, err := ioutil.TempDir("", "k9s-cp-src")
+	assert.Nil(t, err)
+	defer os.RemoveAll(src)
+	f := filepath.Join(src, "heap.hprof")
+	assert.Nil(t, ioutil.WriteFile(f, []byte("heap"), 0644))
+
+	var buff bytes.Buffer
+	assert.Nil(t, writeTar(context.Background(), &buff, f, "heap.hprof", func(int64) {}))
+	dst := filepath.Join(src, "dump.hprof")
+	assert.Nil(t, untar(&buff, "heap.hprof", dst, func(int64) {}))
+
+	bb, err := ioutil.ReadFile(dst)
+	assert.Nil(t, err)
+	assert.Equal(t, "heap", string(bb))
+}
+
+func TestUntarNoMatch(t *testing.T) {
+	src, err := ioutil.TempDir("", "k9s-cp-src")
+	assert.Nil(t, err)
+	defer os.RemoveAll(src)
+	f := filepath.Join(src, "fred.txt")
+	assert.Nil(t, ioutil.WriteFile(f, []byte("fred"), 0644))
+
+	var buff bytes.Buffer
+	assert.Nil(t, writeTar(context.Background(), &buff, f, "fred.txt", func(int64) {}))
+
+	assert.NotNil(t, untar(&buff, "blee.txt", filepath.Join(src, "blee.txt"), func(int64) {}))
+}
+
+func TestParseDu(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		e   int64
+	}{
+		"plain": {out: "12\t/tmp/fred\n", e: 12 * 1024},
+		"empty": {},
+		"toast": {out: "du: /fred: No such file or directory"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, parseDu(u.out))
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+
+// Schema represents an OpenAPI v3 schema.
+type Schema map[string]interface{}
+
+// SchemaCache caches custom resources schemas.
+type SchemaCache interface {
+	// Schema returns a cached schema for a given resource.
+	Schema(gvr string) (map[string]interface{}, bool)
+
+	// SetSchema caches a resource schema.
+	SetSchema(gvr string, s map[string]interface{})
+}
+
+// SchemaError represents a manifest structural error.
+type SchemaError struct {
+	// Path locates the field ie spec.containers[0].name.
+	Path string
+
+	// Line is the manifest line number or 0 if unknown.
+	Line int
+
+	// Message describes the error.
+	Message string
+}
+
+// Error returns the error message.
+func (e SchemaError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Path, e.Message)
+}
+
+// FetchSchema returns a custom resource OpenAPI v3 schema. It returns false
+// if the resource is not backed by a CRD or the CRD has no schema.
+func FetchSchema(f Factory, gvr client.GVR) (Schema, bool, error) {
+	if c, ok := f.(SchemaCache); ok {
+		if s, ok := c.Schema(gvr.String()); ok {
+			return s, true, nil
+		}
+	}
+	if gvr.G() == "" {
+		return nil, false, nil
+	}
+	o, err := f.Get(crdGVR, client.FQN(client.ClusterScope, gvr.R()+"."+gvr.G()), false, labels.Everything())
+	if kerrors.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	crd, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, false, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	s, ok := crdSchema(crd.Object, gvr.V())
+	if !ok {
+		return nil, false, nil
+	}
+	if c, ok := f.(SchemaCache); ok {
+		c.SetSchema(gvr.String(), s)
+	}
+
+	return s, true, nil
+}
+
+// crdSchema extracts the schema of a given CRD version, falling back to the
+// CRD wide validation.
+func crdSchema(crd map[string]interface{}, version string) (Schema, bool) {
+	vv, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != version {
+			continue
+		}
+		if s, ok, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema"); ok {
+			return s, true
+		}
+	}
+	if s, ok, _ := unstructured.NestedMap(crd, "spec", "validation", "openAPIV3Schema"); ok {
+		return s, true
+	}
+
+	return nil, false
+}
+
+// ValidateSchema checks a manifest against a schema. Errors are ordered by
+// manifest line.
+func ValidateSchema(s Schema, raw []byte) ([]SchemaError, error) {
+	var o map[string]interface{}
+	if err := yaml.Unmarshal(raw, &o); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	var errs []SchemaError
+	s.validate(nil, o, true, &errs)
+	for i := range errs {
+		errs[i].Line = yamlLine(raw, errs[i].Path)
+	}
+	sort.SliceStable(errs, func(i, j int) bool {
+		return errs[i].Line < errs[j].Line
+	})
+
+	return errs, nil
+}
+
+var metaFields = map[string]struct{}{
+	"apiVersion": {},
+	"kind":       {},
+	"metadata":   {},
+}
+
+func (s Schema) validate(path []string, v interface{}, root bool, errs *[]SchemaError) {
+	add := func(format string, args ...interface{}) {
+		*errs = append(*errs, SchemaError{Path: fieldPath(path), Message: fmt.Sprintf(format, args...)})
+	}
+
+	if v == nil {
+		if t, ok := s["type"].(string); ok && t != "" && !s.flag("nullable") {
+			add("must be of type %s but got null", t)
+		}
+		return
+	}
+	if s.flag("x-kubernetes-int-or-string") {
+		switch v.(type) {
+		case string, float64, int64:
+		default:
+			add("must be an integer or a string")
+		}
+		return
+	}
+	if t, ok := s["type"].(string); ok && !isType(t, v) {
+		add("must be of type %s but got %s", t, typeOf(v))
+		return
+	}
+	if ee, ok := s["enum"].([]interface{}); ok && !inEnum(ee, v) {
+		add("unsupported value %v. Expecting one of %s", v, enumString(ee))
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		s.validateObject(path, t, root, add, errs)
+	case []interface{}:
+		s.validateArray(path, t, add, errs)
+	case string:
+		s.validateString(t, add)
+	case float64:
+		s.validateNumber(t, add)
+	}
+}
+
+func (s Schema) validateObject(path []string, m map[string]interface{}, root bool, add func(string, ...interface{}), errs *[]SchemaError) {
+	rr, _ := s["required"].([]interface{})
+	for _, r := range rr {
+		if k, ok := r.(string); ok {
+			if _, ok := m[k]; !ok {
+				add("missing required field %q", k)
+			}
+		}
+	}
+
+	pp, _ := s["properties"].(map[string]interface{})
+	extra, _ := s["additionalProperties"].(map[string]interface{})
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fpath := append(append([]string(nil), path...), k)
+		if p, ok := pp[k].(map[string]interface{}); ok {
+			Schema(p).validate(fpath, m[k], false, errs)
+			continue
+		}
+		if extra != nil {
+			Schema(extra).validate(fpath, m[k], false, errs)
+			continue
+		}
+		if _, ok := metaFields[k]; ok && (root || s.flag("x-kubernetes-embedded-resource")) {
+			continue
+		}
+		if (pp != nil || s["additionalProperties"] == false) && !s.flag("x-kubernetes-preserve-unknown-fields") {
+			*errs = append(*errs, SchemaError{Path: fieldPath(fpath), Message: "unknown field"})
+		}
+	}
+}
+
+func (s Schema) validateArray(path []string, aa []interface{}, add func(string, ...interface{}), errs *[]SchemaError) {
+	if n, ok := s.number("minItems"); ok && float64(len(aa)) < n {
+		add("must have at least %v items", n)
+	}
+	if n, ok := s.number("maxItems"); ok && float64(len(aa)) > n {
+		add("must have at most %v items", n)
+	}
+	items, ok := s["items"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for i, v := range aa {
+		ipath := append([]string(nil), path...)
+		if len(ipath) == 0 {
+			ipath = append(ipath, "")
+		}
+		ipath[len(ipath)-1] += "[" + strconv.Itoa(i) + "]"
+		Schema(items).validate(ipath, v, false, errs)
+	}
+}
+
+func (s Schema) validateString(v string, add func(string, ...interface{})) {
+	if n, ok := s.number("minLength"); ok && float64(len(v)) < n {
+		add("must be at least %v characters long", n)
+	}
+	if n, ok := s.number("maxLength"); ok && float64(len(v)) > n {
+		add("must be at most %v characters long", n)
+	}
+	if p, ok := s["pattern"].(string); ok {
+		if rx, err := regexp.Compile(p); err == nil && !rx.MatchString(v) {
+			add("must match pattern %q", p)
+		}
+	}
+}
+
+func (s Schema) validateNumber(v float64, add func(string, ...interface{})) {
+	if n, ok := s.number("minimum"); ok {
+		if v < n || (v == n && s.flag("exclusiveMinimum")) {
+			add("must be greater than %s%v", orEqual(!s.flag("exclusiveMinimum")), n)
+		}
+	}
+	if n, ok := s.number("maximum"); ok {
+		if v > n || (v == n && s.flag("exclusiveMaximum")) {
+			add("must be less than %s%v", orEqual(!s.flag("exclusiveMaximum")), n)
+		}
+	}
+}
+
+func (s Schema) flag(k string) bool {
+	b, _ := s[k].(bool)
+	return b
+}
+
+func (s Schema) number(k string) (float64, bool) {
+	switch t := s[k].(type) {
+	case float64:
+		return t, true
+	case int64:
+		return float64(t), true
+	case int:
+		return float64(t), true
+	default:
+		return 0, false
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func orEqual(b bool) string {
+	if b {
+		return "or equal to "
+	}
+	return ""
+}
+
+func isType(t string, v interface{}) bool {
+	switch t {
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "integer":
+		f, ok := v.(float64)
+		return ok && f == math.Trunc(f)
+	case "number":
+		_, ok := v.(float64)
+		return ok
+	default:
+		return true
+	}
+}
+
+func typeOf(v interface{}) string {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if t == math.Trunc(t) {
+			return "integer"
+		}
+		return "number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func inEnum(ee []interface{}, v interface{}) bool {
+	for _, e := range ee {
+		if fmt.Sprintf("%v", e) == fmt.Sprintf("%v", v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func enumString(ee []interface{}) string {
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, fmt.Sprintf("%v", e))
+	}
+
+	return strings.Join(ss, "|")
+}
+
+func fieldPath(path []string) string {
+	if len(path) == 0 {
+		return "<root>"
+	}
+
+	return strings.Join(path, ".")
+}
+
+var indexRX = regexp.MustCompile(`^(.*?)((?:\[\d+\])*)$`)
+
+// yamlLine locates a field path in a manifest. It returns the closest parent
+// line found or 0 if the path can't be located.
+func yamlLine(raw []byte, path string) int {
+	lines := make([]string, 0, 100)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	var line, from, indent = 0, 0, -1
+	for _, seg := range strings.Split(path, ".") {
+		mm := indexRX.FindStringSubmatch(seg)
+		key, idx := mm[1], mm[2]
+		if key != "" {
+			l, in, ok := findKey(lines, from, indent, key)
+			if !ok {
+				return line
+			}
+			line, from, indent = l+1, l+1, in
+		}
+		for _, i := range strings.Split(strings.Trim(idx, "[]"), "][") {
+			if i == "" {
+				continue
+			}
+			n, _ := strconv.Atoi(i)
+			l, in, ok := findItem(lines, from, indent, n)
+			if !ok {
+				return line
+			}
+			// Item fields start on the dash line.
+			line, from, indent = l+1, l, in
+		}
+	}
+
+	return line
+}
+
+// findKey looks for a direct child key below the given indentation.
+func findKey(lines []string, from, indent int, key string) (int, int, bool) {
+	child := -1
+	for i := from; i < len(lines); i++ {
+		t, raw, in := yamlIndent(lines[i])
+		if t == "" || strings.HasPrefix(t, "#") {
+			continue
+		}
+		if i > from && raw <= indent {
+			break
+		}
+		if child == -1 {
+			child = in
+		}
+		if in != child {
+			continue
+		}
+		if strings.HasPrefix(t, key+":") || strings.HasPrefix(t, strconv.Quote(key)+":") {
+			return i, in, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// findItem looks for the nth sequence item below the given indentation.
+func findItem(lines []string, from, indent, n int) (int, int, bool) {
+	var count, dash = 0, -1
+	for i := from; i < len(lines); i++ {
+		t := strings.TrimLeft(lines[i], " ")
+		if t == "" || strings.HasPrefix(t, "#") {
+			continue
+		}
+		raw := len(lines[i]) - len(t)
+		if raw < indent || (raw == indent && !isDash(t)) {
+			break
+		}
+		if !isDash(t) {
+			continue
+		}
+		if dash == -1 {
+			dash = raw
+		}
+		if raw != dash {
+			continue
+		}
+		if count == n {
+			return i, raw, true
+		}
+		count++
+	}
+
+	return 0, 0, false
+}
+
+// yamlIndent returns a line content stripped from sequence dashes along with
+// its raw and content indentations.
+func yamlIndent(l string) (string, int, int) {
+	t := strings.TrimLeft(l, " ")
+	raw := len(l) - len(t)
+	for isDash(t) && len(t) > 1 {
+		t = strings.TrimLeft(t[1:], " ")
+	}
+
+	return t, raw, len(l) - len(t)
+}
+
+func isDash(t string) bool {
+	return t == "-" || strings.HasPrefix(t, "- ")
+}
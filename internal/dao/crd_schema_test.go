@@ -0,0 +1,134 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSchema(t *testing.T) {
+	uu := map[string]struct {
+		raw  string
+		errs []string
+	}{
+		"valid": {
+			raw: `apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+spec:
+  size: 10
+  mode: fast
+  containers:
+  - name: c1
+    image: nginx
+`,
+		},
+		"wrongType": {
+			raw: `apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+spec:
+  size: ten
+`,
+			errs: []string{"line 6: spec.size: must be of type integer but got string"},
+		},
+		"required": {
+			raw: `apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+spec:
+  mode: fast
+`,
+			errs: []string{`line 5: spec: missing required field "size"`},
+		},
+		"nested": {
+			raw: `apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+spec:
+  size: 2
+  mode: bozo
+  containers:
+  - name: c1
+    image: nginx
+  - name: c2
+    imag: nginx
+`,
+			errs: []string{
+				"line 6: spec.size: must be greater than or equal to 3",
+				"line 7: spec.mode: unsupported value bozo. Expecting one of fast|slow",
+				"line 12: spec.containers[1].imag: unknown field",
+			},
+		},
+		"preserved": {
+			raw: `apiVersion: fred.io/v1
+kind: Fred
+metadata:
+  name: fred
+spec:
+  size: 3
+  extras:
+    blee: duh
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee, err := dao.ValidateSchema(fredSchema(), []byte(u.raw))
+			assert.Nil(t, err)
+			ss := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ss = append(ss, e.Error())
+			}
+			assert.Equal(t, len(u.errs), len(ss))
+			for i := range u.errs {
+				assert.Equal(t, u.errs[i], ss[i])
+			}
+		})
+	}
+}
+
+func TestValidateSchemaInvalid(t *testing.T) {
+	_, err := dao.ValidateSchema(fredSchema(), []byte("spec: [\n"))
+
+	assert.Error(t, err)
+}
+
+// Helpers...
+
+func fredSchema() dao.Schema {
+	return dao.Schema{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"size"},
+				"properties": map[string]interface{}{
+					"size": map[string]interface{}{"type": "integer", "minimum": int64(3)},
+					"mode": map[string]interface{}{"type": "string", "enum": []interface{}{"fast", "slow"}},
+					"extras": map[string]interface{}{
+						"type":                                 "object",
+						"x-kubernetes-preserve-unknown-fields": true,
+					},
+					"containers": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"name":  map[string]interface{}{"type": "string"},
+								"image": map[string]interface{}{"type": "string"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
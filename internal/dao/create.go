@@ -0,0 +1,246 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// GenericSecret tracks opaque secrets.
+	GenericSecret = "generic"
+
+	// DockerRegistrySecret tracks image registry credentials secrets.
+	DockerRegistrySecret = "docker-registry"
+
+	// TLSSecret tracks certificate secrets.
+	TLSSecret = "tls"
+)
+
+// SecretTypes tracks the supported secret creation types.
+var SecretTypes = []string{GenericSecret, DockerRegistrySecret, TLSSecret}
+
+// RegistryAuth represents image registry credentials.
+type RegistryAuth struct {
+	Server   string
+	Username string
+	Password string
+	Email    string
+}
+
+// Create creates a resource.
+func (g *Generic) Create(ctx context.Context, o runtime.Object) error {
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return err
+	}
+	u := unstructured.Unstructured{Object: raw}
+	ns := u.GetNamespace()
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to create %s", g.gvr.R())
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	opts := metav1.CreateOptions{DryRun: dryRunOpts()}
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Create(ctx, &u, opts)
+		return err
+	}
+	_, err = dial.Namespace(ns).Create(ctx, &u, opts)
+
+	return err
+}
+
+// NewNamespaceObject returns a new namespace.
+func NewNamespaceObject(name string, labels map[string]string) (*v1.Namespace, error) {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid namespace name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return &v1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	}, nil
+}
+
+// NewConfigMapObject returns a new config map from literals and files.
+func NewConfigMapObject(ns, name string, literals map[string]string, files map[string][]byte) (*v1.ConfigMap, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	cm := v1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+		Data:       make(map[string]string, len(literals)+len(files)),
+	}
+	for k, v := range literals {
+		cm.Data[k] = v
+	}
+	for k, v := range files {
+		if _, ok := cm.Data[k]; ok {
+			return nil, fmt.Errorf("duplicate key %q", k)
+		}
+		cm.Data[k] = string(v)
+	}
+
+	return &cm, nil
+}
+
+// NewGenericSecretObject returns a new opaque secret from literals and files.
+func NewGenericSecretObject(ns, name string, literals map[string]string, files map[string][]byte) (*v1.Secret, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(literals)+len(files))
+	for k, v := range literals {
+		data[k] = []byte(v)
+	}
+	for k, v := range files {
+		if _, ok := data[k]; ok {
+			return nil, fmt.Errorf("duplicate key %q", k)
+		}
+		data[k] = v
+	}
+
+	return newSecret(ns, name, v1.SecretTypeOpaque, data), nil
+}
+
+// NewRegistrySecretObject returns a new image registry credentials secret.
+func NewRegistrySecretObject(ns, name string, a RegistryAuth) (*v1.Secret, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if a.Server == "" || a.Username == "" || a.Password == "" {
+		return nil, fmt.Errorf("registry server, username and password must be specified")
+	}
+	auth := map[string]interface{}{
+		"username": a.Username,
+		"password": a.Password,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(a.Username + ":" + a.Password)),
+	}
+	if a.Email != "" {
+		auth["email"] = a.Email
+	}
+	raw, err := json.Marshal(map[string]interface{}{
+		"auths": map[string]interface{}{a.Server: auth},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecret(ns, name, v1.SecretTypeDockerConfigJson, map[string][]byte{
+		v1.DockerConfigJsonKey: raw,
+	}), nil
+}
+
+// NewTLSSecretObject returns a new TLS secret given a certificate and key.
+func NewTLSSecretObject(ns, name string, cert, key []byte) (*v1.Secret, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return nil, fmt.Errorf("invalid certificate/key pair: %w", err)
+	}
+
+	return newSecret(ns, name, v1.SecretTypeTLS, map[string][]byte{
+		v1.TLSCertKey:       cert,
+		v1.TLSPrivateKeyKey: key,
+	}), nil
+}
+
+// ParseLiterals parses comma separated key=value literals.
+func ParseLiterals(s string) (map[string]string, error) {
+	ll := make(map[string]string)
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t == "" {
+			continue
+		}
+		kv := strings.SplitN(t, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid literal %q. Expecting key=value", t)
+		}
+		if err := validateKey(kv[0]); err != nil {
+			return nil, err
+		}
+		ll[kv[0]] = kv[1]
+	}
+
+	return ll, nil
+}
+
+// LoadFiles reads comma separated files specified as path or key=path.
+// Keys default to the file base name.
+func LoadFiles(s string) (map[string][]byte, error) {
+	ff := make(map[string][]byte)
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t == "" {
+			continue
+		}
+		key, path := filepath.Base(t), t
+		if kv := strings.SplitN(t, "=", 2); len(kv) == 2 {
+			key, path = kv[0], kv[1]
+		}
+		if err := validateKey(key); err != nil {
+			return nil, err
+		}
+		if _, ok := ff[key]; ok {
+			return nil, fmt.Errorf("duplicate key %q", key)
+		}
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		ff[key] = raw
+	}
+
+	return ff, nil
+}
+
+// Helpers...
+
+func newSecret(ns, name string, t v1.SecretType, data map[string][]byte) *v1.Secret {
+	return &v1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+		Type:       t,
+		Data:       data,
+	}
+}
+
+func validateName(n string) error {
+	if errs := validation.IsDNS1123Subdomain(n); len(errs) > 0 {
+		return fmt.Errorf("invalid name %q: %s", n, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func validateKey(k string) error {
+	if errs := validation.IsConfigMapKey(k); len(errs) > 0 {
+		return fmt.Errorf("invalid key %q: %s", k, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package dao_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestParseLiterals(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   map[string]string
+		err bool
+	}{
+		"empty": {
+			e: map[string]string{},
+		},
+		"multi": {
+			s: "a=1, b=hello world,c=",
+			e: map[string]string{"a": "1", "b": "hello world", "c": ""},
+		},
+		"noValue": {
+			s:   "a",
+			err: true,
+		},
+		"badKey": {
+			s:   "a b=1",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll, err := dao.ParseLiterals(u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ll)
+		})
+	}
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-create")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	f1, f2 := filepath.Join(dir, "app.conf"), filepath.Join(dir, "other.txt")
+	assert.Nil(t, ioutil.WriteFile(f1, []byte("a=1"), 0600))
+	assert.Nil(t, ioutil.WriteFile(f2, []byte("blee"), 0600))
+
+	ff, err := dao.LoadFiles(f1 + ",custom=" + f2)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"app.conf": []byte("a=1"), "custom": []byte("blee")}, ff)
+
+	_, err = dao.LoadFiles(f1 + "," + f1)
+	assert.NotNil(t, err)
+	_, err = dao.LoadFiles(filepath.Join(dir, "missing"))
+	assert.NotNil(t, err)
+}
+
+func TestNewNamespaceObject(t *testing.T) {
+	ns, err := dao.NewNamespaceObject("fred", map[string]string{"team": "blee"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Namespace", ns.Kind)
+	assert.Equal(t, "blee", ns.Labels["team"])
+
+	_, err = dao.NewNamespaceObject("Fred_1", nil)
+	assert.NotNil(t, err)
+}
+
+func TestNewConfigMapObject(t *testing.T) {
+	cm, err := dao.NewConfigMapObject("default", "fred", map[string]string{"a": "1"}, map[string][]byte{"b": []byte("2")})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, cm.Data)
+
+	_, err = dao.NewConfigMapObject("default", "fred", map[string]string{"a": "1"}, map[string][]byte{"a": []byte("2")})
+	assert.NotNil(t, err)
+}
+
+func TestNewGenericSecretObject(t *testing.T) {
+	s, err := dao.NewGenericSecretObject("default", "fred", map[string]string{"a": "1"}, map[string][]byte{"b": []byte("2")})
+	assert.Nil(t, err)
+	assert.Equal(t, v1.SecretTypeOpaque, s.Type)
+	assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("2")}, s.Data)
+}
+
+func TestNewRegistrySecretObject(t *testing.T) {
+	s, err := dao.NewRegistrySecretObject("default", "fred", dao.RegistryAuth{Server: "r.io", Username: "u", Password: "p"})
+	assert.Nil(t, err)
+	assert.Equal(t, v1.SecretTypeDockerConfigJson, s.Type)
+	assert.Equal(t, `{"auths":{"r.io":{"auth":"dTpw","password":"p","username":"u"}}}`, string(s.Data[v1.DockerConfigJsonKey]))
+
+	_, err = dao.NewRegistrySecretObject("default", "fred", dao.RegistryAuth{Server: "r.io"})
+	assert.NotNil(t, err)
+}
+
+func TestNewTLSSecretObject(t *testing.T) {
+	cert, key := makeCertPair(t)
+	s, err := dao.NewTLSSecretObject("default", "fred", cert, key)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.SecretTypeTLS, s.Type)
+	assert.Equal(t, cert, s.Data[v1.TLSCertKey])
+
+	_, err = dao.NewTLSSecretObject("default", "fred", cert, []byte("blee"))
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func makeCertPair(t *testing.T) ([]byte, []byte) {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &k.PublicKey, k)
+	assert.Nil(t, err)
+	kder, err := x509.MarshalECPrivateKey(k)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder})
+}
@@ -13,14 +13,16 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
 const maxJobNameSize = 42
 
 var (
-	_ Accessor = (*CronJob)(nil)
-	_ Runnable = (*CronJob)(nil)
+	_ Accessor    = (*CronJob)(nil)
+	_ Runnable    = (*CronJob)(nil)
+	_ Suspendable = (*CronJob)(nil)
 )
 
 // CronJob represents a cronjob K8s resource.
@@ -39,46 +41,92 @@ func (c *CronJob) Run(path string) error {
 		return fmt.Errorf("user is not authorized to run jobs")
 	}
 
-	o, err := c.Factory.Get("batch/v1beta1/cronjobs", path, true, labels.Everything())
+	cj, err := c.load(path)
 	if err != nil {
 		return err
 	}
+	dial, err := c.Client().Dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.Client().Config().CallTimeout())
+	defer cancel()
+	_, err = dial.BatchV1().Jobs(ns).Create(ctx, jobFor(cj), metav1.CreateOptions{DryRun: dryRunOpts()})
+
+	return err
+}
+
+// SetSuspend suspends or resumes a CronJob schedule.
+func (c *CronJob) SetSuspend(ctx context.Context, path string, suspend bool) error {
+	ns, n := client.Namespaced(path)
+	auth, err := c.Client().CanI(ns, c.GVR(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch cronjobs")
+	}
+
+	dial, err := c.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.BatchV1beta1().CronJobs(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)),
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+
+	return err
+}
+
+func (c *CronJob) load(path string) (*batchv1beta1.CronJob, error) {
+	o, err := c.Factory.Get(c.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
 	var cj batchv1beta1.CronJob
 	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &cj)
 	if err != nil {
-		return errors.New("expecting CronJob resource")
+		return nil, errors.New("expecting CronJob resource")
 	}
+
+	return &cj, nil
+}
+
+// jobFor returns a manual job instance from a CronJob template.
+func jobFor(cj *batchv1beta1.CronJob) *batchv1.Job {
 	var jobName = cj.Name
 	if len(cj.Name) >= maxJobNameSize {
 		jobName = cj.Name[0:maxJobNameSize]
 	}
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for k, v := range cj.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
 	true := true
-	job := &batchv1.Job{
+
+	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName + "-manual-" + rand.String(3),
-			Namespace: ns,
-			Labels:    cj.Spec.JobTemplate.Labels,
+			Name:        jobName + "-manual-" + rand.String(3),
+			Namespace:   cj.Namespace,
+			Labels:      cj.Spec.JobTemplate.Labels,
+			Annotations: annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				{
-					APIVersion:         "batch/v1beta",
+					APIVersion:         "batch/v1beta1",
 					Kind:               "CronJob",
 					BlockOwnerDeletion: &true,
+					Controller:         &true,
 					Name:               cj.Name,
 					UID:                cj.UID,
 				},
 			},
 		},
-		Spec: cj.Spec.JobTemplate.Spec,
-	}
-	dial, err := c.Client().Dial()
-	if err != nil {
-		return err
+		Spec: *cj.Spec.JobTemplate.Spec.DeepCopy(),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), c.Client().Config().CallTimeout())
-	defer cancel()
-	_, err = dial.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
-
-	return err
 }
 
 // ScanSA scans for serviceaccount refs.
@@ -0,0 +1,233 @@
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ Accessor = (*DataKey)(nil)
+
+// DataKey represents a secret or configmap data keys dao. Values are only
+// decoded on demand.
+type DataKey struct {
+	NonResource
+}
+
+// List returns a collection of data keys.
+func (d *DataKey) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, u, err := d.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kk := dataKeys(gvr, u, ctx.Value(internal.KeyRevealed))
+	oo := make([]runtime.Object, 0, len(kk))
+	for _, k := range kk {
+		oo = append(oo, k)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given data key.
+func (d *DataKey) Get(ctx context.Context, key string) (runtime.Object, error) {
+	gvr, u, err := d.owner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range dataKeys(gvr, u, ctx.Value(internal.KeyRevealed)) {
+		if k.Key == key {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no data key %q found on %s", key, u.GetName())
+}
+
+// Value returns a given key decoded value.
+func (d *DataKey) Value(gvr, path, key string) ([]byte, error) {
+	u, err := d.fetch(gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	for _, src := range dataSources(gvr) {
+		v, ok, _ := unstructured.NestedString(u.Object, src.field, key)
+		if !ok {
+			continue
+		}
+		if !src.encoded {
+			return []byte(v), nil
+		}
+		return base64.StdEncoding.DecodeString(v)
+	}
+
+	return nil, fmt.Errorf("no data key %q found on %s", key, path)
+}
+
+// SetValue adds or updates a single data key. Values are encoded as needed.
+func (d *DataKey) SetValue(ctx context.Context, gvr, path, key string, v []byte) error {
+	patch, err := dataKeyPatch(gvr, key, v)
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := d.Client().CanI(ns, gvr, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := d.Client().DynDial()
+	if err != nil {
+		return err
+	}
+
+	return Retry(ctx, func() error {
+		_, err := dial.Resource(client.NewGVR(gvr).GVR()).Namespace(ns).Patch(
+			ctx,
+			n,
+			types.MergePatchType,
+			patch,
+			metav1.PatchOptions{DryRun: dryRunOpts()},
+		)
+		return err
+	})
+}
+
+// owner fetches the secret or configmap tracked in the context.
+func (d *DataKey) owner(ctx context.Context) (string, *unstructured.Unstructured, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return "", nil, fmt.Errorf("no context path for %q", d.gvr)
+	}
+	gvr, ok := ctx.Value(internal.KeyOwnerGVR).(string)
+	if !ok {
+		return "", nil, fmt.Errorf("no context owner for %q", d.gvr)
+	}
+	u, err := d.fetch(gvr, path)
+
+	return gvr, u, err
+}
+
+func (d *DataKey) fetch(gvr, path string) (*unstructured.Unstructured, error) {
+	o, err := d.Factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
+}
+
+// dataKeys returns a resource data keys sorted by source. Only revealed keys
+// values are decoded.
+func dataKeys(gvr string, u *unstructured.Unstructured, r interface{}) []render.DataKeyRes {
+	revealed, _ := r.(map[string]bool)
+	kk := make([]render.DataKeyRes, 0, 10)
+	for _, src := range dataSources(gvr) {
+		m, _, _ := unstructured.NestedStringMap(u.Object, src.field)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			size := len(m[k])
+			if src.encoded {
+				size = decodedLen(m[k])
+			}
+			res := render.DataKeyRes{Key: k, Source: src.field, Size: size}
+			if revealed[k] {
+				res.Value, res.Revealed = decodeValue(m[k], src.encoded), true
+			}
+			kk = append(kk, res)
+		}
+	}
+
+	return kk
+}
+
+// DataSize computes a resource data section raw size.
+func DataSize(u *unstructured.Unstructured, field string) int {
+	m, _, _ := unstructured.NestedStringMap(u.Object, field)
+	var size int
+	for _, v := range m {
+		size += len(v)
+	}
+
+	return size
+}
+
+type dataSource struct {
+	field   string
+	encoded bool
+}
+
+func dataSources(gvr string) []dataSource {
+	if gvr == "v1/secrets" {
+		return []dataSource{{field: "data", encoded: true}}
+	}
+
+	return []dataSource{{field: "data"}, {field: "binaryData", encoded: true}}
+}
+
+// dataKeyPatch returns a merge patch setting a data key value. Configmap
+// values that are not valid utf8 are stored as binary data.
+func dataKeyPatch(gvr, key string, v []byte) ([]byte, error) {
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid key %q: %s", key, strings.Join(errs, ", "))
+	}
+
+	if gvr == "v1/secrets" {
+		return json.Marshal(map[string]interface{}{
+			"data": map[string]string{key: base64.StdEncoding.EncodeToString(v)},
+		})
+	}
+	if !utf8.Valid(v) {
+		return json.Marshal(map[string]interface{}{
+			"data":       map[string]interface{}{key: nil},
+			"binaryData": map[string]string{key: base64.StdEncoding.EncodeToString(v)},
+		})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"data":       map[string]string{key: string(v)},
+		"binaryData": map[string]interface{}{key: nil},
+	})
+}
+
+func decodeValue(v string, encoded bool) []byte {
+	if !encoded {
+		return []byte(v)
+	}
+	b, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return []byte(v)
+	}
+
+	return b
+}
+
+// DecodedLen computes a base64 value size without decoding it.
+func decodedLen(s string) int {
+	return base64.StdEncoding.DecodedLen(len(s)) - (len(s) - len(strings.TrimRight(s, "=")))
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDecodedLen(t *testing.T) {
+	uu := map[string]string{
+		"empty": "",
+		"one":   "a",
+		"two":   "ab",
+		"three": "abc",
+		"long":  "The quick brown fox jumps over the lazy dog",
+	}
+
+	for k := range uu {
+		v := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, len(v), decodedLen(base64.StdEncoding.EncodeToString([]byte(v))))
+		})
+	}
+}
+
+func TestDataKeyPatch(t *testing.T) {
+	uu := map[string]struct {
+		gvr, key string
+		v        []byte
+		e        string
+		err      bool
+	}{
+		"secret": {
+			gvr: "v1/secrets",
+			key: "password",
+			v:   []byte("s3cr3t"),
+			e:   `{"data":{"password":"czNjcjN0"}}`,
+		},
+		"configmap": {
+			gvr: "v1/configmaps",
+			key: "app.conf",
+			v:   []byte("debug=true"),
+			e:   `{"binaryData":{"app.conf":null},"data":{"app.conf":"debug=true"}}`,
+		},
+		"binary": {
+			gvr: "v1/configmaps",
+			key: "blob",
+			v:   []byte{0xff, 0xfe},
+			e:   `{"binaryData":{"blob":"//4="},"data":{"blob":null}}`,
+		},
+		"badKey": {
+			gvr: "v1/secrets",
+			key: "fred/blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := dataKeyPatch(u.gvr, u.key, u.v)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, string(p))
+			}
+		})
+	}
+}
+
+func TestDataKeys(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"data": map[string]interface{}{
+			"b": "YmxlZQ==",
+			"a": "ZnJlZA==",
+		},
+	}}
+
+	kk := dataKeys("v1/secrets", &u, map[string]bool{"b": true})
+	assert.Equal(t, 2, len(kk))
+	assert.Equal(t, render.DataKeyRes{Key: "a", Source: "data", Size: 4}, kk[0])
+	assert.Equal(t, render.DataKeyRes{Key: "b", Source: "data", Size: 4, Value: []byte("blee"), Revealed: true}, kk[1])
+}
+
+func TestDataSize(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"data": map[string]interface{}{
+			"a": "fred",
+			"b": "blee",
+		},
+	}}
+
+	assert.Equal(t, 8, DataSize(&u, "data"))
+	assert.Equal(t, 0, DataSize(&u, "binaryData"))
+}
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Debugger = (*Pod)(nil)
+
+const debuggerPrefix = "debugger-"
+
+// DebugOptions represents ephemeral debug container options.
+type DebugOptions struct {
+	// Image is the debug container image.
+	Image string
+
+	// Command overrides the image entrypoint.
+	Command []string
+
+	// Target is the container whose process namespace gets shared.
+	Target string
+}
+
+// Debug injects an ephemeral debug container in a running pod and returns
+// the debug container name.
+func (p *Pod) Debug(ctx context.Context, path string, opts DebugOptions) (string, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:ephemeralcontainers", []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to debug pod %s", path)
+	}
+
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	pods := dial.CoreV1().Pods(ns)
+	ec, err := pods.GetEphemeralContainers(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("ephemeral containers are not available on this cluster: %w", err)
+	}
+
+	co := ephemeralContainerFor(debuggerName(ec.EphemeralContainers), opts)
+	ec.EphemeralContainers = append(ec.EphemeralContainers, co)
+	if _, err := pods.UpdateEphemeralContainers(ctx, n, ec, metav1.UpdateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", err
+	}
+
+	return co.Name, nil
+}
+
+// IsDebuggerRunning checks if a pod ephemeral container is running.
+func (p *Pod) IsDebuggerRunning(ctx context.Context, path, co string) (bool, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return false, err
+	}
+	pod, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return debuggerRunning(pod.Status.EphemeralContainerStatuses, co)
+}
+
+// Helpers...
+
+func ephemeralContainerFor(name string, opts DebugOptions) v1.EphemeralContainer {
+	return v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    opts.Image,
+			Command:                  opts.Command,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			Stdin:                    true,
+			TTY:                      true,
+		},
+		TargetContainerName: opts.Target,
+	}
+}
+
+// debuggerName returns the next available debug container name.
+func debuggerName(cc []v1.EphemeralContainer) string {
+	names := make(map[string]struct{}, len(cc))
+	for _, c := range cc {
+		names[c.Name] = struct{}{}
+	}
+	for i := len(cc); ; i++ {
+		n := fmt.Sprintf("%s%d", debuggerPrefix, i)
+		if _, ok := names[n]; !ok {
+			return n
+		}
+	}
+}
+
+func debuggerRunning(ss []v1.ContainerStatus, co string) (bool, error) {
+	for _, s := range ss {
+		if s.Name != co {
+			continue
+		}
+		switch {
+		case s.State.Running != nil:
+			return true, nil
+		case s.State.Terminated != nil:
+			return false, fmt.Errorf("debug container %s terminated: %s", co, s.State.Terminated.Reason)
+		case s.State.Waiting != nil && strings.HasSuffix(s.State.Waiting.Reason, "BackOff"):
+			return false, fmt.Errorf("debug container %s failed: %s", co, s.State.Waiting.Message)
+		}
+	}
+
+	return false, nil
+}
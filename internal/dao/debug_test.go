@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestDebuggerName(t *testing.T) {
+	uu := map[string]struct {
+		cc []v1.EphemeralContainer
+		e  string
+	}{
+		"none": {
+			e: "debugger-0",
+		},
+		"next": {
+			cc: []v1.EphemeralContainer{makeEphemeral("debugger-0")},
+			e:  "debugger-1",
+		},
+		"taken": {
+			cc: []v1.EphemeralContainer{makeEphemeral("fred"), makeEphemeral("debugger-2"), makeEphemeral("debugger-3")},
+			e:  "debugger-4",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, debuggerName(u.cc))
+		})
+	}
+}
+
+func TestEphemeralContainerFor(t *testing.T) {
+	co := ephemeralContainerFor("debugger-0", DebugOptions{Image: "busybox", Command: []string{"sh"}, Target: "fred"})
+
+	assert.Equal(t, "debugger-0", co.Name)
+	assert.Equal(t, "busybox", co.Image)
+	assert.Equal(t, []string{"sh"}, co.Command)
+	assert.Equal(t, "fred", co.TargetContainerName)
+	assert.True(t, co.Stdin)
+	assert.True(t, co.TTY)
+}
+
+func TestDebuggerRunning(t *testing.T) {
+	uu := map[string]struct {
+		ss  []v1.ContainerStatus
+		e   bool
+		err bool
+	}{
+		"missing": {},
+		"waiting": {
+			ss: []v1.ContainerStatus{{Name: "debugger-0", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}}},
+		},
+		"running": {
+			ss: []v1.ContainerStatus{{Name: "debugger-0", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}},
+			e:  true,
+		},
+		"backoff": {
+			ss:  []v1.ContainerStatus{{Name: "debugger-0", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}}},
+			err: true,
+		},
+		"terminated": {
+			ss:  []v1.ContainerStatus{{Name: "debugger-0", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error"}}}},
+			err: true,
+		},
+		"other": {
+			ss: []v1.ContainerStatus{{Name: "fred", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := debuggerRunning(u.ss, "debugger-0")
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+// Helpers...
+
+func makeEphemeral(n string) v1.EphemeralContainer {
+	return v1.EphemeralContainer{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: n}}
+}
@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Deprecation)(nil)
+
+// DeprecationQuery represents a scan for resources using deprecated apis.
+type DeprecationQuery struct {
+	// Target tracks the cluster version to check against. It defaults to
+	// the current cluster version.
+	Target string
+}
+
+// Deprecation represents resources written with deprecated or removed api
+// versions.
+type Deprecation struct {
+	NonResource
+}
+
+// List returns all resources using deprecated api versions.
+func (d *Deprecation) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	defer func(t time.Time) {
+		log.Debug().Msgf("Deprecations Scan %v", time.Since(t))
+	}(time.Now())
+
+	q, _ := ctx.Value(internal.KeyDeprecations).(DeprecationQuery)
+	if q.Target == "" {
+		if info, err := d.Client().ServerVersion(); err == nil {
+			q.Target = info.GitVersion
+		}
+	}
+	dial, err := d.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg sync.WaitGroup
+		mx sync.Mutex
+		dd []render.APIDeprecation
+	)
+	sem := make(chan struct{}, grepWorkers)
+	timeout := d.Client().Config().CallTimeout()
+	for _, t := range deprecationTargets(MetaAccess) {
+		wg.Add(1)
+		go func(t deprecationTarget) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			cctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			ll, err := dial.Resource(t.gvr.GVR()).List(cctx, metav1.ListOptions{})
+			if err != nil {
+				log.Debug().Err(err).Msgf("Deprecations list failed for %q", t.gvr)
+				return
+			}
+			rr := apiDeprecations(t, ll.Items, q.Target)
+			mx.Lock()
+			dd = append(dd, rr...)
+			mx.Unlock()
+		}(t)
+	}
+	wg.Wait()
+
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].ID() < dd[j].ID()
+	})
+	oo := make([]runtime.Object, len(dd))
+	for i, d := range dd {
+		oo[i] = d
+	}
+
+	return oo, nil
+}
+
+// deprecationTarget represents a resource kind with versions scheduled for
+// removal.
+type deprecationTarget struct {
+	gvr  client.GVR
+	kind string
+}
+
+// deprecationTargets returns the listable resources which kinds have api
+// versions scheduled for removal. Each kind is listed once preferably via a
+// version that is not scheduled for removal.
+func deprecationTargets(m *Meta) []deprecationTarget {
+	kinds := make(map[string]client.GVR)
+	for _, gvr := range m.AllGVRs() {
+		meta, err := m.MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) || !canList(meta.Verbs) {
+			continue
+		}
+		if !render.HasAPILifecycle(meta.Kind) {
+			continue
+		}
+		curr, ok := kinds[meta.Kind]
+		if !ok || isScheduled(curr, meta.Kind) && !isScheduled(gvr, meta.Kind) {
+			kinds[meta.Kind] = gvr
+		}
+	}
+	tt := make([]deprecationTarget, 0, len(kinds))
+	for k, gvr := range kinds {
+		tt = append(tt, deprecationTarget{gvr: gvr, kind: k})
+	}
+	sort.Slice(tt, func(i, j int) bool {
+		return tt[i].kind < tt[j].kind
+	})
+
+	return tt
+}
+
+func isScheduled(gvr client.GVR, kind string) bool {
+	_, ok := render.LookupAPILifecycle(gvr.GV().String(), kind)
+	return ok
+}
+
+func apiDeprecations(t deprecationTarget, uu []unstructured.Unstructured, target string) []render.APIDeprecation {
+	var dd []render.APIDeprecation
+	for i := range uu {
+		l, status, ok := render.APIIssue(t.kind, render.ObjectAPIVersions(&uu[i]), target)
+		if !ok {
+			continue
+		}
+		dd = append(dd, render.APIDeprecation{
+			GVR:       t.gvr.String(),
+			Namespace: uu[i].GetNamespace(),
+			Name:      uu[i].GetName(),
+			Kind:      t.kind,
+			Lifecycle: l,
+			Status:    status,
+		})
+	}
+
+	return dd
+}
@@ -0,0 +1,42 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDeprecationTargets(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("v1/pods", metav1.APIResource{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"list"}})
+	m.RegisterMeta("extensions/v1beta1/ingresses", metav1.APIResource{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: []string{"list"}})
+	m.RegisterMeta("networking.k8s.io/v1/ingresses", metav1.APIResource{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: []string{"list"}})
+	m.RegisterMeta("batch/v1beta1/cronjobs", metav1.APIResource{Name: "cronjobs", Kind: "CronJob", Namespaced: true, Verbs: []string{"list"}})
+	m.RegisterMeta("v1/events", metav1.APIResource{Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"create"}})
+
+	assert.Equal(t, []deprecationTarget{
+		{gvr: client.NewGVR("batch/v1beta1/cronjobs"), kind: "CronJob"},
+		{gvr: client.NewGVR("networking.k8s.io/v1/ingresses"), kind: "Ingress"},
+	}, deprecationTargets(m))
+}
+
+func TestAPIDeprecations(t *testing.T) {
+	uu := make([]unstructured.Unstructured, 2)
+	uu[0].SetNamespace("default")
+	uu[0].SetName("fred")
+	uu[0].SetAnnotations(map[string]string{lastAppliedKey: `{"apiVersion":"extensions/v1beta1"}`})
+	uu[1].SetNamespace("default")
+	uu[1].SetName("blee")
+	uu[1].SetManagedFields([]metav1.ManagedFieldsEntry{{APIVersion: "networking.k8s.io/v1"}})
+	tg := deprecationTarget{gvr: client.NewGVR("networking.k8s.io/v1/ingresses"), kind: "Ingress"}
+
+	dd := apiDeprecations(tg, uu, "v1.22.0")
+	assert.Equal(t, 1, len(dd))
+	assert.Equal(t, "networking.k8s.io/v1/ingresses:default/fred", dd[0].ID())
+	assert.Equal(t, render.APIRemoved, dd[0].Status)
+	assert.Equal(t, "networking.k8s.io/v1", dd[0].Lifecycle.Replacement)
+}
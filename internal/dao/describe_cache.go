@@ -0,0 +1,209 @@
+package dao
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+const maxDescribeEntries = 100
+
+var describeCache = NewDescribeCache()
+
+type describeEntry struct {
+	rev, desc string
+
+	// watches tracks the informers evicting the entry on changes.
+	watches []cache.SharedIndexInformer
+}
+
+// DescribeCache caches describe output keyed by object UID and resource version.
+type DescribeCache struct {
+	entries map[string]describeEntry
+	watched map[cache.SharedIndexInformer]struct{}
+	mx      sync.RWMutex
+}
+
+// NewDescribeCache returns a new describe cache.
+func NewDescribeCache() *DescribeCache {
+	return &DescribeCache{
+		entries: make(map[string]describeEntry),
+		watched: make(map[cache.SharedIndexInformer]struct{}),
+	}
+}
+
+// Get returns a cached description if the resource did not change.
+func (d *DescribeCache) Get(uid, rev string) (string, bool) {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	e, ok := d.entries[uid]
+	if !ok || e.rev != rev {
+		return "", false
+	}
+
+	return e.desc, true
+}
+
+// Set caches a resource description. The description is only cached while
+// the given informers tracking its changes are watched.
+func (d *DescribeCache) Set(uid, rev, desc string, ii ...cache.SharedIndexInformer) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	for _, inf := range ii {
+		if _, ok := d.watched[inf]; !ok {
+			return
+		}
+	}
+	if _, ok := d.entries[uid]; !ok && len(d.entries) >= maxDescribeEntries {
+		for k := range d.entries {
+			delete(d.entries, k)
+			break
+		}
+	}
+	d.entries[uid] = describeEntry{rev: rev, desc: desc, watches: ii}
+}
+
+// Evict clears out a cached description.
+func (d *DescribeCache) Evict(uid string) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	delete(d.entries, uid)
+}
+
+// Len returns the number of cached descriptions.
+func (d *DescribeCache) Len() int {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	return len(d.entries)
+}
+
+// Watch evicts cached descriptions when an informer reports changes. The uid
+// function extracts the description key from a watch event object. Once done
+// closes, the informer stopped and the descriptions it tracked get evicted as
+// their changes would otherwise go unnoticed.
+func (d *DescribeCache) Watch(inf cache.SharedIndexInformer, done <-chan struct{}, uid func(interface{}) string) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	if _, ok := d.watched[inf]; ok {
+		return
+	}
+	d.watched[inf] = struct{}{}
+	if done != nil {
+		go func() {
+			<-done
+			d.unwatch(inf)
+		}()
+	}
+
+	evict := func(o interface{}) {
+		if id := uid(o); id != "" {
+			d.Evict(id)
+		}
+	}
+	// Registering a handler replays the informer content as adds. These are
+	// already reflected in cached descriptions so skip them.
+	replay := storeVersions(inf.GetStore())
+	inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(o interface{}) {
+			if replayed(replay, o) {
+				return
+			}
+			evict(o)
+		},
+		UpdateFunc: func(_, o interface{}) { evict(o) },
+		DeleteFunc: evict,
+	})
+}
+
+// unwatch evicts the descriptions tracked by a stopped informer.
+func (d *DescribeCache) unwatch(inf cache.SharedIndexInformer) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	delete(d.watched, inf)
+	for k, e := range d.entries {
+		for _, w := range e.watches {
+			if w == inf {
+				delete(d.entries, k)
+				break
+			}
+		}
+	}
+}
+
+// storeVersions returns the resource versions of the objects in a store.
+func storeVersions(s cache.Store) map[string]string {
+	oo := s.List()
+	vv := make(map[string]string, len(oo))
+	for _, o := range oo {
+		if m, err := meta.Accessor(o); err == nil {
+			vv[string(m.GetUID())] = m.GetResourceVersion()
+		}
+	}
+
+	return vv
+}
+
+// replayed checks if an added object was part of the initial replay.
+func replayed(vv map[string]string, o interface{}) bool {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return false
+	}
+	uid := string(m.GetUID())
+	rev, ok := vv[uid]
+	if !ok {
+		return false
+	}
+	delete(vv, uid)
+
+	return rev == m.GetResourceVersion()
+}
+
+// Reset clears out all cached descriptions.
+func (d *DescribeCache) Reset() {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.entries = make(map[string]describeEntry)
+	d.watched = make(map[cache.SharedIndexInformer]struct{})
+}
+
+// ResetDescribeCache clears out cached descriptions ie on context switch.
+func ResetDescribeCache() {
+	describeCache.Reset()
+}
+
+// ObjectUID extracts the UID of a watched object.
+func ObjectUID(o interface{}) string {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return ""
+	}
+
+	return string(m.GetUID())
+}
+
+// EventObjectUID extracts the UID of the object a watched event refers to.
+func EventObjectUID(o interface{}) string {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	uid, _, _ := unstructured.NestedString(u.Object, "involvedObject", "uid")
+
+	return uid
+}
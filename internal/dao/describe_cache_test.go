@@ -0,0 +1,119 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestDescribeCache(t *testing.T) {
+	c := dao.NewDescribeCache()
+
+	_, ok := c.Get("u1", "1")
+	assert.False(t, ok)
+
+	c.Set("u1", "1", "blee")
+	desc, ok := c.Get("u1", "1")
+	assert.True(t, ok)
+	assert.Equal(t, "blee", desc)
+
+	_, ok = c.Get("u1", "2")
+	assert.False(t, ok)
+
+	c.Evict("u1")
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestDescribeCacheUnwatch(t *testing.T) {
+	c := dao.NewDescribeCache()
+	inf1, inf2 := makeDescInformer(), makeDescInformer()
+	done := make(chan struct{})
+	c.Watch(inf1, done, dao.ObjectUID)
+
+	c.Set("u1", "1", "blee", inf1)
+	c.Set("u2", "1", "duh", inf1, inf2)
+	assert.Equal(t, 1, c.Len(), "untracked descriptions are not cached")
+
+	close(done)
+	assert.Eventually(t, func() bool { return c.Len() == 0 }, time.Second, 10*time.Millisecond)
+	c.Set("u1", "1", "blee", inf1)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestDescribeCacheReplay(t *testing.T) {
+	w := watch.NewFake()
+	inf := cache.NewSharedIndexInformer(&cache.ListWatch{
+		ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+			return &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*makeDescObject("u1", "1")}}, nil
+		},
+		WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+			return w, nil
+		},
+	}, &unstructured.Unstructured{}, 0, cache.Indexers{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go inf.Run(stop)
+	assert.True(t, cache.WaitForCacheSync(stop, inf.HasSynced))
+
+	c := dao.NewDescribeCache()
+	c.Watch(inf, nil, dao.ObjectUID)
+	c.Set("u1", "1", "blee", inf)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, c.Len(), "replayed adds do not evict")
+
+	w.Modify(makeDescObject("u1", "2"))
+	assert.Eventually(t, func() bool { return c.Len() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestObjectUID(t *testing.T) {
+	o := &metav1.ObjectMeta{UID: "u1"}
+	uu := map[string]struct {
+		o   interface{}
+		uid string
+	}{
+		"object":    {o: &unstructured.Unstructured{Object: map[string]interface{}{"metadata": map[string]interface{}{"uid": "u1"}}}, uid: "u1"},
+		"tombstone": {o: cache.DeletedFinalStateUnknown{Obj: o}, uid: "u1"},
+		"invalid":   {o: "blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.uid, dao.ObjectUID(u.o))
+		})
+	}
+}
+
+func TestEventObjectUID(t *testing.T) {
+	evt := &unstructured.Unstructured{Object: map[string]interface{}{
+		"involvedObject": map[string]interface{}{"uid": "u1"},
+	}}
+
+	assert.Equal(t, "u1", dao.EventObjectUID(evt))
+	assert.Equal(t, "u1", dao.EventObjectUID(cache.DeletedFinalStateUnknown{Obj: evt}))
+	assert.Equal(t, "", dao.EventObjectUID(&unstructured.Unstructured{}))
+}
+
+// Helpers...
+
+func makeDescInformer() cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{})
+}
+
+func makeDescObject(uid, rev string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"namespace":       "default",
+			"uid":             uid,
+			"resourceVersion": rev,
+		},
+	}}
+}
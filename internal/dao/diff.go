@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LastAppliedAnnotation tracks the configuration last applied via kubectl.
+	LastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	rsRevisionAnnotation = "deployment.kubernetes.io/revision"
+	podTemplateHashLabel = "pod-template-hash"
+)
+
+// serverFields tracks metadata fields set by the api server.
+var serverFields = []string{
+	"managedFields",
+	"uid",
+	"resourceVersion",
+	"creationTimestamp",
+	"generation",
+	"selfLink",
+}
+
+// LastApplied returns a resource live manifest, stripped of server fields,
+// along its last applied configuration.
+func LastApplied(o runtime.Object) (string, string, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	raw, ok := u.GetAnnotations()[LastAppliedAnnotation]
+	if !ok {
+		return "", "", fmt.Errorf("no last applied configuration found on %s", FQN(u.GetNamespace(), u.GetName()))
+	}
+	applied, err := yaml.JSONToYAML([]byte(raw))
+	if err != nil {
+		return "", "", err
+	}
+
+	live := u.DeepCopy()
+	unstructured.RemoveNestedField(live.Object, "status")
+	for _, f := range serverFields {
+		unstructured.RemoveNestedField(live.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(live.Object, "metadata", "annotations", LastAppliedAnnotation)
+	if len(live.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(live.Object, "metadata", "annotations")
+	}
+	bb, err := yaml.Marshal(live.Object)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(bb), string(applied), nil
+}
+
+// Revision returns a ReplicaSet or ControllerRevision revision number along
+// with the spec it captures.
+func Revision(o runtime.Object) (int64, string, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return 0, "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	var (
+		rev  int64
+		spec interface{}
+	)
+	switch u.GetKind() {
+	case "ReplicaSet":
+		r, err := strconv.ParseInt(u.GetAnnotations()[rsRevisionAnnotation], 10, 64)
+		if err != nil {
+			return 0, "", fmt.Errorf("no revision found on %s", FQN(u.GetNamespace(), u.GetName()))
+		}
+		tpl, ok, _ := unstructured.NestedMap(u.Object, "spec", "template")
+		if !ok {
+			return 0, "", fmt.Errorf("no pod template found on %s", FQN(u.GetNamespace(), u.GetName()))
+		}
+		// Pod template hashes always differ across revisions.
+		unstructured.RemoveNestedField(tpl, "metadata", "labels", podTemplateHashLabel)
+		rev, spec = r, tpl
+	case "ControllerRevision":
+		r, ok, _ := unstructured.NestedInt64(u.Object, "revision")
+		if !ok {
+			return 0, "", fmt.Errorf("no revision found on %s", FQN(u.GetNamespace(), u.GetName()))
+		}
+		rev, spec = r, u.Object["data"]
+	default:
+		return 0, "", fmt.Errorf("revisions are not supported for %s", u.GetKind())
+	}
+	bb, err := yaml.Marshal(spec)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return rev, string(bb), nil
+}
+
+// PreviousRevision returns the revision preceding the given one amongst
+// revisions sharing the same controller.
+func PreviousRevision(oo []runtime.Object, o runtime.Object) (runtime.Object, error) {
+	rev, _, err := Revision(o)
+	if err != nil {
+		return nil, err
+	}
+	u := o.(*unstructured.Unstructured)
+	owner := controllerUID(u)
+
+	var (
+		prev    runtime.Object
+		prevRev int64
+	)
+	for _, c := range oo {
+		cu, ok := c.(*unstructured.Unstructured)
+		if !ok || cu.GetUID() == u.GetUID() || cu.GetNamespace() != u.GetNamespace() || controllerUID(cu) != owner {
+			continue
+		}
+		r, _, err := Revision(c)
+		if err != nil || r >= rev || r <= prevRev {
+			continue
+		}
+		prev, prevRev = c, r
+	}
+	if prev == nil {
+		return nil, fmt.Errorf("no revision prior to %d found for %s", rev, FQN(u.GetNamespace(), u.GetName()))
+	}
+
+	return prev, nil
+}
+
+func controllerUID(u *unstructured.Unstructured) string {
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return string(ref.UID)
+		}
+	}
+
+	return ""
+}
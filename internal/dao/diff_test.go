@@ -0,0 +1,153 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLastApplied(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"namespace":       "blee",
+			"uid":             "123",
+			"resourceVersion": "10",
+			"annotations": map[string]interface{}{
+				dao.LastAppliedAnnotation: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"fred","namespace":"blee"},"data":{"a":"1"}}`,
+			},
+		},
+		"data": map[string]interface{}{"a": "2"},
+	}}
+
+	live, applied, err := dao.LastApplied(o)
+	assert.Nil(t, err)
+	assert.Equal(t, "apiVersion: v1\ndata:\n  a: \"2\"\nkind: ConfigMap\nmetadata:\n  name: fred\n  namespace: blee\n", live)
+	assert.Equal(t, "apiVersion: v1\ndata:\n  a: \"1\"\nkind: ConfigMap\nmetadata:\n  name: fred\n  namespace: blee\n", applied)
+	assert.Equal(t, "10", o.GetResourceVersion())
+}
+
+func TestLastAppliedMissing(t *testing.T) {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "ConfigMap",
+		"metadata": map[string]interface{}{"name": "fred", "namespace": "blee"},
+	}}
+
+	_, _, err := dao.LastApplied(o)
+	assert.NotNil(t, err)
+}
+
+func TestRevision(t *testing.T) {
+	uu := map[string]struct {
+		o    runtime.Object
+		rev  int64
+		spec string
+		err  bool
+	}{
+		"rs": {
+			o:    makeRS("rs1", "3", "nginx:1.19"),
+			rev:  3,
+			spec: "metadata:\n  labels:\n    app: fred\nspec:\n  containers:\n  - image: nginx:1.19\n    name: c1\n",
+		},
+		"cr": {
+			o:    makeCR("cr1", 2, "nginx:1.19"),
+			rev:  2,
+			spec: "spec:\n  template:\n    spec:\n      containers:\n      - image: nginx:1.19\n",
+		},
+		"noRevision": {
+			o:   makeRS("rs1", "", "nginx"),
+			err: true,
+		},
+		"unsupported": {
+			o:   &unstructured.Unstructured{Object: map[string]interface{}{"kind": "Pod"}},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rev, spec, err := dao.Revision(u.o)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.rev, rev)
+			assert.Equal(t, u.spec, spec)
+		})
+	}
+}
+
+func TestPreviousRevision(t *testing.T) {
+	rs1, rs2, rs3 := makeRS("rs1", "1", "nginx:1.17"), makeRS("rs2", "2", "nginx:1.18"), makeRS("rs3", "3", "nginx:1.19")
+	other := makeRS("rs4", "2", "nginx:1.18")
+	other.SetOwnerReferences(nil)
+	oo := []runtime.Object{rs3, other, rs1, rs2}
+
+	prev, err := dao.PreviousRevision(oo, rs3)
+	assert.Nil(t, err)
+	assert.Equal(t, "rs2", prev.(*unstructured.Unstructured).GetName())
+
+	prev, err = dao.PreviousRevision(oo, rs2)
+	assert.Nil(t, err)
+	assert.Equal(t, "rs1", prev.(*unstructured.Unstructured).GetName())
+
+	_, err = dao.PreviousRevision(oo, rs1)
+	assert.NotNil(t, err)
+}
+
+// Helpers...
+
+func makeRS(n, rev, img string) *unstructured.Unstructured {
+	ann := map[string]interface{}{}
+	if rev != "" {
+		ann["deployment.kubernetes.io/revision"] = rev
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "ReplicaSet",
+		"metadata": map[string]interface{}{
+			"name":        n,
+			"namespace":   "blee",
+			"uid":         n,
+			"annotations": ann,
+			"ownerReferences": []interface{}{
+				map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "fred", "uid": "dp1", "controller": true},
+			},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "fred", "pod-template-hash": n},
+				},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "c1", "image": img},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func makeCR(n string, rev int64, img string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "ControllerRevision",
+		"metadata":   map[string]interface{}{"name": n, "namespace": "blee"},
+		"revision":   rev,
+		"data": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"image": img},
+						},
+					},
+				},
+			},
+		},
+	}}
+}
@@ -22,6 +22,8 @@ var (
 	_ Nuker           = (*Deployment)(nil)
 	_ Loggable        = (*Deployment)(nil)
 	_ Restartable     = (*Deployment)(nil)
+	_ Pausable        = (*Deployment)(nil)
+	_ Undoable        = (*Deployment)(nil)
 	_ Scalable        = (*Deployment)(nil)
 	_ Controller      = (*Deployment)(nil)
 	_ ContainsPodSpec = (*Deployment)(nil)
@@ -57,9 +59,11 @@ func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) err
 		return err
 	}
 	scale.Spec.Replicas = replicas
-	_, err = dial.AppsV1().Deployments(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
 
-	return err
+	return Retry(ctx, func() error {
+		_, err := dial.AppsV1().Deployments(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{DryRun: dryRunOpts()})
+		return err
+	})
 }
 
 // Restart a Deployment rollout.
@@ -91,11 +95,68 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		dp.Name,
 		types.StrategicMergePatchType,
 		update,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+	return err
+}
+
+// Pause pauses a Deployment rollout.
+func (d *Deployment) Pause(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "pause", polymorphichelpers.ObjectPauserFn)
+}
+
+// Resume resumes a paused Deployment rollout.
+func (d *Deployment) Resume(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "resume", polymorphichelpers.ObjectResumerFn)
+}
+
+func (d *Deployment) patchRollout(ctx context.Context, path, verb string, fn func(runtime.Object) ([]byte, error)) error {
+	dp, err := d.Load(d.Factory, path)
+	if err != nil {
+		return err
+	}
+
+	auth, err := d.Client().CanI(dp.Namespace, "apps/v1/deployments", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to %s a deployment", verb)
+	}
+	update, err := fn(dp)
+	if err != nil {
+		return err
+	}
+
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.AppsV1().Deployments(dp.Namespace).Patch(
+		ctx,
+		dp.Name,
+		types.StrategicMergePatchType,
+		update,
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
 
+// Revisions returns the Deployment rollout history.
+func (d *Deployment) Revisions(path string) ([]RolloutRevision, error) {
+	return rolloutRevisions(d.Factory, "apps/v1/deployments", "apps/v1/replicasets", path)
+}
+
+// Undo rolls a Deployment back to a given revision.
+func (d *Deployment) Undo(path string, rev int64) error {
+	dp, err := d.Load(d.Factory, path)
+	if err != nil {
+		return err
+	}
+
+	return undoRollout(d.Client(), "apps/v1/deployments", "Deployment", dp.Namespace, dp, rev)
+}
+
 // TailLogs tail logs for all pods represented by this Deployment.
 func (d *Deployment) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 	dp, err := d.Load(d.Factory, opts.Path)
@@ -245,7 +306,7 @@ func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs Imag
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
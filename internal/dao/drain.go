@@ -0,0 +1,263 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DrainEvict tracks a pod evicted by a drain.
+	DrainEvict DrainAction = "Evict"
+
+	// DrainSkip tracks a pod left in place by a drain.
+	DrainSkip DrainAction = "Skip"
+
+	// DrainBlocked tracks a pod preventing a drain with the current options.
+	DrainBlocked DrainAction = "Blocked"
+
+	// evictRetry tracks the delay between evictions denied by a budget.
+	evictRetry = 5 * time.Second
+
+	// evictPoll tracks the delay between evicted pod deletion checks.
+	evictPoll = time.Second
+)
+
+// DrainAction represents a drain outcome for a given pod.
+type DrainAction string
+
+// DrainPod represents a node pod drain plan.
+type DrainPod struct {
+	Path   string
+	Action DrainAction
+	Reason string
+
+	// PDB tracks a disruption budget delaying the pod eviction.
+	PDB string
+}
+
+// String returns the pod plan summary.
+func (p DrainPod) String() string {
+	s := fmt.Sprintf("%-7s %s", p.Action, p.Path)
+	var rr []string
+	if p.Reason != "" {
+		rr = append(rr, p.Reason)
+	}
+	if p.PDB != "" {
+		rr = append(rr, "waits on PDB "+p.PDB)
+	}
+	if len(rr) > 0 {
+		s += " (" + strings.Join(rr, ", ") + ")"
+	}
+
+	return s
+}
+
+// DrainPlan represents the pods affected by a node drain.
+type DrainPlan []DrainPod
+
+// Evictions returns the paths of the pods to evict.
+func (pp DrainPlan) Evictions() []string {
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		if p.Action == DrainEvict {
+			ss = append(ss, p.Path)
+		}
+	}
+
+	return ss
+}
+
+// Blocked returns the pods preventing the drain.
+func (pp DrainPlan) Blocked() []DrainPod {
+	var bb []DrainPod
+	for _, p := range pp {
+		if p.Action == DrainBlocked {
+			bb = append(bb, p)
+		}
+	}
+
+	return bb
+}
+
+// String returns a drain plan report.
+func (pp DrainPlan) String() string {
+	if len(pp) == 0 {
+		return "No pods to evict."
+	}
+	var evict, skip int
+	ss := make([]string, 0, len(pp)+2)
+	for _, p := range pp {
+		switch p.Action {
+		case DrainEvict:
+			evict++
+		case DrainSkip:
+			skip++
+		}
+		ss = append(ss, p.String())
+	}
+	summary := fmt.Sprintf("%d pod(s) to evict, %d skipped", evict, skip)
+	if b := len(pp.Blocked()); b > 0 {
+		summary += fmt.Sprintf(", %d blocking the drain", b)
+	}
+
+	return summary + "\n\n" + strings.Join(ss, "\n")
+}
+
+// NewDrainPlan sorts out which pods a drain evicts, skips or gets blocked
+// on. It follows the kubectl drain filters.
+func NewDrainPlan(pods []*v1.Pod, ii []DisruptionImpact, opts DrainOptions) DrainPlan {
+	budgets := make(map[string]string)
+	for _, i := range ii {
+		if !i.Exceeded() {
+			continue
+		}
+		for _, p := range i.Pods {
+			budgets[p] = i.PDB
+		}
+	}
+
+	pp := make(DrainPlan, 0, len(pods))
+	for _, po := range pods {
+		p := drainPodFor(po, opts)
+		if p.Action == DrainEvict {
+			p.PDB = budgets[p.Path]
+		}
+		pp = append(pp, p)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return pp[i].Path < pp[j].Path
+	})
+
+	return pp
+}
+
+func drainPodFor(po *v1.Pod, opts DrainOptions) DrainPod {
+	p := DrainPod{Path: client.MetaFQN(po.ObjectMeta), Action: DrainEvict}
+	ref := metav1.GetControllerOf(po)
+	switch {
+	case po.DeletionTimestamp != nil:
+		p.Action, p.Reason = DrainSkip, "terminating"
+	case isMirrorPod(po):
+		p.Action, p.Reason = DrainSkip, "mirror pod"
+	case ref != nil && ref.Kind == "DaemonSet":
+		p.Reason = "DaemonSet managed"
+		if opts.IgnoreAllDaemonSets {
+			p.Action = DrainSkip
+		} else {
+			p.Action = DrainBlocked
+		}
+	case po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed:
+		p.Reason = "completed"
+	case ref == nil && !opts.Force:
+		p.Action, p.Reason = DrainBlocked, "not managed by a controller"
+	case hasLocalStorage(po) && !opts.DeleteLocalData:
+		p.Action, p.Reason = DrainBlocked, "uses local storage"
+	case ref == nil:
+		p.Reason = "not managed by a controller, will not be recreated"
+	case hasLocalStorage(po):
+		p.Reason = "local data will be lost"
+	}
+
+	return p
+}
+
+// DrainPlan computes the given node drain plan.
+func (n *Node) DrainPlan(path string, opts DrainOptions) (DrainPlan, error) {
+	pods, err := n.GetPods(path)
+	if err != nil {
+		return nil, err
+	}
+	ii, err := n.DrainImpacts(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDrainPlan(pods, ii, opts), nil
+}
+
+// Evict evicts a drained pod and waits for its deletion. Evictions denied
+// by a disruption budget are retried until the drain times out.
+func (n *Node) Evict(ctx context.Context, path string, opts DrainOptions) error {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return err
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ns, name := client.Namespaced(path)
+	po, err := dial.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	dOpts := metav1.DeleteOptions{DryRun: dryRunOpts()}
+	if opts.GracePeriodSeconds >= 0 {
+		grace := int64(opts.GracePeriodSeconds)
+		dOpts.GracePeriodSeconds = &grace
+	}
+	ev := v1beta1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Namespace: ns, Name: name},
+		DeleteOptions: &dOpts,
+	}
+	for {
+		err = dial.PolicyV1beta1().Evictions(ns).Evict(ctx, &ev)
+		if err == nil || apierrors.IsNotFound(err) {
+			break
+		}
+		if !apierrors.IsTooManyRequests(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("eviction blocked by disruption budget: %w", ctx.Err())
+		case <-time.After(evictRetry):
+		}
+	}
+	if IsDryRun() {
+		return nil
+	}
+
+	for {
+		curr, err := dial.CoreV1().Pods(ns).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && curr.UID != po.UID) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for pod deletion: %w", ctx.Err())
+		case <-time.After(evictPoll):
+		}
+	}
+}
+
+// Helpers...
+
+func isMirrorPod(po *v1.Pod) bool {
+	_, ok := po.Annotations[v1.MirrorPodAnnotationKey]
+	return ok
+}
+
+func hasLocalStorage(po *v1.Pod) bool {
+	for _, v := range po.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,100 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewDrainPlan(t *testing.T) {
+	managed := makeDrainPod("p1", "ReplicaSet")
+	ds := makeDrainPod("p2", "DaemonSet")
+	bare := makeDrainPod("p3", "")
+	local := makeDrainPod("p4", "ReplicaSet")
+	local.Spec.Volumes = []v1.Volume{{Name: "v1", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}}
+	mirror := makeDrainPod("p5", "")
+	mirror.Annotations = map[string]string{v1.MirrorPodAnnotationKey: "blee"}
+	done := makeDrainPod("p6", "")
+	done.Status.Phase = v1.PodSucceeded
+	pods := []*v1.Pod{done, mirror, local, bare, ds, managed}
+
+	uu := map[string]struct {
+		opts dao.DrainOptions
+		e    dao.DrainPlan
+	}{
+		"default": {
+			e: dao.DrainPlan{
+				{Path: "default/p1", Action: dao.DrainEvict},
+				{Path: "default/p2", Action: dao.DrainBlocked, Reason: "DaemonSet managed"},
+				{Path: "default/p3", Action: dao.DrainBlocked, Reason: "not managed by a controller"},
+				{Path: "default/p4", Action: dao.DrainBlocked, Reason: "uses local storage"},
+				{Path: "default/p5", Action: dao.DrainSkip, Reason: "mirror pod"},
+				{Path: "default/p6", Action: dao.DrainEvict, Reason: "completed"},
+			},
+		},
+		"all": {
+			opts: dao.DrainOptions{IgnoreAllDaemonSets: true, DeleteLocalData: true, Force: true},
+			e: dao.DrainPlan{
+				{Path: "default/p1", Action: dao.DrainEvict},
+				{Path: "default/p2", Action: dao.DrainSkip, Reason: "DaemonSet managed"},
+				{Path: "default/p3", Action: dao.DrainEvict, Reason: "not managed by a controller, will not be recreated"},
+				{Path: "default/p4", Action: dao.DrainEvict, Reason: "local data will be lost"},
+				{Path: "default/p5", Action: dao.DrainSkip, Reason: "mirror pod"},
+				{Path: "default/p6", Action: dao.DrainEvict, Reason: "completed"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.NewDrainPlan(pods, nil, u.opts))
+		})
+	}
+}
+
+func TestDrainPlanBudgets(t *testing.T) {
+	pods := []*v1.Pod{makeDrainPod("p1", "ReplicaSet"), makeDrainPod("p2", "ReplicaSet")}
+	ii := []dao.DisruptionImpact{
+		{PDB: "default/fred", Pods: []string{"default/p1"}, Allowed: 0},
+		{PDB: "default/blee", Pods: []string{"default/p2"}, Allowed: 1},
+	}
+
+	plan := dao.NewDrainPlan(pods, ii, dao.DrainOptions{})
+	assert.Equal(t, "default/fred", plan[0].PDB)
+	assert.Equal(t, "", plan[1].PDB)
+	assert.Equal(t, []string{"default/p1", "default/p2"}, plan.Evictions())
+	assert.Equal(t, 0, len(plan.Blocked()))
+}
+
+func TestDrainPlanString(t *testing.T) {
+	plan := dao.DrainPlan{
+		{Path: "default/p1", Action: dao.DrainEvict, PDB: "default/fred"},
+		{Path: "default/p2", Action: dao.DrainBlocked, Reason: "DaemonSet managed"},
+		{Path: "default/p3", Action: dao.DrainSkip, Reason: "mirror pod"},
+	}
+
+	assert.Equal(t, "1 pod(s) to evict, 1 skipped, 1 blocking the drain\n\n"+
+		"Evict   default/p1 (waits on PDB default/fred)\n"+
+		"Blocked default/p2 (DaemonSet managed)\n"+
+		"Skip    default/p3 (mirror pod)", plan.String())
+	assert.Equal(t, "No pods to evict.", dao.DrainPlan{}.String())
+}
+
+// Helpers...
+
+func makeDrainPod(n, owner string) *v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+	if owner != "" {
+		ctrl := true
+		po.OwnerReferences = []metav1.OwnerReference{{Kind: owner, Name: "fred", Controller: &ctrl}}
+	}
+
+	return &po
+}
@@ -0,0 +1,272 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// InjectEnv tracks values injected as container env vars.
+	InjectEnv = "env"
+
+	// InjectVolume tracks values mounted as a volume.
+	InjectVolume = "volume"
+
+	// InjectSubPath tracks values mounted using a volume subPath.
+	InjectSubPath = "subPath"
+
+	// DriftCurrent tracks pods started after the last change.
+	DriftCurrent = "Current"
+
+	// DriftStale tracks pods running with outdated values.
+	DriftStale = "Stale"
+
+	// DriftSyncing tracks pods whose mounted volumes get refreshed by the kubelet.
+	DriftSyncing = "Syncing"
+
+	cmGVR  = "v1/configmaps"
+	secGVR = "v1/secrets"
+)
+
+// PodDrift represents a pod consuming a configmap or secret.
+type PodDrift struct {
+	Pod      string
+	Started  time.Time
+	Via      []string
+	Status   string
+	OwnerGVR string
+	Owner    string
+}
+
+// Stale checks if the pod needs a restart to pick up the changes.
+func (d PodDrift) Stale() bool {
+	return d.Status == DriftStale
+}
+
+// String returns a drift report line.
+func (d PodDrift) String() string {
+	s := fmt.Sprintf("%s [%s] %s", d.Pod, strings.Join(d.Via, ","), d.Status)
+	if d.Owner != "" {
+		s += " (" + client.NewGVR(d.OwnerGVR).R() + " " + d.Owner + ")"
+	}
+
+	return s
+}
+
+// ConfigDrift represents the pods drift for a given configmap or secret.
+type ConfigDrift struct {
+	Path    string
+	Changed time.Time
+	Pods    []PodDrift
+}
+
+// Stale returns the pods running outdated values.
+func (c ConfigDrift) Stale() []PodDrift {
+	var dd []PodDrift
+	for _, d := range c.Pods {
+		if d.Stale() {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// Owners returns the workloads owning stale pods keyed by path with
+// their gvr as values.
+func (c ConfigDrift) Owners() map[string]string {
+	oo := make(map[string]string)
+	for _, d := range c.Stale() {
+		if d.Owner != "" {
+			oo[d.Owner] = d.OwnerGVR
+		}
+	}
+
+	return oo
+}
+
+// FetchConfigDrift computes how pods consuming a configmap or secret drift
+// from its current values. Pod contents are not inspected, pods started
+// before the last change are flagged instead.
+func FetchConfigDrift(f Factory, gvr, path string) (*ConfigDrift, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	m := metav1.ObjectMeta{
+		CreationTimestamp: u.GetCreationTimestamp(),
+		ManagedFields:     u.GetManagedFields(),
+	}
+
+	ns, n := client.Namespaced(path)
+	oo, err := f.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods, idx := make([]v1.Pod, 0, len(oo)), make(map[string]int, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		idx[client.FQN(po.Namespace, po.Name)] = len(pods)
+		pods = append(pods, po)
+	}
+
+	d := ConfigDrift{Path: path, Changed: LastModified(m)}
+	d.Pods = PodDrifts(pods, gvr, n, d.Changed)
+	for i := range d.Pods {
+		d.Pods[i].OwnerGVR, d.Pods[i].Owner = podWorkload(f, &pods[idx[d.Pods[i].Pod]])
+	}
+
+	return &d, nil
+}
+
+// LastModified returns the last time a resource was written to.
+func LastModified(m metav1.ObjectMeta) time.Time {
+	t := m.CreationTimestamp.Time
+	for _, f := range m.ManagedFields {
+		if f.Time != nil && f.Time.After(t) {
+			t = f.Time.Time
+		}
+	}
+
+	return t
+}
+
+// PodDrifts computes the drift of running pods consuming a configmap or secret.
+func PodDrifts(pods []v1.Pod, gvr, name string, changed time.Time) []PodDrift {
+	dd := make([]PodDrift, 0, len(pods))
+	for _, po := range pods {
+		if po.Status.Phase != v1.PodRunning || po.Status.StartTime == nil {
+			continue
+		}
+		via := Injections(&po.Spec, gvr, name)
+		if len(via) == 0 {
+			continue
+		}
+		d := PodDrift{
+			Pod:     client.FQN(po.Namespace, po.Name),
+			Started: po.Status.StartTime.Time,
+			Via:     via,
+			Status:  DriftCurrent,
+		}
+		if d.Started.Before(changed) {
+			d.Status = DriftSyncing
+			if in(via, InjectEnv) || in(via, InjectSubPath) {
+				d.Status = DriftStale
+			}
+		}
+		dd = append(dd, d)
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].Pod < dd[j].Pod
+	})
+
+	return dd
+}
+
+// Injections returns how a pod spec consumes a given configmap or secret.
+func Injections(spec *v1.PodSpec, gvr, name string) []string {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+
+	var via []string
+	for _, c := range cc {
+		if gvr == secGVR && containerHasSecret(c, name) || gvr == cmGVR && containerHasConfigMap(c, name) {
+			via = append(via, InjectEnv)
+			break
+		}
+	}
+	vols := configVolumes(spec, gvr, name)
+	if len(vols) == 0 {
+		return via
+	}
+	via = append(via, InjectVolume)
+	for _, c := range cc {
+		for _, m := range c.VolumeMounts {
+			if _, ok := vols[m.Name]; ok && m.SubPath != "" {
+				return append(via, InjectSubPath)
+			}
+		}
+	}
+
+	return via
+}
+
+// Helpers...
+
+func configVolumes(spec *v1.PodSpec, gvr, name string) map[string]struct{} {
+	vv := make(map[string]struct{})
+	for _, v := range spec.Volumes {
+		switch {
+		case gvr == cmGVR && v.ConfigMap != nil && v.ConfigMap.Name == name:
+			vv[v.Name] = struct{}{}
+		case gvr == secGVR && v.Secret != nil && v.Secret.SecretName == name:
+			vv[v.Name] = struct{}{}
+		case v.Projected != nil:
+			for _, s := range v.Projected.Sources {
+				if gvr == cmGVR && s.ConfigMap != nil && s.ConfigMap.Name == name ||
+					gvr == secGVR && s.Secret != nil && s.Secret.Name == name {
+					vv[v.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return vv
+}
+
+// podWorkload returns a pod restartable workload if any.
+func podWorkload(f Factory, po *v1.Pod) (string, string) {
+	for _, ref := range po.OwnerReferences {
+		switch ref.Kind {
+		case "StatefulSet":
+			return "apps/v1/statefulsets", client.FQN(po.Namespace, ref.Name)
+		case "DaemonSet":
+			return "apps/v1/daemonsets", client.FQN(po.Namespace, ref.Name)
+		case "ReplicaSet":
+			if dp := rsDeployment(f, client.FQN(po.Namespace, ref.Name)); dp != "" {
+				return "apps/v1/deployments", client.FQN(po.Namespace, dp)
+			}
+		}
+	}
+
+	return "", ""
+}
+
+func rsDeployment(f Factory, path string) string {
+	o, err := f.Get("apps/v1/replicasets", path, true, labels.Everything())
+	if err != nil {
+		return ""
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.Kind == "Deployment" {
+			return ref.Name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,155 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInjections(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		gvr  string
+		e    []string
+	}{
+		"none": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			gvr:  "v1/configmaps",
+		},
+		"envFrom": {
+			spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:    "c1",
+				EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}},
+			}}},
+			gvr: "v1/configmaps",
+			e:   []string{dao.InjectEnv},
+		},
+		"secretKeyRef": {
+			spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "c1",
+				Env: []v1.EnvVar{{Name: "PWD", ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}, Key: "pwd"},
+				}}},
+			}}},
+			gvr: "v1/secrets",
+			e:   []string{dao.InjectEnv},
+		},
+		"volume": {
+			spec: makeVolumeSpec(v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}, ""),
+			gvr:  "v1/configmaps",
+			e:    []string{dao.InjectVolume},
+		},
+		"subPath": {
+			spec: makeVolumeSpec(v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "fred"}}, "app.conf"),
+			gvr:  "v1/secrets",
+			e:    []string{dao.InjectVolume, dao.InjectSubPath},
+		},
+		"projected": {
+			spec: makeVolumeSpec(v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}},
+			}}}, ""),
+			gvr: "v1/secrets",
+			e:   []string{dao.InjectVolume},
+		},
+		"wrongKind": {
+			spec: makeVolumeSpec(v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "fred"}}, ""),
+			gvr:  "v1/configmaps",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.Injections(&u.spec, u.gvr, "fred"))
+		})
+	}
+}
+
+func TestPodDrifts(t *testing.T) {
+	changed := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	env := v1.PodSpec{Containers: []v1.Container{{
+		Name:    "c1",
+		EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}},
+	}}}
+	vol := makeVolumeSpec(v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "fred"}}}, "")
+
+	pods := []v1.Pod{
+		makeDriftPod("p1", env, changed.Add(-time.Hour), v1.PodRunning),
+		makeDriftPod("p2", env, changed.Add(time.Hour), v1.PodRunning),
+		makeDriftPod("p3", vol, changed.Add(-time.Hour), v1.PodRunning),
+		makeDriftPod("p4", env, changed.Add(-time.Hour), v1.PodSucceeded),
+		makeDriftPod("p5", v1.PodSpec{}, changed.Add(-time.Hour), v1.PodRunning),
+	}
+	dd := dao.PodDrifts(pods, "v1/configmaps", "fred", changed)
+
+	assert.Equal(t, 3, len(dd))
+	assert.Equal(t, "default/p1", dd[0].Pod)
+	assert.Equal(t, dao.DriftStale, dd[0].Status)
+	assert.Equal(t, dao.DriftCurrent, dd[1].Status)
+	assert.Equal(t, dao.DriftSyncing, dd[2].Status)
+
+	d := dao.ConfigDrift{Pods: dd}
+	d.Pods[0].OwnerGVR, d.Pods[0].Owner = "apps/v1/deployments", "default/fred"
+	assert.Equal(t, 1, len(d.Stale()))
+	assert.Equal(t, map[string]string{"default/fred": "apps/v1/deployments"}, d.Owners())
+	assert.Equal(t, "default/p1 [env] Stale (deployments default/fred)", d.Pods[0].String())
+}
+
+func TestLastModified(t *testing.T) {
+	t1 := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	uu := map[string]struct {
+		m metav1.ObjectMeta
+		e time.Time
+	}{
+		"created": {
+			m: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(t1)},
+			e: t1,
+		},
+		"managed": {
+			m: metav1.ObjectMeta{
+				CreationTimestamp: metav1.NewTime(t1),
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: "kubectl"},
+					{Manager: "k9s", Time: &metav1.Time{Time: t2}},
+				},
+			},
+			e: t2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.LastModified(u.m))
+		})
+	}
+}
+
+// Helpers...
+
+func makeVolumeSpec(src v1.VolumeSource, subPath string) v1.PodSpec {
+	return v1.PodSpec{
+		Volumes: []v1.Volume{{Name: "config", VolumeSource: src}},
+		Containers: []v1.Container{{
+			Name:         "c1",
+			VolumeMounts: []v1.VolumeMount{{Name: "config", MountPath: "/etc/app", SubPath: subPath}},
+		}},
+	}
+}
+
+func makeDriftPod(n string, spec v1.PodSpec, started time.Time, phase v1.PodPhase) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "default"},
+		Spec:       spec,
+		Status: v1.PodStatus{
+			Phase:     phase,
+			StartTime: &metav1.Time{Time: started},
+		},
+	}
+}
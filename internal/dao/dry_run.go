@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"sync/atomic"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+// dryRun tracks whether mutating actions are performed as server side dry-runs.
+var dryRun int32
+
+// SetDryRun toggles server side dry-runs for all mutating actions.
+func SetDryRun(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&dryRun, v)
+}
+
+// IsDryRun returns true if mutating actions are server side dry-runs.
+func IsDryRun() bool {
+	return atomic.LoadInt32(&dryRun) == 1
+}
+
+// Helpers...
+
+func dryRunOpts() []string {
+	if IsDryRun() {
+		return []string{metav1.DryRunAll}
+	}
+
+	return nil
+}
+
+func dryRunStrategy() cmdutil.DryRunStrategy {
+	if IsDryRun() {
+		return cmdutil.DryRunServer
+	}
+
+	return cmdutil.DryRunNone
+}
@@ -0,0 +1,19 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	defer dao.SetDryRun(false)
+
+	assert.False(t, dao.IsDryRun())
+	assert.False(t, dao.NewAuditEntry("delete", "v1/pods", "default/fred", nil).DryRun)
+
+	dao.SetDryRun(true)
+	assert.True(t, dao.IsDryRun())
+	assert.True(t, dao.NewAuditEntry("delete", "v1/pods", "default/fred", nil).DryRun)
+}
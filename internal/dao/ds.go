@@ -25,6 +25,7 @@ var (
 	_ Nuker           = (*DaemonSet)(nil)
 	_ Loggable        = (*DaemonSet)(nil)
 	_ Restartable     = (*DaemonSet)(nil)
+	_ Undoable        = (*DaemonSet)(nil)
 	_ Controller      = (*DaemonSet)(nil)
 	_ ContainsPodSpec = (*DaemonSet)(nil)
 )
@@ -67,11 +68,26 @@ func (d *DaemonSet) Restart(ctx context.Context, path string) error {
 		ds.Name,
 		types.StrategicMergePatchType,
 		update,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
 
+// Revisions returns the DaemonSet rollout history.
+func (d *DaemonSet) Revisions(path string) ([]RolloutRevision, error) {
+	return rolloutRevisions(d.Factory, "apps/v1/daemonsets", "apps/v1/controllerrevisions", path)
+}
+
+// Undo rolls a DaemonSet back to a given revision.
+func (d *DaemonSet) Undo(path string, rev int64) error {
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+
+	return undoRollout(d.Client(), "apps/v1/daemonsets", "DaemonSet", ds.Namespace, ds, rev)
+}
+
 // TailLogs tail logs for all pods represented by this DaemonSet.
 func (d *DaemonSet) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 	ds, err := d.GetInstance(opts.Path)
@@ -109,18 +125,17 @@ func podLogs(ctx context.Context, c LogChan, sel map[string]string, opts LogOpti
 
 	po := Pod{}
 	po.Init(f, client.NewGVR("v1/pods"))
+	paths := make([]string, 0, len(oo))
 	for _, o := range oo {
 		var pod v1.Pod
 		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod)
 		if err != nil {
 			return err
 		}
-		opts.Path = client.FQN(pod.Namespace, pod.Name)
-		if err := po.TailLogs(ctx, c, opts); err != nil {
-			return err
-		}
+		paths = append(paths, client.FQN(pod.Namespace, pod.Name))
 	}
-	return nil
+
+	return tailPodsLogs(ctx, &po, c, paths, opts)
 }
 
 // Pod returns a pod victim by name.
@@ -259,7 +274,7 @@ func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs Image
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
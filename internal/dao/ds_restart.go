@@ -0,0 +1,108 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// nodeRestartPoll tracks the delay between replacement pod readiness checks.
+const nodeRestartPoll = time.Second
+
+// RestartOnNode deletes a daemonset pod and waits for the pod the daemonset
+// recreates on the same node to become ready.
+func (d *DaemonSet) RestartOnNode(ctx context.Context, path, pod string) error {
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	auth, err := d.Client().CanI(ds.Namespace, "v1/pods", []string{client.DeleteVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to delete pods")
+	}
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	ns, n := client.Namespaced(pod)
+	po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := checkNodeRestart(ds, po); err != nil {
+		return err
+	}
+	err = dial.CoreV1().Pods(ns).Delete(ctx, n, metav1.DeleteOptions{DryRun: dryRunOpts()})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if IsDryRun() {
+		return nil
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	opts := metav1.ListOptions{
+		LabelSelector: sel.String(),
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", po.Spec.NodeName).String(),
+	}
+	for {
+		ll, err := dial.CoreV1().Pods(ns).List(ctx, opts)
+		if err == nil && replacementReady(ll.Items, ds.UID, po.UID) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for a ready pod on node %s: %w", po.Spec.NodeName, ctx.Err())
+		case <-time.After(nodeRestartPoll):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// checkNodeRestart ensures a pod is scheduled and managed by a daemonset.
+func checkNodeRestart(ds *appsv1.DaemonSet, po *v1.Pod) error {
+	ref := metav1.GetControllerOf(po)
+	if ref == nil || ref.UID != ds.UID {
+		return fmt.Errorf("pod %s is not managed by daemonset %s", client.MetaFQN(po.ObjectMeta), client.MetaFQN(ds.ObjectMeta))
+	}
+	if po.Spec.NodeName == "" {
+		return fmt.Errorf("pod %s is not scheduled on a node", client.MetaFQN(po.ObjectMeta))
+	}
+
+	return nil
+}
+
+// replacementReady checks if a daemonset pod other than the deleted one is
+// ready.
+func replacementReady(pods []v1.Pod, ds, old types.UID) bool {
+	for i := range pods {
+		if pods[i].UID == old {
+			continue
+		}
+		if ref := metav1.GetControllerOf(&pods[i]); ref == nil || ref.UID != ds {
+			continue
+		}
+		if isPodReady(pods[i]) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,97 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCheckNodeRestart(t *testing.T) {
+	ds := appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred", UID: "ds"}}
+	uu := map[string]struct {
+		po  *v1.Pod
+		err string
+	}{
+		"ok": {
+			po: makeNodePod("p1", "ds", "n1", true),
+		},
+		"unmanaged": {
+			po:  makeNodePod("p1", "blee", "n1", true),
+			err: "pod default/p1 is not managed by daemonset default/fred",
+		},
+		"unscheduled": {
+			po:  makeNodePod("p1", "ds", "", false),
+			err: "pod default/p1 is not scheduled on a node",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := checkNodeRestart(&ds, u.po)
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestReplacementReady(t *testing.T) {
+	old := makeNodePod("p1", "ds", "n1", true)
+	uu := map[string]struct {
+		pods []v1.Pod
+		e    bool
+	}{
+		"none": {},
+		"old": {
+			pods: []v1.Pod{*old},
+		},
+		"pending": {
+			pods: []v1.Pod{*old, *makeNodePod("p2", "ds", "n1", false)},
+		},
+		"other": {
+			pods: []v1.Pod{*makeNodePod("p2", "blee", "n1", true)},
+		},
+		"ready": {
+			pods: []v1.Pod{*makeNodePod("p2", "ds", "n1", true)},
+			e:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, replacementReady(u.pods, "ds", old.UID))
+		})
+	}
+}
+
+// Helpers...
+
+func makeNodePod(n string, owner types.UID, node string, ready bool) *v1.Pod {
+	ctrl := true
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "default",
+			Name:            n,
+			UID:             types.UID(n),
+			OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "fred", UID: owner, Controller: &ctrl}},
+		},
+		Spec: v1.PodSpec{NodeName: node},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+		},
+	}
+}
@@ -0,0 +1,147 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Editor = (*Generic)(nil)
+
+// Apply saves an edited manifest using a server side apply. Force takes
+// ownership of fields managed by others.
+func (g *Generic) Apply(ctx context.Context, path string, manifest []byte, manager string, force bool) error {
+	data, err := ApplyManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	return g.patch(ctx, path, types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: manager,
+		Force:        &force,
+		DryRun:       dryRunOpts(),
+	})
+}
+
+// PatchEdit saves the changes between the original and modified manifests
+// as a patch. It returns false if there is nothing to save.
+func (g *Generic) PatchEdit(ctx context.Context, path string, original, modified []byte, manager string) (bool, error) {
+	patch, pt, err := EditPatch(original, modified)
+	if err != nil || patch == nil {
+		return false, err
+	}
+
+	return true, g.patch(ctx, path, pt, patch, metav1.PatchOptions{
+		FieldManager: manager,
+		DryRun:       dryRunOpts(),
+	})
+}
+
+func (g *Generic) patch(ctx context.Context, path string, pt types.PatchType, data []byte, opts metav1.PatchOptions) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+
+	return Retry(ctx, func() error {
+		if client.IsClusterScoped(ns) {
+			_, err := dial.Patch(ctx, n, pt, data, opts)
+			return err
+		}
+		_, err := dial.Namespace(ns).Patch(ctx, n, pt, data, opts)
+		return err
+	})
+}
+
+// ApplyManifest converts an edited manifest to a server side apply payload.
+// Server managed metadata is dropped so the apply does not conflict with
+// concurrent controller updates.
+func ApplyManifest(manifest []byte) ([]byte, error) {
+	var u unstructured.Unstructured
+	if err := unmarshalManifest(manifest, &u); err != nil {
+		return nil, err
+	}
+	u.SetManagedFields(nil)
+	u.SetResourceVersion("")
+
+	return u.MarshalJSON()
+}
+
+// EditPatch computes a patch from an original and a modified manifest.
+// Built-in resources get a strategic merge patch, others a json merge patch.
+// The patch is nil when both manifests are the same.
+func EditPatch(original, modified []byte) ([]byte, types.PatchType, error) {
+	var o, m unstructured.Unstructured
+	if err := unmarshalManifest(original, &o); err != nil {
+		return nil, "", err
+	}
+	if err := unmarshalManifest(modified, &m); err != nil {
+		return nil, "", err
+	}
+	oj, err := o.MarshalJSON()
+	if err != nil {
+		return nil, "", err
+	}
+	mj, err := m.MarshalJSON()
+	if err != nil {
+		return nil, "", err
+	}
+
+	preconditions := []mergepatch.PreconditionFunc{
+		mergepatch.RequireKeyUnchanged("apiVersion"),
+		mergepatch.RequireKeyUnchanged("kind"),
+		mergepatch.RequireMetadataKeyUnchanged("name"),
+		mergepatch.RequireMetadataKeyUnchanged("namespace"),
+	}
+	var (
+		patch []byte
+		pt    types.PatchType
+	)
+	if obj, e := scheme.Scheme.New(m.GroupVersionKind()); e == nil {
+		pt = types.StrategicMergePatchType
+		patch, err = strategicpatch.CreateTwoWayMergePatch(oj, mj, obj, preconditions...)
+	} else {
+		pt = types.MergePatchType
+		patch, err = jsonmergepatch.CreateThreeWayJSONMergePatch(oj, mj, oj, preconditions...)
+	}
+	if mergepatch.IsPreconditionFailed(err) {
+		return nil, "", fmt.Errorf("apiVersion, kind, name and namespace can't be edited")
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if string(patch) == "{}" {
+		return nil, pt, nil
+	}
+
+	return patch, pt, nil
+}
+
+// Helpers...
+
+func unmarshalManifest(manifest []byte, u *unstructured.Unstructured) error {
+	raw, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	return u.UnmarshalJSON(raw)
+}
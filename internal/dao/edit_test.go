@@ -0,0 +1,97 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEditPatch(t *testing.T) {
+	uu := map[string]struct {
+		original, modified string
+		e                  string
+		pt                 types.PatchType
+		err                bool
+	}{
+		"strategic": {
+			original: editPod("fred", "{app: fred}"),
+			modified: editPod("fred", "{app: blee}"),
+			e:        `{"metadata":{"labels":{"app":"blee"}}}`,
+			pt:       types.StrategicMergePatchType,
+		},
+		"removed": {
+			original: editPod("fred", "{app: fred}"),
+			modified: editPod("fred", "{}"),
+			e:        `{"metadata":{"labels":{"app":null}}}`,
+			pt:       types.StrategicMergePatchType,
+		},
+		"custom": {
+			original: "apiVersion: fred.io/v1\nkind: Blee\nmetadata:\n  name: b1\nspec:\n  size: 1\n",
+			modified: "apiVersion: fred.io/v1\nkind: Blee\nmetadata:\n  name: b1\nspec:\n  size: 2\n",
+			e:        `{"spec":{"size":2}}`,
+			pt:       types.MergePatchType,
+		},
+		"unchanged": {
+			original: editPod("fred", "{app: fred}"),
+			modified: editPod("fred", "{app: fred}"),
+			pt:       types.StrategicMergePatchType,
+		},
+		"renamed": {
+			original: editPod("fred", "{app: fred}"),
+			modified: editPod("blee", "{app: fred}"),
+			err:      true,
+		},
+		"invalid": {
+			original: editPod("fred", "{app: fred}"),
+			modified: "metadata: [",
+			err:      true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			patch, pt, err := dao.EditPatch([]byte(u.original), []byte(u.modified))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.pt, pt)
+			if u.e == "" {
+				assert.Nil(t, patch)
+				return
+			}
+			assert.JSONEq(t, u.e, string(patch))
+		})
+	}
+}
+
+func TestApplyManifest(t *testing.T) {
+	m := `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: default
+  resourceVersion: "42"
+  managedFields:
+  - manager: kubectl
+    operation: Update
+spec:
+  containers:
+  - name: c1
+    image: nginx
+`
+	raw, err := dao.ApplyManifest([]byte(m))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"fred","namespace":"default"},"spec":{"containers":[{"name":"c1","image":"nginx"}]}}`, string(raw))
+}
+
+// Helpers...
+
+func editPod(name, labels string) string {
+	return "apiVersion: v1\nkind: Pod\nmetadata:\n  name: " + name + "\n  namespace: default\n  labels: " + labels + "\nspec:\n  containers:\n  - name: c1\n    image: nginx\n"
+}
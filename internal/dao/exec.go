@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"io"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// execStream runs a command in a pod container streaming its stdin, stdout
+// and stderr. No stdin is attached when in is nil.
+func execStream(conn client.Connection, fqn, co string, cmd []string, in io.Reader, out, errOut io.Writer) error {
+	ns, n := client.Namespaced(fqn)
+	cfg, err := conn.RestConfig()
+	if err != nil {
+		return err
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		return err
+	}
+	req := dial.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdin:     in != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	x, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return x.Stream(remotecommand.StreamOptions{
+		Stdin:  in,
+		Stdout: out,
+		Stderr: errOut,
+	})
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -91,6 +92,19 @@ func (g *Generic) ToYAML(path string, showManaged bool) (string, error) {
 	return raw, nil
 }
 
+// StreamYAML writes a resource yaml one entry at a time.
+func (g *Generic) StreamYAML(path string, showManaged bool, w io.Writer) error {
+	o, err := g.Get(context.Background(), path)
+	if err != nil {
+		return err
+	}
+
+	if err := EncodeYAML(o, showManaged, w); err != nil {
+		return fmt.Errorf("unable to marshal resource %s", err)
+	}
+	return nil
+}
+
 // Delete deletes a resource.
 func (g *Generic) Delete(path string, cascade, force bool) error {
 	log.Debug().Msgf("DELETE %q -- %t:%t", path, cascade, force)
@@ -114,6 +128,7 @@ func (g *Generic) Delete(path string, cascade, force bool) error {
 	opts := metav1.DeleteOptions{
 		PropagationPolicy:  &p,
 		GracePeriodSeconds: grace,
+		DryRun:             dryRunOpts(),
 	}
 
 	dial, err := g.dynClient()
@@ -0,0 +1,207 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+var _ Accessor = (*Grep)(nil)
+
+const (
+	// grepWorkers caps the number of concurrent list calls.
+	grepWorkers = 10
+
+	lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// GrepQuery represents a search for resources by name, labels or annotations.
+type GrepQuery struct {
+	Pattern, Namespace string
+}
+
+// String returns the query as pattern@namespace.
+func (q GrepQuery) String() string {
+	if q.Namespace == "" {
+		return q.Pattern
+	}
+
+	return q.Pattern + "@" + q.Namespace
+}
+
+// Grep represents a search across all listable cluster resources.
+type Grep struct {
+	NonResource
+}
+
+// List returns all resources matching the context query.
+func (g *Grep) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	defer func(t time.Time) {
+		log.Debug().Msgf("Grep Scan %v", time.Since(t))
+	}(time.Now())
+
+	q, ok := ctx.Value(internal.KeyGrep).(GrepQuery)
+	if !ok {
+		return nil, errors.New("expecting a context grep query")
+	}
+	rx, err := regexp.Compile("(?i)" + q.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid grep pattern %q: %w", q.Pattern, err)
+	}
+	dial, err := g.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+
+	rr := g.search(ctx, dial, grepTargets(MetaAccess, q.Namespace), q.Namespace, rx)
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+func (g *Grep) search(ctx context.Context, dial dynamic.Interface, gvrs client.GVRs, ns string, rx *regexp.Regexp) []render.GrepRes {
+	var (
+		wg  sync.WaitGroup
+		mx  sync.Mutex
+		res []render.GrepRes
+	)
+	sem := make(chan struct{}, grepWorkers)
+	timeout := g.Client().Config().CallTimeout()
+	for _, gvr := range gvrs {
+		wg.Add(1)
+		go func(gvr client.GVR) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			cctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			ll, err := dial.Resource(gvr.GVR()).Namespace(ns).List(cctx, metav1.ListOptions{})
+			if err != nil {
+				log.Debug().Err(err).Msgf("Grep list failed for %q", gvr)
+				return
+			}
+			rr := grepList(gvr.String(), ll.Items, rx)
+			mx.Lock()
+			res = append(res, rr...)
+			mx.Unlock()
+		}(gvr)
+	}
+	wg.Wait()
+
+	return res
+}
+
+// grepTargets returns the listable resources to search in a given namespace.
+func grepTargets(m *Meta, ns string) client.GVRs {
+	gvrs := make(client.GVRs, 0, 50)
+	for _, gvr := range m.AllGVRs() {
+		meta, err := m.MetaFor(gvr)
+		if err != nil || !IsK8sMeta(meta) {
+			continue
+		}
+		if strings.Contains(meta.Name, "/") || gvr.R() == "events" {
+			continue
+		}
+		if ns != "" && !meta.Namespaced {
+			continue
+		}
+		if !canList(meta.Verbs) {
+			continue
+		}
+		gvrs = append(gvrs, gvr)
+	}
+	sort.Slice(gvrs, func(i, j int) bool {
+		return gvrs[i].String() < gvrs[j].String()
+	})
+
+	return gvrs
+}
+
+// canList checks if a resource supports listing. CRD metas carry no verbs.
+func canList(verbs []string) bool {
+	if len(verbs) == 0 {
+		return true
+	}
+	for _, v := range verbs {
+		if v == "list" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func grepList(gvr string, uu []unstructured.Unstructured, rx *regexp.Regexp) []render.GrepRes {
+	rr := make([]render.GrepRes, 0, len(uu))
+	for i := range uu {
+		mm := grepMatches(&uu[i], rx)
+		if len(mm) == 0 {
+			continue
+		}
+		rr = append(rr, render.GrepRes{
+			GVR:       gvr,
+			Namespace: uu[i].GetNamespace(),
+			Name:      uu[i].GetName(),
+			Matches:   mm,
+		})
+	}
+
+	return rr
+}
+
+// grepMatches returns the resource name, labels and annotations matching
+// a pattern.
+func grepMatches(o metav1.Object, rx *regexp.Regexp) []string {
+	var mm []string
+	if rx.MatchString(o.GetName()) {
+		mm = append(mm, "name")
+	}
+	for _, k := range sortedKeys(o.GetLabels()) {
+		v := o.GetLabels()[k]
+		if rx.MatchString(k) || rx.MatchString(v) {
+			mm = append(mm, "label:"+k+"="+v)
+		}
+	}
+	for _, k := range sortedKeys(o.GetAnnotations()) {
+		if k == lastAppliedKey {
+			continue
+		}
+		if rx.MatchString(k) || rx.MatchString(o.GetAnnotations()[k]) {
+			mm = append(mm, "annotation:"+k)
+		}
+	}
+
+	return mm
+}
+
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,74 @@
+package dao
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGrepQueryString(t *testing.T) {
+	assert.Equal(t, "fred", GrepQuery{Pattern: "fred"}.String())
+	assert.Equal(t, "fred@blee", GrepQuery{Pattern: "fred", Namespace: "blee"}.String())
+}
+
+func TestGrepTargets(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("v1/pods", metav1.APIResource{Name: "pods", Namespaced: true, Verbs: []string{"get", "list"}})
+	m.RegisterMeta("v1/podlogs", metav1.APIResource{Name: "pods/log", Namespaced: true, Verbs: []string{"get"}})
+	m.RegisterMeta("v1/nodes", metav1.APIResource{Name: "nodes", Verbs: []string{"get", "list"}})
+	m.RegisterMeta("v1/events", metav1.APIResource{Name: "events", Namespaced: true, Verbs: []string{"list"}})
+	m.RegisterMeta("v1/bindings", metav1.APIResource{Name: "bindings", Namespaced: true, Verbs: []string{"create"}})
+	m.RegisterMeta("fred.io/v1/blees", metav1.APIResource{Name: "blees", Namespaced: true})
+	m.RegisterMeta("aliases", metav1.APIResource{Name: "aliases", Categories: []string{"k9s"}})
+
+	uu := map[string]struct {
+		ns string
+		e  client.GVRs
+	}{
+		"all": {
+			e: client.GVRs{client.NewGVR("fred.io/v1/blees"), client.NewGVR("v1/nodes"), client.NewGVR("v1/pods")},
+		},
+		"namespaced": {
+			ns: "default",
+			e:  client.GVRs{client.NewGVR("fred.io/v1/blees"), client.NewGVR("v1/pods")},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, grepTargets(m, u.ns))
+		})
+	}
+}
+
+func TestGrepMatches(t *testing.T) {
+	o := metav1.ObjectMeta{
+		Name:   "fred-db",
+		Labels: map[string]string{"app": "fred", "tier": "db"},
+		Annotations: map[string]string{
+			"owner":        "Fred",
+			lastAppliedKey: `{"metadata":{"name":"fred-db"}}`,
+		},
+	}
+
+	uu := map[string]struct {
+		pattern string
+		e       []string
+	}{
+		"all":     {pattern: "fred", e: []string{"name", "label:app=fred", "annotation:owner"}},
+		"label":   {pattern: "^tier$", e: []string{"label:tier=db"}},
+		"regex":   {pattern: "-db$", e: []string{"name"}},
+		"noMatch": {pattern: "blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, grepMatches(&o, regexp.MustCompile("(?i)"+u.pattern)))
+		})
+	}
+}
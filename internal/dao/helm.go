@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
+// maxHelmHistory tracks the max number of revisions to list.
+const maxHelmHistory = 256
+
 var (
 	_ Accessor  = (*Helm)(nil)
 	_ Nuker     = (*Helm)(nil)
@@ -90,13 +96,21 @@ func (c *Helm) ToYAML(path string, showManaged bool) (string, error) {
 
 // Delete uninstall a Helm.
 func (c *Helm) Delete(path string, cascade, force bool) error {
+	return c.Uninstall(path, false)
+}
+
+// Uninstall uninstalls a release, optionally retaining its revisions history.
+func (c *Helm) Uninstall(path string, keepHistory bool) error {
 	ns, n := client.Namespaced(path)
 	cfg, err := c.EnsureHelmConfig(ns)
 	if err != nil {
 		return err
 	}
 
-	res, err := action.NewUninstall(cfg).Run(n)
+	u := action.NewUninstall(cfg)
+	u.DryRun = IsDryRun()
+	u.KeepHistory = keepHistory
+	res, err := u.Run(n)
 	if err != nil {
 		return err
 	}
@@ -108,6 +122,42 @@ func (c *Helm) Delete(path string, cascade, force bool) error {
 	return nil
 }
 
+// History returns a release revisions, most recent first.
+func (c *Helm) History(path string) ([]*release.Release, error) {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	h := action.NewHistory(cfg)
+	h.Max = maxHelmHistory
+	rr, err := h.Run(n)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Version > rr[j].Version
+	})
+
+	return rr, nil
+}
+
+// Rollback rolls a release back to a given revision.
+func (c *Helm) Rollback(path string, rev int) error {
+	ns, n := client.Namespaced(path)
+	cfg, err := c.EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+
+	r := action.NewRollback(cfg)
+	r.Version = rev
+	r.DryRun = IsDryRun()
+
+	return r.Run(n)
+}
+
 // EnsureHelmConfig return a new configuration.
 func (c *Helm) EnsureHelmConfig(ns string) (*action.Configuration, error) {
 	cfg := new(action.Configuration)
@@ -121,3 +171,40 @@ func (c *Helm) EnsureHelmConfig(ns string) (*action.Configuration, error) {
 func helmLogger(s string, args ...interface{}) {
 	log.Debug().Msgf("%s %v", s, args)
 }
+
+// ReleaseValues returns the user supplied values of a release revision as YAML.
+func ReleaseValues(r *release.Release) (string, error) {
+	if len(r.Config) == 0 {
+		return "", nil
+	}
+	raw, err := yaml.Marshal(r.Config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// FindRevision returns a given revision from a release history.
+func FindRevision(rr []*release.Release, rev int) (*release.Release, bool) {
+	for _, r := range rr {
+		if r.Version == rev {
+			return r, true
+		}
+	}
+
+	return nil, false
+}
+
+// PreviousRelease returns the revision preceding a given one in a release
+// history.
+func PreviousRelease(rr []*release.Release, rev int) (*release.Release, bool) {
+	var prev *release.Release
+	for _, r := range rr {
+		if r.Version < rev && (prev == nil || r.Version > prev.Version) {
+			prev = r
+		}
+	}
+
+	return prev, prev != nil
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*HelmHistory)(nil)
+
+// HelmHistory represents a helm release revisions dao.
+type HelmHistory struct {
+	NonResource
+}
+
+// List returns a collection of release revisions.
+func (h *HelmHistory) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", h.gvr)
+	}
+
+	var helm Helm
+	helm.Init(h.Factory, client.NewGVR("helm"))
+	rr, err := helm.History(path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, render.HelmRes{Release: r})
+	}
+
+	return oo, nil
+}
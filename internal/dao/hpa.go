@@ -5,9 +5,13 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var (
@@ -52,3 +56,54 @@ func (h *HorizontalPodAutoscaler) list(gvr, ns string, sel labels.Selector) ([]r
 	}
 	return oo, nil
 }
+
+// ScalerFor returns the HPA managing a given workload or nil if none.
+func (h *HorizontalPodAutoscaler) ScalerFor(ctx context.Context, kind, path string) (*autoscalingv1.HorizontalPodAutoscaler, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.AutoscalingV1().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range ll.Items {
+		ref := ll.Items[i].Spec.ScaleTargetRef
+		if ref.Kind == kind && ref.Name == n {
+			return &ll.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetBounds updates an HPA min and max replicas.
+func (h *HorizontalPodAutoscaler) SetBounds(ctx context.Context, path string, min, max int32) error {
+	if min < 1 || max < min {
+		return fmt.Errorf("invalid HPA bounds min=%d max=%d", min, max)
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := h.Client().CanI(ns, "autoscaling/v1/horizontalpodautoscalers", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update HPA %s", path)
+	}
+
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"spec":{"minReplicas":%d,"maxReplicas":%d}}`, min, max)
+	_, err = dial.AutoscalingV1().HorizontalPodAutoscalers(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+
+	return err
+}
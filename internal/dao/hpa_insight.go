@@ -0,0 +1,429 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// FrozenBoundsKey tracks an autoscaler bounds while its autoscaling is frozen.
+	FrozenBoundsKey = "k9scli.io/frozen-bounds"
+
+	maxHPAEvents = 5
+	unknownValue = "<unknown>"
+)
+
+var vpaGVR = schema.GroupVersionResource{
+	Group:    "autoscaling.k8s.io",
+	Version:  "v1",
+	Resource: "verticalpodautoscalers",
+}
+
+// HPAMetric represents an autoscaler metric current value against its target.
+type HPAMetric struct {
+	Type, Name      string
+	Current, Target string
+
+	// Over indicates the current value exceeds the target.
+	Over bool
+}
+
+// VPARecommendation represents a vertical autoscaler container recommendation.
+type VPARecommendation struct {
+	VPA, Mode, Container string
+	Target, Lower, Upper string
+}
+
+// HPAInsight represents an autoscaler live state.
+type HPAInsight struct {
+	Target           string
+	Min, Max         int32
+	Current, Desired int32
+	LastScale        time.Time
+	Frozen           bool
+	Metrics          []HPAMetric
+	Conditions       []string
+	Events           []string
+	VPAs             []VPARecommendation
+}
+
+// Insight returns an autoscaler metrics, scale events and matching vertical
+// autoscalers recommendations.
+func (h *HorizontalPodAutoscaler) Insight(ctx context.Context, path string) (*HPAInsight, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	hpa, err := dial.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Falling back to autoscaling/v1 for HPA %s", path)
+		hpav1, err := dial.AutoscalingV1().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		hpa = v1AsV2beta2(hpav1)
+	}
+	i := newHPAInsight(hpa)
+
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=HorizontalPodAutoscaler,involvedObject.name=" + n,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("HPA events for %s", path)
+	} else {
+		i.Events = hpaEvents(ee.Items, maxHPAEvents)
+	}
+
+	dyn, err := h.Client().DynDial()
+	if err != nil {
+		return i, nil
+	}
+	ll, err := dyn.Resource(vpaGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Debug().Err(err).Msgf("No VPA available in namespace %q", ns)
+		return i, nil
+	}
+	ref := hpa.Spec.ScaleTargetRef
+	i.VPAs = vpaRecommendations(ll.Items, ref.Kind, ref.Name)
+
+	return i, nil
+}
+
+// ToggleFreeze pins an autoscaler min and max replicas to its current replicas,
+// or restores its original bounds if already frozen. Returns the frozen state.
+func (h *HorizontalPodAutoscaler) ToggleFreeze(ctx context.Context, path string) (bool, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := h.Client().CanI(ns, "autoscaling/v1/horizontalpodautoscalers", []string{client.GetVerb, client.PatchVerb})
+	if err != nil {
+		return false, err
+	}
+	if !auth {
+		return false, fmt.Errorf("user is not authorized to update HPA %s", path)
+	}
+
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return false, err
+	}
+	hpa, err := dial.AutoscalingV1().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	patch, frozen, err := freezePatch(hpa)
+	if err != nil {
+		return false, err
+	}
+	_, err = dial.AutoscalingV1().HorizontalPodAutoscalers(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		patch,
+		metav1.PatchOptions{DryRun: dryRunOpts()},
+	)
+
+	return frozen, err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// freezePatch returns a patch freezing or thawing an autoscaler.
+func freezePatch(hpa *autoscalingv1.HorizontalPodAutoscaler) ([]byte, bool, error) {
+	min := int32(1)
+	if hpa.Spec.MinReplicas != nil {
+		min = *hpa.Spec.MinReplicas
+	}
+
+	if bounds, ok := hpa.Annotations[FrozenBoundsKey]; ok {
+		tokens := strings.Split(bounds, "/")
+		if len(tokens) != 2 {
+			return nil, false, fmt.Errorf("invalid frozen bounds %q", bounds)
+		}
+		lo, err := strconv.Atoi(tokens[0])
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid frozen min %q", tokens[0])
+		}
+		hi, err := strconv.Atoi(tokens[1])
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid frozen max %q", tokens[1])
+		}
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}},"spec":{"minReplicas":%d,"maxReplicas":%d}}`, FrozenBoundsKey, lo, hi)
+		return []byte(patch), false, nil
+	}
+
+	pin := hpa.Status.CurrentReplicas
+	if pin < min {
+		pin = min
+	}
+	if pin > hpa.Spec.MaxReplicas {
+		pin = hpa.Spec.MaxReplicas
+	}
+	patch := fmt.Sprintf(
+		`{"metadata":{"annotations":{%q:"%d/%d"}},"spec":{"minReplicas":%d,"maxReplicas":%d}}`,
+		FrozenBoundsKey, min, hpa.Spec.MaxReplicas, pin, pin,
+	)
+
+	return []byte(patch), true, nil
+}
+
+func newHPAInsight(hpa *autoscalingv2beta2.HorizontalPodAutoscaler) *HPAInsight {
+	i := HPAInsight{
+		Target:  hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+		Min:     1,
+		Max:     hpa.Spec.MaxReplicas,
+		Current: hpa.Status.CurrentReplicas,
+		Desired: hpa.Status.DesiredReplicas,
+		Metrics: hpaMetrics(hpa.Spec.Metrics, hpa.Status.CurrentMetrics),
+	}
+	if hpa.Spec.MinReplicas != nil {
+		i.Min = *hpa.Spec.MinReplicas
+	}
+	if hpa.Status.LastScaleTime != nil {
+		i.LastScale = hpa.Status.LastScaleTime.Time
+	}
+	_, i.Frozen = hpa.Annotations[FrozenBoundsKey]
+	for _, c := range hpa.Status.Conditions {
+		i.Conditions = append(i.Conditions, fmt.Sprintf("%s=%s %s", c.Type, c.Status, c.Message))
+	}
+
+	return &i
+}
+
+// v1AsV2beta2 converts a v1 autoscaler, tracking cpu only, to its v2beta2 counterpart.
+func v1AsV2beta2(hpa *autoscalingv1.HorizontalPodAutoscaler) *autoscalingv2beta2.HorizontalPodAutoscaler {
+	h := autoscalingv2beta2.HorizontalPodAutoscaler{
+		ObjectMeta: hpa.ObjectMeta,
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+				Kind:       hpa.Spec.ScaleTargetRef.Kind,
+				Name:       hpa.Spec.ScaleTargetRef.Name,
+				APIVersion: hpa.Spec.ScaleTargetRef.APIVersion,
+			},
+			MinReplicas: hpa.Spec.MinReplicas,
+			MaxReplicas: hpa.Spec.MaxReplicas,
+		},
+		Status: autoscalingv2beta2.HorizontalPodAutoscalerStatus{
+			LastScaleTime:   hpa.Status.LastScaleTime,
+			CurrentReplicas: hpa.Status.CurrentReplicas,
+			DesiredReplicas: hpa.Status.DesiredReplicas,
+		},
+	}
+	if hpa.Spec.TargetCPUUtilizationPercentage != nil {
+		h.Spec.Metrics = []autoscalingv2beta2.MetricSpec{
+			{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricSource{
+					Name: v1.ResourceCPU,
+					Target: autoscalingv2beta2.MetricTarget{
+						Type:               autoscalingv2beta2.UtilizationMetricType,
+						AverageUtilization: hpa.Spec.TargetCPUUtilizationPercentage,
+					},
+				},
+			},
+		}
+	}
+	if hpa.Status.CurrentCPUUtilizationPercentage != nil {
+		h.Status.CurrentMetrics = []autoscalingv2beta2.MetricStatus{
+			{
+				Type: autoscalingv2beta2.ResourceMetricSourceType,
+				Resource: &autoscalingv2beta2.ResourceMetricStatus{
+					Name:    v1.ResourceCPU,
+					Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: hpa.Status.CurrentCPUUtilizationPercentage},
+				},
+			},
+		}
+	}
+
+	return &h
+}
+
+// hpaMetrics matches autoscaler metrics specs against their current status.
+func hpaMetrics(specs []autoscalingv2beta2.MetricSpec, statuses []autoscalingv2beta2.MetricStatus) []HPAMetric {
+	current := make(map[string]autoscalingv2beta2.MetricValueStatus, len(statuses))
+	for _, s := range statuses {
+		if k, c, ok := statusValue(s); ok {
+			current[k] = c
+		}
+	}
+
+	mm := make([]HPAMetric, 0, len(specs))
+	for _, s := range specs {
+		name, target, ok := specTarget(s)
+		if !ok {
+			mm = append(mm, HPAMetric{Type: string(s.Type), Name: unknownValue, Current: unknownValue, Target: unknownValue})
+			continue
+		}
+		m := HPAMetric{Type: string(s.Type), Name: name, Target: targetString(target), Current: unknownValue}
+		if c, ok := current[string(s.Type)+":"+name]; ok {
+			m.Current, m.Over = currentString(c, target)
+		}
+		mm = append(mm, m)
+	}
+
+	return mm
+}
+
+func specTarget(s autoscalingv2beta2.MetricSpec) (string, autoscalingv2beta2.MetricTarget, bool) {
+	switch {
+	case s.Type == autoscalingv2beta2.ResourceMetricSourceType && s.Resource != nil:
+		return string(s.Resource.Name), s.Resource.Target, true
+	case s.Type == autoscalingv2beta2.PodsMetricSourceType && s.Pods != nil:
+		return s.Pods.Metric.Name, s.Pods.Target, true
+	case s.Type == autoscalingv2beta2.ObjectMetricSourceType && s.Object != nil:
+		return objectMetricName(s.Object.DescribedObject, s.Object.Metric.Name), s.Object.Target, true
+	case s.Type == autoscalingv2beta2.ExternalMetricSourceType && s.External != nil:
+		return s.External.Metric.Name, s.External.Target, true
+	default:
+		return "", autoscalingv2beta2.MetricTarget{}, false
+	}
+}
+
+func statusValue(s autoscalingv2beta2.MetricStatus) (string, autoscalingv2beta2.MetricValueStatus, bool) {
+	var name string
+	var c autoscalingv2beta2.MetricValueStatus
+	switch {
+	case s.Type == autoscalingv2beta2.ResourceMetricSourceType && s.Resource != nil:
+		name, c = string(s.Resource.Name), s.Resource.Current
+	case s.Type == autoscalingv2beta2.PodsMetricSourceType && s.Pods != nil:
+		name, c = s.Pods.Metric.Name, s.Pods.Current
+	case s.Type == autoscalingv2beta2.ObjectMetricSourceType && s.Object != nil:
+		name, c = objectMetricName(s.Object.DescribedObject, s.Object.Metric.Name), s.Object.Current
+	case s.Type == autoscalingv2beta2.ExternalMetricSourceType && s.External != nil:
+		name, c = s.External.Metric.Name, s.External.Current
+	default:
+		return "", c, false
+	}
+
+	return string(s.Type) + ":" + name, c, true
+}
+
+func objectMetricName(ref autoscalingv2beta2.CrossVersionObjectReference, metric string) string {
+	return fmt.Sprintf("%s/%s %s", strings.ToLower(ref.Kind), ref.Name, metric)
+}
+
+func targetString(t autoscalingv2beta2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *t.AverageUtilization)
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	default:
+		return unknownValue
+	}
+}
+
+// currentString returns a metric current value in its target unit and
+// whether it exceeds the target.
+func currentString(c autoscalingv2beta2.MetricValueStatus, t autoscalingv2beta2.MetricTarget) (string, bool) {
+	switch {
+	case t.AverageUtilization != nil:
+		if c.AverageUtilization == nil {
+			return unknownValue, false
+		}
+		return fmt.Sprintf("%d%%", *c.AverageUtilization), *c.AverageUtilization > *t.AverageUtilization
+	case t.AverageValue != nil:
+		if c.AverageValue == nil {
+			return unknownValue, false
+		}
+		return c.AverageValue.String(), c.AverageValue.Cmp(*t.AverageValue) > 0
+	case t.Value != nil:
+		if c.Value == nil {
+			return unknownValue, false
+		}
+		return c.Value.String(), c.Value.Cmp(*t.Value) > 0
+	default:
+		return unknownValue, false
+	}
+}
+
+// hpaEvents returns the most recent autoscaler events.
+func hpaEvents(ee []v1.Event, max int) []string {
+	sort.Slice(ee, func(i, j int) bool {
+		return eventTime(&ee[i]).After(eventTime(&ee[j]))
+	})
+	if len(ee) > max {
+		ee = ee[:max]
+	}
+
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		s := fmt.Sprintf("%s %s %s", eventTime(&e).Format("15:04:05"), e.Reason, e.Message)
+		if e.Count > 1 {
+			s += fmt.Sprintf(" (x%d)", e.Count)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss
+}
+
+// vpaRecommendations returns the recommendations of the vertical autoscalers
+// targeting a given workload.
+func vpaRecommendations(uu []unstructured.Unstructured, kind, name string) []VPARecommendation {
+	var rr []VPARecommendation
+	for _, u := range uu {
+		k, _, _ := unstructured.NestedString(u.Object, "spec", "targetRef", "kind")
+		n, _, _ := unstructured.NestedString(u.Object, "spec", "targetRef", "name")
+		if k != kind || n != name {
+			continue
+		}
+		mode, _, _ := unstructured.NestedString(u.Object, "spec", "updatePolicy", "updateMode")
+		if mode == "" {
+			mode = "Auto"
+		}
+		cc, _, _ := unstructured.NestedSlice(u.Object, "status", "recommendation", "containerRecommendations")
+		for _, c := range cc {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			co, _, _ := unstructured.NestedString(m, "containerName")
+			rr = append(rr, VPARecommendation{
+				VPA:       u.GetName(),
+				Mode:      mode,
+				Container: co,
+				Target:    resourcesString(m, "target"),
+				Lower:     resourcesString(m, "lowerBound"),
+				Upper:     resourcesString(m, "upperBound"),
+			})
+		}
+	}
+
+	return rr
+}
+
+func resourcesString(m map[string]interface{}, key string) string {
+	rr, _, _ := unstructured.NestedStringMap(m, key)
+	if len(rr) == 0 {
+		return "n/a"
+	}
+	kk := make([]string, 0, len(rr))
+	for k := range rr {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, k+"="+rr[k])
+	}
+
+	return strings.Join(ss, ",")
+}
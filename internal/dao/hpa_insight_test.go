@@ -0,0 +1,185 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHPAMetrics(t *testing.T) {
+	util, cur := int32(50), int32(75)
+	avg, avgCur := resource.MustParse("100"), resource.MustParse("20")
+	val := resource.MustParse("10")
+
+	specs := []autoscalingv2beta2.MetricSpec{
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name:   v1.ResourceCPU,
+				Target: autoscalingv2beta2.MetricTarget{AverageUtilization: &util},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.PodsMetricSourceType,
+			Pods: &autoscalingv2beta2.PodsMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: "rps"},
+				Target: autoscalingv2beta2.MetricTarget{AverageValue: &avg},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ExternalMetricSourceType,
+			External: &autoscalingv2beta2.ExternalMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{Name: "queue"},
+				Target: autoscalingv2beta2.MetricTarget{Value: &val},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ObjectMetricSourceType,
+		},
+	}
+	statuses := []autoscalingv2beta2.MetricStatus{
+		{
+			Type: autoscalingv2beta2.PodsMetricSourceType,
+			Pods: &autoscalingv2beta2.PodsMetricStatus{
+				Metric:  autoscalingv2beta2.MetricIdentifier{Name: "rps"},
+				Current: autoscalingv2beta2.MetricValueStatus{AverageValue: &avgCur},
+			},
+		},
+		{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricStatus{
+				Name:    v1.ResourceCPU,
+				Current: autoscalingv2beta2.MetricValueStatus{AverageUtilization: &cur},
+			},
+		},
+	}
+
+	assert.Equal(t, []HPAMetric{
+		{Type: "Resource", Name: "cpu", Current: "75%", Target: "50%", Over: true},
+		{Type: "Pods", Name: "rps", Current: "20", Target: "100 (avg)"},
+		{Type: "External", Name: "queue", Current: unknownValue, Target: "10"},
+		{Type: "Object", Name: unknownValue, Current: unknownValue, Target: unknownValue},
+	}, hpaMetrics(specs, statuses))
+}
+
+func TestFreezePatch(t *testing.T) {
+	min := int32(2)
+	uu := map[string]struct {
+		hpa    autoscalingv1.HorizontalPodAutoscaler
+		e      string
+		frozen bool
+		err    bool
+	}{
+		"freeze": {
+			hpa:    makeFreezeHPA(&min, 10, 4, nil),
+			e:      `{"metadata":{"annotations":{"k9scli.io/frozen-bounds":"2/10"}},"spec":{"minReplicas":4,"maxReplicas":4}}`,
+			frozen: true,
+		},
+		"freezeNoReplicas": {
+			hpa:    makeFreezeHPA(nil, 10, 0, nil),
+			e:      `{"metadata":{"annotations":{"k9scli.io/frozen-bounds":"1/10"}},"spec":{"minReplicas":1,"maxReplicas":1}}`,
+			frozen: true,
+		},
+		"thaw": {
+			hpa: makeFreezeHPA(&min, 4, 4, map[string]string{FrozenBoundsKey: "2/10"}),
+			e:   `{"metadata":{"annotations":{"k9scli.io/frozen-bounds":null}},"spec":{"minReplicas":2,"maxReplicas":10}}`,
+		},
+		"toast": {
+			hpa: makeFreezeHPA(&min, 4, 4, map[string]string{FrozenBoundsKey: "fred"}),
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			patch, frozen, err := freezePatch(&u.hpa)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(patch))
+			assert.Equal(t, u.frozen, frozen)
+		})
+	}
+}
+
+func TestHPAEvents(t *testing.T) {
+	now := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	ee := []v1.Event{
+		{Reason: "SuccessfulRescale", Message: "New size: 2", LastTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+		{Reason: "SuccessfulRescale", Message: "New size: 4", LastTimestamp: metav1.NewTime(now)},
+		{Reason: "FailedGetResourceMetric", Message: "boom", Count: 3, LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+	}
+
+	assert.Equal(t, []string{
+		"10:00:00 SuccessfulRescale New size: 4",
+		"09:59:00 FailedGetResourceMetric boom (x3)",
+	}, hpaEvents(ee, 2))
+}
+
+func TestVPARecommendations(t *testing.T) {
+	uu := []unstructured.Unstructured{
+		makeVPA("fred", "Deployment", "fred", "Off"),
+		makeVPA("blee", "Deployment", "blee", ""),
+	}
+
+	assert.Equal(t, []VPARecommendation{
+		{
+			VPA:       "fred",
+			Mode:      "Off",
+			Container: "c1",
+			Target:    "cpu=250m,memory=256Mi",
+			Lower:     "cpu=100m,memory=128Mi",
+			Upper:     "n/a",
+		},
+	}, vpaRecommendations(uu, "Deployment", "fred"))
+	assert.Equal(t, "Auto", vpaRecommendations(uu, "Deployment", "blee")[0].Mode)
+	assert.Nil(t, vpaRecommendations(uu, "StatefulSet", "fred"))
+}
+
+// Helpers...
+
+func makeFreezeHPA(min *int32, max, current int32, aa map[string]string) autoscalingv1.HorizontalPodAutoscaler {
+	return autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "default", Annotations: aa},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			MinReplicas: min,
+			MaxReplicas: max,
+		},
+		Status: autoscalingv1.HorizontalPodAutoscalerStatus{CurrentReplicas: current},
+	}
+}
+
+func makeVPA(n, kind, target, mode string) unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"targetRef": map[string]interface{}{"kind": kind, "name": target},
+	}
+	if mode != "" {
+		spec["updatePolicy"] = map[string]interface{}{"updateMode": mode}
+	}
+
+	return unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": n},
+		"spec":     spec,
+		"status": map[string]interface{}{
+			"recommendation": map[string]interface{}{
+				"containerRecommendations": []interface{}{
+					map[string]interface{}{
+						"containerName": "c1",
+						"target":        map[string]interface{}{"cpu": "250m", "memory": "256Mi"},
+						"lowerBound":    map[string]interface{}{"cpu": "100m", "memory": "128Mi"},
+					},
+				},
+			},
+		},
+	}}
+}
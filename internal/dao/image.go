@@ -0,0 +1,533 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	dockerHub          = "docker.io"
+	dockerHubAPI       = "registry-1.docker.io"
+	defaultImageTag    = "latest"
+	defaultPlatform    = "linux/amd64"
+	registryTimeout    = 10 * time.Second
+	maxManifestSize    = 4 << 20
+	dockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	ociManifest        = "application/vnd.oci.image.manifest.v1+json"
+	ociIndex           = "application/vnd.oci.image.index.v1+json"
+)
+
+// ImageRef represents a parsed container image reference.
+type ImageRef struct {
+	Registry, Repository, Tag, Digest string
+}
+
+// ParseImageRef parses an image reference ie nginx:1.19 or
+// quay.io/fred/blee@sha256:abc. Images without a registry resolve to
+// docker hub.
+func ParseImageRef(s string) (ImageRef, error) {
+	var ref ImageRef
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ref, errors.New("blank image reference")
+	}
+	if i := strings.Index(s, "@"); i >= 0 {
+		s, ref.Digest = s[:i], s[i+1:]
+		if !strings.Contains(ref.Digest, ":") {
+			return ref, fmt.Errorf("invalid image digest %q", ref.Digest)
+		}
+	}
+	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
+		s, ref.Tag = s[:i], s[i+1:]
+		if ref.Tag == "" {
+			return ref, fmt.Errorf("invalid image tag for %q", s)
+		}
+	}
+	if i := strings.Index(s, "/"); i >= 0 && isRegistryHost(s[:i]) {
+		ref.Registry, ref.Repository = normalizeRegistry(s[:i]), s[i+1:]
+	} else {
+		ref.Registry, ref.Repository = dockerHub, s
+	}
+	if ref.Repository == "" {
+		return ref, fmt.Errorf("invalid image reference %q", s)
+	}
+	if ref.Registry == dockerHub && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultImageTag
+	}
+
+	return ref, nil
+}
+
+// String returns the fully qualified reference.
+func (r ImageRef) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+
+	return s
+}
+
+func (r ImageRef) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+
+	return r.Tag
+}
+
+func (r ImageRef) host() string {
+	if r.Registry == dockerHub {
+		return dockerHubAPI
+	}
+
+	return r.Registry
+}
+
+// ImageLayer represents an image filesystem layer.
+type ImageLayer struct {
+	Digest    string
+	Size      int64
+	CreatedBy string
+}
+
+// ImageInfo represents a container image inspection.
+type ImageInfo struct {
+	// Image is the container spec image.
+	Image string
+	Ref   ImageRef
+	// Digest is the manifest digest the registry resolves the reference to.
+	Digest string
+	// Running is the digest the kubelet reports for the running container.
+	Running    string
+	Platform   string
+	Created    time.Time
+	Labels     map[string]string
+	Entrypoint []string
+	Cmd        []string
+	WorkingDir string
+	User       string
+	Ports      []string
+	Layers     []ImageLayer
+	Vulns      *render.VulnSummary
+}
+
+// Size returns the image compressed size.
+func (i *ImageInfo) Size() int64 {
+	var s int64
+	for _, l := range i.Layers {
+		s += l.Size
+	}
+
+	return s
+}
+
+// Drifted checks if the running digest no longer matches the registry.
+func (i *ImageInfo) Drifted() bool {
+	return i.Running != "" && i.Digest != "" && i.Running != i.Digest
+}
+
+// Registry inspects images using the registry v2 api.
+type Registry struct {
+	client *http.Client
+	auths  map[string]RegistryAuth
+	tokens map[string]string
+	mx     sync.Mutex
+}
+
+type (
+	regDescriptor struct {
+		MediaType string `json:"mediaType"`
+		Digest    string `json:"digest"`
+		Size      int64  `json:"size"`
+	}
+
+	regPlatform struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+		Variant      string `json:"variant,omitempty"`
+	}
+
+	regManifestDescriptor struct {
+		regDescriptor
+		Platform regPlatform `json:"platform"`
+	}
+
+	regManifest struct {
+		MediaType string                  `json:"mediaType"`
+		Config    regDescriptor           `json:"config"`
+		Layers    []regDescriptor         `json:"layers"`
+		Manifests []regManifestDescriptor `json:"manifests"`
+	}
+
+	regImageConfig struct {
+		Architecture string    `json:"architecture"`
+		OS           string    `json:"os"`
+		Created      time.Time `json:"created"`
+		Config       struct {
+			Labels       map[string]string   `json:"Labels"`
+			Entrypoint   []string            `json:"Entrypoint"`
+			Cmd          []string            `json:"Cmd"`
+			WorkingDir   string              `json:"WorkingDir"`
+			User         string              `json:"User"`
+			ExposedPorts map[string]struct{} `json:"ExposedPorts"`
+		} `json:"config"`
+		History []struct {
+			CreatedBy  string `json:"created_by"`
+			EmptyLayer bool   `json:"empty_layer"`
+		} `json:"history"`
+	}
+)
+
+// NewRegistry returns a new registry client using the given per registry
+// host credentials.
+func NewRegistry(auths map[string]RegistryAuth) *Registry {
+	return &Registry{
+		client: &http.Client{Timeout: registryTimeout},
+		auths:  auths,
+		tokens: make(map[string]string),
+	}
+}
+
+// Inspect resolves an image digest, configuration and layers. Multi-arch
+// images resolve to the manifest matching the given os/arch platform.
+func (r *Registry) Inspect(ctx context.Context, ref ImageRef, platform string) (*ImageInfo, error) {
+	m, digest, err := r.manifest(ctx, ref, ref.reference())
+	if err != nil {
+		return nil, err
+	}
+	info := ImageInfo{Ref: ref, Digest: digest}
+	if m.isIndex() {
+		d, ok := m.pick(platform)
+		if !ok {
+			return nil, fmt.Errorf("no %s manifest found for %s", platform, ref)
+		}
+		if m, _, err = r.manifest(ctx, ref, d); err != nil {
+			return nil, err
+		}
+	}
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("unsupported manifest type %q for %s", m.MediaType, ref)
+	}
+
+	raw, _, err := r.fetch(ctx, ref, "/blobs/"+m.Config.Digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	var cfg regImageConfig
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid image config for %s: %w", ref, err)
+	}
+	info.Platform = cfg.OS + "/" + cfg.Architecture
+	info.Created = cfg.Created
+	info.Labels = cfg.Config.Labels
+	info.Entrypoint, info.Cmd = cfg.Config.Entrypoint, cfg.Config.Cmd
+	info.WorkingDir, info.User = cfg.Config.WorkingDir, cfg.Config.User
+	for p := range cfg.Config.ExposedPorts {
+		info.Ports = append(info.Ports, p)
+	}
+	sort.Strings(info.Ports)
+	info.Layers = make([]ImageLayer, 0, len(m.Layers))
+	for _, l := range m.Layers {
+		info.Layers = append(info.Layers, ImageLayer{Digest: l.Digest, Size: l.Size})
+	}
+	var idx int
+	for _, h := range cfg.History {
+		if h.EmptyLayer {
+			continue
+		}
+		if idx < len(info.Layers) {
+			info.Layers[idx].CreatedBy = h.CreatedBy
+		}
+		idx++
+	}
+
+	return &info, nil
+}
+
+func (r *Registry) manifest(ctx context.Context, ref ImageRef, reference string) (*regManifest, string, error) {
+	raw, h, err := r.fetch(ctx, ref, "/manifests/"+reference, []string{dockerManifestList, ociIndex, dockerManifest, ociManifest})
+	if err != nil {
+		return nil, "", err
+	}
+	var m regManifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, "", fmt.Errorf("invalid manifest for %s: %w", ref, err)
+	}
+	if m.MediaType == "" {
+		m.MediaType = strings.TrimSpace(strings.Split(h.Get("Content-Type"), ";")[0])
+	}
+	digest := h.Get("Docker-Content-Digest")
+	if digest == "" {
+		digest = fmt.Sprintf("sha256:%x", sha256.Sum256(raw))
+	}
+
+	return &m, digest, nil
+}
+
+// fetch issues an authorized registry request, negotiating a token on
+// the first unauthorized response.
+func (r *Registry) fetch(ctx context.Context, ref ImageRef, path string, accept []string) ([]byte, http.Header, error) {
+	u := "https://" + ref.host() + "/v2/" + ref.Repository + path
+	key := ref.Registry + "/" + ref.Repository
+	r.mx.Lock()
+	auth := r.tokens[key]
+	r.mx.Unlock()
+
+	resp, err := r.do(ctx, u, accept, auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if auth, err = r.authorize(ctx, ref, challenge); err != nil {
+			return nil, nil, err
+		}
+		r.mx.Lock()
+		r.tokens[key] = auth
+		r.mx.Unlock()
+		if resp, err = r.do(ctx, u, accept, auth); err != nil {
+			return nil, nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	raw, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("registry %s returned %s for %s", ref.Registry, resp.Status, ref)
+	}
+
+	return raw, resp.Header, nil
+}
+
+func (r *Registry) do(ctx context.Context, u string, accept []string, auth string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+
+	return r.client.Do(req)
+}
+
+// authorize returns an authorization header value for a registry challenge.
+func (r *Registry) authorize(ctx context.Context, ref ImageRef, challenge string) (string, error) {
+	creds, hasCreds := r.auths[ref.Registry]
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if !hasCreds {
+			return "", fmt.Errorf("registry %s requires credentials", ref.Registry)
+		}
+		return "Basic " + basicAuth(creds), nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("unsupported registry %s auth challenge %q", ref.Registry, challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("invalid registry %s auth realm %q", ref.Registry, params["realm"])
+	}
+	q := realm.Query()
+	if s := params["service"]; s != "" {
+		q.Set("service", s)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + ref.Repository + ":pull"
+	}
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if hasCreds {
+		req.Header.Set("Authorization", "Basic "+basicAuth(creds))
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("registry %s token request returned %s", ref.Registry, resp.Status)
+	}
+	var tok struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", fmt.Errorf("invalid registry %s token: %w", ref.Registry, err)
+	}
+	if tok.Token == "" {
+		tok.Token = tok.AccessToken
+	}
+	if tok.Token == "" {
+		return "", fmt.Errorf("registry %s issued a blank token", ref.Registry)
+	}
+
+	return "Bearer " + tok.Token, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (m *regManifest) isIndex() bool {
+	return m.MediaType == dockerManifestList || m.MediaType == ociIndex || len(m.Manifests) > 0
+}
+
+// pick returns the manifest digest matching an os/arch platform.
+func (m *regManifest) pick(platform string) (string, bool) {
+	tokens := strings.SplitN(platform, "/", 2)
+	if len(tokens) != 2 {
+		tokens = strings.SplitN(defaultPlatform, "/", 2)
+	}
+	for _, d := range m.Manifests {
+		if d.Platform.OS == tokens[0] && d.Platform.Architecture == tokens[1] {
+			return d.Digest, true
+		}
+	}
+
+	return "", false
+}
+
+// isRegistryHost checks if an image path component designates a registry.
+func isRegistryHost(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
+
+func normalizeRegistry(s string) string {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "https://"), "http://")
+	if i := strings.Index(s, "/"); i >= 0 {
+		s = s[:i]
+	}
+	switch s {
+	case "index.docker.io", dockerHubAPI:
+		return dockerHub
+	}
+
+	return s
+}
+
+func basicAuth(a RegistryAuth) string {
+	return base64.StdEncoding.EncodeToString([]byte(a.Username + ":" + a.Password))
+}
+
+// parseChallenge parses a WWW-Authenticate header ie
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io".
+func parseChallenge(s string) (string, map[string]string) {
+	s = strings.TrimSpace(s)
+	params := make(map[string]string)
+	i := strings.Index(s, " ")
+	if i < 0 {
+		return strings.ToLower(s), params
+	}
+	scheme, rest := strings.ToLower(s[:i]), s[i+1:]
+	for rest != "" {
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		k := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var v string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				v, rest = rest[1:], ""
+			} else {
+				v, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if c := strings.Index(rest, ","); c >= 0 {
+			v, rest = rest[:c], rest[c:]
+		} else {
+			v, rest = rest, ""
+		}
+		params[k] = strings.TrimSpace(v)
+		rest = strings.TrimLeft(rest, ", ")
+	}
+
+	return scheme, params
+}
+
+// ParseDockerConfig extracts registries credentials from an image pull
+// secret payload. Both dockerconfigjson and legacy dockercfg are supported.
+func ParseDockerConfig(raw []byte) (map[string]RegistryAuth, error) {
+	type entry struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+	var cfg struct {
+		Auths map[string]entry `json:"auths"`
+	}
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Auths == nil {
+		if err := json.Unmarshal(raw, &cfg.Auths); err != nil {
+			return nil, err
+		}
+	}
+
+	aa := make(map[string]RegistryAuth, len(cfg.Auths))
+	for host, e := range cfg.Auths {
+		a := RegistryAuth{Username: e.Username, Password: e.Password}
+		if a.Username == "" && e.Auth != "" {
+			dec, err := base64.StdEncoding.DecodeString(e.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth for registry %s", host)
+			}
+			tokens := strings.SplitN(string(dec), ":", 2)
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("invalid auth for registry %s", host)
+			}
+			a.Username, a.Password = tokens[0], tokens[1]
+		}
+		aa[normalizeRegistry(host)] = a
+	}
+
+	return aa, nil
+}
+
+// runningDigest extracts the manifest digest from a container status image id.
+func runningDigest(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i >= 0 {
+		return imageID[i+1:]
+	}
+
+	return ""
+}
@@ -0,0 +1,202 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageRef(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   ImageRef
+		err string
+	}{
+		"short": {
+			s: "nginx",
+			e: ImageRef{Registry: "docker.io", Repository: "library/nginx", Tag: "latest"},
+		},
+		"tag": {
+			s: "fred/blee:1.0",
+			e: ImageRef{Registry: "docker.io", Repository: "fred/blee", Tag: "1.0"},
+		},
+		"registry": {
+			s: "quay.io/fred/blee:v1",
+			e: ImageRef{Registry: "quay.io", Repository: "fred/blee", Tag: "v1"},
+		},
+		"port": {
+			s: "localhost:5000/blee",
+			e: ImageRef{Registry: "localhost:5000", Repository: "blee", Tag: "latest"},
+		},
+		"digest": {
+			s: "gcr.io/fred/blee@sha256:abc",
+			e: ImageRef{Registry: "gcr.io", Repository: "fred/blee", Digest: "sha256:abc"},
+		},
+		"tagDigest": {
+			s: "index.docker.io/nginx:1.19@sha256:abc",
+			e: ImageRef{Registry: "docker.io", Repository: "library/nginx", Tag: "1.19", Digest: "sha256:abc"},
+		},
+		"blank": {
+			err: "blank image reference",
+		},
+		"badDigest": {
+			s:   "nginx@abc",
+			err: `invalid image digest "abc"`,
+		},
+		"badTag": {
+			s:   "nginx:",
+			err: `invalid image tag for "nginx"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ref, err := ParseImageRef(u.s)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ref)
+		})
+	}
+}
+
+func TestImageRefString(t *testing.T) {
+	ref, err := ParseImageRef("nginx:1.19@sha256:abc")
+	assert.Nil(t, err)
+
+	assert.Equal(t, "docker.io/library/nginx:1.19@sha256:abc", ref.String())
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:fred/blee:pull,push"`)
+
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:fred/blee:pull,push",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm=blee`)
+	assert.Equal(t, "basic", scheme)
+	assert.Equal(t, map[string]string{"realm": "blee"}, params)
+}
+
+func TestParseDockerConfig(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   map[string]RegistryAuth
+		err string
+	}{
+		"json": {
+			raw: `{"auths":{"https://index.docker.io/v1/":{"auth":"ZnJlZDpibGVl"},"quay.io":{"username":"u","password":"p"}}}`,
+			e: map[string]RegistryAuth{
+				"docker.io": {Username: "fred", Password: "blee"},
+				"quay.io":   {Username: "u", Password: "p"},
+			},
+		},
+		"legacy": {
+			raw: `{"gcr.io":{"username":"u","password":"p"}}`,
+			e:   map[string]RegistryAuth{"gcr.io": {Username: "u", Password: "p"}},
+		},
+		"badAuth": {
+			raw: `{"auths":{"gcr.io":{"auth":"ZnJlZA=="}}}`,
+			err: "invalid auth for registry gcr.io",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			aa, err := ParseDockerConfig([]byte(u.raw))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
+
+func TestRegistryInspect(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(fakeRegistry))
+	defer srv.Close()
+
+	ref, err := ParseImageRef(strings.TrimPrefix(srv.URL, "https://") + "/fred/blee:1.0")
+	assert.Nil(t, err)
+	r := NewRegistry(map[string]RegistryAuth{ref.Registry: {Username: "fred", Password: "blee"}})
+	r.client = srv.Client()
+
+	info, err := r.Inspect(context.Background(), ref, "linux/arm64")
+	assert.Nil(t, err)
+	assert.Equal(t, "sha256:index", info.Digest)
+	assert.Equal(t, "linux/arm64", info.Platform)
+	assert.Equal(t, map[string]string{"maintainer": "fred"}, info.Labels)
+	assert.Equal(t, []string{"/blee"}, info.Entrypoint)
+	assert.Equal(t, []string{"80/tcp", "90/tcp"}, info.Ports)
+	assert.Equal(t, []ImageLayer{
+		{Digest: "sha256:l1", Size: 100, CreatedBy: "ADD rootfs"},
+		{Digest: "sha256:l2", Size: 20, CreatedBy: "COPY blee"},
+	}, info.Layers)
+	assert.Equal(t, int64(120), info.Size())
+
+	info.Running = "sha256:index"
+	assert.False(t, info.Drifted())
+	info.Running = "sha256:old"
+	assert.True(t, info.Drifted())
+
+	_, err = r.Inspect(context.Background(), ref, "windows/amd64")
+	assert.Contains(t, err.Error(), "no windows/amd64 manifest found")
+}
+
+func TestRunningDigest(t *testing.T) {
+	assert.Equal(t, "sha256:abc", runningDigest("docker-pullable://nginx@sha256:abc"))
+	assert.Equal(t, "", runningDigest("sha256:abc"))
+}
+
+// Helpers...
+
+func fakeRegistry(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/token" {
+		if u, p, ok := r.BasicAuth(); !ok || u != "fred" || p != "blee" || r.URL.Query().Get("scope") != "repository:fred/blee:pull" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": "t1"})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer t1" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="https://`+r.Host+`/token",service="fake"`)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v2/fred/blee/manifests/1.0":
+		w.Header().Set("Content-Type", dockerManifestList)
+		w.Header().Set("Docker-Content-Digest", "sha256:index")
+		_, _ = w.Write([]byte(`{"manifests":[
+			{"digest":"sha256:amd","platform":{"os":"linux","architecture":"amd64"}},
+			{"digest":"sha256:arm","platform":{"os":"linux","architecture":"arm64"}}
+		]}`))
+	case "/v2/fred/blee/manifests/sha256:arm":
+		w.Header().Set("Content-Type", dockerManifest)
+		_, _ = w.Write([]byte(`{"mediaType":"` + dockerManifest + `","config":{"digest":"sha256:cfg"},
+			"layers":[{"digest":"sha256:l1","size":100},{"digest":"sha256:l2","size":20}]}`))
+	case "/v2/fred/blee/blobs/sha256:cfg":
+		_, _ = w.Write([]byte(`{"os":"linux","architecture":"arm64","created":"2020-10-01T10:00:00Z",
+			"config":{"Labels":{"maintainer":"fred"},"Entrypoint":["/blee"],"ExposedPorts":{"90/tcp":{},"80/tcp":{}}},
+			"history":[{"created_by":"ADD rootfs"},{"created_by":"ENV A=1","empty_layer":true},{"created_by":"COPY blee"}]}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -4,22 +4,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
 )
 
 var (
-	_ Accessor = (*Job)(nil)
-	_ Nuker    = (*Job)(nil)
-	_ Loggable = (*Job)(nil)
+	_ Accessor   = (*Job)(nil)
+	_ Nuker      = (*Job)(nil)
+	_ Loggable   = (*Job)(nil)
+	_ Rerunnable = (*Job)(nil)
 )
 
+var rerunRX = regexp.MustCompile(`-rerun-\w{3}\z`)
+
+// jobControllerLabels tracks the labels the job controller adds to jobs and
+// their pods.
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	"batch.kubernetes.io/controller-uid",
+	"batch.kubernetes.io/job-name",
+}
+
 // Job represents a K8s job resource.
 type Job struct {
 	Resource
@@ -56,6 +71,39 @@ func (j *Job) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return ll, nil
 }
 
+// Rerun creates a new job from a given job spec.
+func (j *Job) Rerun(ctx context.Context, path string) (string, error) {
+	ns, _ := client.Namespaced(path)
+	auth, err := j.Client().CanI(ns, j.GVR(), []string{client.GetVerb, client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to run jobs")
+	}
+
+	o, err := j.Factory.Get(j.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var job batchv1.Job
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
+	if err != nil {
+		return "", errors.New("expecting a job resource")
+	}
+
+	dial, err := j.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	clone := cloneJob(&job, rerunName(job.Name))
+	if _, err := dial.BatchV1().Jobs(ns).Create(ctx, clone, metav1.CreateOptions{DryRun: dryRunOpts()}); err != nil {
+		return "", err
+	}
+
+	return client.FQN(ns, clone.Name), nil
+}
+
 // TailLogs tail logs for all pods represented by this Job.
 func (j *Job) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 	o, err := j.Factory.Get(j.gvr.String(), opts.Path, true, labels.Everything())
@@ -144,3 +192,47 @@ func (j *Job) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs, error
 
 	return refs, nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rerunName returns a new job name for a re-run, dropping previous re-runs
+// suffix.
+func rerunName(n string) string {
+	n = rerunRX.ReplaceAllString(n, "")
+	if len(n) >= maxJobNameSize {
+		n = n[0:maxJobNameSize]
+	}
+
+	return n + "-rerun-" + rand.String(3)
+}
+
+// cloneJob returns a copy of a job spec. Unless the selector was set manually,
+// the controller generated selector and labels are dropped so new ones get
+// generated.
+func cloneJob(job *batchv1.Job, name string) *batchv1.Job {
+	spec := job.Spec.DeepCopy()
+	if spec.ManualSelector == nil || !*spec.ManualSelector {
+		spec.Selector = nil
+		for _, l := range jobControllerLabels {
+			delete(spec.Template.Labels, l)
+		}
+	}
+	ll := make(map[string]string, len(job.Labels))
+	for k, v := range job.Labels {
+		ll[k] = v
+	}
+	for _, l := range jobControllerLabels {
+		delete(ll, l)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       job.Namespace,
+			Labels:          ll,
+			OwnerReferences: job.OwnerReferences,
+		},
+		Spec: *spec,
+	}
+}
@@ -0,0 +1,119 @@
+package dao
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRerunName(t *testing.T) {
+	uu := map[string]struct {
+		name, prefix string
+	}{
+		"plain":  {name: "fred", prefix: "fred-rerun-"},
+		"rerun":  {name: "fred-rerun-x1z", prefix: "fred-rerun-"},
+		"manual": {name: "fred-manual-abc", prefix: "fred-manual-abc-rerun-"},
+		"long":   {name: strings.Repeat("a", 50), prefix: strings.Repeat("a", maxJobNameSize) + "-rerun-"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n := rerunName(u.name)
+			assert.True(t, strings.HasPrefix(n, u.prefix), n)
+			assert.Equal(t, len(u.prefix)+3, len(n))
+		})
+	}
+}
+
+func TestCloneJob(t *testing.T) {
+	manual := true
+	uu := map[string]struct {
+		manual   *bool
+		selector bool
+		labels   map[string]string
+	}{
+		"generated": {
+			labels: map[string]string{"app": "fred"},
+		},
+		"manual": {
+			manual:   &manual,
+			selector: true,
+			labels:   map[string]string{"app": "fred", "controller-uid": "123", "job-name": "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			job := makeJob(u.manual)
+			clone := cloneJob(job, "fred-rerun-abc")
+
+			assert.Equal(t, "fred-rerun-abc", clone.Name)
+			assert.Equal(t, "default", clone.Namespace)
+			assert.Equal(t, map[string]string{"app": "fred"}, clone.Labels)
+			assert.Equal(t, job.OwnerReferences, clone.OwnerReferences)
+			assert.Equal(t, u.selector, clone.Spec.Selector != nil)
+			assert.Equal(t, u.labels, clone.Spec.Template.Labels)
+			assert.NotNil(t, job.Spec.Selector)
+			assert.Equal(t, "123", job.Spec.Template.Labels["controller-uid"])
+		})
+	}
+}
+
+func TestJobFor(t *testing.T) {
+	cj := batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "default", UID: types.UID("123")},
+		Spec: batchv1beta1.CronJobSpec{
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "fred"},
+					Annotations: map[string]string{"blee": "duh"},
+				},
+			},
+		},
+	}
+
+	job := jobFor(&cj)
+
+	assert.True(t, strings.HasPrefix(job.Name, "fred-manual-"))
+	assert.Equal(t, "default", job.Namespace)
+	assert.Equal(t, map[string]string{"app": "fred"}, job.Labels)
+	assert.Equal(t, map[string]string{"blee": "duh", "cronjob.kubernetes.io/instantiate": "manual"}, job.Annotations)
+	assert.Equal(t, 1, len(job.OwnerReferences))
+	assert.Equal(t, "batch/v1beta1", job.OwnerReferences[0].APIVersion)
+	assert.True(t, *job.OwnerReferences[0].Controller)
+	assert.Equal(t, types.UID("123"), job.OwnerReferences[0].UID)
+}
+
+// Helpers...
+
+func makeJob(manual *bool) *batchv1.Job {
+	ll := map[string]string{"app": "fred", "controller-uid": "123", "job-name": "fred"}
+	tl := make(map[string]string, len(ll))
+	for k, v := range ll {
+		tl[k] = v
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "fred",
+			Namespace:       "default",
+			Labels:          ll,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob", Name: "fred"}},
+		},
+		Spec: batchv1.JobSpec{
+			ManualSelector: manual,
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "123"}},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: tl},
+			},
+		},
+	}
+}
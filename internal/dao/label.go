@@ -0,0 +1,170 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// LabelsField tracks resource labels.
+	LabelsField = "labels"
+
+	// AnnotationsField tracks resource annotations.
+	AnnotationsField = "annotations"
+)
+
+// MetaOp represents a label or annotation update.
+type MetaOp struct {
+	Key    string
+	Value  string
+	Remove bool
+}
+
+// String returns the update directive.
+func (o MetaOp) String() string {
+	if o.Remove {
+		return o.Key + "-"
+	}
+	return o.Key + "=" + o.Value
+}
+
+// Validate checks the update key and value for the given metadata field.
+func (o MetaOp) Validate(field string) error {
+	if errs := validation.IsQualifiedName(o.Key); len(errs) > 0 {
+		return fmt.Errorf("invalid key %q: %s", o.Key, strings.Join(errs, ", "))
+	}
+	if field == LabelsField && !o.Remove {
+		if errs := validation.IsValidLabelValue(o.Value); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %q: %s", o.Value, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+// MetaEntry represents a label or annotation shared by resources.
+type MetaEntry struct {
+	Key   string
+	Value string
+
+	// Mixed indicates the resources values differ.
+	Mixed bool
+}
+
+// SharedMeta returns the labels or annotations keys present on all the given
+// sets, sorted by key.
+func SharedMeta(mm []map[string]string) []MetaEntry {
+	if len(mm) == 0 {
+		return nil
+	}
+	ee := make([]MetaEntry, 0, len(mm[0]))
+	for k, v := range mm[0] {
+		e, shared := MetaEntry{Key: k, Value: v}, true
+		for _, m := range mm[1:] {
+			mv, ok := m[k]
+			if !ok {
+				shared = false
+				break
+			}
+			if mv != v {
+				e.Value, e.Mixed = "", true
+			}
+		}
+		if shared {
+			ee = append(ee, e)
+		}
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].Key < ee[j].Key
+	})
+
+	return ee
+}
+
+// ParseMetaOps parses space or comma separated `key=value` and `key-`
+// directives for the given metadata field.
+func ParseMetaOps(field, s string) ([]MetaOp, error) {
+	tokens := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("no %s specified", field)
+	}
+
+	oo := make([]MetaOp, 0, len(tokens))
+	for _, t := range tokens {
+		var o MetaOp
+		switch {
+		case strings.HasSuffix(t, "-") && !strings.Contains(t, "="):
+			o = MetaOp{Key: strings.TrimSuffix(t, "-"), Remove: true}
+		case strings.Contains(t, "="):
+			kv := strings.SplitN(t, "=", 2)
+			o = MetaOp{Key: kv[0], Value: kv[1]}
+		default:
+			return nil, fmt.Errorf("invalid directive %q. Expecting key=value or key-", t)
+		}
+		if err := o.Validate(field); err != nil {
+			return nil, err
+		}
+		oo = append(oo, o)
+	}
+
+	return oo, nil
+}
+
+// MetaPatch returns a merge patch applying the updates to a metadata field.
+func MetaPatch(field string, oo []MetaOp) ([]byte, error) {
+	kv := make(map[string]interface{}, len(oo))
+	for _, o := range oo {
+		if o.Remove {
+			kv[o.Key] = nil
+			continue
+		}
+		kv[o.Key] = o.Value
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			field: kv,
+		},
+	})
+}
+
+// Relabel adds or removes labels or annotations on a resource.
+func (g *Generic) Relabel(ctx context.Context, path, field string, oo []MetaOp) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s on %s", field, path)
+	}
+
+	patch, err := MetaPatch(field, oo)
+	if err != nil {
+		return err
+	}
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	opts := metav1.PatchOptions{DryRun: dryRunOpts()}
+
+	return Retry(ctx, func() error {
+		if client.IsClusterScoped(ns) {
+			_, err := dial.Patch(ctx, n, types.MergePatchType, patch, opts)
+			return err
+		}
+		_, err := dial.Namespace(ns).Patch(ctx, n, types.MergePatchType, patch, opts)
+		return err
+	})
+}
@@ -0,0 +1,97 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMetaOps(t *testing.T) {
+	uu := map[string]struct {
+		field, s string
+		e        []dao.MetaOp
+		err      bool
+	}{
+		"add": {
+			field: dao.LabelsField,
+			s:     "app=fred tier=web",
+			e:     []dao.MetaOp{{Key: "app", Value: "fred"}, {Key: "tier", Value: "web"}},
+		},
+		"remove": {
+			field: dao.LabelsField,
+			s:     "app-,debug=true",
+			e:     []dao.MetaOp{{Key: "app", Remove: true}, {Key: "debug", Value: "true"}},
+		},
+		"annotationValue": {
+			field: dao.AnnotationsField,
+			s:     "k9s.io/note=a/b",
+			e:     []dao.MetaOp{{Key: "k9s.io/note", Value: "a/b"}},
+		},
+		"badLabelValue": {
+			field: dao.LabelsField,
+			s:     "note=a/b",
+			err:   true,
+		},
+		"badDirective": {
+			field: dao.LabelsField,
+			s:     "app",
+			err:   true,
+		},
+		"empty": {
+			field: dao.LabelsField,
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := dao.ParseMetaOps(u.field, u.s)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, oo)
+		})
+	}
+}
+
+func TestMetaPatch(t *testing.T) {
+	raw, err := dao.MetaPatch(dao.LabelsField, []dao.MetaOp{{Key: "app", Value: "fred"}, {Key: "debug", Remove: true}})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"metadata":{"labels":{"app":"fred","debug":null}}}`, string(raw))
+}
+
+func TestSharedMeta(t *testing.T) {
+	uu := map[string]struct {
+		mm []map[string]string
+		e  []dao.MetaEntry
+	}{
+		"none": {},
+		"single": {
+			mm: []map[string]string{{"tier": "web", "app": "fred"}},
+			e:  []dao.MetaEntry{{Key: "app", Value: "fred"}, {Key: "tier", Value: "web"}},
+		},
+		"shared": {
+			mm: []map[string]string{
+				{"app": "fred", "tier": "web", "debug": "true"},
+				{"app": "blee", "tier": "web"},
+			},
+			e: []dao.MetaEntry{{Key: "app", Mixed: true}, {Key: "tier", Value: "web"}},
+		},
+		"disjoint": {
+			mm: []map[string]string{{"app": "fred"}, {}},
+			e:  []dao.MetaEntry{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.SharedMeta(u.mm))
+		})
+	}
+}
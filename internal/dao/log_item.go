@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -143,9 +144,35 @@ func (l LogItems) StrLines(showTime bool) []string {
 
 // Render returns logs as a collection of strings.
 func (l LogItems) Render(showTime bool, ll [][]byte) {
+	l.render(showTime, ll, (*LogItem).ID)
+}
+
+// RenderPerContainer returns logs as a collection of strings, using a
+// distinct color per pod container.
+func (l LogItems) RenderPerContainer(showTime bool, ll [][]byte) {
+	l.render(showTime, ll, func(i *LogItem) string {
+		return i.Pod + ":" + i.Container
+	})
+}
+
+// SortByTime orders log items by timestamp. Items with the same timestamp
+// retain their arrival order and items without one stick to their predecessor.
+func (l LogItems) SortByTime() {
+	tt := make([]time.Time, len(l))
+	for i, item := range l {
+		t, err := time.Parse(time.RFC3339Nano, item.Timestamp)
+		if err != nil && i > 0 {
+			t = tt[i-1]
+		}
+		tt[i] = t
+	}
+	sort.Stable(byTime{items: l, times: tt})
+}
+
+func (l LogItems) render(showTime bool, ll [][]byte, idFn func(*LogItem) string) {
 	colors := make(map[string]int, len(l))
 	for i, item := range l {
-		info := item.ID()
+		info := idFn(item)
 		color, ok := colors[info]
 		if !ok {
 			color = colorFor(info)
@@ -155,6 +182,24 @@ func (l LogItems) Render(showTime bool, ll [][]byte) {
 	}
 }
 
+type byTime struct {
+	items LogItems
+	times []time.Time
+}
+
+func (b byTime) Len() int {
+	return len(b.items)
+}
+
+func (b byTime) Swap(i, j int) {
+	b.items[i], b.items[j] = b.items[j], b.items[i]
+	b.times[i], b.times[j] = b.times[j], b.times[i]
+}
+
+func (b byTime) Less(i, j int) bool {
+	return b.times[i].Before(b.times[j])
+}
+
 // DumpDebug for debuging
 func (l LogItems) DumpDebug(m string) {
 	fmt.Println(m + strings.Repeat("-", 50))
@@ -163,6 +208,36 @@ func (l LogItems) DumpDebug(m string) {
 	}
 }
 
+// Structured returns a copy of the items with json lines pretty printed using
+// the given fields. Other lines are left as is.
+func (l LogItems) Structured(fields []string) LogItems {
+	ll := make(LogItems, len(l))
+	for i, item := range l {
+		j, ok := ParseJSONLog(item.Bytes)
+		if !ok {
+			ll[i] = item
+			continue
+		}
+		c := *item
+		c.Bytes = j.Pretty(fields)
+		ll[i] = &c
+	}
+
+	return ll
+}
+
+// FilterFields returns the indices of the json lines matching a field filter.
+func (l LogItems) FilterFields(f FieldFilter) []int {
+	matches := make([]int, 0, len(l))
+	for i, item := range l {
+		if j, ok := ParseJSONLog(item.Bytes); ok && f.Matches(j) {
+			matches = append(matches, i)
+		}
+	}
+
+	return matches
+}
+
 // Filter filters out log items based on given filter.
 func (l LogItems) Filter(q string, showTime bool) ([]int, [][]int, error) {
 	if q == "" {
@@ -125,6 +125,60 @@ func TestLogItemsRender(t *testing.T) {
 	}
 }
 
+func TestLogItemsRenderPerContainer(t *testing.T) {
+	ii := dao.LogItems{
+		dao.NewLogItemFromString("Testing 1,2,3..."),
+		dao.NewLogItemFromString("Testing 1,2,3..."),
+	}
+	ii[0].Pod, ii[0].Container = "fred", "blee"
+	ii[1].Pod, ii[1].Container = "fred", "zorg"
+
+	res := make([][]byte, 2)
+	ii.RenderPerContainer(false, res)
+	assert.Equal(t, "\x1b[38;5;115mfred\x1b[0m:\x1b[38;5;115mblee\x1b[0m Testing 1,2,3...", string(res[0]))
+	assert.Equal(t, "\x1b[38;5;157mfred\x1b[0m:\x1b[38;5;157mzorg\x1b[0m Testing 1,2,3...", string(res[1]))
+}
+
+func TestLogItemsSortByTime(t *testing.T) {
+	uu := map[string]struct {
+		tt []string
+		e  []string
+	}{
+		"empty": {},
+		"sorted": {
+			tt: []string{"2018-12-14T10:36:43.1Z", "2018-12-14T10:36:43.2Z"},
+			e:  []string{"2018-12-14T10:36:43.1Z", "2018-12-14T10:36:43.2Z"},
+		},
+		"unsorted": {
+			tt: []string{"2018-12-14T10:36:43.3Z", "2018-12-14T10:36:43.1Z", "2018-12-14T10:36:43.2Z"},
+			e:  []string{"2018-12-14T10:36:43.1Z", "2018-12-14T10:36:43.2Z", "2018-12-14T10:36:43.3Z"},
+		},
+		"no-timestamp": {
+			tt: []string{"2018-12-14T10:36:43.3Z", "blee", "2018-12-14T10:36:43.1Z"},
+			e:  []string{"2018-12-14T10:36:43.1Z", "2018-12-14T10:36:43.3Z", "blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii := make(dao.LogItems, 0, len(u.tt))
+			for _, ts := range u.tt {
+				ii = append(ii, &dao.LogItem{Timestamp: ts})
+			}
+			ii.SortByTime()
+			tt := make([]string, 0, len(ii))
+			for _, i := range ii {
+				tt = append(tt, i.Timestamp)
+			}
+			assert.Equal(t, len(u.e), len(tt))
+			for i := range u.e {
+				assert.Equal(t, u.e[i], tt[i])
+			}
+		})
+	}
+}
+
 func TestLogItemEmpty(t *testing.T) {
 	uu := map[string]struct {
 		s string
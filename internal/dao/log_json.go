@@ -0,0 +1,312 @@
+package dao
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/color"
+)
+
+const (
+	fieldKeyColor  = 245
+	unknownLevel   = -1
+	levelFieldName = "level"
+)
+
+var (
+	levelKeys   = []string{"level", "lvl", "severity", "log.level"}
+	messageKeys = []string{"msg", "message"}
+
+	levelRanks = map[string]int{
+		"trace":    0,
+		"debug":    1,
+		"info":     2,
+		"warn":     3,
+		"warning":  3,
+		"error":    4,
+		"err":      4,
+		"fatal":    5,
+		"panic":    5,
+		"critical": 5,
+	}
+	levelColors = []int{245, 75, 70, 214, 196, 199}
+
+	// Bunyan/pino style numeric levels.
+	numericLevels = map[string]string{
+		"10": "trace",
+		"20": "debug",
+		"30": "info",
+		"40": "warn",
+		"50": "error",
+		"60": "fatal",
+	}
+)
+
+// JSONLog represents a structured log line.
+type JSONLog map[string]interface{}
+
+// ParseJSONLog checks if a log line is a json object and decodes it.
+func ParseJSONLog(b []byte) (JSONLog, bool) {
+	b = bytes.TrimSpace(b)
+	if len(b) < 2 || b[0] != '{' || b[len(b)-1] != '}' {
+		return nil, false
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var j JSONLog
+	if err := d.Decode(&j); err != nil {
+		return nil, false
+	}
+
+	return j, true
+}
+
+// Field returns a field value. Nested fields are addressed using dots ie log.level.
+func (j JSONLog) Field(name string) (string, bool) {
+	if v, ok := j[name]; ok {
+		return fieldValue(v), true
+	}
+	var m map[string]interface{} = j
+	tokens := strings.Split(name, ".")
+	for i, t := range tokens {
+		v, ok := m[t]
+		if !ok {
+			return "", false
+		}
+		if i == len(tokens)-1 {
+			return fieldValue(v), true
+		}
+		if m, ok = v.(map[string]interface{}); !ok {
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+// Level returns the normalized log level or blank if none.
+func (j JSONLog) Level() string {
+	for _, k := range levelKeys {
+		if v, ok := j.Field(k); ok {
+			return normalizeLevel(v)
+		}
+	}
+
+	return ""
+}
+
+// Message returns the log message.
+func (j JSONLog) Message() string {
+	for _, k := range messageKeys {
+		if v, ok := j.Field(k); ok {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// Pretty renders the log level, message and the given fields. All other fields
+// are rendered if no fields are specified.
+func (j JSONLog) Pretty(fields []string) []byte {
+	bb := make([]byte, 0, 200)
+	if lvl := j.Level(); lvl != "" {
+		bb = append(bb, color.ANSIColorize(fmt.Sprintf("%-5s", strings.ToUpper(lvl)), levelColor(lvl))...)
+		bb = append(bb, ' ')
+	}
+	bb = append(bb, j.Message()...)
+
+	if len(fields) == 0 {
+		fields = j.extraFields()
+	}
+	for _, f := range fields {
+		v, ok := j.Field(f)
+		if !ok {
+			continue
+		}
+		if strings.ContainsAny(v, " \t") {
+			v = strconv.Quote(v)
+		}
+		bb = append(bb, ' ')
+		bb = append(bb, color.ANSIColorize(f+"=", fieldKeyColor)...)
+		bb = append(bb, v...)
+	}
+
+	return bb
+}
+
+func (j JSONLog) extraFields() []string {
+	skip := make(map[string]struct{}, len(levelKeys)+len(messageKeys))
+	for _, k := range append(levelKeys, messageKeys...) {
+		skip[k] = struct{}{}
+	}
+	ff := make([]string, 0, len(j))
+	for k := range j {
+		if _, ok := skip[k]; !ok {
+			ff = append(ff, k)
+		}
+	}
+	sort.Strings(ff)
+
+	return ff
+}
+
+// LevelRank returns the log level severity or -1 if unknown.
+func LevelRank(level string) int {
+	if r, ok := levelRanks[normalizeLevel(level)]; ok {
+		return r
+	}
+
+	return unknownLevel
+}
+
+func normalizeLevel(l string) string {
+	l = strings.ToLower(strings.TrimSpace(l))
+	if n, ok := numericLevels[l]; ok {
+		return n
+	}
+
+	return l
+}
+
+func levelColor(l string) int {
+	r := LevelRank(l)
+	if r == unknownLevel {
+		return fieldKeyColor
+	}
+
+	return levelColors[r]
+}
+
+func fieldValue(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case json.Number:
+		return t.String()
+	case nil:
+		return "null"
+	default:
+		raw, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return string(raw)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+var fieldCondRX = regexp.MustCompile(`^([\w.@\-]+)(>=|<=|!=|=|>|<|~)(.+)$`)
+
+// FieldCond represents a structured log field condition ie level>=warn.
+type FieldCond struct {
+	Field, Op, Value string
+
+	rx *regexp.Regexp
+}
+
+// FieldFilter represents a collection of field conditions that must all match.
+type FieldFilter []FieldCond
+
+// ParseFieldFilter parses space separated field conditions. It returns false
+// if the query is not a field filter.
+func ParseFieldFilter(q string) (FieldFilter, bool, error) {
+	tokens := strings.Fields(q)
+	if len(tokens) == 0 {
+		return nil, false, nil
+	}
+	ff := make(FieldFilter, 0, len(tokens))
+	for _, t := range tokens {
+		mm := fieldCondRX.FindStringSubmatch(t)
+		if mm == nil {
+			return nil, false, nil
+		}
+		c := FieldCond{Field: mm[1], Op: mm[2], Value: mm[3]}
+		if c.Op == "~" {
+			rx, err := regexp.Compile(`(?i)` + c.Value)
+			if err != nil {
+				return nil, true, err
+			}
+			c.rx = rx
+		}
+		ff = append(ff, c)
+	}
+
+	return ff, true, nil
+}
+
+// Matches checks if a structured log matches all conditions.
+func (f FieldFilter) Matches(j JSONLog) bool {
+	for _, c := range f {
+		if !c.matches(j) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c FieldCond) matches(j JSONLog) bool {
+	var (
+		v  string
+		ok bool
+	)
+	if c.Field == levelFieldName {
+		v, ok = j.Level(), j.Level() != ""
+	} else {
+		v, ok = j.Field(c.Field)
+	}
+	if !ok {
+		return false
+	}
+	if c.rx != nil {
+		return c.rx.MatchString(v)
+	}
+
+	return compareOp(c.Op, c.compare(v))
+}
+
+func (c FieldCond) compare(v string) int {
+	if l, r := LevelRank(v), LevelRank(c.Value); l != unknownLevel && r != unknownLevel {
+		return l - r
+	}
+	l, err1 := strconv.ParseFloat(v, 64)
+	r, err2 := strconv.ParseFloat(c.Value, 64)
+	if err1 == nil && err2 == nil {
+		switch {
+		case l < r:
+			return -1
+		case l > r:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(strings.ToLower(v), strings.ToLower(c.Value))
+}
+
+func compareOp(op string, cmp int) bool {
+	switch op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,228 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONLog(t *testing.T) {
+	uu := map[string]struct {
+		line       string
+		ok         bool
+		level, msg string
+	}{
+		"plain": {
+			line: "hello world",
+		},
+		"broken": {
+			line: `{"level": "info"`,
+		},
+		"logrus": {
+			line:  `{"level":"warning","msg":"disk low","time":"2020-01-01T00:00:00Z"}`,
+			ok:    true,
+			level: "warning",
+			msg:   "disk low",
+		},
+		"zap": {
+			line:  ` {"severity":"ERROR","message":"boom"} `,
+			ok:    true,
+			level: "error",
+			msg:   "boom",
+		},
+		"ecs": {
+			line:  `{"log":{"level":"debug"},"message":"yo"}`,
+			ok:    true,
+			level: "debug",
+			msg:   "yo",
+		},
+		"bunyan": {
+			line:  `{"level":50,"msg":"crash"}`,
+			ok:    true,
+			level: "error",
+			msg:   "crash",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			j, ok := dao.ParseJSONLog([]byte(u.line))
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, u.level, j.Level())
+			assert.Equal(t, u.msg, j.Message())
+		})
+	}
+}
+
+func TestJSONLogField(t *testing.T) {
+	j, ok := dao.ParseJSONLog([]byte(`{"a":{"b":{"c":1}},"d.e":"f","g":[1,2],"h":null}`))
+	assert.True(t, ok)
+
+	uu := map[string]struct {
+		field string
+		ok    bool
+		e     string
+	}{
+		"nested": {field: "a.b.c", ok: true, e: "1"},
+		"dotted": {field: "d.e", ok: true, e: "f"},
+		"list":   {field: "g", ok: true, e: "[1,2]"},
+		"null":   {field: "h", ok: true, e: "null"},
+		"object": {field: "a.b", ok: true, e: `{"c":1}`},
+		"toDeep": {field: "a.b.c.d"},
+		"none":   {field: "z"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, ok := j.Field(u.field)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestJSONLogPretty(t *testing.T) {
+	j, ok := dao.ParseJSONLog([]byte(`{"level":"info","msg":"started","port":8080,"host":"my box"}`))
+	assert.True(t, ok)
+
+	lvl := color.ANSIColorize("INFO ", 70)
+	key := func(k string) string {
+		return color.ANSIColorize(k+"=", 245)
+	}
+
+	uu := map[string]struct {
+		fields []string
+		e      string
+	}{
+		"all": {
+			e: lvl + " started " + key("host") + `"my box" ` + key("port") + "8080",
+		},
+		"selected": {
+			fields: []string{"port", "missing"},
+			e:      lvl + " started " + key("port") + "8080",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(j.Pretty(u.fields)))
+		})
+	}
+}
+
+func TestParseFieldFilter(t *testing.T) {
+	uu := map[string]struct {
+		q   string
+		ok  bool
+		err bool
+		e   dao.FieldFilter
+	}{
+		"empty": {},
+		"plain": {
+			q: "fred",
+		},
+		"mixed": {
+			q: "level>=warn fred",
+		},
+		"single": {
+			q:  "level>=warn",
+			ok: true,
+			e:  dao.FieldFilter{{Field: "level", Op: ">=", Value: "warn"}},
+		},
+		"multi": {
+			q:  "level!=debug status=500",
+			ok: true,
+			e: dao.FieldFilter{
+				{Field: "level", Op: "!=", Value: "debug"},
+				{Field: "status", Op: "=", Value: "500"},
+			},
+		},
+		"badRegex": {
+			q:   "msg~(",
+			ok:  true,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff, ok, err := dao.ParseFieldFilter(u.q)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.err, err != nil)
+			if u.e != nil {
+				assert.Equal(t, u.e, ff)
+			}
+		})
+	}
+}
+
+func TestLogItemsFilterFields(t *testing.T) {
+	ii := dao.LogItems{
+		dao.NewLogItemFromString(`{"level":"debug","msg":"cache hit","status":200}`),
+		dao.NewLogItemFromString(`{"level":"info","msg":"served","status":200}`),
+		dao.NewLogItemFromString(`plain text`),
+		dao.NewLogItemFromString(`{"level":"warn","msg":"slow request","status":200}`),
+		dao.NewLogItemFromString(`{"level":"error","msg":"request failed","status":500}`),
+	}
+
+	uu := map[string]struct {
+		q string
+		e []int
+	}{
+		"level": {
+			q: "level>=warn",
+			e: []int{3, 4},
+		},
+		"numeric": {
+			q: "status>=500",
+			e: []int{4},
+		},
+		"regex": {
+			q: "msg~^req",
+			e: []int{4},
+		},
+		"and": {
+			q: "level<=info status=200",
+			e: []int{0, 1},
+		},
+		"notEqual": {
+			q: "level!=info",
+			e: []int{0, 3, 4},
+		},
+		"missing": {
+			q: "user=fred",
+			e: []int{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff, ok, err := dao.ParseFieldFilter(u.q)
+			assert.True(t, ok)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ii.FilterFields(ff))
+		})
+	}
+}
+
+func TestLogItemsStructured(t *testing.T) {
+	ii := dao.LogItems{
+		dao.NewLogItemFromString(`{"level":"info","msg":"yo"}`),
+		dao.NewLogItemFromString(`plain text`),
+	}
+	ss := ii.Structured(nil)
+
+	assert.Equal(t, color.ANSIColorize("INFO ", 70)+" yo", string(ss[0].Bytes))
+	assert.Equal(t, "plain text", string(ss[1].Bytes))
+	assert.Equal(t, `{"level":"info","msg":"yo"}`, string(ii[0].Bytes))
+}
@@ -18,10 +18,14 @@ type LogOptions struct {
 	Previous        bool
 	SingleContainer bool
 	MultiPods       bool
+	Interleave      bool
 	ShowTimestamp   bool
+	Structured      bool
+	Fields          []string
 	SinceTime       string
 	SinceSeconds    int64
 	In, Out         string
+	MaxStreams      int
 }
 
 // Info returns the option pod and container info.
@@ -0,0 +1,137 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultLogStreams tracks the max number of pods tailed in multi pods
+	// mode unless configured.
+	defaultLogStreams = 50
+
+	logStreamWorkers = 10
+	logStreamBuffer  = 500
+)
+
+// logStream buffers a single log stream and drops lines when the consumer
+// can't keep up, so a chatty pod can't stall or bloat a multi pods tail.
+type logStream struct {
+	buff    LogChan
+	opts    LogOptions
+	dropped int
+}
+
+func newLogStream(ctx context.Context, out LogChan, size int, opts LogOptions) *logStream {
+	s := logStream{buff: make(LogChan, size), opts: opts}
+	go func() {
+		for item := range s.buff {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- item:
+			}
+		}
+	}()
+
+	return &s
+}
+
+// Send enqueues a log line or drops it if the stream buffer is full.
+func (s *logStream) Send(item *LogItem) {
+	if s.dropped > 0 {
+		select {
+		case s.buff <- s.opts.DecorateLog(logNotice(fmt.Sprintf("<%d log lines dropped>", s.dropped))):
+			s.dropped = 0
+		default:
+			s.dropped++
+			return
+		}
+	}
+
+	select {
+	case s.buff <- item:
+	default:
+		s.dropped++
+	}
+}
+
+// Close closes the stream once buffered lines are flushed.
+func (s *logStream) Close() {
+	close(s.buff)
+}
+
+func logNotice(msg string) []byte {
+	return []byte(time.Now().Format(time.RFC3339Nano) + " " + msg + "\n")
+}
+
+// tailPodsLogs tails a collection of pods logs using a bounded pool.
+func tailPodsLogs(ctx context.Context, po *Pod, c LogChan, paths []string, opts LogOptions) error {
+	limit := opts.MaxStreams
+	if limit <= 0 {
+		limit = defaultLogStreams
+	}
+	if len(paths) > limit {
+		msg := fmt.Sprintf("<only tailing %d out of %d pods>", limit, len(paths))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case c <- opts.DecorateLog(logNotice(msg)):
+		}
+		paths = paths[:limit]
+	}
+
+	workers := logStreamWorkers
+	if len(paths) < workers {
+		workers = len(paths)
+	}
+	jobs, errs := make(chan string), make(chan error, len(paths))
+	for i := 0; i < workers; i++ {
+		go func() {
+			for path := range jobs {
+				o := opts
+				o.Path = path
+				err := po.TailLogs(ctx, c, o)
+				if err != nil {
+					log.Warn().Err(err).Msgf("Tail logs failed for %s", path)
+				}
+				errs <- err
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, p := range paths {
+			select {
+			case <-ctx.Done():
+				return
+			case jobs <- p:
+			}
+		}
+	}()
+
+	var (
+		err    error
+		tailed bool
+	)
+	for range paths {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e := <-errs:
+			if e != nil {
+				err = e
+				continue
+			}
+			tailed = true
+		}
+	}
+	if tailed {
+		return nil
+	}
+
+	return err
+}
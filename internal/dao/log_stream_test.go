@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogStreamDrops(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := make(LogChan)
+	opts := LogOptions{Path: "ns1/p1", Container: "c1", MultiPods: true}
+	s := logStream{buff: make(LogChan, 2), opts: opts}
+	s.Send(opts.DecorateLog(logNotice("l1")))
+	s.Send(opts.DecorateLog(logNotice("l2")))
+	s.Send(opts.DecorateLog(logNotice("l3")))
+	s.Send(opts.DecorateLog(logNotice("l4")))
+	assert.Equal(t, 2, s.dropped)
+
+	<-s.buff
+	<-s.buff
+	s.Send(opts.DecorateLog(logNotice("l5")))
+	assert.Equal(t, 0, s.dropped)
+	assert.Equal(t, "<2 log lines dropped>", string((<-s.buff).Bytes))
+	assert.Equal(t, "l5", string((<-s.buff).Bytes))
+
+	ls := newLogStream(ctx, out, 2, opts)
+	ls.Send(opts.DecorateLog(logNotice("l6")))
+	ls.Close()
+	select {
+	case item := <-out:
+		assert.Equal(t, "l6", string(item.Bytes))
+	case <-time.After(time.Second):
+		assert.Fail(t, "timed out")
+	}
+}
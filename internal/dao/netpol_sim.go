@@ -0,0 +1,512 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ Accessor = (*NetPolSim)(nil)
+
+const (
+	npGVR = "networking.k8s.io/v1/networkpolicies"
+
+	// IngressDirection tracks traffic coming into a pod.
+	IngressDirection = "Ingress"
+
+	// EgressDirection tracks traffic going out of a pod.
+	EgressDirection = "Egress"
+
+	anyPeer = "*"
+)
+
+// NetPolProbe represents a connection to evaluate ie from a pod to another
+// pod or an ip on a given port.
+type NetPolProbe struct {
+	// From and To are either pod paths or ips.
+	From, To string
+
+	// Port is a port number or a named container port.
+	Port string
+
+	// Protocol defaults to TCP.
+	Protocol string
+}
+
+// String returns the probe as from -> to:port/protocol.
+func (p NetPolProbe) String() string {
+	return fmt.Sprintf("%s -> %s:%s/%s", p.From, p.To, p.Port, p.protocol())
+}
+
+func (p NetPolProbe) protocol() string {
+	if p.Protocol == "" {
+		return string(v1.ProtocolTCP)
+	}
+
+	return strings.ToUpper(p.Protocol)
+}
+
+// NetPolDecision represents the policies verdict on one end of a connection.
+type NetPolDecision struct {
+	Allowed bool
+
+	// Policies lists the allowing rules or the isolating policies if denied.
+	Policies []string
+
+	Reason string
+}
+
+// NetPolVerdict represents a connection probe outcome.
+type NetPolVerdict struct {
+	Egress, Ingress NetPolDecision
+}
+
+// Allowed checks if the connection is allowed on both ends.
+func (v NetPolVerdict) Allowed() bool {
+	return v.Egress.Allowed && v.Ingress.Allowed
+}
+
+// NetPolSim evaluates the network policies applying to a pod.
+type NetPolSim struct {
+	NonResource
+}
+
+// List returns the ingress and egress rules applying to the context pod.
+func (n *NetPolSim) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, errors.New("expecting a context pod path")
+	}
+	po, err := fetchPod(n.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	pols, err := fetchNetPols(n.Factory)
+	if err != nil {
+		return nil, err
+	}
+
+	rr := podNetRules(po, pols)
+	oo := make([]runtime.Object, len(rr))
+	for i, r := range rr {
+		oo[i] = r
+	}
+
+	return oo, nil
+}
+
+// Probe evaluates whether a connection is allowed by the network policies.
+func (n *NetPolSim) Probe(p NetPolProbe) (NetPolVerdict, error) {
+	pols, err := fetchNetPols(n.Factory)
+	if err != nil {
+		return NetPolVerdict{}, err
+	}
+	nss, err := fetchNamespaceLabels(n.Factory)
+	if err != nil {
+		return NetPolVerdict{}, err
+	}
+	src, err := n.endpoint(p.From, nss)
+	if err != nil {
+		return NetPolVerdict{}, err
+	}
+	dst, err := n.endpoint(p.To, nss)
+	if err != nil {
+		return NetPolVerdict{}, err
+	}
+
+	return probe(src, dst, p.Port, p.protocol(), pols), nil
+}
+
+func (n *NetPolSim) endpoint(s string, nss map[string]map[string]string) (npEndpoint, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		return npEndpoint{ip: ip}, nil
+	}
+	po, err := fetchPod(n.Factory, s)
+	if err != nil {
+		return npEndpoint{}, err
+	}
+
+	return newPodEndpoint(po, nss[po.Namespace]), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// npEndpoint represents a connection end, either a pod or an ip.
+type npEndpoint struct {
+	pod      *v1.Pod
+	nsLabels map[string]string
+	ip       net.IP
+}
+
+func newPodEndpoint(po *v1.Pod, nsLabels map[string]string) npEndpoint {
+	return npEndpoint{pod: po, nsLabels: nsLabels, ip: net.ParseIP(po.Status.PodIP)}
+}
+
+func probe(src, dst npEndpoint, port, proto string, pols []netv1.NetworkPolicy) NetPolVerdict {
+	var v NetPolVerdict
+	if src.pod == nil {
+		v.Egress = NetPolDecision{Allowed: true, Reason: "External source"}
+	} else {
+		v.Egress = decide(EgressDirection, src.pod, dst, dst.pod, port, proto, pols)
+	}
+	if dst.pod == nil {
+		v.Ingress = NetPolDecision{Allowed: true, Reason: "External destination"}
+	} else {
+		v.Ingress = decide(IngressDirection, dst.pod, src, dst.pod, port, proto, pols)
+	}
+
+	return v
+}
+
+// decide evaluates the policies isolating a pod in a given direction against
+// a peer. Named ports resolve against the destination pod.
+func decide(dir string, po *v1.Pod, peer npEndpoint, dst *v1.Pod, port, proto string, pols []netv1.NetworkPolicy) NetPolDecision {
+	var isolating, allowing []string
+	for _, np := range isolatingPolicies(dir, po, pols) {
+		isolating = append(isolating, policyName(np))
+		for i, r := range policyRules(dir, np) {
+			if !portsMatch(r.ports, port, proto, dst) {
+				continue
+			}
+			if len(r.peers) > 0 && !anyPeerMatches(r.peers, np.Namespace, peer) {
+				continue
+			}
+			allowing = append(allowing, fmt.Sprintf("%s#%d", policyName(np), i+1))
+		}
+	}
+
+	switch {
+	case len(isolating) == 0:
+		return NetPolDecision{Allowed: true, Reason: "No policy isolates " + client.FQN(po.Namespace, po.Name)}
+	case len(allowing) > 0:
+		return NetPolDecision{Allowed: true, Policies: allowing, Reason: "Allowed by matching rules"}
+	default:
+		return NetPolDecision{Policies: isolating, Reason: "Isolated and no rule matches"}
+	}
+}
+
+// podNetRules lists the rules allowing traffic in and out of a pod.
+func podNetRules(po *v1.Pod, pols []netv1.NetworkPolicy) []render.NetPolRuleRes {
+	rr := make([]render.NetPolRuleRes, 0, 10)
+	for _, dir := range []string{IngressDirection, EgressDirection} {
+		nps := isolatingPolicies(dir, po, pols)
+		if len(nps) == 0 {
+			rr = append(rr, render.NetPolRuleRes{
+				Direction: dir,
+				Peer:      anyPeer,
+				Ports:     anyPeer,
+				Verdict:   render.AllowVerdict,
+				Reason:    "Not isolated",
+			})
+			continue
+		}
+		names := make([]string, 0, len(nps))
+		for _, np := range nps {
+			names = append(names, policyName(np))
+			for i, r := range policyRules(dir, np) {
+				peers := []string{anyPeer}
+				if len(r.peers) > 0 {
+					peers = peers[:0]
+					for _, p := range r.peers {
+						peers = append(peers, peerDesc(p, np.Namespace))
+					}
+				}
+				for _, p := range peers {
+					rr = append(rr, render.NetPolRuleRes{
+						Direction: dir,
+						Policy:    policyName(np),
+						Peer:      p,
+						Ports:     portsDesc(r.ports),
+						Verdict:   render.AllowVerdict,
+						Reason:    "Rule #" + strconv.Itoa(i+1),
+						Index:     i + 1,
+					})
+				}
+			}
+		}
+		rr = append(rr, render.NetPolRuleRes{
+			Direction: dir,
+			Policy:    strings.Join(names, ","),
+			Peer:      anyPeer,
+			Ports:     anyPeer,
+			Verdict:   render.DenyVerdict,
+			Reason:    "Isolated. Unmatched traffic is denied",
+		})
+	}
+
+	return rr
+}
+
+func isolatingPolicies(dir string, po *v1.Pod, pols []netv1.NetworkPolicy) []netv1.NetworkPolicy {
+	nps := make([]netv1.NetworkPolicy, 0, len(pols))
+	for _, np := range pols {
+		if np.Namespace != po.Namespace || !hasPolicyType(np, dir) {
+			continue
+		}
+		if selectorMatches(&np.Spec.PodSelector, po.Labels) {
+			nps = append(nps, np)
+		}
+	}
+	sort.Slice(nps, func(i, j int) bool {
+		return nps[i].Name < nps[j].Name
+	})
+
+	return nps
+}
+
+func hasPolicyType(np netv1.NetworkPolicy, dir string) bool {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return dir == IngressDirection || len(np.Spec.Egress) > 0
+	}
+	for _, t := range np.Spec.PolicyTypes {
+		if string(t) == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+type npRule struct {
+	peers []netv1.NetworkPolicyPeer
+	ports []netv1.NetworkPolicyPort
+}
+
+func policyRules(dir string, np netv1.NetworkPolicy) []npRule {
+	var rr []npRule
+	if dir == IngressDirection {
+		for _, r := range np.Spec.Ingress {
+			rr = append(rr, npRule{peers: r.From, ports: r.Ports})
+		}
+		return rr
+	}
+	for _, r := range np.Spec.Egress {
+		rr = append(rr, npRule{peers: r.To, ports: r.Ports})
+	}
+
+	return rr
+}
+
+func anyPeerMatches(pp []netv1.NetworkPolicyPeer, ns string, e npEndpoint) bool {
+	for _, p := range pp {
+		if peerMatches(p, ns, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func peerMatches(p netv1.NetworkPolicyPeer, ns string, e npEndpoint) bool {
+	if p.IPBlock != nil {
+		return ipBlockMatches(p.IPBlock, e.ip)
+	}
+	if e.pod == nil {
+		return false
+	}
+	if p.NamespaceSelector == nil {
+		if e.pod.Namespace != ns {
+			return false
+		}
+	} else if !selectorMatches(p.NamespaceSelector, e.nsLabels) {
+		return false
+	}
+
+	return p.PodSelector == nil || selectorMatches(p.PodSelector, e.pod.Labels)
+}
+
+func ipBlockMatches(b *netv1.IPBlock, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(b.CIDR)
+	if err != nil || !cidr.Contains(ip) {
+		return false
+	}
+	for _, e := range b.Except {
+		if _, ex, err := net.ParseCIDR(e); err == nil && ex.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func portsMatch(pp []netv1.NetworkPolicyPort, port, proto string, dst *v1.Pod) bool {
+	if len(pp) == 0 {
+		return true
+	}
+	num, numOK := resolvePort(dst, port, proto)
+	for _, p := range pp {
+		pproto := string(v1.ProtocolTCP)
+		if p.Protocol != nil {
+			pproto = string(*p.Protocol)
+		}
+		if pproto != proto {
+			continue
+		}
+		if p.Port == nil {
+			return true
+		}
+		if p.Port.Type == intstr.String {
+			if p.Port.StrVal == port {
+				return true
+			}
+			if n, ok := resolvePort(dst, p.Port.StrVal, proto); ok && numOK && n == num {
+				return true
+			}
+			continue
+		}
+		if numOK && p.Port.IntVal == num {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvePort converts a port number or a named container port.
+func resolvePort(po *v1.Pod, port, proto string) (int32, bool) {
+	if n, err := strconv.Atoi(port); err == nil {
+		return int32(n), true
+	}
+	if po == nil {
+		return 0, false
+	}
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			pproto := p.Protocol
+			if pproto == "" {
+				pproto = v1.ProtocolTCP
+			}
+			if p.Name == port && string(pproto) == proto {
+				return p.ContainerPort, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func selectorMatches(s *metav1.LabelSelector, ll map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return false
+	}
+
+	return sel.Matches(labels.Set(ll))
+}
+
+func policyName(np netv1.NetworkPolicy) string {
+	return client.FQN(np.Namespace, np.Name)
+}
+
+func peerDesc(p netv1.NetworkPolicyPeer, ns string) string {
+	if b := p.IPBlock; b != nil {
+		if len(b.Except) == 0 {
+			return "ip:" + b.CIDR
+		}
+		return "ip:" + b.CIDR + " except " + strings.Join(b.Except, ",")
+	}
+	nsDesc := ns
+	if p.NamespaceSelector != nil {
+		nsDesc = selectorDesc(p.NamespaceSelector)
+	}
+	poDesc := anyPeer
+	if p.PodSelector != nil {
+		poDesc = selectorDesc(p.PodSelector)
+	}
+
+	return fmt.Sprintf("ns:%s pods:%s", nsDesc, poDesc)
+}
+
+func selectorDesc(s *metav1.LabelSelector) string {
+	if d := metav1.FormatLabelSelector(s); d != "<none>" {
+		return d
+	}
+
+	return anyPeer
+}
+
+func portsDesc(pp []netv1.NetworkPolicyPort) string {
+	if len(pp) == 0 {
+		return anyPeer
+	}
+	ss := make([]string, 0, len(pp))
+	for _, p := range pp {
+		proto := string(v1.ProtocolTCP)
+		if p.Protocol != nil {
+			proto = string(*p.Protocol)
+		}
+		port := anyPeer
+		if p.Port != nil {
+			port = p.Port.String()
+		}
+		ss = append(ss, proto+":"+port)
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func fetchPod(f Factory, path string) (*v1.Pod, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+		return nil, err
+	}
+
+	return &po, nil
+}
+
+func fetchNetPols(f Factory) ([]netv1.NetworkPolicy, error) {
+	oo, err := f.List(npGVR, client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nps := make([]netv1.NetworkPolicy, 0, len(oo))
+	for _, o := range oo {
+		var np netv1.NetworkPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &np); err != nil {
+			return nil, err
+		}
+		nps = append(nps, np)
+	}
+
+	return nps, nil
+}
+
+func fetchNamespaceLabels(f Factory) (map[string]map[string]string, error) {
+	oo, err := f.List("v1/namespaces", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	nss := make(map[string]map[string]string, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			nss[u.GetName()] = u.GetLabels()
+		}
+	}
+
+	return nss, nil
+}
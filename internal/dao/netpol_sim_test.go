@@ -0,0 +1,205 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNetPolProbe(t *testing.T) {
+	fe := newPodEndpoint(makeNetPod("fe", "default", "10.0.0.1", map[string]string{"app": "fe"}), nil)
+	db := newPodEndpoint(makeNetPod("db", "default", "10.0.0.2", map[string]string{"app": "db"}), nil)
+	ops := newPodEndpoint(makeNetPod("ops", "ops", "10.0.1.1", map[string]string{"app": "ops"}), map[string]string{"team": "ops"})
+
+	uu := map[string]struct {
+		src, dst npEndpoint
+		port     string
+		pols     []netv1.NetworkPolicy
+		e        NetPolVerdict
+	}{
+		"noPolicies": {
+			src:  fe,
+			dst:  db,
+			port: "5432",
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Reason: "No policy isolates default/fe"},
+				Ingress: NetPolDecision{Allowed: true, Reason: "No policy isolates default/db"},
+			},
+		},
+		"allowed": {
+			src:  fe,
+			dst:  db,
+			port: "5432",
+			pols: []netv1.NetworkPolicy{dbPolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Reason: "No policy isolates default/fe"},
+				Ingress: NetPolDecision{Allowed: true, Policies: []string{"default/db#1"}, Reason: "Allowed by matching rules"},
+			},
+		},
+		"namedPort": {
+			src:  fe,
+			dst:  db,
+			port: "pg",
+			pols: []netv1.NetworkPolicy{dbPolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Reason: "No policy isolates default/fe"},
+				Ingress: NetPolDecision{Allowed: true, Policies: []string{"default/db#1"}, Reason: "Allowed by matching rules"},
+			},
+		},
+		"wrongPort": {
+			src:  fe,
+			dst:  db,
+			port: "80",
+			pols: []netv1.NetworkPolicy{dbPolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Reason: "No policy isolates default/fe"},
+				Ingress: NetPolDecision{Policies: []string{"default/db"}, Reason: "Isolated and no rule matches"},
+			},
+		},
+		"namespaceSelector": {
+			src:  ops,
+			dst:  db,
+			port: "5432",
+			pols: []netv1.NetworkPolicy{dbPolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Reason: "No policy isolates ops/ops"},
+				Ingress: NetPolDecision{Allowed: true, Policies: []string{"default/db#2"}, Reason: "Allowed by matching rules"},
+			},
+		},
+		"egressDenied": {
+			src:  fe,
+			dst:  npEndpoint{ip: []byte{8, 8, 8, 8}},
+			port: "443",
+			pols: []netv1.NetworkPolicy{fePolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Policies: []string{"default/fe"}, Reason: "Isolated and no rule matches"},
+				Ingress: NetPolDecision{Allowed: true, Reason: "External destination"},
+			},
+		},
+		"egressIPBlock": {
+			src:  fe,
+			dst:  npEndpoint{ip: []byte{10, 1, 2, 3}},
+			port: "443",
+			pols: []netv1.NetworkPolicy{fePolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Allowed: true, Policies: []string{"default/fe#1"}, Reason: "Allowed by matching rules"},
+				Ingress: NetPolDecision{Allowed: true, Reason: "External destination"},
+			},
+		},
+		"egressExcept": {
+			src:  fe,
+			dst:  npEndpoint{ip: []byte{10, 2, 0, 1}},
+			port: "443",
+			pols: []netv1.NetworkPolicy{fePolicy()},
+			e: NetPolVerdict{
+				Egress:  NetPolDecision{Policies: []string{"default/fe"}, Reason: "Isolated and no rule matches"},
+				Ingress: NetPolDecision{Allowed: true, Reason: "External destination"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := probe(u.src, u.dst, u.port, "TCP", u.pols)
+			assert.Equal(t, u.e, v)
+			assert.Equal(t, u.e.Egress.Allowed && u.e.Ingress.Allowed, v.Allowed())
+		})
+	}
+}
+
+func TestPodNetRules(t *testing.T) {
+	db := makeNetPod("db", "default", "10.0.0.2", map[string]string{"app": "db"})
+
+	assert.Equal(t, []render.NetPolRuleRes{
+		{
+			Direction: IngressDirection,
+			Policy:    "default/db",
+			Peer:      "ns:default pods:app=fe",
+			Ports:     "TCP:5432",
+			Verdict:   render.AllowVerdict,
+			Reason:    "Rule #1",
+			Index:     1,
+		},
+		{
+			Direction: IngressDirection,
+			Policy:    "default/db",
+			Peer:      "ns:team=ops pods:*",
+			Ports:     "*",
+			Verdict:   render.AllowVerdict,
+			Reason:    "Rule #2",
+			Index:     2,
+		},
+		{
+			Direction: IngressDirection,
+			Policy:    "default/db",
+			Peer:      "*",
+			Ports:     "*",
+			Verdict:   render.DenyVerdict,
+			Reason:    "Isolated. Unmatched traffic is denied",
+		},
+		{
+			Direction: EgressDirection,
+			Peer:      "*",
+			Ports:     "*",
+			Verdict:   render.AllowVerdict,
+			Reason:    "Not isolated",
+		},
+	}, podNetRules(db, []netv1.NetworkPolicy{dbPolicy(), fePolicy()}))
+}
+
+// Helpers...
+
+func makeNetPod(n, ns, ip string, ll map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns, Labels: ll},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  "c1",
+					Ports: []v1.ContainerPort{{Name: "pg", ContainerPort: 5432}},
+				},
+			},
+		},
+		Status: v1.PodStatus{PodIP: ip},
+	}
+}
+
+func dbPolicy() netv1.NetworkPolicy {
+	pg := intstr.FromInt(5432)
+	return netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Ingress: []netv1.NetworkPolicyIngressRule{
+				{
+					From:  []netv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fe"}}}},
+					Ports: []netv1.NetworkPolicyPort{{Port: &pg}},
+				},
+				{
+					From: []netv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ops"}}}},
+				},
+			},
+		},
+	}
+}
+
+func fePolicy() netv1.NetworkPolicy {
+	return netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "fe", Namespace: "default"},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "fe"}},
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+			Egress: []netv1.NetworkPolicyEgressRule{
+				{
+					To: []netv1.NetworkPolicyPeer{{IPBlock: &netv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.2.0.0/16"}}}},
+				},
+			},
+		},
+	}
+}
@@ -3,7 +3,7 @@ package dao
 import (
 	"context"
 	"fmt"
-	"io"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -14,7 +14,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -23,6 +22,7 @@ import (
 var (
 	_ Accessor       = (*Node)(nil)
 	_ NodeMaintainer = (*Node)(nil)
+	_ Tainter        = (*Node)(nil)
 )
 
 // NodeMetricsFunc retrieves node metrics.
@@ -58,7 +58,7 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 		return err
 	}
 
-	err, patchErr := h.PatchOrReplace(dial, false)
+	err, patchErr := h.PatchOrReplace(dial, IsDryRun())
 	if patchErr != nil {
 		return patchErr
 	}
@@ -69,45 +69,6 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 	return nil
 }
 
-func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
-	return drain.Helper{
-		Client:              k,
-		GracePeriodSeconds:  o.GracePeriodSeconds,
-		Timeout:             o.Timeout,
-		DeleteLocalData:     o.DeleteLocalData,
-		IgnoreAllDaemonSets: o.IgnoreAllDaemonSets,
-		Out:                 w,
-		ErrOut:              w,
-	}
-}
-
-// Drain drains a node.
-func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
-	_ = n.ToggleCordon(path, true)
-
-	dial, err := n.Factory.Client().Dial()
-	if err != nil {
-		return err
-	}
-	h := opts.toDrainHelper(dial, w)
-	dd, errs := h.GetPodsForDeletion(path)
-	if len(errs) != 0 {
-		for _, e := range errs {
-			if _, err := h.ErrOut.Write([]byte(e.Error() + "\n")); err != nil {
-				return err
-			}
-		}
-		return errs[0]
-	}
-
-	if err := h.DeleteOrEvictPods(dd.Pods()); err != nil {
-		return err
-	}
-	fmt.Fprintf(h.Out, "Node %s drained!", path)
-
-	return nil
-}
-
 // Get returns a node resource.
 func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 	var (
@@ -138,11 +99,14 @@ func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 // List returns a collection of node resources.
 func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	var (
-		nmx *mv1beta1.NodeMetricsList
-		err error
+		nmx   *mv1beta1.NodeMetricsList
+		stale time.Duration
+		err   error
 	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		if nmx, err = client.DialMetrics(n.Client()).FetchNodesMetrics(ctx); err != nil {
+		if cache, ok := ctx.Value(internal.KeyMetricsCache).(MetricsCache); ok && cache != nil {
+			nmx, stale = cache.NodesMetrics()
+		} else if nmx, err = client.DialMetrics(n.Client()).FetchNodesMetrics(ctx); err != nil {
 			log.Warn().Err(err).Msgf("No node metrics")
 		}
 	}
@@ -167,9 +131,10 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return nil, err
 		}
 		oo[i] = &render.NodeWithMetrics{
-			Raw:  &unstructured.Unstructured{Object: o},
-			MX:   nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
-			Pods: pods,
+			Raw:      &unstructured.Unstructured{Object: o},
+			MX:       nodeMetricsFor(MetaFQN(no.ObjectMeta), nmx),
+			Pods:     pods,
+			StaleFor: stale,
 		}
 	}
 
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Notification represents a watched resource status change.
+type Notification struct {
+	Time    time.Time `json:"time"`
+	Context string    `json:"context"`
+	Cluster string    `json:"cluster"`
+	GVR     string    `json:"gvr"`
+	Path    string    `json:"path"`
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+}
+
+// Title returns a notification title.
+func (n Notification) Title() string {
+	return "K9s " + n.Context
+}
+
+// Message returns a notification message.
+func (n Notification) Message() string {
+	if n.From == "" {
+		return fmt.Sprintf("%s %s: %s", n.GVR, n.Path, n.To)
+	}
+	return fmt.Sprintf("%s %s: %s -> %s", n.GVR, n.Path, n.From, n.To)
+}
+
+// Notifier dispatches notifications to the desktop and/or a webhook.
+type Notifier struct {
+	desktop bool
+	webhook string
+	client  *http.Client
+}
+
+// NewNotifier returns a new notifier.
+func NewNotifier(desktop bool, webhook string) *Notifier {
+	return &Notifier{
+		desktop: desktop,
+		webhook: webhook,
+		client:  &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Notify dispatches a notification in the background.
+func (n *Notifier) Notify(e Notification) {
+	if n.desktop {
+		go func() {
+			if err := desktopNotify(e.Title(), e.Message()); err != nil {
+				log.Warn().Err(err).Msgf("Desktop notification failed")
+			}
+		}()
+	}
+	if n.webhook == "" {
+		return
+	}
+	raw, err := json.Marshal(e)
+	if err != nil {
+		log.Error().Err(err).Msgf("Notification marshal failed")
+		return
+	}
+	go func() {
+		if err := postWebhook(n.client, n.webhook, raw); err != nil {
+			log.Warn().Err(err).Msgf("Notification webhook failed")
+		}
+	}()
+}
+
+// Helpers...
+
+func desktopNotify(title, msg string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptQuote(msg), appleScriptQuote(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux", "freebsd", "openbsd", "netbsd":
+		cmd = exec.Command("notify-send", title, msg)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	return cmd.Run()
+}
+
+// appleScriptQuote returns an AppleScript string literal. Only backslashes and
+// double quotes need escaping.
+func appleScriptQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
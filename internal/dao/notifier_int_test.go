@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppleScriptQuote(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain": {
+			s: "Pod fred is Running",
+			e: `"Pod fred is Running"`,
+		},
+		"quotes": {
+			s: `say "hi"`,
+			e: `"say \"hi\""`,
+		},
+		"backslash": {
+			s: `C:\blee`,
+			e: `"C:\\blee"`,
+		},
+		"unicode": {
+			s: "fred ✓\tblee",
+			e: "\"fred ✓\tblee\"",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, appleScriptQuote(u.s))
+		})
+	}
+}
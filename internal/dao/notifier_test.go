@@ -0,0 +1,42 @@
+package dao_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationMessage(t *testing.T) {
+	n := dao.Notification{Context: "fred", GVR: "v1/pods", Path: "default/p1", From: "Running", To: "Error"}
+
+	assert.Equal(t, "K9s fred", n.Title())
+	assert.Equal(t, "v1/pods default/p1: Running -> Error", n.Message())
+
+	n.From = ""
+	assert.Equal(t, "v1/pods default/p1: Error", n.Message())
+}
+
+func TestNotifierWebhook(t *testing.T) {
+	got := make(chan dao.Notification, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var n dao.Notification
+		_ = json.NewDecoder(r.Body).Decode(&n)
+		got <- n
+	}))
+	defer srv.Close()
+
+	dao.NewNotifier(false, srv.URL).Notify(dao.Notification{GVR: "batch/v1/jobs", Path: "default/j1", From: "0/1", To: "1/1"})
+
+	select {
+	case n := <-got:
+		assert.Equal(t, "default/j1", n.Path)
+		assert.Equal(t, "1/1", n.To)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "webhook not called")
+	}
+}
@@ -0,0 +1,253 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*NSDiff)(nil)
+
+// nsDiffGVRs tracks the resources compared when none are specified.
+var nsDiffGVRs = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1beta1/cronjobs",
+	"v1/services",
+	"v1/configmaps",
+	"v1/serviceaccounts",
+	"extensions/v1beta1/ingresses",
+	"networking.k8s.io/v1beta1/ingresses",
+	"networking.k8s.io/v1/networkpolicies",
+}
+
+// nsDiffMetaFields tracks metadata fields managed by the cluster.
+var nsDiffMetaFields = []string{
+	"namespace",
+	"uid",
+	"resourceVersion",
+	"generation",
+	"selfLink",
+	"creationTimestamp",
+	"managedFields",
+}
+
+// nsDiffAnnotations tracks annotations managed by the cluster.
+var nsDiffAnnotations = []string{
+	lastAppliedKey,
+	"deployment.kubernetes.io/revision",
+}
+
+// NSDiffQuery represents a comparison of two namespaces resources.
+type NSDiffQuery struct {
+	Left, Right string
+	GVRs        client.GVRs
+}
+
+// String returns the query as left<>right.
+func (q NSDiffQuery) String() string {
+	return q.Left + "<>" + q.Right
+}
+
+// NSDiff represents resources differing between two namespaces.
+type NSDiff struct {
+	NonResource
+}
+
+// List returns all resources missing in either namespace or differing in spec.
+func (n *NSDiff) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyNSDiff).(NSDiffQuery)
+	if !ok {
+		return nil, errors.New("expecting a context nsdiff query")
+	}
+	dial, err := n.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+	gvrs := q.GVRs
+	if len(gvrs) == 0 {
+		gvrs = nsDiffTargets(MetaAccess)
+	}
+
+	var (
+		wg sync.WaitGroup
+		mx sync.Mutex
+		oo []runtime.Object
+	)
+	for _, gvr := range gvrs {
+		wg.Add(1)
+		go func(gvr client.GVR) {
+			defer wg.Done()
+			rr, err := n.compare(ctx, dial, gvr, q.Left, q.Right)
+			if err != nil {
+				log.Debug().Err(err).Msgf("NSDiff failed for %q", gvr)
+				return
+			}
+			mx.Lock()
+			for _, r := range rr {
+				oo = append(oo, r)
+			}
+			mx.Unlock()
+		}(gvr)
+	}
+	wg.Wait()
+
+	return oo, nil
+}
+
+// Manifests returns the comparable manifests of a resource in both
+// namespaces. A missing resource yields a blank manifest.
+func (n *NSDiff) Manifests(ctx context.Context, gvr client.GVR, name, left, right string) (string, string, error) {
+	dial, err := n.Client().DynDial()
+	if err != nil {
+		return "", "", err
+	}
+	var mm [2]string
+	for i, ns := range []string{left, right} {
+		cctx, cancel := context.WithTimeout(ctx, n.Client().Config().CallTimeout())
+		o, err := dial.Resource(gvr.GVR()).Namespace(ns).Get(cctx, name, metav1.GetOptions{})
+		cancel()
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return "", "", err
+		}
+		if mm[i], err = nsDiffManifest(o); err != nil {
+			return "", "", err
+		}
+	}
+
+	return mm[0], mm[1], nil
+}
+
+func (n *NSDiff) compare(ctx context.Context, dial dynamic.Interface, gvr client.GVR, left, right string) ([]render.NSDiffRes, error) {
+	var ll [2][]unstructured.Unstructured
+	for i, ns := range []string{left, right} {
+		cctx, cancel := context.WithTimeout(ctx, n.Client().Config().CallTimeout())
+		l, err := dial.Resource(gvr.GVR()).Namespace(ns).List(cctx, metav1.ListOptions{})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		ll[i] = l.Items
+	}
+
+	return nsDiff(gvr.String(), left, right, ll[0], ll[1])
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nsDiffTargets returns the default resources available on the cluster.
+func nsDiffTargets(m *Meta) client.GVRs {
+	gvrs := make(client.GVRs, 0, len(nsDiffGVRs))
+	for _, s := range nsDiffGVRs {
+		gvr := client.NewGVR(s)
+		meta, err := m.MetaFor(gvr)
+		if err != nil || !canList(meta.Verbs) {
+			continue
+		}
+		gvrs = append(gvrs, gvr)
+	}
+
+	return gvrs
+}
+
+// nsDiff lists resources by name missing on either side or differing.
+func nsDiff(gvr, left, right string, ll, rr []unstructured.Unstructured) ([]render.NSDiffRes, error) {
+	lm, err := nsDiffIndex(ll)
+	if err != nil {
+		return nil, err
+	}
+	rm, err := nsDiffIndex(rr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]render.NSDiffRes, 0, len(lm)+len(rm))
+	for n, l := range lm {
+		r, ok := rm[n]
+		switch {
+		case !ok:
+			res = append(res, render.NSDiffRes{GVR: gvr, Name: n, Left: left, Status: render.NSDiffMissing})
+		case l != r:
+			res = append(res, render.NSDiffRes{GVR: gvr, Name: n, Left: left, Right: right, Status: render.NSDiffChanged})
+		}
+	}
+	for n := range rm {
+		if _, ok := lm[n]; !ok {
+			res = append(res, render.NSDiffRes{GVR: gvr, Name: n, Right: right, Status: render.NSDiffMissing})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+// nsDiffIndex returns comparable manifests by name. Resources owned by
+// another resource are skipped as they get generated.
+func nsDiffIndex(oo []unstructured.Unstructured) (map[string]string, error) {
+	m := make(map[string]string, len(oo))
+	for i := range oo {
+		if len(oo[i].GetOwnerReferences()) > 0 {
+			continue
+		}
+		s, err := nsDiffManifest(&oo[i])
+		if err != nil {
+			return nil, err
+		}
+		m[oo[i].GetName()] = s
+	}
+
+	return m, nil
+}
+
+// nsDiffManifest returns a resource manifest stripped of its namespace,
+// status and cluster managed fields.
+func nsDiffManifest(o *unstructured.Unstructured) (string, error) {
+	u := o.DeepCopy()
+	for _, f := range nsDiffMetaFields {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+	if aa := u.GetAnnotations(); len(aa) > 0 {
+		for _, k := range nsDiffAnnotations {
+			delete(aa, k)
+		}
+		if len(aa) == 0 {
+			aa = nil
+		}
+		u.SetAnnotations(aa)
+	}
+	unstructured.RemoveNestedField(u.Object, "status")
+	switch u.GetKind() {
+	case "Service":
+		unstructured.RemoveNestedField(u.Object, "spec", "clusterIP")
+		unstructured.RemoveNestedField(u.Object, "spec", "clusterIPs")
+	case "ServiceAccount":
+		unstructured.RemoveNestedField(u.Object, "secrets")
+	}
+
+	raw, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal %s: %w", o.GetName(), err)
+	}
+
+	return string(raw), nil
+}
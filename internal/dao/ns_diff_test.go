@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNSDiffQueryString(t *testing.T) {
+	assert.Equal(t, "dev<>prod", NSDiffQuery{Left: "dev", Right: "prod"}.String())
+}
+
+func TestNSDiffTargets(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("apps/v1/deployments", metav1.APIResource{Name: "deployments", Namespaced: true, Verbs: []string{"get", "list"}})
+	m.RegisterMeta("v1/configmaps", metav1.APIResource{Name: "configmaps", Namespaced: true, Verbs: []string{"get"}})
+
+	assert.Equal(t, client.GVRs{client.NewGVR("apps/v1/deployments")}, nsDiffTargets(m))
+}
+
+func TestNSDiff(t *testing.T) {
+	left := []unstructured.Unstructured{
+		makeNSDiffCM("dev", "same", "1", map[string]string{lastAppliedKey: "{}"}),
+		makeNSDiffCM("dev", "changed", "1", nil),
+		makeNSDiffCM("dev", "devOnly", "1", nil),
+		makeNSDiffOwned(makeNSDiffCM("dev", "owned", "1", nil)),
+	}
+	right := []unstructured.Unstructured{
+		makeNSDiffCM("prod", "same", "1", nil),
+		makeNSDiffCM("prod", "changed", "2", nil),
+		makeNSDiffCM("prod", "prodOnly", "1", nil),
+	}
+
+	rr, err := nsDiff("v1/configmaps", "dev", "prod", left, right)
+	assert.Nil(t, err)
+	assert.Equal(t, []render.NSDiffRes{
+		{GVR: "v1/configmaps", Name: "changed", Left: "dev", Right: "prod", Status: render.NSDiffChanged},
+		{GVR: "v1/configmaps", Name: "devOnly", Left: "dev", Status: render.NSDiffMissing},
+		{GVR: "v1/configmaps", Name: "prodOnly", Right: "prod", Status: render.NSDiffMissing},
+	}, rr)
+}
+
+func TestNSDiffManifest(t *testing.T) {
+	o := makeNSDiffCM("dev", "fred", "1", map[string]string{lastAppliedKey: "{}", "team": "blee"})
+	o.SetUID("abc")
+	o.SetResourceVersion("10")
+	o.Object["status"] = map[string]interface{}{"ready": true}
+
+	s, err := nsDiffManifest(&o)
+	assert.Nil(t, err)
+	assert.Equal(t, `apiVersion: v1
+data:
+  v: "1"
+kind: ConfigMap
+metadata:
+  annotations:
+    team: blee
+  name: fred
+`, s)
+}
+
+// Helpers...
+
+func makeNSDiffCM(ns, n, v string, aa map[string]string) unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"data":       map[string]interface{}{"v": v},
+	}}
+	o.SetNamespace(ns)
+	o.SetName(n)
+	o.SetAnnotations(aa)
+
+	return o
+}
+
+func makeNSDiffOwned(o unstructured.Unstructured) unstructured.Unstructured {
+	o.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Deployment", Name: "fred"}})
+	return o
+}
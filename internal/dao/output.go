@@ -0,0 +1,121 @@
+package dao
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// Manifest output formats.
+const (
+	YAMLFormat  = "yaml"
+	JSONFormat  = "json"
+	QueryFormat = "query"
+)
+
+// ToJSON converts a resource to its indented JSON representation.
+func ToJSON(o runtime.Object, showManaged bool) (string, error) {
+	m, err := objectMap(o, showManaged)
+	if err != nil {
+		return "", err
+	}
+	raw, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// CompileQuery parses a JSONPath or a jq like expression ie
+// `.spec.containers[*].image` or `.spec.containers[].name`.
+func CompileQuery(expr string) (*jsonpath.JSONPath, error) {
+	p := jsonpath.New("query").AllowMissingKeys(true)
+	if err := p.Parse(queryExpr(expr)); err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	return p, nil
+}
+
+// QueryObject returns the YAML representation of the resource subtrees
+// matching a query.
+func QueryObject(o runtime.Object, expr string, showManaged bool) (string, error) {
+	p, err := CompileQuery(expr)
+	if err != nil {
+		return "", err
+	}
+	m, err := objectMap(o, showManaged)
+	if err != nil {
+		return "", err
+	}
+	rr, err := p.FindResults(m)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		out    []string
+		nested bool
+	)
+	for _, r := range rr {
+		for _, v := range r {
+			if !v.IsValid() || !v.CanInterface() {
+				continue
+			}
+			raw, err := yaml.Marshal(v.Interface())
+			if err != nil {
+				return "", err
+			}
+			s := strings.TrimRight(string(raw), "\n")
+			nested = nested || strings.Contains(s, "\n")
+			out = append(out, s)
+		}
+	}
+	if nested {
+		return strings.Join(out, "\n---\n"), nil
+	}
+
+	return strings.Join(out, "\n"), nil
+}
+
+func queryExpr(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") && strings.HasSuffix(expr, "}") {
+		return expr
+	}
+	if expr == "" || expr == "." {
+		return "{@}"
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+
+	return "{" + strings.ReplaceAll(expr, "[]", "[*]") + "}"
+}
+
+func objectMap(o runtime.Object, showManaged bool) (map[string]interface{}, error) {
+	if o == nil {
+		return nil, errors.New("no object to convert")
+	}
+	var m map[string]interface{}
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		m = u.Object
+	} else {
+		var err error
+		if m, err = runtime.DefaultUnstructuredConverter.ToUnstructured(o); err != nil {
+			return nil, err
+		}
+	}
+	if !showManaged {
+		m = withoutManagedFields(m)
+	}
+
+	return m, nil
+}
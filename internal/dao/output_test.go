@@ -0,0 +1,96 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestToJSON(t *testing.T) {
+	uu := map[string]struct {
+		managed bool
+		e       string
+	}{
+		"managed": {
+			managed: true,
+			e:       "{\n  \"kind\": \"Pod\",\n  \"metadata\": {\n    \"managedFields\": [\n      \"fred\"\n    ],\n    \"name\": \"p1\"\n  },\n  \"spec\": {\n    \"containers\": [\n      {\n        \"image\": \"nginx\",\n        \"name\": \"c1\"\n      },\n      {\n        \"image\": \"busybox\",\n        \"name\": \"c2\"\n      }\n    ]\n  }\n}",
+		},
+		"unmanaged": {
+			e: "{\n  \"kind\": \"Pod\",\n  \"metadata\": {\n    \"name\": \"p1\"\n  },\n  \"spec\": {\n    \"containers\": [\n      {\n        \"image\": \"nginx\",\n        \"name\": \"c1\"\n      },\n      {\n        \"image\": \"busybox\",\n        \"name\": \"c2\"\n      }\n    ]\n  }\n}",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := dao.ToJSON(makeQueryPod(), u.managed)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestQueryObject(t *testing.T) {
+	uu := map[string]struct {
+		q   string
+		e   string
+		err bool
+	}{
+		"scalar": {
+			q: ".metadata.name",
+			e: "p1",
+		},
+		"jsonpath": {
+			q: "{.spec.containers[*].image}",
+			e: "nginx\nbusybox",
+		},
+		"jq": {
+			q: ".spec.containers[].name",
+			e: "c1\nc2",
+		},
+		"noDot": {
+			q: "spec.containers[0].name",
+			e: "c1",
+		},
+		"subtree": {
+			q: ".spec.containers[]",
+			e: "image: nginx\nname: c1\n---\nimage: busybox\nname: c2",
+		},
+		"missing": {
+			q: ".spec.fred",
+		},
+		"invalid": {
+			q:   ".spec.containers[",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := dao.QueryObject(makeQueryPod(), u.q, false)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+// Helpers...
+
+func makeQueryPod() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Pod",
+		"metadata": map[string]interface{}{
+			"name":          "p1",
+			"managedFields": []interface{}{"fred"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "nginx"},
+				map[string]interface{}{"name": "c2", "image": "busybox"},
+			},
+		},
+	}}
+}
@@ -0,0 +1,242 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+var pagedLists = NewPageCache()
+
+// ListProgress tracks a paginated list loading progress.
+type ListProgress struct {
+	// Loaded tracks the number of items listed so far.
+	Loaded int
+
+	// Total tracks the estimated collection size or 0 if unknown.
+	Total int
+
+	Done bool
+}
+
+// pagedList tracks a resource collection listed one api page at a time.
+type pagedList struct {
+	oo        []runtime.Object
+	remaining int64
+	done      bool
+	running   bool
+	mx        sync.RWMutex
+}
+
+func (l *pagedList) add(ll *unstructured.UnstructuredList) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for i := range ll.Items {
+		l.oo = append(l.oo, &ll.Items[i])
+	}
+	if ll.GetRemainingItemCount() != nil {
+		l.remaining = *ll.GetRemainingItemCount()
+	}
+	if ll.GetContinue() == "" {
+		l.done, l.remaining = true, 0
+	}
+}
+
+func (l *pagedList) stop() {
+	l.mx.Lock()
+	l.running = false
+	l.mx.Unlock()
+}
+
+func (l *pagedList) items() []runtime.Object {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	oo := make([]runtime.Object, len(l.oo))
+	copy(oo, l.oo)
+
+	return oo
+}
+
+func (l *pagedList) progress() ListProgress {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	p := ListProgress{Loaded: len(l.oo), Done: l.done}
+	if l.remaining > 0 {
+		p.Total = p.Loaded + int(l.remaining)
+	}
+
+	return p
+}
+
+// PageCache tracks resource collections listed in api pages while their
+// informer cache loads.
+type PageCache struct {
+	lists map[string]*pagedList
+	mx    sync.Mutex
+}
+
+// NewPageCache returns a new paginated lists cache.
+func NewPageCache() *PageCache {
+	return &PageCache{lists: make(map[string]*pagedList)}
+}
+
+// Progress returns a collection paginated list progress if it is still
+// listed in pages.
+func (p *PageCache) Progress(key string) (ListProgress, bool) {
+	p.mx.Lock()
+	l, ok := p.lists[key]
+	p.mx.Unlock()
+	if !ok {
+		return ListProgress{}, false
+	}
+
+	return l.progress(), true
+}
+
+// Delete evicts a paginated list.
+func (p *PageCache) Delete(key string) {
+	p.mx.Lock()
+	delete(p.lists, key)
+	p.mx.Unlock()
+}
+
+// release evicts a paginated list unless it was since replaced.
+func (p *PageCache) release(key string, l *pagedList) {
+	p.mx.Lock()
+	if p.lists[key] == l {
+		delete(p.lists, key)
+	}
+	p.mx.Unlock()
+}
+
+// acquire returns a collection paginated list. It starts over when the last
+// listing was canceled before completion.
+func (p *PageCache) acquire(key string) (*pagedList, bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if l, ok := p.lists[key]; ok {
+		l.mx.RLock()
+		live := l.done || l.running
+		l.mx.RUnlock()
+		if live {
+			return l, false
+		}
+	}
+	l := pagedList{running: true}
+	p.lists[key] = &l
+
+	return &l, true
+}
+
+// PageProgress returns a resource paginated list progress for the context
+// labels.
+func PageProgress(ctx context.Context, gvr, ns string) (ListProgress, bool) {
+	sel, _ := ctx.Value(internal.KeyLabels).(string)
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+
+	return pagedLists.Progress(pageKey(gvr, ns, sel))
+}
+
+// listPages lists a resource in api pages until its informer syncs so large
+// collections show up incrementally instead of blocking on a full list. The
+// first page is fetched inline, the remaining pages in the background.
+func (r *Resource) listPages(ctx context.Context, ns, sel string) ([]runtime.Object, bool, error) {
+	size, _ := ctx.Value(internal.KeyPageSize).(int64)
+	s, ok := r.Factory.(CacheSyncer)
+	if size <= 0 || !ok {
+		return nil, false, nil
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	gvr := r.gvr.String()
+	key := pageKey(gvr, ns, sel)
+	synced, err := s.HasSynced(gvr, ns)
+	if err != nil {
+		return nil, false, err
+	}
+	if synced {
+		pagedLists.Delete(key)
+		return nil, false, nil
+	}
+
+	l, fresh := pagedLists.acquire(key)
+	if !fresh {
+		return l.items(), true, nil
+	}
+	dial, err := r.dynClient()
+	if err != nil {
+		pagedLists.release(key, l)
+		return nil, false, err
+	}
+	opts := metav1.ListOptions{LabelSelector: sel, Limit: size}
+	ll, err := listPage(ctx, dial, ns, opts)
+	if err != nil {
+		pagedLists.release(key, l)
+		return nil, false, err
+	}
+	l.add(ll)
+	go func() {
+		<-ctx.Done()
+		pagedLists.release(key, l)
+	}()
+	if opts.Continue = ll.GetContinue(); opts.Continue != "" {
+		go fetchPages(ctx, dial, l, gvr, ns, opts)
+	} else {
+		l.stop()
+	}
+
+	return l.items(), true, nil
+}
+
+// fetchPages lists a collection remaining pages. The listing stops on an
+// expired continue token and the informer takes over once synced.
+func fetchPages(ctx context.Context, dial dynamic.NamespaceableResourceInterface, l *pagedList, gvr, ns string, opts metav1.ListOptions) {
+	defer l.stop()
+	for opts.Continue != "" {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		ll, err := listPage(ctx, dial, ns, opts)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Paginated list of %s stopped", gvr)
+			l.mx.Lock()
+			l.done, l.remaining = true, 0
+			l.mx.Unlock()
+			return
+		}
+		l.add(ll)
+		opts.Continue = ll.GetContinue()
+	}
+}
+
+func listPage(ctx context.Context, dial dynamic.NamespaceableResourceInterface, ns string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if client.IsClusterScoped(ns) {
+		return dial.List(ctx, opts)
+	}
+
+	return dial.Namespace(ns).List(ctx, opts)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func pageKey(gvr, ns, sel string) string {
+	return strings.Join([]string{gvr, ns, sel}, "|")
+}
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPagedListAdd(t *testing.T) {
+	var l pagedList
+	l.add(makePage(2, "fred", 3))
+	assert.Equal(t, ListProgress{Loaded: 2, Total: 5}, l.progress())
+	assert.Equal(t, 2, len(l.items()))
+
+	l.add(makePage(3, "", 0))
+	assert.Equal(t, ListProgress{Loaded: 5, Done: true}, l.progress())
+}
+
+func TestPageCacheAcquire(t *testing.T) {
+	c := NewPageCache()
+	l, fresh := c.acquire("fred")
+	assert.True(t, fresh)
+	_, fresh = c.acquire("fred")
+	assert.False(t, fresh)
+
+	l.add(makePage(1, "blee", 0))
+	l.stop()
+	p, ok := c.Progress("fred")
+	assert.True(t, ok)
+	assert.Equal(t, ListProgress{Loaded: 1}, p)
+
+	l2, fresh := c.acquire("fred")
+	assert.True(t, fresh, "canceled lists start over")
+	c.release("fred", l)
+	_, ok = c.Progress("fred")
+	assert.True(t, ok, "replaced lists are not released")
+	c.release("fred", l2)
+	_, ok = c.Progress("fred")
+	assert.False(t, ok)
+}
+
+func TestPageProgress(t *testing.T) {
+	l, _ := pagedLists.acquire(pageKey("v1/pods", "", "app=fred"))
+	defer pagedLists.Delete(pageKey("v1/pods", "", "app=fred"))
+	l.add(makePage(1, "", 0))
+
+	ctx := context.WithValue(context.Background(), internal.KeyLabels, "app=fred")
+	p, ok := PageProgress(ctx, "v1/pods", "all")
+	assert.True(t, ok)
+	assert.Equal(t, ListProgress{Loaded: 1, Done: true}, p)
+	_, ok = PageProgress(context.Background(), "v1/pods", "all")
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func makePage(n int, cont string, remaining int64) *unstructured.UnstructuredList {
+	var ll unstructured.UnstructuredList
+	for i := 0; i < n; i++ {
+		ll.Items = append(ll.Items, unstructured.Unstructured{Object: map[string]interface{}{}})
+	}
+	ll.SetContinue(cont)
+	if remaining > 0 {
+		ll.SetRemainingItemCount(&remaining)
+	}
+
+	return &ll
+}
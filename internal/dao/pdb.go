@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const pdbGVR = "policy/v1beta1/poddisruptionbudgets"
+
+// DisruptionImpact represents the effect of removing pods on a disruption budget.
+type DisruptionImpact struct {
+	PDB     string
+	Pods    []string
+	Allowed int32
+	Healthy int32
+	Desired int32
+}
+
+// Exceeded returns true if more pods are removed than the budget allows.
+func (i DisruptionImpact) Exceeded() bool {
+	return int32(len(i.Pods)) > i.Allowed
+}
+
+// String returns the impact summary.
+func (i DisruptionImpact) String() string {
+	return fmt.Sprintf("PDB %s: %d pod(s) affected, %d disruption(s) allowed (%d/%d healthy)",
+		i.PDB, len(i.Pods), i.Allowed, i.Healthy, i.Desired)
+}
+
+// DisruptionImpacts computes the budgets affected by the removal of the given pods.
+func DisruptionImpacts(pdbs []v1beta1.PodDisruptionBudget, pods []*v1.Pod) []DisruptionImpact {
+	var ii []DisruptionImpact
+	for _, pdb := range pdbs {
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || sel.Empty() {
+			continue
+		}
+		i := DisruptionImpact{
+			PDB:     client.MetaFQN(pdb.ObjectMeta),
+			Allowed: pdb.Status.DisruptionsAllowed,
+			Healthy: pdb.Status.CurrentHealthy,
+			Desired: pdb.Status.DesiredHealthy,
+		}
+		for _, po := range pods {
+			if po.Namespace == pdb.Namespace && sel.Matches(labels.Set(po.Labels)) {
+				i.Pods = append(i.Pods, client.MetaFQN(po.ObjectMeta))
+			}
+		}
+		if len(i.Pods) > 0 {
+			ii = append(ii, i)
+		}
+	}
+	sort.Slice(ii, func(a, b int) bool {
+		return ii[a].PDB < ii[b].PDB
+	})
+
+	return ii
+}
+
+// FetchDisruptionImpacts lists the budgets in the pods namespaces and
+// computes the impact of removing the pods.
+func FetchDisruptionImpacts(f Factory, pods []*v1.Pod) ([]DisruptionImpact, error) {
+	nss := make(map[string]struct{})
+	for _, po := range pods {
+		nss[po.Namespace] = struct{}{}
+	}
+
+	var pdbs []v1beta1.PodDisruptionBudget
+	for ns := range nss {
+		oo, err := f.List(pdbGVR, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			var pdb v1beta1.PodDisruptionBudget
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pdb); err != nil {
+				return nil, err
+			}
+			pdbs = append(pdbs, pdb)
+		}
+	}
+
+	return DisruptionImpacts(pdbs, pods), nil
+}
+
+// ImpactsString returns a disruption impacts report.
+func ImpactsString(ii []DisruptionImpact, exceeded string) string {
+	ss := make([]string, 0, len(ii))
+	for _, i := range ii {
+		s := i.String()
+		if i.Exceeded() {
+			s += " -> " + exceeded
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+// DeleteImpacts computes the budgets affected by deleting the given pods.
+func (p *Pod) DeleteImpacts(paths []string) ([]DisruptionImpact, error) {
+	pods := make([]*v1.Pod, 0, len(paths))
+	for _, path := range paths {
+		po, err := p.GetInstance(path)
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	return FetchDisruptionImpacts(p.Factory, pods)
+}
+
+// DrainImpacts computes the budgets affected by evicting a node pods.
+// DaemonSet and mirror pods are not evicted and thus skipped.
+func (n *Node) DrainImpacts(path string) ([]DisruptionImpact, error) {
+	pp, err := n.GetPods(path)
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*v1.Pod, 0, len(pp))
+	for _, po := range pp {
+		if isEvictable(po) {
+			pods = append(pods, po)
+		}
+	}
+
+	return FetchDisruptionImpacts(n.Factory, pods)
+}
+
+// Helpers...
+
+func isEvictable(po *v1.Pod) bool {
+	if isMirrorPod(po) {
+		return false
+	}
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+		return false
+	}
+	if ref := metav1.GetControllerOf(po); ref != nil && ref.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDisruptionImpacts(t *testing.T) {
+	pdbs := []v1beta1.PodDisruptionBudget{
+		makePDB("default", "fred", map[string]string{"app": "fred"}, 1),
+		makePDB("default", "blee", map[string]string{"app": "blee"}, 0),
+		makePDB("ns1", "fred", map[string]string{"app": "fred"}, 5),
+		makePDB("default", "none", nil, 1),
+	}
+
+	uu := map[string]struct {
+		pods []*v1.Pod
+		e    []string
+		x    []bool
+	}{
+		"none": {
+			pods: []*v1.Pod{makePDBPod("default", "p1", "zorg")},
+		},
+		"allowed": {
+			pods: []*v1.Pod{makePDBPod("default", "p1", "fred")},
+			e:    []string{"PDB default/fred: 1 pod(s) affected, 1 disruption(s) allowed (3/2 healthy)"},
+			x:    []bool{false},
+		},
+		"exceeded": {
+			pods: []*v1.Pod{
+				makePDBPod("default", "p1", "fred"),
+				makePDBPod("default", "p2", "fred"),
+				makePDBPod("default", "p3", "blee"),
+			},
+			e: []string{
+				"PDB default/blee: 1 pod(s) affected, 0 disruption(s) allowed (3/2 healthy)",
+				"PDB default/fred: 2 pod(s) affected, 1 disruption(s) allowed (3/2 healthy)",
+			},
+			x: []bool{true, true},
+		},
+		"otherNS": {
+			pods: []*v1.Pod{makePDBPod("ns1", "p1", "fred")},
+			e:    []string{"PDB ns1/fred: 1 pod(s) affected, 5 disruption(s) allowed (3/2 healthy)"},
+			x:    []bool{false},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii := dao.DisruptionImpacts(pdbs, u.pods)
+			assert.Equal(t, len(u.e), len(ii))
+			for i := range ii {
+				assert.Equal(t, u.e[i], ii[i].String())
+				assert.Equal(t, u.x[i], ii[i].Exceeded())
+			}
+		})
+	}
+}
+
+func TestImpactsString(t *testing.T) {
+	ii := []dao.DisruptionImpact{
+		{PDB: "default/fred", Pods: []string{"default/p1", "default/p2"}, Allowed: 1, Healthy: 2, Desired: 1},
+		{PDB: "default/blee", Pods: []string{"default/p3"}, Allowed: 1, Healthy: 2, Desired: 1},
+	}
+
+	assert.Equal(t, "PDB default/fred: 2 pod(s) affected, 1 disruption(s) allowed (2/1 healthy) -> BLOCKED\nPDB default/blee: 1 pod(s) affected, 1 disruption(s) allowed (2/1 healthy)", dao.ImpactsString(ii, "BLOCKED"))
+}
+
+// Helpers...
+
+func makePDB(ns, n string, sel map[string]string, allowed int32) v1beta1.PodDisruptionBudget {
+	pdb := v1beta1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n},
+		Status: v1beta1.PodDisruptionBudgetStatus{
+			DisruptionsAllowed: allowed,
+			CurrentHealthy:     3,
+			DesiredHealthy:     2,
+		},
+	}
+	if sel != nil {
+		pdb.Spec.Selector = &metav1.LabelSelector{MatchLabels: sel}
+	}
+
+	return pdb
+}
+
+func makePDBPod(ns, n, app string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      n,
+			Labels:    map[string]string{"app": app},
+		},
+	}
+}
@@ -0,0 +1,236 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PluginJob)(nil)
+
+const (
+	// maxPluginJobs tracks the number of jobs retained once completed.
+	maxPluginJobs = 50
+
+	// maxJobOutput caps a job output size. Older output is dropped.
+	maxJobOutput = 1024 * 1024
+)
+
+// PluginJobLister represents a source of plugin jobs.
+type PluginJobLister interface {
+	// Jobs returns all tracked jobs.
+	Jobs() []render.PluginJobRes
+}
+
+// PluginJob represents plugin background jobs.
+type PluginJob struct {
+	NonResource
+}
+
+// List returns a collection of plugin jobs.
+func (p *PluginJob) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	l, ok := ctx.Value(internal.KeyPluginJobs).(PluginJobLister)
+	if !ok || l == nil {
+		return nil, errors.New("expecting a context plugin jobs lister")
+	}
+
+	jj := l.Jobs()
+	oo := make([]runtime.Object, 0, len(jj))
+	for _, j := range jj {
+		oo = append(oo, j)
+	}
+
+	return oo, nil
+}
+
+// PluginJobs tracks plugin commands running in the background.
+type PluginJobs struct {
+	jobs []*pluginJob
+	seq  int
+	mx   sync.RWMutex
+}
+
+type pluginJob struct {
+	render.PluginJobRes
+
+	out      *jobOutput
+	cancelFn context.CancelFunc
+	canceled bool
+}
+
+// NewPluginJobs returns a new jobs tracker.
+func NewPluginJobs() *PluginJobs {
+	return &PluginJobs{}
+}
+
+// Start runs a plugin command in the background. The done callback is
+// called once the command exits.
+func (p *PluginJobs) Start(plugin, bin string, args []string, done func(render.PluginJobRes)) string {
+	ctx, cancel := context.WithCancel(context.Background())
+	j := p.add(plugin, strings.TrimSpace(bin+" "+strings.Join(args, " ")), cancel)
+
+	log.Debug().Msgf("Running plugin job %s> %s", j.ID, j.Command)
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Stdout, cmd.Stderr = j.out, j.out
+	if err := cmd.Start(); err != nil {
+		cancel()
+		done(p.finish(j, err))
+		return j.ID
+	}
+	go func() {
+		err := cmd.Wait()
+		cancel()
+		done(p.finish(j, err))
+	}()
+
+	return j.ID
+}
+
+// Cancel terminates a running job.
+func (p *PluginJobs) Cancel(id string) error {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	j, ok := p.find(id)
+	if !ok {
+		return fmt.Errorf("no plugin job %q found", id)
+	}
+	if !j.IsRunning() {
+		return fmt.Errorf("plugin job %q is not running", id)
+	}
+	j.canceled = true
+	j.cancelFn()
+
+	return nil
+}
+
+// Output returns a job output so far.
+func (p *PluginJobs) Output(id string) (string, error) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	j, ok := p.find(id)
+	if !ok {
+		return "", fmt.Errorf("no plugin job %q found", id)
+	}
+
+	return j.out.String(), nil
+}
+
+// Jobs returns all tracked jobs.
+func (p *PluginJobs) Jobs() []render.PluginJobRes {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	jj := make([]render.PluginJobRes, 0, len(p.jobs))
+	for _, j := range p.jobs {
+		jj = append(jj, j.PluginJobRes)
+	}
+
+	return jj
+}
+
+func (p *PluginJobs) add(plugin, cmd string, cancel context.CancelFunc) *pluginJob {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.seq++
+	j := pluginJob{
+		PluginJobRes: render.PluginJobRes{
+			ID:      fmt.Sprintf("%s-%d", plugin, p.seq),
+			Plugin:  plugin,
+			Command: cmd,
+			Status:  render.JobRunning,
+			Started: time.Now(),
+		},
+		out:      newJobOutput(maxJobOutput),
+		cancelFn: cancel,
+	}
+	p.jobs = append(p.jobs, &j)
+	p.prune()
+
+	return &j
+}
+
+func (p *PluginJobs) finish(j *pluginJob, err error) render.PluginJobRes {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	j.Ended, j.Status = time.Now(), render.JobSucceeded
+	switch {
+	case j.canceled:
+		j.Status = render.JobCanceled
+	case err != nil:
+		j.Status, j.Error = render.JobFailed, err.Error()
+	}
+
+	return j.PluginJobRes
+}
+
+// prune drops the oldest completed jobs past the retention limit.
+func (p *PluginJobs) prune() {
+	over := len(p.jobs) - maxPluginJobs
+	if over <= 0 {
+		return
+	}
+	jj := make([]*pluginJob, 0, len(p.jobs))
+	for _, j := range p.jobs {
+		if over > 0 && !j.IsRunning() {
+			over--
+			continue
+		}
+		jj = append(jj, j)
+	}
+	p.jobs = jj
+}
+
+func (p *PluginJobs) find(id string) (*pluginJob, bool) {
+	for _, j := range p.jobs {
+		if j.ID == id {
+			return j, true
+		}
+	}
+
+	return nil, false
+}
+
+// jobOutput collects a job output, retaining its most recent bytes.
+type jobOutput struct {
+	buff []byte
+	max  int
+	mx   sync.Mutex
+}
+
+func newJobOutput(max int) *jobOutput {
+	return &jobOutput{max: max}
+}
+
+// Write appends to the output.
+func (o *jobOutput) Write(b []byte) (int, error) {
+	o.mx.Lock()
+	defer o.mx.Unlock()
+
+	o.buff = append(o.buff, b...)
+	if over := len(o.buff) - o.max; over > 0 {
+		o.buff = append(o.buff[:0:0], o.buff[over:]...)
+	}
+
+	return len(b), nil
+}
+
+// String returns the output.
+func (o *jobOutput) String() string {
+	o.mx.Lock()
+	defer o.mx.Unlock()
+
+	return string(o.buff)
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginJobsStartFailed(t *testing.T) {
+	jj := NewPluginJobs()
+
+	var res render.PluginJobRes
+	id := jj.Start("fred", "/k9s/no/such/binary", []string{"-a", "blee"}, func(j render.PluginJobRes) {
+		res = j
+	})
+
+	assert.Equal(t, "fred-1", id)
+	assert.Equal(t, render.JobFailed, res.Status)
+	assert.Equal(t, "/k9s/no/such/binary -a blee", res.Command)
+	assert.NotEmpty(t, res.Error)
+	assert.Error(t, jj.Cancel(id))
+	assert.Error(t, jj.Cancel("zorg"))
+
+	out, err := jj.Output(id)
+	assert.Nil(t, err)
+	assert.Empty(t, out)
+	assert.Equal(t, []render.PluginJobRes{res}, jj.Jobs())
+}
+
+func TestPluginJobsPrune(t *testing.T) {
+	jj := NewPluginJobs()
+	running := jj.add("fred", "fred", func() {})
+	for i := 0; i < maxPluginJobs+5; i++ {
+		jj.finish(jj.add("blee", "blee", func() {}), nil)
+	}
+
+	assert.Equal(t, maxPluginJobs, len(jj.Jobs()))
+	_, ok := jj.find(running.ID)
+	assert.True(t, ok)
+	_, ok = jj.find("blee-2")
+	assert.False(t, ok)
+	_, ok = jj.find("blee-" + strconv.Itoa(maxPluginJobs+6))
+	assert.True(t, ok)
+}
+
+func TestJobOutput(t *testing.T) {
+	o := newJobOutput(10)
+	n, err := o.Write([]byte("hello "))
+	assert.Nil(t, err)
+	assert.Equal(t, 6, n)
+	_, _ = o.Write([]byte("world!"))
+
+	assert.Equal(t, "llo world!", o.String())
+	_, _ = o.Write([]byte(strings.Repeat("x", 20)))
+	assert.Equal(t, strings.Repeat("x", 10), o.String())
+}
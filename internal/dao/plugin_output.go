@@ -0,0 +1,143 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PluginOutput)(nil)
+
+// PluginOutput represents a plugin columnar output.
+type PluginOutput struct {
+	NonResource
+}
+
+// List returns the plugin output as a table.
+func (p *PluginOutput) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	raw, ok := ctx.Value(internal.KeyPluginOut).(string)
+	if !ok {
+		return nil, errors.New("expecting a context plugin output")
+	}
+	t, err := parsePluginTable(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return []runtime.Object{t}, nil
+}
+
+// parsePluginTable converts a columnar output ie kubectl get to a table.
+// Columns are delimited by tabs or by at least two spaces in the header line.
+// A NAMESPACE column is used as the rows namespace.
+func parsePluginTable(raw string) (*metav1beta1.Table, error) {
+	ll := make([]string, 0, 10)
+	for _, l := range strings.Split(raw, "\n") {
+		l = strings.TrimRight(l, "\r")
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		ll = append(ll, l)
+	}
+	if len(ll) == 0 {
+		return nil, errors.New("no plugin output to display")
+	}
+
+	split := splitterFor(ll[0])
+	hh, nsIndex := split(ll[0]), -1
+	t := metav1beta1.Table{
+		ColumnDefinitions: make([]metav1.TableColumnDefinition, 0, len(hh)),
+	}
+	for i, h := range hh {
+		if strings.EqualFold(h, "NAMESPACE") {
+			nsIndex = i
+			continue
+		}
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1.TableColumnDefinition{Name: h, Type: "string"})
+	}
+	for _, l := range ll[1:] {
+		cc := split(l)
+		var ns string
+		cells := make([]interface{}, 0, len(t.ColumnDefinitions))
+		for i := range hh {
+			var c string
+			if i < len(cc) {
+				c = cc[i]
+			}
+			if i == nsIndex {
+				ns = c
+				continue
+			}
+			cells = append(cells, c)
+		}
+		meta, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]string{"namespace": ns},
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.Rows = append(t.Rows, metav1beta1.TableRow{
+			Cells:  cells,
+			Object: runtime.RawExtension{Raw: meta},
+		})
+	}
+
+	return &t, nil
+}
+
+// splitterFor returns a line splitter given an output header.
+func splitterFor(header string) func(string) []string {
+	if strings.Contains(header, "\t") {
+		return func(l string) []string {
+			ss := strings.Split(l, "\t")
+			for i := range ss {
+				ss[i] = strings.TrimSpace(ss[i])
+			}
+			return ss
+		}
+	}
+	starts := columnStarts([]rune(header))
+	if len(starts) == 1 {
+		return strings.Fields
+	}
+
+	return func(l string) []string {
+		rr := []rune(l)
+		ss := make([]string, len(starts))
+		for i := range starts {
+			from, to := starts[i], len(rr)
+			if i == 0 {
+				from = 0
+			}
+			if i+1 < len(starts) && starts[i+1] < to {
+				to = starts[i+1]
+			}
+			if from < to {
+				ss[i] = strings.TrimSpace(string(rr[from:to]))
+			}
+		}
+		return ss
+	}
+}
+
+// columnStarts returns the header columns offsets. Columns are separated by
+// at least two spaces.
+func columnStarts(h []rune) []int {
+	ss := make([]int, 0, 10)
+	for i, r := range h {
+		if r == ' ' {
+			continue
+		}
+		if len(ss) == 0 || (i >= 2 && h[i-1] == ' ' && h[i-2] == ' ') {
+			ss = append(ss, i)
+		}
+	}
+
+	return ss
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePluginTable(t *testing.T) {
+	uu := map[string]struct {
+		raw   string
+		cols  []string
+		cells [][]interface{}
+		nss   []string
+		err   bool
+	}{
+		"kubectl": {
+			raw:  "NAMESPACE   NAME    NOMINATED NODE   AGE\nfred        p1      <none>           3d\nblee        p2-xl   n1               10m\n",
+			cols: []string{"NAME", "NOMINATED NODE", "AGE"},
+			cells: [][]interface{}{
+				{"p1", "<none>", "3d"},
+				{"p2-xl", "n1", "10m"},
+			},
+			nss: []string{"fred", "blee"},
+		},
+		"tabs": {
+			raw:   "KEY\tVALUE\na\t1\nb\t\n",
+			cols:  []string{"KEY", "VALUE"},
+			cells: [][]interface{}{{"a", "1"}, {"b", ""}},
+			nss:   []string{"", ""},
+		},
+		"singleSpaced": {
+			raw:   "NAME CPU MEM\nfred 10m 20Mi\r\n",
+			cols:  []string{"NAME", "CPU", "MEM"},
+			cells: [][]interface{}{{"fred", "10m", "20Mi"}},
+			nss:   []string{""},
+		},
+		"shortRow": {
+			raw:   "NAME   STATUS\nfred\n",
+			cols:  []string{"NAME", "STATUS"},
+			cells: [][]interface{}{{"fred", ""}},
+			nss:   []string{""},
+		},
+		"empty": {
+			raw: "\n  \n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt, err := parsePluginTable(u.raw)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			cols := make([]string, 0, len(tt.ColumnDefinitions))
+			for _, c := range tt.ColumnDefinitions {
+				cols = append(cols, c.Name)
+			}
+			assert.Equal(t, u.cols, cols)
+			assert.Equal(t, len(u.cells), len(tt.Rows))
+			for i, r := range tt.Rows {
+				assert.Equal(t, u.cells[i], r.Cells)
+				assert.Equal(t, `{"metadata":{"namespace":"`+u.nss[i]+`"}}`, string(r.Object.Raw))
+			}
+		})
+	}
+}
+
+func TestColumnStarts(t *testing.T) {
+	assert.Equal(t, []int{0, 7, 16}, columnStarts([]rune("NAME   READY    AGE")))
+	assert.Equal(t, []int{1, 7}, columnStarts([]rune(" NAME  AGE")))
+	assert.Equal(t, []int{0}, columnStarts([]rune("NAME READY")))
+}
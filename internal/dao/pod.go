@@ -24,11 +24,12 @@ import (
 )
 
 var (
-	_ Accessor        = (*Pod)(nil)
-	_ Nuker           = (*Pod)(nil)
-	_ Loggable        = (*Pod)(nil)
-	_ Controller      = (*Pod)(nil)
-	_ ContainsPodSpec = (*Pod)(nil)
+	_ Accessor            = (*Pod)(nil)
+	_ Nuker               = (*Pod)(nil)
+	_ Loggable            = (*Pod)(nil)
+	_ Controller          = (*Pod)(nil)
+	_ ContainsPodSpec     = (*Pod)(nil)
+	_ DisruptionPreviewer = (*Pod)(nil)
 )
 
 const (
@@ -87,21 +88,31 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	var pmx *mv1beta1.PodMetricsList
+	var (
+		pmx   *mv1beta1.PodMetricsList
+		stale time.Duration
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		if pmx, err = client.DialMetrics(p.Client()).FetchPodsMetrics(ctx, ns); err != nil {
+		if cache, ok := ctx.Value(internal.KeyMetricsCache).(MetricsCache); ok && cache != nil {
+			pmx, stale = cache.PodsMetrics(ns)
+		} else if pmx, err = client.DialMetrics(p.Client()).FetchPodsMetrics(ctx, ns); err != nil {
 			log.Debug().Err(err).Msgf("No pods metrics")
 		}
 	}
 
+	vc, _ := ctx.Value(internal.KeyVulnCache).(VulnCache)
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
+		var vv *render.VulnSummary
+		if vc != nil {
+			vv = podVulns(vc, u)
+		}
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), StaleFor: stale, Vulns: vv})
 			continue
 		}
 
@@ -110,7 +121,7 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx)})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: podMetricsFor(o, pmx), StaleFor: stale, Vulns: vv})
 		}
 	}
 
@@ -335,7 +346,7 @@ done:
 		if err == nil {
 			// This call will block if nothing is in the stream!!
 			if stream, err = req.Stream(ctx); err == nil {
-				go readLogs(stream, c, opts)
+				go readLogs(ctx, stream, c, opts)
 				break
 			} else {
 				log.Error().Err(err).Msg("Streaming logs")
@@ -362,7 +373,13 @@ done:
 	return nil
 }
 
-func readLogs(stream io.ReadCloser, c LogChan, opts LogOptions) {
+func readLogs(ctx context.Context, stream io.ReadCloser, c LogChan, opts LogOptions) {
+	send := func(item *LogItem) { c <- item }
+	if opts.MultiPods {
+		s := newLogStream(ctx, c, logStreamBuffer, opts)
+		defer s.Close()
+		send = s.Send
+	}
 	defer func() {
 		log.Debug().Msgf(">>> Closing stream %s", opts.Info())
 		if err := stream.Close(); err != nil {
@@ -376,14 +393,14 @@ func readLogs(stream io.ReadCloser, c LogChan, opts LogOptions) {
 		if err != nil {
 			if err == io.EOF {
 				log.Warn().Err(err).Msgf("Stream closed for %s", opts.Info())
-				c <- opts.DecorateLog([]byte("\nlog stream closed\n"))
+				send(opts.DecorateLog([]byte("\nlog stream closed\n")))
 				return
 			}
 			log.Warn().Err(err).Msgf("Stream READ error %s", opts.Info())
-			c <- opts.DecorateLog([]byte(fmt.Sprintf("\nlog stream failed: %#v\n", err)))
+			send(opts.DecorateLog([]byte(fmt.Sprintf("\nlog stream failed: %#v\n", err))))
 			return
 		}
-		c <- opts.DecorateLog(bytes)
+		send(opts.DecorateLog(bytes))
 	}
 }
 
@@ -493,7 +510,7 @@ func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs)
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
@@ -0,0 +1,149 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// controllerGVRs tracks the pod controllers that replace their pods.
+var controllerGVRs = map[string]string{
+	"ReplicaSet":            "apps/v1/replicasets",
+	"Deployment":            "apps/v1/deployments",
+	"StatefulSet":           "apps/v1/statefulsets",
+	"DaemonSet":             "apps/v1/daemonsets",
+	"ReplicationController": "v1/replicationcontrollers",
+}
+
+// PodSelector returns the selector of the top most controller owning a pod.
+// Pods not managed by a controller yield an empty selector.
+func PodSelector(f Factory, path string) (string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	ctrl := u
+	for {
+		ref := metav1.GetControllerOf(ctrl)
+		if ref == nil {
+			break
+		}
+		gvr, ok := controllerGVRs[ref.Kind]
+		if !ok {
+			break
+		}
+		o, err := f.Get(gvr, client.FQN(u.GetNamespace(), ref.Name), true, labels.Everything())
+		if err != nil {
+			return "", err
+		}
+		if ctrl, ok = o.(*unstructured.Unstructured); !ok {
+			return "", fmt.Errorf("expecting unstructured but got %T", o)
+		}
+	}
+	if ctrl == u {
+		return "", nil
+	}
+
+	return controllerSelector(ctrl)
+}
+
+// ReadyPod returns the most recent ready pod matching a selector.
+func ReadyPod(f Factory, ns, selector string) (string, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return "", err
+	}
+	oo, err := f.List("v1/pods", ns, true, sel)
+	if err != nil {
+		return "", err
+	}
+
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return "", fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var pod v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pod); err != nil {
+			return "", err
+		}
+		if isPodReady(pod) {
+			pp = append(pp, pod)
+		}
+	}
+	if len(pp) == 0 {
+		return "", fmt.Errorf("no ready pods matching %q in namespace %q", selector, ns)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return pp[j].CreationTimestamp.Before(&pp[i].CreationTimestamp)
+	})
+
+	return client.FQN(pp[0].Namespace, pp[0].Name), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func controllerSelector(u *unstructured.Unstructured) (string, error) {
+	if u.GetKind() == "ReplicationController" {
+		m, _, err := unstructured.NestedStringMap(u.Object, "spec", "selector")
+		if err != nil {
+			return "", err
+		}
+		return labels.SelectorFromSet(m).String(), nil
+	}
+
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil || !ok {
+		return "", err
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return "", err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return "", err
+	}
+
+	return sel.String(), nil
+}
+
+func isPodReady(pod v1.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func isPodRunning(f Factory, path string) bool {
+	o, err := f.Get("v1/pods", path, false, labels.Everything())
+	if err != nil {
+		return false
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok || u.GetDeletionTimestamp() != nil {
+		return false
+	}
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+
+	return phase == string(v1.PodRunning)
+}
@@ -0,0 +1,142 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPodSelector(t *testing.T) {
+	f := selectorFactory{oo: map[string][]runtime.Object{
+		"apps/v1/deployments": {makeSelDeployment("dp1", "fred")},
+		"apps/v1/replicasets": {makeSelOwned("ReplicaSet", "rs1", "Deployment", "dp1")},
+		"v1/pods": {
+			makeSelPod("p1", "ReplicaSet", "rs1", true, time.Now()),
+			makeSelPod("p2", "", "", true, time.Now()),
+			makeSelPod("p3", "Job", "j1", true, time.Now()),
+		},
+	}}
+
+	uu := map[string]struct {
+		path, sel string
+	}{
+		"deployment": {path: "default/p1", sel: "app=fred"},
+		"bare":       {path: "default/p2"},
+		"job":        {path: "default/p3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, err := dao.PodSelector(f, u.path)
+			assert.Nil(t, err)
+			assert.Equal(t, u.sel, sel)
+		})
+	}
+}
+
+func TestReadyPod(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		pods []runtime.Object
+		path string
+		err  bool
+	}{
+		"newest": {
+			pods: []runtime.Object{
+				makeSelPod("p1", "", "", true, now.Add(-time.Hour)),
+				makeSelPod("p2", "", "", true, now),
+				makeSelPod("p3", "", "", false, now.Add(time.Minute)),
+			},
+			path: "default/p2",
+		},
+		"none": {
+			pods: []runtime.Object{makeSelPod("p1", "", "", false, now)},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := selectorFactory{oo: map[string][]runtime.Object{"v1/pods": u.pods}}
+			path, err := dao.ReadyPod(f, "default", "app=fred")
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+// Helpers...
+
+type selectorFactory struct {
+	testFactory
+	oo map[string][]runtime.Object
+}
+
+func (f selectorFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	for _, o := range f.oo[gvr] {
+		u := o.(*unstructured.Unstructured)
+		if client.FQN(u.GetNamespace(), u.GetName()) == path {
+			return o, nil
+		}
+	}
+
+	return nil, errors.New("not found")
+}
+
+func (f selectorFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.oo[gvr], nil
+}
+
+func makeSelOwned(kind, name, ownerKind, owner string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetNamespace("default")
+	if owner != "" {
+		ctrl := true
+		u.SetOwnerReferences([]metav1.OwnerReference{{Kind: ownerKind, Name: owner, Controller: &ctrl}})
+	}
+
+	return &u
+}
+
+func makeSelDeployment(name, app string) *unstructured.Unstructured {
+	dp := appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+		},
+	}
+	o, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&dp)
+
+	return &unstructured.Unstructured{Object: o}
+}
+
+func makeSelPod(name, ownerKind, owner string, ready bool, at time.Time) *unstructured.Unstructured {
+	u := makeSelOwned("Pod", name, ownerKind, owner)
+	u.SetCreationTimestamp(metav1.NewTime(at))
+	status := v1.ConditionFalse
+	if ready {
+		status = v1.ConditionTrue
+	}
+	s, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.PodStatus{
+		Phase:      v1.PodRunning,
+		Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: status}},
+	})
+	u.Object["status"] = s
+
+	return u
+}
@@ -1,10 +1,12 @@
 package dao
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -21,18 +23,43 @@ import (
 	"k8s.io/client-go/transport/spdy"
 )
 
-const localhost = "localhost"
+const (
+	localhost = "localhost"
+
+	// PFActive indicates the forward is streaming.
+	PFActive = "Active"
+	// PFReconnecting indicates the forward is looking for a replacement pod.
+	PFReconnecting = "Reconnecting"
+	// PFInactive indicates the forward is not streaming.
+	PFInactive = "Inactive"
+
+	// MaxPFRetries tracks the number of reconnect attempts before giving up.
+	MaxPFRetries = 10
+
+	pfRetryDelay    = time.Second
+	pfMaxRetryDelay = 30 * time.Second
+	pfWatchRate     = 2 * time.Second
+)
+
+var errPFStopped = errors.New("port-forward stopped")
 
 // PortForwarder tracks a port forward stream.
 type PortForwarder struct {
 	Factory
 	genericclioptions.IOStreams
 
+	mx                  sync.RWMutex
 	stopChan, readyChan chan struct{}
+	done                chan struct{}
 	active              bool
+	reconnecting        bool
+	retries             int
 	path                string
 	container           string
+	address             string
+	selector            string
 	ports               []string
+	tunnels             []client.PortTunnel
 	age                 time.Time
 }
 
@@ -42,31 +69,95 @@ func NewPortForwarder(f Factory) *PortForwarder {
 		Factory:   f,
 		stopChan:  make(chan struct{}),
 		readyChan: make(chan struct{}),
+		done:      make(chan struct{}),
 	}
 }
 
 // Age returns the port forward age.
 func (p *PortForwarder) Age() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return time.Since(p.age).String()
 }
 
 // Active returns the forward status.
 func (p *PortForwarder) Active() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.active
 }
 
 // SetActive mark a portforward as active.
 func (p *PortForwarder) SetActive(b bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	p.active = b
 }
 
+// Status returns the forward streaming status.
+func (p *PortForwarder) Status() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	switch {
+	case !p.active:
+		return PFInactive
+	case p.reconnecting:
+		return PFReconnecting
+	default:
+		return PFActive
+	}
+}
+
+// Retries returns the number of reconnect attempts.
+func (p *PortForwarder) Retries() int {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.retries
+}
+
+// Selector returns the labels matching replacement pods.
+func (p *PortForwarder) Selector() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.selector
+}
+
+// SetSelector sets the labels matching replacement pods. Forwards with no
+// selector terminate once their pod goes away.
+func (p *PortForwarder) SetSelector(s string) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.selector = s
+}
+
 // Ports returns the forwarded ports mappings.
 func (p *PortForwarder) Ports() []string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return p.ports
 }
 
+// Address returns the local forwarding address.
+func (p *PortForwarder) Address() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.address
+}
+
 // Path returns the pod resource path.
 func (p *PortForwarder) Path() string {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
 	return PortForwardID(p.path, p.container)
 }
 
@@ -82,19 +173,33 @@ func (p *PortForwarder) Container() string {
 
 // Stop terminates a port forard
 func (p *PortForwarder) Stop() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
 	log.Debug().Msgf("<<< Stopping PortForward %q %v", p.path, p.ports)
 	p.active = false
-	close(p.stopChan)
+	closeChan(p.done)
+	closeChan(p.stopChan)
+}
+
+// Stopped returns true once the forward was terminated.
+func (p *PortForwarder) Stopped() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
 }
 
 // FQN returns the portforward unique id.
 func (p *PortForwarder) FQN() string {
-	return p.path + ":" + p.container
+	return p.Path()
 }
 
 // HasPortMapping checks if port mapping is defined for this fwd.
 func (p *PortForwarder) HasPortMapping(m string) bool {
-	for _, mapping := range p.ports {
+	for _, mapping := range p.Ports() {
 		if mapping == m {
 			return true
 		}
@@ -111,7 +216,10 @@ func (p *PortForwarder) Start(path, co string, tt []client.PortTunnel) (*portfor
 	for _, t := range tt {
 		fwds = append(fwds, t.PortMap())
 	}
-	p.path, p.container, p.ports, p.age = path, co, fwds, time.Now()
+	stopChan, readyChan, err := p.reset(path, co, tt, fwds)
+	if err != nil {
+		return nil, err
+	}
 
 	ns, n := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, "v1/pods", []string{client.GetVerb})
@@ -158,10 +266,106 @@ func (p *PortForwarder) Start(path, co string, tt []client.PortTunnel) (*portfor
 		Name(n).
 		SubResource("portforward")
 
-	return p.forwardPorts("POST", req.URL(), tt[0].Address, fwds)
+	fwd, err := p.forwardPorts("POST", req.URL(), tt[0].Address, fwds, stopChan, readyChan)
+	if err != nil {
+		return nil, err
+	}
+	if p.Selector() != "" {
+		go p.watchPod(path, stopChan)
+	}
+
+	return fwd, nil
 }
 
-func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string, ports []string) (*portforward.PortForwarder, error) {
+// Reconnect re-establishes the forward on a ready pod matching the forward
+// selector, backing off between attempts. It bails out once the forward is
+// stopped or the retries are exhausted.
+func (p *PortForwarder) Reconnect() (*portforward.PortForwarder, error) {
+	p.mx.Lock()
+	ns, _ := client.Namespaced(p.path)
+	sel, co, tt := p.selector, p.container, p.tunnels
+	p.reconnecting = true
+	p.mx.Unlock()
+	defer func() {
+		p.mx.Lock()
+		p.reconnecting = false
+		p.mx.Unlock()
+	}()
+	if sel == "" {
+		return nil, fmt.Errorf("no selector to reconnect port-forward %s", p.Path())
+	}
+
+	delay := pfRetryDelay
+	for i := 0; i < MaxPFRetries; i++ {
+		select {
+		case <-p.done:
+			return nil, errPFStopped
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > pfMaxRetryDelay {
+			delay = pfMaxRetryDelay
+		}
+		p.mx.Lock()
+		p.retries++
+		p.mx.Unlock()
+
+		path, err := ReadyPod(p.Factory, ns, sel)
+		if err != nil {
+			log.Warn().Err(err).Msgf("No replacement pod for port-forward %s", p.Path())
+			continue
+		}
+		fwd, err := p.Start(path, co, tt)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Port-forward reconnect failed on %s", path)
+			continue
+		}
+		log.Debug().Msgf(">>> PortForward reconnected on %q", path)
+		return fwd, nil
+	}
+
+	return nil, fmt.Errorf("port-forward %s gave up after %d retries", p.Path(), MaxPFRetries)
+}
+
+// reset prepares a new stream on a given pod.
+func (p *PortForwarder) reset(path, co string, tt []client.PortTunnel, fwds []string) (chan struct{}, chan struct{}, error) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.Stopped() {
+		return nil, nil, errPFStopped
+	}
+	closeChan(p.stopChan)
+	p.stopChan, p.readyChan = make(chan struct{}), make(chan struct{})
+	p.path, p.container, p.ports, p.tunnels = path, co, fwds, tt
+	p.address = tt[0].Address
+	if p.age.IsZero() {
+		p.age = time.Now()
+	}
+
+	return p.stopChan, p.readyChan, nil
+}
+
+// watchPod drops the current stream once its pod goes away so the forward
+// can be re-established on a replacement pod.
+func (p *PortForwarder) watchPod(path string, stopChan chan struct{}) {
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-time.After(pfWatchRate):
+		}
+		if isPodRunning(p.Factory, path) {
+			continue
+		}
+		log.Debug().Msgf("PortForward pod %q is gone", path)
+		p.mx.Lock()
+		closeChan(stopChan)
+		p.mx.Unlock()
+		return
+	}
+}
+
+func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string, ports []string, stopChan, readyChan chan struct{}) (*portforward.PortForwarder, error) {
 	cfg, err := p.Client().Config().RESTConfig()
 	if err != nil {
 		return nil, err
@@ -176,12 +380,20 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, address string
 		address = localhost
 	}
 	addrs := strings.Split(address, ",")
-	return portforward.NewOnAddresses(dialer, addrs, ports, p.stopChan, p.readyChan, p.Out, p.ErrOut)
+	return portforward.NewOnAddresses(dialer, addrs, ports, stopChan, readyChan, p.Out, p.ErrOut)
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func closeChan(c chan struct{}) {
+	select {
+	case <-c:
+	default:
+		close(c)
+	}
+}
+
 func codec() (serializer.CodecFactory, runtime.ParameterCodec) {
 	scheme := runtime.NewScheme()
 	gv := schema.GroupVersion{Group: "", Version: "v1"}
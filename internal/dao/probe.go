@@ -0,0 +1,380 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	probeBodyMax        = 1024
+	probeForwardTimeout = 10 * time.Second
+	probeTCPSettle      = 500 * time.Millisecond
+	probeUserAgent      = "kube-probe/k9s"
+)
+
+// ProbeResult represents the outcome of an on demand container probe run.
+type ProbeResult struct {
+	// Type tracks the probe type ie startup, readiness or liveness.
+	Type string
+
+	// Handler describes the probe action ie GET http://:8080/healthz.
+	Handler string
+
+	Success bool
+
+	// Code tracks the http status or the command exit code.
+	Code int
+
+	// Body tracks the http response body or the command output.
+	Body string
+
+	Latency time.Duration
+	Err     error
+}
+
+// Probes runs a container startup, readiness and liveness probes once. Http
+// and tcp probes go thru a port-forward, exec probes run in the container.
+func (c *Container) Probes(ctx context.Context, fqn, co string) ([]ProbeResult, error) {
+	po, err := c.fetchPod(fqn)
+	if err != nil {
+		return nil, err
+	}
+	spec, ok := containerSpec(po, co)
+	if !ok {
+		return nil, fmt.Errorf("no container %q found in pod %s", co, fqn)
+	}
+	pp := containerProbes(spec)
+	if len(pp) == 0 {
+		return nil, fmt.Errorf("container %q defines no probes", co)
+	}
+
+	rr := make([]ProbeResult, 0, len(pp))
+	for _, p := range pp {
+		r := ProbeResult{Type: p.kind, Handler: probeHandler(p.probe)}
+		c.runProbe(ctx, fqn, spec, p.probe, &r)
+		rr = append(rr, r)
+	}
+
+	return rr, nil
+}
+
+func (c *Container) runProbe(ctx context.Context, fqn string, co *v1.Container, p *v1.Probe, r *ProbeResult) {
+	timeout := time.Duration(p.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+
+	switch {
+	case p.Exec != nil:
+		c.execProbe(fqn, co.Name, p.Exec.Command, timeout, r)
+	case p.HTTPGet != nil:
+		port, err := probePort(p.HTTPGet.Port, co)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		local, stop, err := c.forward(fqn, port)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		defer stop()
+		httpProbe(ctx, local, p.HTTPGet, timeout, r)
+	case p.TCPSocket != nil:
+		port, err := probePort(p.TCPSocket.Port, co)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		local, stop, err := c.forward(fqn, port)
+		if err != nil {
+			r.Err = err
+			return
+		}
+		defer stop()
+		tcpProbe(local, timeout, r)
+	default:
+		r.Err = errors.New("unsupported probe handler")
+	}
+}
+
+func (c *Container) execProbe(fqn, co string, cmd []string, timeout time.Duration, r *ProbeResult) {
+	ns, _ := client.Namespaced(fqn)
+	auth, err := c.Client().CanI(ns, "v1/pods:exec", []string{client.CreateVerb})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if !auth {
+		r.Err = fmt.Errorf("user is not authorized to exec into pods")
+		return
+	}
+
+	var stdout, stderr bytes.Buffer
+	done := make(chan error, 1)
+	t := time.Now()
+	go func() {
+		done <- execStream(c.Client(), fqn, co, cmd, nil, &stdout, &stderr)
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(timeout):
+		r.Latency, r.Err = time.Since(t), fmt.Errorf("command timed out after %s", timeout)
+		return
+	}
+	r.Latency = time.Since(t)
+	r.Body = truncateBody(stdout.String() + stderr.String())
+
+	var exit utilexec.ExitError
+	switch {
+	case err == nil:
+		r.Success = true
+	case errors.As(err, &exit):
+		r.Code = exit.ExitStatus()
+	default:
+		r.Err = err
+	}
+}
+
+// forward opens an ephemeral local port to a pod port. The returned func
+// tears the tunnel down.
+func (c *Container) forward(fqn string, port int) (int, func(), error) {
+	return forwardPort(c.Client(), fqn, port)
+}
+
+func forwardPort(conn client.Connection, fqn string, port int) (int, func(), error) {
+	ns, n := client.Namespaced(fqn)
+	auth, err := conn.CanI(ns, "v1/pods:portforward", []string{client.CreateVerb})
+	if err != nil {
+		return 0, nil, err
+	}
+	if !auth {
+		return 0, nil, fmt.Errorf("user is not authorized to port-forward pods")
+	}
+
+	cfg, err := conn.RestConfig()
+	if err != nil {
+		return 0, nil, err
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		return 0, nil, err
+	}
+	req := dial.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("portforward")
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return 0, nil, err
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+
+	stopChan, readyChan := make(chan struct{}), make(chan struct{})
+	fwd, err := portforward.NewOnAddresses(dialer, []string{localhost}, []string{fmt.Sprintf("0:%d", port)}, stopChan, readyChan, ioutil.Discard, ioutil.Discard)
+	if err != nil {
+		return 0, nil, err
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fwd.ForwardPorts()
+	}()
+	select {
+	case <-readyChan:
+	case err := <-errChan:
+		return 0, nil, err
+	case <-time.After(probeForwardTimeout):
+		closeChan(stopChan)
+		return 0, nil, fmt.Errorf("port-forward to %s:%d timed out", fqn, port)
+	}
+	pp, err := fwd.GetPorts()
+	if err != nil || len(pp) == 0 {
+		closeChan(stopChan)
+		return 0, nil, fmt.Errorf("no local port forwarded to %s:%d", fqn, port)
+	}
+
+	return int(pp[0].Local), func() { closeChan(stopChan) }, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type containerProbe struct {
+	kind  string
+	probe *v1.Probe
+}
+
+func containerProbes(co *v1.Container) []containerProbe {
+	pp := make([]containerProbe, 0, 3)
+	for _, p := range []containerProbe{
+		{kind: "startup", probe: co.StartupProbe},
+		{kind: "readiness", probe: co.ReadinessProbe},
+		{kind: "liveness", probe: co.LivenessProbe},
+	} {
+		if p.probe != nil {
+			pp = append(pp, p)
+		}
+	}
+
+	return pp
+}
+
+func containerSpec(po *v1.Pod, co string) (*v1.Container, bool) {
+	for i := range po.Spec.Containers {
+		if po.Spec.Containers[i].Name == co {
+			return &po.Spec.Containers[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// probePort resolves a probe port number or container port name.
+func probePort(port intstr.IntOrString, co *v1.Container) (int, error) {
+	if port.Type == intstr.Int {
+		return port.IntValue(), nil
+	}
+	for _, p := range co.Ports {
+		if p.Name == port.StrVal {
+			return int(p.ContainerPort), nil
+		}
+	}
+	if n, err := strconv.Atoi(port.StrVal); err == nil {
+		return n, nil
+	}
+
+	return 0, fmt.Errorf("no container port named %q", port.StrVal)
+}
+
+// probeHandler describes a probe action.
+func probeHandler(p *v1.Probe) string {
+	switch {
+	case p.Exec != nil:
+		return "exec " + strings.Join(p.Exec.Command, " ")
+	case p.HTTPGet != nil:
+		scheme := strings.ToLower(string(p.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		return fmt.Sprintf("GET %s://%s:%s%s", scheme, p.HTTPGet.Host, p.HTTPGet.Port.String(), p.HTTPGet.Path)
+	case p.TCPSocket != nil:
+		return fmt.Sprintf("TCP %s:%s", p.TCPSocket.Host, p.TCPSocket.Port.String())
+	default:
+		return "unknown"
+	}
+}
+
+// probeURL returns a probe url targeting a local port.
+func probeURL(g *v1.HTTPGetAction, port int) (*url.URL, error) {
+	u, err := url.Parse(g.Path)
+	if err != nil {
+		return nil, err
+	}
+	u.Scheme = strings.ToLower(string(g.Scheme))
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	u.Host = net.JoinHostPort(localhost, strconv.Itoa(port))
+
+	return u, nil
+}
+
+// httpProbe issues a probe request. Like the kubelet, certificates are not
+// verified, redirects are not followed and 2xx/3xx codes are successes.
+func httpProbe(ctx context.Context, port int, g *v1.HTTPGetAction, timeout time.Duration, r *ProbeResult) {
+	u, err := probeURL(g, port)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	req.Header.Set("User-Agent", probeUserAgent)
+	for _, h := range g.HTTPHeaders {
+		if strings.EqualFold(h.Name, "Host") {
+			req.Host = h.Value
+			continue
+		}
+		req.Header.Set(h.Name, h.Value)
+	}
+
+	clt := http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	t := time.Now()
+	resp, err := clt.Do(req)
+	r.Latency = time.Since(t)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, probeBodyMax+1))
+	r.Code, r.Body = resp.StatusCode, truncateBody(string(body))
+	r.Success = resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusBadRequest
+}
+
+// tcpProbe checks a forwarded port accepts connections. The forward closes
+// the local connection promptly when the container refuses it.
+func tcpProbe(port int, timeout time.Duration, r *ProbeResult) {
+	t := time.Now()
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(localhost, strconv.Itoa(port)), timeout)
+	r.Latency = time.Since(t)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer conn.Close()
+
+	settle := probeTCPSettle
+	if timeout < settle {
+		settle = timeout
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(settle))
+	_, err = conn.Read(make([]byte, 1))
+	var nerr net.Error
+	switch {
+	case err == nil, errors.As(err, &nerr) && nerr.Timeout():
+		r.Success = true
+	case errors.Is(err, io.EOF):
+		r.Err = errors.New("connection refused by container")
+	default:
+		r.Err = err
+	}
+}
+
+func truncateBody(s string) string {
+	if len(s) <= probeBodyMax {
+		return s
+	}
+
+	return s[:probeBodyMax] + "..."
+}
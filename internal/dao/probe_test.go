@@ -0,0 +1,168 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestContainerProbes(t *testing.T) {
+	p := v1.Probe{}
+	co := v1.Container{LivenessProbe: &p, StartupProbe: &p}
+
+	pp := containerProbes(&co)
+	assert.Equal(t, 2, len(pp))
+	assert.Equal(t, "startup", pp[0].kind)
+	assert.Equal(t, "liveness", pp[1].kind)
+}
+
+func TestProbePort(t *testing.T) {
+	co := v1.Container{Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}}
+
+	uu := map[string]struct {
+		port intstr.IntOrString
+		e    int
+		err  bool
+	}{
+		"number": {port: intstr.FromInt(9090), e: 9090},
+		"named":  {port: intstr.FromString("http"), e: 8080},
+		"string": {port: intstr.FromString("8443"), e: 8443},
+		"toast":  {port: intstr.FromString("grpc"), err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			port, err := probePort(u.port, &co)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, port)
+		})
+	}
+}
+
+func TestProbeHandler(t *testing.T) {
+	uu := map[string]struct {
+		p v1.Probe
+		e string
+	}{
+		"exec": {
+			p: v1.Probe{Handler: v1.Handler{Exec: &v1.ExecAction{Command: []string{"cat", "/tmp/healthy"}}}},
+			e: "exec cat /tmp/healthy",
+		},
+		"http": {
+			p: v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("http")}}},
+			e: "GET http://:http/healthz",
+		},
+		"https": {
+			p: v1.Probe{Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/", Port: intstr.FromInt(8443), Scheme: v1.URISchemeHTTPS}}},
+			e: "GET https://:8443/",
+		},
+		"tcp": {
+			p: v1.Probe{Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(5432)}}},
+			e: "TCP :5432",
+		},
+		"none": {
+			e: "unknown",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, probeHandler(&u.p))
+		})
+	}
+}
+
+func TestProbeURL(t *testing.T) {
+	u, err := probeURL(&v1.HTTPGetAction{Path: "/healthz?full=1"}, 1234)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "http://localhost:1234/healthz?full=1", u.String())
+}
+
+func TestHTTPProbe(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, probeUserAgent, r.UserAgent())
+		assert.Equal(t, "fred", r.Header.Get("X-Blee"))
+		if r.URL.Path == "/toast" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, "db down")
+			return
+		}
+		fmt.Fprint(w, strings.Repeat("ok", probeBodyMax))
+	}))
+	defer srv.Close()
+	port := srv.Listener.Addr().(*net.TCPAddr).Port
+
+	uu := map[string]struct {
+		path string
+		ok   bool
+		code int
+		body string
+	}{
+		"ok":    {path: "/healthz", ok: true, code: 200, body: strings.Repeat("ok", probeBodyMax/2) + "..."},
+		"toast": {path: "/toast", code: 503, body: "db down"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r ProbeResult
+			g := v1.HTTPGetAction{Path: u.path, HTTPHeaders: []v1.HTTPHeader{{Name: "X-Blee", Value: "fred"}}}
+			httpProbe(context.Background(), port, &g, time.Second, &r)
+			assert.Nil(t, r.Err)
+			assert.Equal(t, u.ok, r.Success)
+			assert.Equal(t, u.code, r.Code)
+			assert.Equal(t, u.body, r.Body)
+		})
+	}
+}
+
+func TestTCPProbe(t *testing.T) {
+	uu := map[string]struct {
+		close bool
+		ok    bool
+	}{
+		"accepted": {ok: true},
+		"refused":  {close: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := net.Listen("tcp", "localhost:0")
+			assert.Nil(t, err)
+			defer l.Close()
+			go func() {
+				for {
+					c, err := l.Accept()
+					if err != nil {
+						return
+					}
+					if u.close {
+						c.Close()
+					}
+				}
+			}()
+
+			var r ProbeResult
+			tcpProbe(l.Addr().(*net.TCPAddr).Port, 200*time.Millisecond, &r)
+			assert.Equal(t, u.ok, r.Success)
+			assert.Equal(t, u.ok, r.Err == nil)
+		})
+	}
+}
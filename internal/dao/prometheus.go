@@ -0,0 +1,169 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const (
+	promTimeout     = 5 * time.Second
+	promQueryPath   = "/api/v1/query"
+	promVectorType  = "vector"
+	promScalarType  = "scalar"
+	promStatusError = "error"
+)
+
+// PromClient queries a Prometheus server.
+type PromClient struct {
+	url, token string
+	client     *http.Client
+}
+
+// PromResult represents a panel query outcome.
+type PromResult struct {
+	Panel config.PromPanel
+	Value float64
+	OK    bool
+	Err   error
+}
+
+// NewPromClient returns a new Prometheus client.
+func NewPromClient(cfg *config.Prometheus) (*PromClient, error) {
+	c := PromClient{
+		url:    cfg.URL,
+		client: &http.Client{Timeout: promTimeout},
+	}
+	if cfg.Insecure {
+		c.client.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+	}
+	if cfg.BearerTokenFile != "" {
+		raw, err := ioutil.ReadFile(cfg.BearerTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		c.token = strings.TrimSpace(string(raw))
+	}
+
+	return &c, nil
+}
+
+// Query evaluates an instant PromQL query. Vector samples get summed. Ok is
+// false when the query returned no samples.
+func (p *PromClient) Query(ctx context.Context, q string) (float64, bool, error) {
+	u := p.url + promQueryPath + "?" + url.Values{"query": []string{q}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var r promResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return 0, false, fmt.Errorf("prometheus returned status %d: %w", resp.StatusCode, err)
+	}
+	if r.Status == promStatusError {
+		return 0, false, fmt.Errorf("prometheus query failed (%s): %s", r.ErrorType, r.Error)
+	}
+
+	return promValue(r.Data.ResultType, r.Data.Result)
+}
+
+// QueryPanels evaluates panels queries for a given resource in parallel.
+func (p *PromClient) QueryPanels(ctx context.Context, pp []config.PromPanel, ns, n string) []PromResult {
+	rr := make([]PromResult, len(pp))
+	var wg sync.WaitGroup
+	wg.Add(len(pp))
+	for i := range pp {
+		go func(i int) {
+			defer wg.Done()
+			rr[i].Panel = pp[i]
+			rr[i].Value, rr[i].OK, rr[i].Err = p.Query(ctx, pp[i].Expand(ns, n))
+		}(i)
+	}
+	wg.Wait()
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type promResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// promSample represents a [timestamp, "value"] pair.
+type promSample [2]interface{}
+
+func (s promSample) value() (float64, bool) {
+	v, ok := s[1].(string)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsNaN(f) {
+		return 0, false
+	}
+
+	return f, true
+}
+
+func promValue(kind string, raw json.RawMessage) (float64, bool, error) {
+	switch kind {
+	case promScalarType:
+		var s promSample
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return 0, false, err
+		}
+		v, ok := s.value()
+		return v, ok, nil
+	case promVectorType:
+		var vv []struct {
+			Value promSample `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &vv); err != nil {
+			return 0, false, err
+		}
+		var (
+			sum   float64
+			found bool
+		)
+		for _, v := range vv {
+			if f, ok := v.Value.value(); ok {
+				sum, found = sum+f, true
+			}
+		}
+		return sum, found, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported prometheus result type %q", kind)
+	}
+}
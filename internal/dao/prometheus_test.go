@@ -0,0 +1,94 @@
+package dao_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromClientQuery(t *testing.T) {
+	uu := map[string]struct {
+		resp  string
+		value float64
+		ok    bool
+		err   string
+	}{
+		"vector": {
+			resp:  `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"pod":"a"},"value":[1600000000.1,"1.5"]},{"metric":{"pod":"b"},"value":[1600000000.1,"2"]}]}}`,
+			value: 3.5,
+			ok:    true,
+		},
+		"scalar": {
+			resp:  `{"status":"success","data":{"resultType":"scalar","result":[1600000000.1,"42"]}}`,
+			value: 42,
+			ok:    true,
+		},
+		"empty": {
+			resp: `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		},
+		"nan": {
+			resp: `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1600000000.1,"NaN"]}]}}`,
+		},
+		"matrix": {
+			resp: `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			err:  `unsupported prometheus result type "matrix"`,
+		},
+		"failed": {
+			resp: `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			err:  "prometheus query failed (bad_data): parse error",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/query", r.URL.Path)
+				assert.Equal(t, "up", r.URL.Query().Get("query"))
+				_, _ = w.Write([]byte(u.resp))
+			}))
+			defer srv.Close()
+
+			c, err := dao.NewPromClient(&config.Prometheus{URL: srv.URL})
+			assert.Nil(t, err)
+			v, ok, err := c.Query(context.Background(), "up")
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.value, v)
+		})
+	}
+}
+
+func TestPromClientQueryPanels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer fred-token", r.Header.Get("Authorization"))
+		v := "1"
+		if r.URL.Query().Get("query") == `up{pod="fred"}` {
+			v = "2"
+		}
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[0,"` + v + `"]}}`))
+	}))
+	defer srv.Close()
+
+	c, err := dao.NewPromClient(&config.Prometheus{URL: srv.URL, BearerTokenFile: "testdata/prom_token"})
+	assert.Nil(t, err)
+	pp := []config.PromPanel{
+		{Name: "a", Query: "up"},
+		{Name: "b", Query: `up{pod="$NAME"}`},
+	}
+	rr := c.QueryPanels(context.Background(), pp, "default", "fred")
+
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, float64(1), rr[0].Value)
+	assert.Equal(t, float64(2), rr[1].Value)
+	assert.Equal(t, "b", rr[1].Panel.Name)
+}
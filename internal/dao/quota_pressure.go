@@ -0,0 +1,214 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const failedCreateReason = "FailedCreate"
+
+var (
+	quotaNameRX    = regexp.MustCompile(`(?:exceeded|failed) quota: ([^,:]+)`)
+	quotaDetailsRX = regexp.MustCompile(`(requested: .+|must specify .+)\z`)
+)
+
+// QuotaUsage represents a quota resource consumption.
+type QuotaUsage struct {
+	Quota, Resource string
+	Used, Hard      string
+
+	// Ratio tracks used over hard.
+	Ratio float64
+}
+
+// LimitDefault represents a limit range constraints on a resource.
+type LimitDefault struct {
+	LimitRange, Type, Resource string
+	Default, DefaultRequest    string
+	Min, Max, MaxRatio         string
+}
+
+// QuotaBlock represents a workload failing to create pods due to a quota.
+type QuotaBlock struct {
+	Object, Quota, Details string
+	Count                  int32
+	Last                   time.Time
+}
+
+// QuotaPressure represents a namespace quotas consumption, limit ranges and
+// workloads blocked by quotas.
+type QuotaPressure struct {
+	Namespace string
+	Usages    []QuotaUsage
+	Limits    []LimitDefault
+	Blocked   []QuotaBlock
+}
+
+// FetchQuotaPressure aggregates a namespace quotas status, limit ranges and
+// quota admission failures events.
+func FetchQuotaPressure(ctx context.Context, f Factory, ns string) (*QuotaPressure, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	qq, err := dial.CoreV1().ResourceQuotas(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	p := QuotaPressure{
+		Namespace: ns,
+		Usages:    quotaUsages(qq.Items),
+	}
+
+	ll, err := dial.CoreV1().LimitRanges(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Limit ranges for namespace %q", ns)
+	} else {
+		p.Limits = limitDefaults(ll.Items)
+	}
+
+	ee, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: "reason=" + failedCreateReason,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Quota events for namespace %q", ns)
+	} else {
+		p.Blocked = quotaBlocks(ee.Items)
+	}
+
+	return &p, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func quotaUsages(qq []v1.ResourceQuota) []QuotaUsage {
+	uu := make([]QuotaUsage, 0, len(qq))
+	for _, q := range qq {
+		for _, r := range sortedResources(q.Status.Hard) {
+			hard, used := q.Status.Hard[r], q.Status.Used[r]
+			uu = append(uu, QuotaUsage{
+				Quota:    q.Name,
+				Resource: string(r),
+				Used:     used.String(),
+				Hard:     hard.String(),
+				Ratio:    quotaRatio(used, hard),
+			})
+		}
+	}
+	sort.SliceStable(uu, func(i, j int) bool {
+		return uu[i].Quota < uu[j].Quota
+	})
+
+	return uu
+}
+
+func quotaRatio(used, hard resource.Quantity) float64 {
+	h, u := hard.MilliValue(), used.MilliValue()
+	if h == 0 {
+		if u > 0 {
+			return 1
+		}
+		return 0
+	}
+
+	return float64(u) / float64(h)
+}
+
+func limitDefaults(ll []v1.LimitRange) []LimitDefault {
+	var dd []LimitDefault
+	for _, l := range ll {
+		for _, it := range l.Spec.Limits {
+			rr := make(v1.ResourceList)
+			for _, m := range []v1.ResourceList{it.Default, it.DefaultRequest, it.Min, it.Max, it.MaxLimitRequestRatio} {
+				for k, v := range m {
+					rr[k] = v
+				}
+			}
+			for _, r := range sortedResources(rr) {
+				dd = append(dd, LimitDefault{
+					LimitRange:     l.Name,
+					Type:           string(it.Type),
+					Resource:       string(r),
+					Default:        quantityFor(it.Default, r),
+					DefaultRequest: quantityFor(it.DefaultRequest, r),
+					Min:            quantityFor(it.Min, r),
+					Max:            quantityFor(it.Max, r),
+					MaxRatio:       quantityFor(it.MaxLimitRequestRatio, r),
+				})
+			}
+		}
+	}
+
+	return dd
+}
+
+// quotaBlocks tallies the workloads failing to create pods due to quotas,
+// most recent first.
+func quotaBlocks(ee []v1.Event) []QuotaBlock {
+	index := make(map[string]int)
+	var bb []QuotaBlock
+	for _, e := range ee {
+		if e.Reason != failedCreateReason {
+			continue
+		}
+		m := quotaNameRX.FindStringSubmatch(e.Message)
+		if m == nil {
+			continue
+		}
+		o := e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name
+		count := e.Count
+		if count == 0 {
+			count = 1
+		}
+		b := QuotaBlock{Object: o, Quota: m[1], Count: count, Last: eventTime(&e)}
+		if m := quotaDetailsRX.FindStringSubmatch(e.Message); m != nil {
+			b.Details = m[1]
+		}
+		i, ok := index[o]
+		if !ok {
+			index[o] = len(bb)
+			bb = append(bb, b)
+			continue
+		}
+		bb[i].Count += count
+		if b.Last.After(bb[i].Last) {
+			b.Count = bb[i].Count
+			bb[i] = b
+		}
+	}
+	sort.SliceStable(bb, func(i, j int) bool {
+		return bb[i].Last.After(bb[j].Last)
+	})
+
+	return bb
+}
+
+func sortedResources(rl v1.ResourceList) []v1.ResourceName {
+	rr := make([]v1.ResourceName, 0, len(rl))
+	for r := range rl {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i] < rr[j]
+	})
+
+	return rr
+}
+
+func quantityFor(rl v1.ResourceList, r v1.ResourceName) string {
+	q, ok := rl[r]
+	if !ok {
+		return ""
+	}
+
+	return q.String()
+}
@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestQuotaUsages(t *testing.T) {
+	q := v1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute"},
+		Status: v1.ResourceQuotaStatus{
+			Hard: v1.ResourceList{
+				v1.ResourceLimitsCPU: resource.MustParse("4"),
+				v1.ResourcePods:      resource.MustParse("10"),
+				v1.ResourceServices:  resource.MustParse("0"),
+			},
+			Used: v1.ResourceList{
+				v1.ResourceLimitsCPU: resource.MustParse("3"),
+				v1.ResourcePods:      resource.MustParse("2"),
+			},
+		},
+	}
+
+	assert.Equal(t, []QuotaUsage{
+		{Quota: "compute", Resource: "limits.cpu", Used: "3", Hard: "4", Ratio: 0.75},
+		{Quota: "compute", Resource: "pods", Used: "2", Hard: "10", Ratio: 0.2},
+		{Quota: "compute", Resource: "services", Used: "0", Hard: "0"},
+	}, quotaUsages([]v1.ResourceQuota{q}))
+}
+
+func TestLimitDefaults(t *testing.T) {
+	l := v1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits"},
+		Spec: v1.LimitRangeSpec{
+			Limits: []v1.LimitRangeItem{
+				{
+					Type:           v1.LimitTypeContainer,
+					Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+					DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")},
+					Max:            v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []LimitDefault{
+		{LimitRange: "limits", Type: "Container", Resource: "cpu", Default: "500m", DefaultRequest: "250m"},
+		{LimitRange: "limits", Type: "Container", Resource: "memory", Max: "1Gi"},
+	}, limitDefaults([]v1.LimitRange{l}))
+}
+
+func TestQuotaBlocks(t *testing.T) {
+	t1, t2 := time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC), time.Date(2020, 10, 1, 11, 0, 0, 0, time.UTC)
+	ee := []v1.Event{
+		makeQuotaEvent("ReplicaSet", "fe-1", `Error creating: pods "fe-1-abc" is forbidden: exceeded quota: compute, requested: limits.cpu=2, used: limits.cpu=3, limited: limits.cpu=4`, 2, t1),
+		makeQuotaEvent("Job", "db", `Error creating: pods "db-xyz" is forbidden: failed quota: compute: must specify limits.cpu`, 0, t2),
+		makeQuotaEvent("ReplicaSet", "fe-1", `Error creating: pods "fe-1-def" is forbidden: exceeded quota: compute, requested: limits.cpu=2, used: limits.cpu=4, limited: limits.cpu=4`, 1, t2),
+		makeQuotaEvent("ReplicaSet", "be-1", `Error creating: pods "be-1-abc" is forbidden: no PriorityClass`, 1, t2),
+	}
+
+	assert.Equal(t, []QuotaBlock{
+		{Object: "ReplicaSet/fe-1", Quota: "compute", Details: "requested: limits.cpu=2, used: limits.cpu=4, limited: limits.cpu=4", Count: 3, Last: t2},
+		{Object: "Job/db", Quota: "compute", Details: "must specify limits.cpu", Count: 1, Last: t2},
+	}, quotaBlocks(ee))
+}
+
+// Helpers...
+
+func makeQuotaEvent(kind, name, msg string, count int32, at time.Time) v1.Event {
+	return v1.Event{
+		InvolvedObject: v1.ObjectReference{Kind: kind, Name: name},
+		Reason:         failedCreateReason,
+		Message:        msg,
+		Count:          count,
+		LastTimestamp:  metav1.Time{Time: at},
+	}
+}
@@ -0,0 +1,193 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*WhoCan)(nil)
+
+// allScopes indicates a permission granted in all namespaces.
+const allScopes = "*"
+
+// WhoCanQuery represents an action to find the allowed subjects for.
+type WhoCanQuery struct {
+	Verb, Group, Resource, Namespace string
+}
+
+// String returns the query as verb resource.group@namespace.
+func (q WhoCanQuery) String() string {
+	s := q.Verb + " " + q.Resource
+	if q.Group != "" {
+		s += "." + q.Group
+	}
+	if q.Namespace != "" {
+		s += "@" + q.Namespace
+	}
+
+	return s
+}
+
+// WhoCan represents a reverse rbac lookup of the subjects allowed to perform
+// an action.
+type WhoCan struct {
+	Policy
+}
+
+// List returns the subjects allowed to perform the context action.
+func (w *WhoCan) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	q, ok := ctx.Value(internal.KeyWhoCan).(WhoCanQuery)
+	if !ok {
+		return nil, errors.New("expecting a context who-can query")
+	}
+
+	crbs, err := fetchClusterRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(w.Factory)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := w.fetchClusterRoles()
+	if err != nil {
+		return nil, err
+	}
+	ros, err := w.fetchRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	ss := whoCan(q, crbs, rbs, crs, ros)
+	oo := make([]runtime.Object, len(ss))
+	for i, s := range ss {
+		oo[i] = s
+	}
+
+	return oo, nil
+}
+
+func whoCan(q WhoCanQuery, crbs []rbacv1.ClusterRoleBinding, rbs []rbacv1.RoleBinding, crs []rbacv1.ClusterRole, ros []rbacv1.Role) []render.WhoCanRes {
+	crRules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for _, cr := range crs {
+		crRules[cr.Name] = cr.Rules
+	}
+	roRules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for _, ro := range ros {
+		roRules[FQN(ro.Namespace, ro.Name)] = ro.Rules
+	}
+
+	var ss []render.WhoCanRes
+	for _, crb := range crbs {
+		if crb.RoleRef.Kind != "ClusterRole" {
+			continue
+		}
+		names, ok := q.allows(crRules[crb.RoleRef.Name])
+		if !ok {
+			continue
+		}
+		g := grant{scope: allScopes, binding: "CRB:" + crb.Name, role: "CR:" + crb.RoleRef.Name, names: names}
+		ss = g.subjects(ss, crb.Subjects)
+	}
+
+	for _, rb := range rbs {
+		if q.Namespace != "" && rb.Namespace != q.Namespace {
+			continue
+		}
+		var (
+			rules []rbacv1.PolicyRule
+			role  string
+		)
+		switch rb.RoleRef.Kind {
+		case "ClusterRole":
+			rules, role = crRules[rb.RoleRef.Name], "CR:"+rb.RoleRef.Name
+		case "Role":
+			rules, role = roRules[FQN(rb.Namespace, rb.RoleRef.Name)], "RO:"+rb.RoleRef.Name
+		default:
+			continue
+		}
+		names, ok := q.allows(rules)
+		if !ok {
+			continue
+		}
+		g := grant{scope: rb.Namespace, binding: "RB:" + FQN(rb.Namespace, rb.Name), role: role, names: names}
+		ss = g.subjects(ss, rb.Subjects)
+	}
+	sort.SliceStable(ss, func(i, j int) bool {
+		return ss[i].ID() < ss[j].ID()
+	})
+
+	return ss
+}
+
+// grant tracks a binding granting an action.
+type grant struct {
+	scope, binding, role string
+	names                []string
+}
+
+func (g grant) subjects(ss []render.WhoCanRes, subjects []rbacv1.Subject) []render.WhoCanRes {
+	for _, s := range subjects {
+		ss = append(ss, render.WhoCanRes{
+			Name:             s.Name,
+			Kind:             s.Kind,
+			SubjectNamespace: s.Namespace,
+			Scope:            g.scope,
+			Binding:          g.binding,
+			Role:             g.role,
+			ResourceNames:    g.names,
+		})
+	}
+
+	return ss
+}
+
+// allows checks if the rules grant the action. If so, it returns the resource
+// names the grant is restricted to if any.
+func (q WhoCanQuery) allows(rules []rbacv1.PolicyRule) ([]string, bool) {
+	var (
+		names []string
+		found bool
+	)
+	for _, r := range rules {
+		if !matchesRule(r.Verbs, q.Verb) || !matchesRule(r.APIGroups, q.Group) || !q.matchesResource(r.Resources) {
+			continue
+		}
+		if len(r.ResourceNames) == 0 {
+			return nil, true
+		}
+		names, found = append(names, r.ResourceNames...), true
+	}
+
+	return names, found
+}
+
+func (q WhoCanQuery) matchesResource(rr []string) bool {
+	if matchesRule(rr, q.Resource) {
+		return true
+	}
+	tokens := strings.SplitN(q.Resource, "/", 2)
+	if len(tokens) != 2 {
+		return false
+	}
+
+	return matchesRule(rr, fmt.Sprintf("*/%s", tokens[1]))
+}
+
+func matchesRule(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == rbacv1.ResourceAll || v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,165 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWhoCanQueryString(t *testing.T) {
+	uu := map[string]struct {
+		q WhoCanQuery
+		e string
+	}{
+		"core":       {q: WhoCanQuery{Verb: "get", Resource: "pods"}, e: "get pods"},
+		"group":      {q: WhoCanQuery{Verb: "list", Resource: "deployments", Group: "apps"}, e: "list deployments.apps"},
+		"namespaced": {q: WhoCanQuery{Verb: "delete", Resource: "secrets", Namespace: "fred"}, e: "delete secrets@fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.q.String())
+		})
+	}
+}
+
+func TestWhoCanQueryAllows(t *testing.T) {
+	uu := map[string]struct {
+		q     WhoCanQuery
+		rules []rbacv1.PolicyRule
+		names []string
+		ok    bool
+	}{
+		"exact": {
+			q:     WhoCanQuery{Verb: "get", Resource: "pods"},
+			rules: []rbacv1.PolicyRule{makeRule("", "pods", "get", "list")},
+			ok:    true,
+		},
+		"wildcards": {
+			q:     WhoCanQuery{Verb: "delete", Resource: "deployments", Group: "apps"},
+			rules: []rbacv1.PolicyRule{makeRule("*", "*", "*")},
+			ok:    true,
+		},
+		"wrongVerb": {
+			q:     WhoCanQuery{Verb: "delete", Resource: "pods"},
+			rules: []rbacv1.PolicyRule{makeRule("", "pods", "get", "list")},
+		},
+		"wrongGroup": {
+			q:     WhoCanQuery{Verb: "get", Resource: "deployments", Group: "apps"},
+			rules: []rbacv1.PolicyRule{makeRule("", "deployments", "get")},
+		},
+		"subresource": {
+			q:     WhoCanQuery{Verb: "get", Resource: "pods/log"},
+			rules: []rbacv1.PolicyRule{makeRule("", "*/log", "get")},
+			ok:    true,
+		},
+		"resourceNames": {
+			q: WhoCanQuery{Verb: "get", Resource: "secrets"},
+			rules: []rbacv1.PolicyRule{
+				withNames(makeRule("", "secrets", "get"), "s1"),
+				withNames(makeRule("", "secrets", "get"), "s2"),
+			},
+			names: []string{"s1", "s2"},
+			ok:    true,
+		},
+		"unrestricted": {
+			q: WhoCanQuery{Verb: "get", Resource: "secrets"},
+			rules: []rbacv1.PolicyRule{
+				withNames(makeRule("", "secrets", "get"), "s1"),
+				makeRule("", "secrets", "get"),
+			},
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			names, ok := u.q.allows(u.rules)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.names, names)
+		})
+	}
+}
+
+func TestWhoCan(t *testing.T) {
+	crs := []rbacv1.ClusterRole{
+		{ObjectMeta: metav1.ObjectMeta{Name: "admin"}, Rules: []rbacv1.PolicyRule{makeRule("*", "*", "*")}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "view"}, Rules: []rbacv1.PolicyRule{makeRule("", "pods", "get", "list")}},
+	}
+	ros := []rbacv1.Role{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "deleter"}, Rules: []rbacv1.PolicyRule{makeRule("", "pods", "delete")}},
+	}
+	crbs := []rbacv1.ClusterRoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crb1"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "admin"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: "system:masters"}},
+		},
+	}
+	rbs := []rbacv1.RoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "rb1"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "deleter"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Namespace: "ns1", Name: "bot"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "rb2"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: "User", Name: "fred"}},
+		},
+	}
+
+	masters := render.WhoCanRes{Name: "system:masters", Kind: "Group", Scope: "*", Binding: "CRB:crb1", Role: "CR:admin"}
+	bot := render.WhoCanRes{Name: "bot", Kind: "ServiceAccount", SubjectNamespace: "ns1", Scope: "ns1", Binding: "RB:ns1/rb1", Role: "RO:deleter"}
+	fred := render.WhoCanRes{Name: "fred", Kind: "User", Scope: "ns2", Binding: "RB:ns2/rb2", Role: "CR:view"}
+
+	uu := map[string]struct {
+		q WhoCanQuery
+		e []render.WhoCanRes
+	}{
+		"delete": {
+			q: WhoCanQuery{Verb: "delete", Resource: "pods"},
+			e: []render.WhoCanRes{masters, bot},
+		},
+		"get": {
+			q: WhoCanQuery{Verb: "get", Resource: "pods"},
+			e: []render.WhoCanRes{masters, fred},
+		},
+		"namespaced": {
+			q: WhoCanQuery{Verb: "list", Resource: "pods", Namespace: "ns2"},
+			e: []render.WhoCanRes{masters, fred},
+		},
+		"otherNamespace": {
+			q: WhoCanQuery{Verb: "delete", Resource: "pods", Namespace: "ns2"},
+			e: []render.WhoCanRes{masters},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, whoCan(u.q, crbs, rbs, crs, ros))
+		})
+	}
+}
+
+// Helpers...
+
+func makeRule(group, res string, verbs ...string) rbacv1.PolicyRule {
+	return rbacv1.PolicyRule{
+		APIGroups: []string{group},
+		Resources: []string{res},
+		Verbs:     verbs,
+	}
+}
+
+func withNames(r rbacv1.PolicyRule, names ...string) rbacv1.PolicyRule {
+	r.ResourceNames = names
+
+	return r
+}
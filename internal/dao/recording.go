@@ -0,0 +1,278 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	// RecordCommand tracks a recorded command.
+	RecordCommand = "command"
+
+	// RecordTable tracks a recorded table snapshot.
+	RecordTable = "table"
+
+	// RecordLog tracks recorded log lines.
+	RecordLog = "log"
+
+	// RecordLogClear tracks a recorded log view clear.
+	RecordLogClear = "clear"
+
+	// maxRecordLine tracks the largest recorded entry size.
+	maxRecordLine = 16 * 1024 * 1024
+
+	// maxRecordLogLines tracks how many recorded lines per view are
+	// remembered to skip re-sent lines.
+	maxRecordLogLines = 10_000
+)
+
+// RecordEntry represents a session recording timeline entry.
+type RecordEntry struct {
+	Time      time.Time `json:"time"`
+	Kind      string    `json:"kind"`
+	View      string    `json:"view"`
+	Namespace string    `json:"namespace,omitempty"`
+	Text      string    `json:"text"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// String returns the entry summary.
+func (e RecordEntry) String() string {
+	s := fmt.Sprintf("%s %-7s %s", e.Time.Format("15:04:05"), e.Kind, e.View)
+	if e.Namespace != "" {
+		s += " (" + e.Namespace + ")"
+	}
+
+	return s
+}
+
+// Recorder captures a session timeline into a json lines file.
+type Recorder struct {
+	file   *os.File
+	tables map[string]string
+	logs   map[string]*logTally
+	mx     sync.Mutex
+}
+
+// NewRecorder returns a new recorder. Any previous recording is overwritten.
+func NewRecorder(path string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{file: f, tables: make(map[string]string), logs: make(map[string]*logTally)}, nil
+}
+
+// RecordCommand records a command issued during the session.
+func (r *Recorder) RecordCommand(cmd, path string, err error) error {
+	e := RecordEntry{Time: time.Now(), Kind: RecordCommand, View: cmd, Text: path}
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	return r.Record(e)
+}
+
+// RecordTable records a table snapshot. Snapshots are skipped unless the
+// table content changed since the view was last recorded.
+func (r *Recorder) RecordTable(view string, data render.TableData) error {
+	key := tableKey(data)
+	r.mx.Lock()
+	same := r.tables[view] == key
+	r.tables[view] = key
+	r.mx.Unlock()
+	if same {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := render.WriteTable(&b, data); err != nil {
+		return err
+	}
+
+	return r.Record(RecordEntry{
+		Time:      time.Now(),
+		Kind:      RecordTable,
+		View:      view,
+		Namespace: data.Namespace,
+		Text:      strings.TrimSuffix(b.String(), "\n"),
+	})
+}
+
+// RecordLog records log lines. Lines re-sent since the view was last
+// rewound are skipped so only new lines are recorded.
+func (r *Recorder) RecordLog(view string, lines [][]byte) error {
+	r.mx.Lock()
+	t, ok := r.logs[view]
+	if !ok {
+		t = newLogTally()
+		r.logs[view] = t
+	}
+	ll := t.fresh(lines)
+	r.mx.Unlock()
+	if len(ll) == 0 {
+		return nil
+	}
+
+	return r.Record(RecordEntry{
+		Time: time.Now(),
+		Kind: RecordLog,
+		View: view,
+		Text: string(bytes.Join(ll, []byte{'\n'})),
+	})
+}
+
+// RewindLog flags a log view is about to re-send its lines ie on refresh or
+// filter changes.
+func (r *Recorder) RewindLog(view string) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if t, ok := r.logs[view]; ok {
+		t.rewind()
+	}
+}
+
+// RecordLogCleared records a log view was cleared.
+func (r *Recorder) RecordLogCleared(view string) error {
+	r.mx.Lock()
+	delete(r.logs, view)
+	r.mx.Unlock()
+
+	return r.Record(RecordEntry{Time: time.Now(), Kind: RecordLogClear, View: view})
+}
+
+// Record appends an entry to the recording.
+func (r *Recorder) Record(e RecordEntry) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.file == nil {
+		return errors.New("recording is closed")
+	}
+	_, err = r.file.Write(append(raw, '\n'))
+
+	return err
+}
+
+// Close ends the recording.
+func (r *Recorder) Close() error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+
+	return err
+}
+
+// LoadRecording reads a session recording.
+func LoadRecording(path string) ([]RecordEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var ee []RecordEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRecordLine)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var e RecordEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("invalid recording entry on line %d: %w", line, err)
+		}
+		ee = append(ee, e)
+	}
+
+	return ee, scanner.Err()
+}
+
+// Helpers...
+
+// tableKey returns a table content key. Raw fields are used so ages do not
+// count as changes.
+func tableKey(data render.TableData) string {
+	var b strings.Builder
+	for _, h := range data.Header {
+		b.WriteString(h.Name)
+		b.WriteByte('\t')
+	}
+	for _, re := range data.RowEvents {
+		b.WriteByte('\n')
+		b.WriteString(strings.Join(re.Row.Fields, "\t"))
+	}
+
+	return b.String()
+}
+
+// logTally tracks a log view recorded lines. Lines are tallied by hash so
+// duplicated lines are still recorded when new.
+type logTally struct {
+	seen  map[uint64]int
+	sent  map[uint64]int
+	order []uint64
+}
+
+func newLogTally() *logTally {
+	return &logTally{seen: make(map[uint64]int), sent: make(map[uint64]int)}
+}
+
+// fresh returns the lines not recorded yet.
+func (t *logTally) fresh(lines [][]byte) [][]byte {
+	ll := make([][]byte, 0, len(lines))
+	for _, l := range lines {
+		h := fnv.New64a()
+		_, _ = h.Write(l)
+		k := h.Sum64()
+		t.sent[k]++
+		if t.sent[k] <= t.seen[k] {
+			continue
+		}
+		t.seen[k]++
+		t.order = append(t.order, k)
+		ll = append(ll, l)
+	}
+	for len(t.order) > maxRecordLogLines {
+		k := t.order[0]
+		t.order = t.order[1:]
+		if t.seen[k]--; t.seen[k] <= 0 {
+			delete(t.seen, k)
+		}
+		if t.sent[k] > 0 {
+			t.sent[k]--
+		}
+	}
+
+	return ll
+}
+
+func (t *logTally) rewind() {
+	t.sent = make(map[uint64]int, len(t.seen))
+}
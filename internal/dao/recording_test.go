@@ -0,0 +1,130 @@
+package dao_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorderRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-recording")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "session", "rec.jsonl")
+	r, err := dao.NewRecorder(path)
+	assert.Nil(t, err)
+
+	data := render.TableData{
+		Namespace: "ns1",
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "STATUS"},
+		},
+		RowEvents: render.RowEvents{
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "ns1/a", Fields: render.Fields{"a", "Running"}}),
+		},
+	}
+	assert.Nil(t, r.RecordCommand("po", "", nil))
+	assert.Nil(t, r.RecordTable("v1/pods", data))
+	assert.Nil(t, r.RecordTable("v1/pods", data))
+	assert.Nil(t, r.RecordLog("ns1/a", nil))
+	assert.Nil(t, r.RecordLog("ns1/a", [][]byte{[]byte("l1"), []byte("l2")}))
+	assert.Nil(t, r.RecordCommand("blee", "", errors.New("`blee` Command not found")))
+	assert.Nil(t, r.Close())
+	assert.Error(t, r.Record(dao.RecordEntry{}))
+
+	ee, err := dao.LoadRecording(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(ee))
+	assert.Equal(t, dao.RecordCommand, ee[0].Kind)
+	assert.Equal(t, "po", ee[0].View)
+	assert.Equal(t, dao.RecordTable, ee[1].Kind)
+	assert.Equal(t, "ns1", ee[1].Namespace)
+	assert.Equal(t, "NAME   STATUS\na      Running", ee[1].Text)
+	assert.Equal(t, dao.RecordLog, ee[2].Kind)
+	assert.Equal(t, "l1\nl2", ee[2].Text)
+	assert.Equal(t, "`blee` Command not found", ee[3].Error)
+}
+
+func TestRecorderTableChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-recording")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rec.jsonl")
+	r, err := dao.NewRecorder(path)
+	assert.Nil(t, err)
+
+	data := func(status string) render.TableData {
+		return render.TableData{
+			Header: render.Header{render.HeaderColumn{Name: "NAME"}, render.HeaderColumn{Name: "STATUS"}},
+			RowEvents: render.RowEvents{
+				render.NewRowEvent(render.EventUnchanged, render.Row{ID: "a", Fields: render.Fields{"a", status}}),
+			},
+		}
+	}
+	assert.Nil(t, r.RecordTable("v1/pods", data("Pending")))
+	assert.Nil(t, r.RecordTable("v1/nodes", data("Pending")))
+	assert.Nil(t, r.RecordTable("v1/pods", data("Pending")))
+	assert.Nil(t, r.RecordTable("v1/pods", data("Running")))
+	assert.Nil(t, r.Close())
+
+	ee, err := dao.LoadRecording(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, "v1/nodes", ee[1].View)
+	assert.Equal(t, "NAME   STATUS\na      Running", ee[2].Text)
+}
+
+func TestRecorderLogDeltas(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-recording")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rec.jsonl")
+	r, err := dao.NewRecorder(path)
+	assert.Nil(t, err)
+
+	lines := func(ss ...string) [][]byte {
+		ll := make([][]byte, 0, len(ss))
+		for _, s := range ss {
+			ll = append(ll, []byte(s))
+		}
+		return ll
+	}
+	assert.Nil(t, r.RecordLog("ns1/a", lines("l1", "l2")))
+	r.RewindLog("ns1/a")
+	assert.Nil(t, r.RecordLog("ns1/a", lines("l1", "l2", "l3")))
+	r.RewindLog("ns1/a")
+	assert.Nil(t, r.RecordLog("ns1/a", lines("l2")))
+	assert.Nil(t, r.RecordLog("ns1/a", lines("l2")))
+	assert.Nil(t, r.RecordLogCleared("ns1/a"))
+	assert.Nil(t, r.RecordLog("ns1/a", lines("l1")))
+	assert.Nil(t, r.Close())
+
+	ee, err := dao.LoadRecording(path)
+	assert.Nil(t, err)
+	kk := make([]string, 0, len(ee))
+	for _, e := range ee {
+		kk = append(kk, e.Kind+":"+e.Text)
+	}
+	assert.Equal(t, []string{"log:l1\nl2", "log:l3", "log:l2", "clear:", "log:l1"}, kk)
+}
+
+func TestLoadRecordingInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-recording")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rec.jsonl")
+	assert.Nil(t, ioutil.WriteFile(path, []byte("{\"kind\":\"log\"}\n\nblee\n"), 0600))
+	_, err = dao.LoadRecording(path)
+	assert.EqualError(t, err, "invalid recording entry on line 3: invalid character 'b' looking for beginning of value")
+}
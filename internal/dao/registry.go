@@ -35,22 +35,28 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 	m := Accessors{
 		client.NewGVR("contexts"):                      &Context{},
 		client.NewGVR("containers"):                    &Container{},
+		client.NewGVR("datakeys"):                      &DataKey{},
 		client.NewGVR("screendumps"):                   &ScreenDump{},
 		client.NewGVR("benchmarks"):                    &Benchmark{},
 		client.NewGVR("portforwards"):                  &PortForward{},
+		client.NewGVR("alerts"):                        &Alert{},
+		client.NewGVR("stats"):                         &Stat{},
 		client.NewGVR("v1/services"):                   &Service{},
+		client.NewGVR("v1/secrets"):                    &Secret{},
 		client.NewGVR("v1/pods"):                       &Pod{},
 		client.NewGVR("v1/nodes"):                      &Node{},
 		client.NewGVR("apps/v1/deployments"):           &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):            &DaemonSet{},
 		client.NewGVR("extensions/v1beta1/daemonsets"): &DaemonSet{},
 		client.NewGVR("apps/v1/statefulsets"):          &StatefulSet{},
+		client.NewGVR("apps/v1/replicasets"):           &ReplicaSet{},
 		client.NewGVR("batch/v1beta1/cronjobs"):        &CronJob{},
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
 		client.NewGVR("openfaas"):                      &OpenFaas{},
 		client.NewGVR("popeye"):                        &Popeye{},
 		client.NewGVR("sanitizer"):                     &Popeye{},
 		client.NewGVR("helm"):                          &Helm{},
+		client.NewGVR("helmhistory"):                   &HelmHistory{},
 		client.NewGVR("dir"):                           &Dir{},
 	}
 
@@ -146,6 +152,27 @@ func loadNonResource(m ResourceMetas) {
 }
 
 func loadK9s(m ResourceMetas) {
+	m[client.NewGVR("netpolsim")] = metav1.APIResource{
+		Name:       "netpolsim",
+		Kind:       "NetPolSim",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("grep")] = metav1.APIResource{
+		Name:       "grep",
+		Kind:       "Grep",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:       "deprecations",
+		Kind:       "Deprecation",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("nsdiff")] = metav1.APIResource{
+		Name:       "nsdiff",
+		Kind:       "NSDiff",
+		Verbs:      []string{},
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("pulses")] = metav1.APIResource{
 		Name:         "pulses",
 		Kind:         "Pulse",
@@ -227,6 +254,43 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("alerts")] = metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alerts",
+		SingularName: "alert",
+		ShortNames:   []string{"al"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("pluginjobs")] = metav1.APIResource{
+		Name:         "pluginjobs",
+		Kind:         "PluginJobs",
+		SingularName: "pluginjob",
+		ShortNames:   []string{"pj"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("pluginoutput")] = metav1.APIResource{
+		Name:       "pluginoutput",
+		Kind:       "PluginOutput",
+		Verbs:      []string{},
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("audits")] = metav1.APIResource{
+		Name:         "audits",
+		Kind:         "Audits",
+		SingularName: "audit",
+		ShortNames:   []string{"au"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("stats")] = metav1.APIResource{
+		Name:         "stats",
+		Kind:         "Stats",
+		SingularName: "stat",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("containers")] = metav1.APIResource{
 		Name:         "containers",
 		Kind:         "Containers",
@@ -234,6 +298,13 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("datakeys")] = metav1.APIResource{
+		Name:         "datakeys",
+		Kind:         "DataKeys",
+		SingularName: "datakey",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
@@ -244,6 +315,14 @@ func loadHelm(m ResourceMetas) {
 		Verbs:      []string{"delete"},
 		Categories: []string{"helm"},
 	}
+	m[client.NewGVR("helmhistory")] = metav1.APIResource{
+		Name:         "helmhistory",
+		Kind:         "HelmHistory",
+		SingularName: "helmhistory",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadOpenFaas(m ResourceMetas) {
@@ -269,6 +348,11 @@ func loadRBAC(m ResourceMetas) {
 		Namespaced: true,
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("whocan")] = metav1.APIResource{
+		Name:       "whocan",
+		Kind:       "WhoCan",
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("users")] = metav1.APIResource{
 		Name:       "users",
 		Kind:       "User",
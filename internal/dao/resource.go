@@ -3,16 +3,23 @@ package dao
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 var (
-	_ Accessor  = (*Resource)(nil)
-	_ Describer = (*Resource)(nil)
-	_ Nuker     = (*Resource)(nil)
+	_ Accessor     = (*Resource)(nil)
+	_ Describer    = (*Resource)(nil)
+	_ YAMLStreamer = (*Resource)(nil)
+	_ Nuker        = (*Resource)(nil)
 )
 
 // Resource represents an informer based resource.
@@ -20,9 +27,13 @@ type Resource struct {
 	Generic
 }
 
-// List returns a collection of resources.
+// List returns a collection of resources. Collections are listed in api pages
+// until their informer syncs.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	strLabel, _ := ctx.Value(internal.KeyLabels).(string)
+	if oo, ok, err := r.listPages(ctx, ns, strLabel); ok || err != nil {
+		return oo, err
+	}
 	lsel := labels.Everything()
 	if strLabel != "" {
 		if sel, err := labels.Parse(strLabel); err == nil {
@@ -38,6 +49,65 @@ func (r *Resource) Get(_ context.Context, path string) (runtime.Object, error) {
 	return r.Factory.Get(r.gvr.String(), path, true, labels.Everything())
 }
 
+// Describe describes a resource. Descriptions are cached until the resource
+// or its related events change.
+func (r *Resource) Describe(path string) (string, error) {
+	o, err := r.Get(context.Background(), path)
+	if err != nil {
+		return Describe(r.Client(), r.gvr, path)
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return Describe(r.Client(), r.gvr, path)
+	}
+	uid, rev := string(m.GetUID()), m.GetResourceVersion()
+	if desc, ok := describeCache.Get(uid, rev); ok {
+		return desc, nil
+	}
+
+	desc, err := Describe(r.Client(), r.gvr, path)
+	if err != nil {
+		return "", err
+	}
+	if ii, ok := r.watchDescribe(path); ok {
+		describeCache.Set(uid, rev, desc, ii...)
+	}
+
+	return desc, nil
+}
+
+// watchDescribe ensures cached descriptions get evicted on resource or event
+// changes. Returns the tracking informers or false if changes can't be tracked.
+func (r *Resource) watchDescribe(path string) ([]cache.SharedIndexInformer, bool) {
+	ns, _ := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = client.AllNamespaces
+	}
+	inf, err := r.Factory.ForResource(ns, r.gvr.String())
+	if err != nil || inf == nil {
+		return nil, false
+	}
+	evt, err := r.Factory.CanForResource(ns, "v1/events", client.MonitorAccess)
+	if err != nil || evt == nil {
+		log.Debug().Msgf("Describe cache disabled for %s: %v", path, err)
+		return nil, false
+	}
+	describeCache.Watch(inf.Informer(), informerDone(inf), ObjectUID)
+	describeCache.Watch(evt.Informer(), informerDone(evt), EventObjectUID)
+
+	return []cache.SharedIndexInformer{inf.Informer(), evt.Informer()}, true
+}
+
+// informerDone returns a channel closed once an informer stops or nil if the
+// informer does not report it.
+func informerDone(inf informers.GenericInformer) <-chan struct{} {
+	if s, ok := inf.(interface{ Done() <-chan struct{} }); ok {
+		return s.Done()
+	}
+
+	return nil
+}
+
 // ToYAML returns a resource yaml.
 func (r *Resource) ToYAML(path string, showManaged bool) (string, error) {
 	o, err := r.Get(context.Background(), path)
@@ -51,3 +121,16 @@ func (r *Resource) ToYAML(path string, showManaged bool) (string, error) {
 	}
 	return raw, nil
 }
+
+// StreamYAML writes a resource yaml one entry at a time.
+func (r *Resource) StreamYAML(path string, showManaged bool, w io.Writer) error {
+	o, err := r.Get(context.Background(), path)
+	if err != nil {
+		return err
+	}
+
+	if err := EncodeYAML(o, showManaged, w); err != nil {
+		return fmt.Errorf("unable to marshal resource %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const maxRetryBackoff = 5 * time.Second
+
+// RetryPolicy tracks how transient api operation failures are retried.
+type RetryPolicy struct {
+	Attempts    int
+	Backoff     time.Duration
+	StatusCodes []int
+}
+
+// NewRetryPolicy returns a retry policy for the given settings.
+func NewRetryPolicy(r *config.Retry) RetryPolicy {
+	if r == nil {
+		r = config.NewRetry()
+	}
+
+	return RetryPolicy{
+		Attempts:    r.Attempts,
+		Backoff:     time.Duration(r.BackoffMillis) * time.Millisecond,
+		StatusCodes: r.StatusCodes,
+	}
+}
+
+// IsRetryable checks if an error is transient.
+func (p RetryPolicy) IsRetryable(err error) bool {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return false
+	}
+	code := int(status.Status().Code)
+	for _, c := range p.StatusCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Do runs an operation, retrying with an exponential backoff on transient errors.
+func (p RetryPolicy) Do(ctx context.Context, f func() error) error {
+	backoff := p.Backoff
+	var err error
+	for i := 0; i < p.Attempts || i == 0; i++ {
+		if i > 0 {
+			delay := backoff
+			if secs, ok := apierrors.SuggestsClientDelay(err); ok && time.Duration(secs)*time.Second > delay {
+				delay = time.Duration(secs) * time.Second
+			}
+			log.Debug().Msgf("Retrying in %v (%d/%d) -- %v", delay, i+1, p.Attempts, err)
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay):
+			}
+			if backoff *= 2; backoff > maxRetryBackoff {
+				backoff = maxRetryBackoff
+			}
+		}
+		if err = f(); err == nil || !p.IsRetryable(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Retry runs an operation using the retry policy from context if any.
+func Retry(ctx context.Context, f func() error) error {
+	p, ok := ctx.Value(internal.KeyRetryPolicy).(RetryPolicy)
+	if !ok {
+		return f()
+	}
+
+	return p.Do(ctx, f)
+}
+
+// RetryDelete runs a delete using the retry policy from context if any. A
+// resource gone on a retry was removed by an attempt whose response got
+// lost, so the delete is reported as successful.
+func RetryDelete(ctx context.Context, f func() error) error {
+	var attempts int
+	return Retry(ctx, func() error {
+		attempts++
+		err := f()
+		if attempts > 1 && isNotFound(err) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// Helpers...
+
+func isNotFound(err error) bool {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return false
+	}
+
+	return status.Status().Reason == metav1.StatusReasonNotFound
+}
@@ -0,0 +1,126 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRetryPolicyDo(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	uu := map[string]struct {
+		errs  []error
+		calls int
+		err   bool
+	}{
+		"ok": {
+			calls: 1,
+		},
+		"transient": {
+			errs:  []error{apierrors.NewServiceUnavailable("blee"), apierrors.NewInternalError(errors.New("boom"))},
+			calls: 3,
+		},
+		"exhausted": {
+			errs:  []error{apierrors.NewServiceUnavailable("blee"), apierrors.NewServiceUnavailable("blee"), apierrors.NewServiceUnavailable("blee")},
+			calls: 3,
+			err:   true,
+		},
+		"permanent": {
+			errs:  []error{apierrors.NewNotFound(gr, "fred")},
+			calls: 1,
+			err:   true,
+		},
+		"plain": {
+			errs:  []error{errors.New("boom")},
+			calls: 1,
+			err:   true,
+		},
+	}
+
+	p := dao.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, StatusCodes: []int{500, 503}}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var calls int
+			err := p.Do(context.Background(), func() error {
+				defer func() { calls++ }()
+				if calls < len(u.errs) {
+					return u.errs[calls]
+				}
+				return nil
+			})
+			assert.Equal(t, u.calls, calls)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls int
+	f := func() error {
+		calls++
+		return apierrors.NewServiceUnavailable("blee")
+	}
+
+	assert.NotNil(t, dao.Retry(context.Background(), f))
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	p := dao.RetryPolicy{Attempts: 2, Backoff: time.Millisecond, StatusCodes: []int{503}}
+	ctx := context.WithValue(context.Background(), internal.KeyRetryPolicy, p)
+	assert.NotNil(t, dao.Retry(ctx, f))
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryDelete(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	uu := map[string]struct {
+		errs  []error
+		calls int
+		err   bool
+	}{
+		"ok": {
+			calls: 1,
+		},
+		"missing": {
+			errs:  []error{apierrors.NewNotFound(gr, "fred")},
+			calls: 1,
+			err:   true,
+		},
+		"retriedGone": {
+			errs:  []error{apierrors.NewServiceUnavailable("blee"), fmt.Errorf("delete failed: %w", apierrors.NewNotFound(gr, "fred"))},
+			calls: 2,
+		},
+		"retriedFailed": {
+			errs:  []error{apierrors.NewServiceUnavailable("blee"), apierrors.NewForbidden(gr, "fred", errors.New("boom"))},
+			calls: 2,
+			err:   true,
+		},
+	}
+
+	p := dao.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, StatusCodes: []int{503}}
+	ctx := context.WithValue(context.Background(), internal.KeyRetryPolicy, p)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var calls int
+			err := dao.RetryDelete(ctx, func() error {
+				defer func() { calls++ }()
+				if calls < len(u.errs) {
+					return u.errs[calls]
+				}
+				return nil
+			})
+			assert.Equal(t, u.calls, calls)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
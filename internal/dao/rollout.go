@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+)
+
+const (
+	revisionKey    = "deployment.kubernetes.io/revision"
+	changeCauseKey = "kubernetes.io/change-cause"
+)
+
+// RolloutRevision represents a workload rollout revision.
+type RolloutRevision struct {
+	Number      int64
+	Name        string
+	ChangeCause string
+	Images      []string
+	Created     time.Time
+	Current     bool
+}
+
+// rolloutRevisions returns a workload revisions from its owned replicasets or
+// controller revisions.
+func rolloutRevisions(f Factory, gvr, src, path string) ([]RolloutRevision, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	ns, _ := client.Namespaced(path)
+	oo, err := f.List(src, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return ownedRevisions(u.GetUID(), oo), nil
+}
+
+// ownedRevisions returns the revisions controlled by a given owner sorted most recent first.
+func ownedRevisions(uid types.UID, oo []runtime.Object) []RolloutRevision {
+	rr := make([]RolloutRevision, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !controlledBy(u, uid) {
+			continue
+		}
+		r, err := toRevision(u)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping revision %s", u.GetName())
+			continue
+		}
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Number > rr[j].Number
+	})
+	if len(rr) > 0 {
+		rr[0].Current = true
+	}
+
+	return rr
+}
+
+func controlledBy(u *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.UID == uid && ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toRevision(u *unstructured.Unstructured) (RolloutRevision, error) {
+	r := RolloutRevision{
+		Name:        u.GetName(),
+		ChangeCause: u.GetAnnotations()[changeCauseKey],
+		Created:     u.GetCreationTimestamp().Time,
+	}
+
+	var (
+		cc  []interface{}
+		err error
+	)
+	switch u.GetKind() {
+	case "ReplicaSet":
+		if r.Number, err = strconv.ParseInt(u.GetAnnotations()[revisionKey], 10, 64); err != nil {
+			return r, fmt.Errorf("invalid revision annotation: %w", err)
+		}
+		cc, _, _ = unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
+	case "ControllerRevision":
+		var ok bool
+		if r.Number, ok, _ = unstructured.NestedInt64(u.Object, "revision"); !ok {
+			return r, errors.New("missing revision")
+		}
+		cc, _, _ = unstructured.NestedSlice(u.Object, "data", "spec", "template", "spec", "containers")
+	default:
+		return r, fmt.Errorf("no revision for kind %q", u.GetKind())
+	}
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if img, ok := m["image"].(string); ok {
+			r.Images = append(r.Images, img)
+		}
+	}
+
+	return r, nil
+}
+
+// undoRollout rolls a workload back to a given revision.
+func undoRollout(c client.Connection, gvr, kind, ns string, o runtime.Object, rev int64) error {
+	auth, err := c.CanI(ns, gvr, []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to rollback a %s", kind)
+	}
+
+	dial, err := c.Dial()
+	if err != nil {
+		return err
+	}
+	rb, err := polymorphichelpers.RollbackerFor(schema.GroupKind{Group: appsv1.GroupName, Kind: kind}, dial)
+	if err != nil {
+		return err
+	}
+	_, err = rb.Rollback(o, map[string]string{}, rev, dryRunStrategy())
+
+	return err
+}
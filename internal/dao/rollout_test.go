@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOwnedRevisions(t *testing.T) {
+	uu := map[string]struct {
+		oo []runtime.Object
+		e  []RolloutRevision
+	}{
+		"empty": {
+			e: []RolloutRevision{},
+		},
+		"replicasets": {
+			oo: []runtime.Object{
+				makeRevisionRS("fred-1", "1", "u1", "nginx:1.18"),
+				makeRevisionRS("fred-3", "3", "u1", "nginx:1.20"),
+				makeRevisionRS("blee-2", "2", "u2", "redis"),
+				makeRevisionRS("fred-x", "bozo", "u1", "nginx"),
+			},
+			e: []RolloutRevision{
+				{Number: 3, Name: "fred-3", Images: []string{"nginx:1.20"}, Current: true},
+				{Number: 1, Name: "fred-1", Images: []string{"nginx:1.18"}},
+			},
+		},
+		"controllerRevisions": {
+			oo: []runtime.Object{
+				makeControllerRevision("fred-a", 1, "u1", "nginx:1.18"),
+				makeControllerRevision("fred-b", 2, "u1", "nginx:1.20"),
+			},
+			e: []RolloutRevision{
+				{Number: 2, Name: "fred-b", Images: []string{"nginx:1.20"}, Current: true},
+				{Number: 1, Name: "fred-a", Images: []string{"nginx:1.18"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ownedRevisions("u1", u.oo))
+		})
+	}
+}
+
+// Helpers...
+
+func revisionOwner(uid string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"name":       "fred",
+			"uid":        uid,
+			"controller": true,
+		},
+	}
+}
+
+func revisionContainers(img string) map[string]interface{} {
+	return map[string]interface{}{
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "c1", "image": img},
+				},
+			},
+		},
+	}
+}
+
+func makeRevisionRS(n, rev, owner, img string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "ReplicaSet",
+		"metadata": map[string]interface{}{
+			"name":            n,
+			"annotations":     map[string]interface{}{revisionKey: rev},
+			"ownerReferences": revisionOwner(owner),
+		},
+		"spec": revisionContainers(img),
+	}}
+}
+
+func makeControllerRevision(n string, rev int64, owner, img string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "ControllerRevision",
+		"metadata": map[string]interface{}{
+			"name":            n,
+			"ownerReferences": revisionOwner(owner),
+		},
+		"revision": rev,
+		"data":     map[string]interface{}{"spec": revisionContainers(img)},
+	}}
+}
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,19 +10,48 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
+var _ Scalable = (*ReplicaSet)(nil)
+
 // ReplicaSet represents a replicaset K8s resource.
 type ReplicaSet struct {
 	Resource
 }
 
+// Scale a ReplicaSet.
+func (r *ReplicaSet) Scale(ctx context.Context, path string, replicas int32) error {
+	ns, n := client.Namespaced(path)
+	auth, err := r.Client().CanI(ns, "apps/v1/replicasets:scale", []string{client.GetVerb, client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to scale replicasets")
+	}
+
+	dial, err := r.Client().Dial()
+	if err != nil {
+		return err
+	}
+	scale, err := dial.AppsV1().ReplicaSets(ns).GetScale(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	scale.Spec.Replicas = replicas
+
+	return Retry(ctx, func() error {
+		_, err := dial.AppsV1().ReplicaSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{DryRun: dryRunOpts()})
+		return err
+	})
+}
+
 // Load returns a given instance.
 func (r *ReplicaSet) Load(f Factory, path string) (*v1.ReplicaSet, error) {
 	o, err := f.Get("apps/v1/replicasets", path, true, labels.Everything())
@@ -100,7 +130,7 @@ func (r *ReplicaSet) Rollback(fqn string) error {
 		return err
 	}
 
-	_, err = rb.Rollback(dp, map[string]string{}, version, cmdutil.DryRunNone)
+	_, err = rb.Rollback(dp, map[string]string{}, version, dryRunStrategy())
 	if err != nil {
 		return err
 	}
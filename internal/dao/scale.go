@@ -0,0 +1,107 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const maxScaleEvents = 5
+
+var scalingReasons = map[string]struct{}{
+	"ScalingReplicaSet": {},
+	"SuccessfulCreate":  {},
+	"SuccessfulDelete":  {},
+	"SuccessfulRescale": {},
+}
+
+// ScaleStatus represents a workload replicas state and its recent scaling
+// activity.
+type ScaleStatus struct {
+	Desired, Current, Ready int32
+
+	// Owner tracks the controller managing the workload ie Deployment/fred.
+	Owner string
+
+	// Events tracks the most recent scaling events, most recent first.
+	Events []string
+}
+
+// FetchScaleStatus returns a workload replicas state along with its scaling
+// events and the ones of its autoscaler if any.
+func FetchScaleStatus(ctx context.Context, f Factory, gvr client.GVR, kind, path, hpa string) (*ScaleStatus, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	st := scaleStatus(u)
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return st, nil
+	}
+	ns, n := client.Namespaced(path)
+	refs := [][2]string{{kind, n}}
+	if hpa != "" {
+		refs = append(refs, [2]string{"HorizontalPodAutoscaler", hpa})
+	}
+	var ee []v1.Event
+	for _, r := range refs {
+		ll, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+			FieldSelector: "involvedObject.kind=" + r[0] + ",involvedObject.name=" + r[1],
+		})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Scaling events for %s/%s", r[0], r[1])
+			continue
+		}
+		ee = append(ee, ll.Items...)
+	}
+	st.Events = hpaEvents(scalingEvents(ee), maxScaleEvents)
+
+	return st, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func scaleStatus(u *unstructured.Unstructured) *ScaleStatus {
+	var st ScaleStatus
+	desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !ok {
+		desired = 1
+	}
+	st.Desired = int32(desired)
+	current, _, _ := unstructured.NestedInt64(u.Object, "status", "replicas")
+	st.Current = int32(current)
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+	st.Ready = int32(ready)
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			st.Owner = ref.Kind + "/" + ref.Name
+			break
+		}
+	}
+
+	return &st
+}
+
+func scalingEvents(ee []v1.Event) []v1.Event {
+	ss := make([]v1.Event, 0, len(ee))
+	for _, e := range ee {
+		if _, ok := scalingReasons[e.Reason]; ok {
+			ss = append(ss, e)
+		}
+	}
+
+	return ss
+}
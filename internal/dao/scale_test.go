@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestScaleStatus(t *testing.T) {
+	uu := map[string]struct {
+		o map[string]interface{}
+		e ScaleStatus
+	}{
+		"plain": {
+			o: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"replicas": int64(2), "readyReplicas": int64(1)},
+			},
+			e: ScaleStatus{Desired: 3, Current: 2, Ready: 1},
+		},
+		"defaultReplicas": {
+			o: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			},
+			e: ScaleStatus{Desired: 1},
+		},
+		"owned": {
+			o: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"ownerReferences": []interface{}{
+						map[string]interface{}{"apiVersion": "v1", "kind": "Fred", "name": "blee", "uid": "1"},
+						map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "fred", "uid": "2", "controller": true},
+					},
+				},
+				"spec":   map[string]interface{}{"replicas": int64(0)},
+				"status": map[string]interface{}{},
+			},
+			e: ScaleStatus{Owner: "Deployment/fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, &u.e, scaleStatus(&unstructured.Unstructured{Object: u.o}))
+		})
+	}
+}
+
+func TestScalingEvents(t *testing.T) {
+	ee := []v1.Event{
+		{Reason: "ScalingReplicaSet", Message: "Scaled up replica set fred-123 to 3"},
+		{Reason: "FailedGetResourceMetric", Message: "boom"},
+		{Reason: "SuccessfulRescale", Message: "New size: 4"},
+		{Reason: "Pulled", Message: "pulled"},
+	}
+
+	ss := scalingEvents(ee)
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, "ScalingReplicaSet", ss[0].Reason)
+	assert.Equal(t, "SuccessfulRescale", ss[1].Reason)
+}
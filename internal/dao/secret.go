@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	_ Accessor     = (*Secret)(nil)
+	_ SecretEditor = (*Secret)(nil)
+)
+
+// Secret represents a k8s secret.
+type Secret struct {
+	Resource
+}
+
+// EditBuffer returns a secret manifest with its data values decoded.
+func (s *Secret) EditBuffer(path string) ([]byte, error) {
+	sec, err := s.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return SecretEditBuffer(sec)
+}
+
+// SaveBuffer re-encodes an edited secret manifest and updates the secret.
+func (s *Secret) SaveBuffer(ctx context.Context, path string, raw []byte) error {
+	sec, err := ParseSecretEditBuffer(raw)
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+	if sec.Name != n || sec.Namespace != ns {
+		return fmt.Errorf("secret name or namespace can not be changed while editing %s", path)
+	}
+	auth, err := s.Client().CanI(ns, s.gvr.String(), []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update secret %s", path)
+	}
+
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Secrets(ns).Update(ctx, sec, metav1.UpdateOptions{DryRun: dryRunOpts()})
+
+	return err
+}
+
+// GetInstance returns a secret instance.
+func (s *Secret) GetInstance(path string) (*v1.Secret, error) {
+	o, err := s.Factory.Get(s.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		return nil, err
+	}
+
+	return &sec, nil
+}
+
+// SecretEditBuffer converts a secret into an editable manifest. Text values
+// are moved to stringData so they can be edited in the clear, binary values
+// remain base64 encoded in data.
+func SecretEditBuffer(s *v1.Secret) ([]byte, error) {
+	sec := s.DeepCopy()
+	sec.APIVersion, sec.Kind = "v1", "Secret"
+	sec.ManagedFields = nil
+	for k, v := range sec.Data {
+		if !utf8.Valid(v) {
+			continue
+		}
+		if sec.StringData == nil {
+			sec.StringData = make(map[string]string, len(sec.Data))
+		}
+		sec.StringData[k] = string(v)
+		delete(sec.Data, k)
+	}
+	if len(sec.Data) == 0 {
+		sec.Data = nil
+	}
+
+	return yaml.Marshal(sec)
+}
+
+// ParseSecretEditBuffer converts an edited manifest back into a secret,
+// encoding stringData values into data. Keys missing from the manifest are
+// removed from the secret.
+func ParseSecretEditBuffer(raw []byte) (*v1.Secret, error) {
+	var sec v1.Secret
+	if err := yaml.Unmarshal(raw, &sec); err != nil {
+		return nil, fmt.Errorf("invalid secret manifest: %w", err)
+	}
+	if sec.Kind != "Secret" {
+		return nil, fmt.Errorf("expecting a Secret manifest but got %q", sec.Kind)
+	}
+	for k, v := range sec.StringData {
+		if err := validateKey(k); err != nil {
+			return nil, err
+		}
+		if sec.Data == nil {
+			sec.Data = make(map[string][]byte, len(sec.StringData))
+		}
+		sec.Data[k] = []byte(v)
+	}
+	sec.StringData = nil
+
+	return &sec, nil
+}
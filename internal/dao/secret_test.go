@@ -0,0 +1,117 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestSecretEditBuffer(t *testing.T) {
+	uu := map[string]struct {
+		data   map[string][]byte
+		text   map[string]string
+		binary map[string][]byte
+	}{
+		"empty": {},
+		"text": {
+			data: map[string][]byte{"user": []byte("fred"), "pwd": []byte("s3cr3t\n")},
+			text: map[string]string{"user": "fred", "pwd": "s3cr3t\n"},
+		},
+		"binary": {
+			data:   map[string][]byte{"user": []byte("fred"), "key": {0xff, 0xfe, 0x00}},
+			text:   map[string]string{"user": "fred"},
+			binary: map[string][]byte{"key": {0xff, 0xfe, 0x00}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := dao.SecretEditBuffer(makeSecret(u.data))
+			assert.Nil(t, err)
+
+			var s v1.Secret
+			assert.Nil(t, yaml.Unmarshal(raw, &s))
+			assert.Equal(t, "Secret", s.Kind)
+			assert.Equal(t, u.text, s.StringData)
+			assert.Equal(t, u.binary, s.Data)
+			assert.Nil(t, s.ManagedFields)
+		})
+	}
+}
+
+func TestParseSecretEditBuffer(t *testing.T) {
+	uu := map[string]struct {
+		raw  string
+		data map[string][]byte
+		err  bool
+	}{
+		"text": {
+			raw:  "kind: Secret\nmetadata:\n  name: fred\nstringData:\n  user: blee\n",
+			data: map[string][]byte{"user": []byte("blee")},
+		},
+		"merged": {
+			raw:  "kind: Secret\nmetadata:\n  name: fred\ndata:\n  key: //4A\nstringData:\n  user: blee\n",
+			data: map[string][]byte{"user": []byte("blee"), "key": {0xff, 0xfe, 0x00}},
+		},
+		"override": {
+			raw:  "kind: Secret\nmetadata:\n  name: fred\ndata:\n  user: ZnJlZA==\nstringData:\n  user: blee\n",
+			data: map[string][]byte{"user": []byte("blee")},
+		},
+		"badKind": {
+			raw: "kind: ConfigMap\nmetadata:\n  name: fred\n",
+			err: true,
+		},
+		"badKey": {
+			raw: "kind: Secret\nmetadata:\n  name: fred\nstringData:\n  a/b: blee\n",
+			err: true,
+		},
+		"badYAML": {
+			raw: "kind: [Secret\n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := dao.ParseSecretEditBuffer([]byte(u.raw))
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.data, s.Data)
+			assert.Nil(t, s.StringData)
+		})
+	}
+}
+
+func TestSecretEditRoundTrip(t *testing.T) {
+	data := map[string][]byte{"user": []byte("fred"), "key": {0xff, 0xfe, 0x00}}
+	raw, err := dao.SecretEditBuffer(makeSecret(data))
+	assert.Nil(t, err)
+
+	s, err := dao.ParseSecretEditBuffer(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, data, s.Data)
+	assert.Equal(t, "fred", s.Name)
+	assert.Equal(t, "default", s.Namespace)
+}
+
+// Helpers...
+
+func makeSecret(data map[string][]byte) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "fred",
+			Namespace:     "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Data: data,
+	}
+}
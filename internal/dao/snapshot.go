@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+const snapshotExt = ".json.gz"
+
+var snapshotNameRX = regexp.MustCompile(`[:/\\]+`)
+
+// SnapshotInfo represents a persisted table snapshot.
+type SnapshotInfo struct {
+	Path string
+	Time time.Time
+}
+
+type snapshotColumn struct {
+	Name  string `json:"name"`
+	Align int    `json:"align,omitempty"`
+	Wide  bool   `json:"wide,omitempty"`
+	MX    bool   `json:"mx,omitempty"`
+	Time  bool   `json:"time,omitempty"`
+}
+
+type snapshotRow struct {
+	ID     string   `json:"id"`
+	Kind   int      `json:"kind,omitempty"`
+	Fields []string `json:"fields"`
+}
+
+type snapshot struct {
+	Namespace string           `json:"namespace"`
+	Header    []snapshotColumn `json:"header"`
+	Rows      []snapshotRow    `json:"rows"`
+}
+
+// SnapshotDir returns the snapshots location for a given view.
+func SnapshotDir(base, cluster, gvr, ns string) string {
+	if ns == "" {
+		ns = "all"
+	}
+
+	return filepath.Join(base, snapshotName(cluster), snapshotName(gvr), snapshotName(ns))
+}
+
+// SaveSnapshot persists a compressed table snapshot.
+func SaveSnapshot(dir string, data render.TableData, at time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	s := snapshot{
+		Namespace: data.Namespace,
+		Header:    make([]snapshotColumn, 0, len(data.Header)),
+		Rows:      make([]snapshotRow, 0, len(data.RowEvents)),
+	}
+	for _, h := range data.Header {
+		s.Header = append(s.Header, snapshotColumn{Name: h.Name, Align: h.Align, Wide: h.Wide, MX: h.MX, Time: h.Time})
+	}
+	for _, re := range data.RowEvents {
+		s.Rows = append(s.Rows, snapshotRow{ID: re.Row.ID, Kind: int(re.Kind), Fields: re.Row.Fields})
+	}
+
+	path := filepath.Join(dir, strconv.FormatInt(at.UnixNano(), 10)+snapshotExt)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	w := gzip.NewWriter(f)
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		return "", err
+	}
+
+	return path, w.Close()
+}
+
+// LoadSnapshot loads a table snapshot.
+func LoadSnapshot(path string) (render.TableData, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return render.TableData{}, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return render.TableData{}, err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	var s snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return render.TableData{}, fmt.Errorf("invalid snapshot %q: %w", path, err)
+	}
+	data := render.TableData{
+		Namespace: s.Namespace,
+		Header:    make(render.Header, 0, len(s.Header)),
+		RowEvents: make(render.RowEvents, 0, len(s.Rows)),
+	}
+	for _, h := range s.Header {
+		data.Header = append(data.Header, render.HeaderColumn{Name: h.Name, Align: h.Align, Wide: h.Wide, MX: h.MX, Time: h.Time})
+	}
+	for _, r := range s.Rows {
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Kind: render.ResEvent(r.Kind),
+			Row:  render.Row{ID: r.ID, Fields: r.Fields},
+		})
+	}
+
+	return data, nil
+}
+
+// ListSnapshots returns all snapshots in a given location, oldest first.
+func ListSnapshots(dir string) ([]SnapshotInfo, error) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ss := make([]SnapshotInfo, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), snapshotExt) {
+			continue
+		}
+		nano, err := strconv.ParseInt(strings.TrimSuffix(f.Name(), snapshotExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		ss = append(ss, SnapshotInfo{Path: filepath.Join(dir, f.Name()), Time: time.Unix(0, nano)})
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].Time.Before(ss[j].Time)
+	})
+
+	return ss, nil
+}
+
+// PruneSnapshots deletes snapshots older than the given retention.
+func PruneSnapshots(dir string, retention time.Duration, now time.Time) error {
+	ss, err := ListSnapshots(dir)
+	if err != nil {
+		return err
+	}
+	for _, s := range ss {
+		if now.Sub(s.Time) <= retention {
+			break
+		}
+		if err := os.Remove(s.Path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helpers...
+
+func snapshotName(s string) string {
+	return snapshotNameRX.ReplaceAllString(s, "-")
+}
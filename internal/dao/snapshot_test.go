@@ -0,0 +1,93 @@
+package dao_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotDir(t *testing.T) {
+	uu := map[string]struct {
+		cluster, gvr, ns, e string
+	}{
+		"namespaced": {
+			cluster: "arn:aws:eks:cl1",
+			gvr:     "apps/v1/deployments",
+			ns:      "default",
+			e:       "/tmp/s/arn-aws-eks-cl1/apps-v1-deployments/default",
+		},
+		"all": {
+			cluster: "c1",
+			gvr:     "v1/pods",
+			e:       "/tmp/s/c1/v1-pods/all",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.SnapshotDir("/tmp/s", u.cluster, u.gvr, u.ns))
+		})
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-snapshots")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	data := render.TableData{
+		Namespace: "ns1",
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "CPU", Align: 2, MX: true},
+			render.HeaderColumn{Name: "AGE", Time: true},
+		},
+		RowEvents: render.RowEvents{
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "ns1/a", Fields: render.Fields{"a", "10", "2m"}}),
+			render.NewRowEvent(render.EventAdd, render.Row{ID: "ns1/b", Fields: render.Fields{"b", "20", "1m"}}),
+		},
+	}
+
+	now := time.Now()
+	path, err := dao.SaveSnapshot(dir, data, now)
+	assert.Nil(t, err)
+	assert.Equal(t, dir, filepath.Dir(path))
+
+	ss, err := dao.ListSnapshots(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, now.UnixNano(), ss[0].Time.UnixNano())
+
+	d, err := dao.LoadSnapshot(ss[0].Path)
+	assert.Nil(t, err)
+	assert.Equal(t, data, d)
+}
+
+func TestSnapshotPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-snapshots")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	for _, d := range []time.Duration{30 * time.Minute, 20 * time.Minute, time.Minute} {
+		_, err := dao.SaveSnapshot(dir, render.TableData{}, now.Add(-d))
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, dao.PruneSnapshots(dir, 25*time.Minute, now))
+	ss, err := dao.ListSnapshots(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ss))
+	assert.Equal(t, now.Add(-20*time.Minute).UnixNano(), ss[0].Time.UnixNano())
+
+	ss, err = dao.ListSnapshots(filepath.Join(dir, "zorg"))
+	assert.Nil(t, err)
+	assert.Nil(t, ss)
+}
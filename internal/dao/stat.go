@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Stat)(nil)
+
+// StatsLister represents a source of session stats.
+type StatsLister interface {
+	// Stats returns the current session stats.
+	Stats() []render.SessionStat
+}
+
+// Stat represents session stats.
+type Stat struct {
+	NonResource
+}
+
+// List returns a collection of session stats.
+func (s *Stat) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	l, ok := ctx.Value(internal.KeyStats).(StatsLister)
+	if !ok || l == nil {
+		return nil, errors.New("no session stats available")
+	}
+
+	ss := l.Stats()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		oo = append(oo, s)
+	}
+
+	return oo, nil
+}
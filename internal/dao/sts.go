@@ -23,6 +23,7 @@ var (
 	_ Nuker           = (*StatefulSet)(nil)
 	_ Loggable        = (*StatefulSet)(nil)
 	_ Restartable     = (*StatefulSet)(nil)
+	_ Undoable        = (*StatefulSet)(nil)
 	_ Scalable        = (*StatefulSet)(nil)
 	_ Controller      = (*StatefulSet)(nil)
 	_ ContainsPodSpec = (*StatefulSet)(nil)
@@ -58,9 +59,11 @@ func (s *StatefulSet) Scale(ctx context.Context, path string, replicas int32) er
 		return err
 	}
 	scale.Spec.Replicas = replicas
-	_, err = dial.AppsV1().StatefulSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
 
-	return err
+	return Retry(ctx, func() error {
+		_, err := dial.AppsV1().StatefulSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{DryRun: dryRunOpts()})
+		return err
+	})
 }
 
 // Restart a StatefulSet rollout.
@@ -93,11 +96,26 @@ func (s *StatefulSet) Restart(ctx context.Context, path string) error {
 		sts.Name,
 		types.StrategicMergePatchType,
 		update,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
 
+// Revisions returns the StatefulSet rollout history.
+func (s *StatefulSet) Revisions(path string) ([]RolloutRevision, error) {
+	return rolloutRevisions(s.Factory, "apps/v1/statefulsets", "apps/v1/controllerrevisions", path)
+}
+
+// Undo rolls a StatefulSet back to a given revision.
+func (s *StatefulSet) Undo(path string, rev int64) error {
+	sts, err := s.getStatefulSet(path)
+	if err != nil {
+		return err
+	}
+
+	return undoRollout(s.Client(), "apps/v1/statefulsets", "StatefulSet", sts.Namespace, sts, rev)
+}
+
 // TailLogs tail logs for all pods represented by this StatefulSet.
 func (s *StatefulSet) TailLogs(ctx context.Context, c LogChan, opts LogOptions) error {
 	sts, err := s.getStatefulSet(opts.Path)
@@ -255,7 +273,7 @@ func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs Ima
 		n,
 		types.StrategicMergePatchType,
 		jsonPatch,
-		metav1.PatchOptions{},
+		metav1.PatchOptions{DryRun: dryRunOpts()},
 	)
 	return err
 }
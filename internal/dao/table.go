@@ -61,6 +61,7 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		Namespace(ns).
 		Resource(t.gvr.R()).
 		VersionedParams(&metav1.ListOptions{LabelSelector: labelSel}, codec).
+		VersionedParams(&metav1beta1.TableOptions{IncludeObject: metav1.IncludeObject}, codec).
 		Do(ctx).Get()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,153 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// TaintEffects tracks valid taint effects.
+var TaintEffects = []string{
+	string(v1.TaintEffectNoSchedule),
+	string(v1.TaintEffectPreferNoSchedule),
+	string(v1.TaintEffectNoExecute),
+}
+
+// TaintString returns a taint as key[=value]:effect.
+func TaintString(t v1.Taint) string {
+	s := t.Key
+	if t.Value != "" {
+		s += "=" + t.Value
+	}
+
+	return s + ":" + string(t.Effect)
+}
+
+// ParseTaint parses a key[=value]:effect taint specification.
+func ParseTaint(s string) (v1.Taint, error) {
+	var t v1.Taint
+	s = strings.TrimSpace(s)
+	i := strings.LastIndex(s, ":")
+	if i == -1 {
+		return t, fmt.Errorf("invalid taint %q. Expecting key[=value]:effect", s)
+	}
+	kv, effect := s[:i], s[i+1:]
+	tokens := strings.SplitN(kv, "=", 2)
+	t.Key, t.Effect = tokens[0], v1.TaintEffect(effect)
+	if len(tokens) == 2 {
+		t.Value = tokens[1]
+	}
+
+	return t, ValidateTaint(t)
+}
+
+// ValidateTaint checks a taint key, value and effect.
+func ValidateTaint(t v1.Taint) error {
+	if errs := validation.IsQualifiedName(t.Key); len(errs) > 0 {
+		return fmt.Errorf("invalid taint key %q: %s", t.Key, strings.Join(errs, ", "))
+	}
+	if t.Value != "" {
+		if errs := validation.IsValidLabelValue(t.Value); len(errs) > 0 {
+			return fmt.Errorf("invalid taint value %q: %s", t.Value, strings.Join(errs, ", "))
+		}
+	}
+	for _, e := range TaintEffects {
+		if string(t.Effect) == e {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid taint effect %q. Expecting one of %s", t.Effect, strings.Join(TaintEffects, ", "))
+}
+
+// AddTaint adds a taint or updates the value of a taint with the same key
+// and effect.
+func AddTaint(tt []v1.Taint, t v1.Taint) []v1.Taint {
+	res := make([]v1.Taint, 0, len(tt)+1)
+	var found bool
+	for _, o := range tt {
+		if o.Key == t.Key && o.Effect == t.Effect {
+			o.Value, found = t.Value, true
+		}
+		res = append(res, o)
+	}
+	if !found {
+		res = append(res, t)
+	}
+
+	return res
+}
+
+// RemoveTaint removes the taint matching the given key and effect.
+func RemoveTaint(tt []v1.Taint, key string, effect v1.TaintEffect) []v1.Taint {
+	res := make([]v1.Taint, 0, len(tt))
+	for _, t := range tt {
+		if t.Key == key && t.Effect == effect {
+			continue
+		}
+		res = append(res, t)
+	}
+
+	return res
+}
+
+// TaintsPatch returns a merge patch replacing a node taints.
+func TaintsPatch(tt []v1.Taint) ([]byte, error) {
+	if tt == nil {
+		tt = []v1.Taint{}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"taints": tt,
+		},
+	})
+}
+
+// Taints returns a node taints.
+func (n *Node) Taints(ctx context.Context, path string) ([]v1.Taint, error) {
+	no, err := FetchNode(ctx, n.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return no.Spec.Taints, nil
+}
+
+// SetTaints replaces a node taints.
+func (n *Node) SetTaints(ctx context.Context, path string, tt []v1.Taint) error {
+	for _, t := range tt {
+		if err := ValidateTaint(t); err != nil {
+			return err
+		}
+	}
+	auth, err := n.Client().CanI(client.ClusterScope, n.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update taints on %s", path)
+	}
+
+	patch, err := TaintsPatch(tt)
+	if err != nil {
+		return err
+	}
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, name := client.Namespaced(path)
+
+	return Retry(ctx, func() error {
+		_, err := dial.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{DryRun: dryRunOpts()})
+		return err
+	})
+}
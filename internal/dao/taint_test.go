@@ -0,0 +1,93 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestParseTaint(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   v1.Taint
+		err bool
+	}{
+		"full": {
+			s: "dedicated=gpu:NoSchedule",
+			e: v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		},
+		"noValue": {
+			s: "example.com/spot:PreferNoSchedule",
+			e: v1.Taint{Key: "example.com/spot", Effect: v1.TaintEffectPreferNoSchedule},
+		},
+		"noEffect": {
+			s:   "dedicated=gpu",
+			err: true,
+		},
+		"badEffect": {
+			s:   "dedicated=gpu:Never",
+			err: true,
+		},
+		"badKey": {
+			s:   "-fred:NoExecute",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta, err := dao.ParseTaint(u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ta)
+			assert.Equal(t, u.s, dao.TaintString(ta))
+		})
+	}
+}
+
+func TestAddRemoveTaint(t *testing.T) {
+	tt := []v1.Taint{
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoSchedule},
+		{Key: "a", Value: "1", Effect: v1.TaintEffectNoExecute},
+	}
+
+	tt = dao.AddTaint(tt, v1.Taint{Key: "a", Value: "2", Effect: v1.TaintEffectNoSchedule})
+	assert.Equal(t, 2, len(tt))
+	assert.Equal(t, "2", tt[0].Value)
+
+	tt = dao.AddTaint(tt, v1.Taint{Key: "b", Effect: v1.TaintEffectNoSchedule})
+	assert.Equal(t, 3, len(tt))
+
+	tt = dao.RemoveTaint(tt, "a", v1.TaintEffectNoExecute)
+	assert.Equal(t, []string{"a=2:NoSchedule", "b:NoSchedule"}, []string{dao.TaintString(tt[0]), dao.TaintString(tt[1])})
+}
+
+func TestTaintsPatch(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Taint
+		e  string
+	}{
+		"none": {
+			e: `{"spec":{"taints":[]}}`,
+		},
+		"one": {
+			tt: []v1.Taint{{Key: "a", Effect: v1.TaintEffectNoSchedule}},
+			e:  `{"spec":{"taints":[{"key":"a","effect":"NoSchedule"}]}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := dao.TaintsPatch(u.tt)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
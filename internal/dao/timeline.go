@@ -0,0 +1,173 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const eventGVR = "v1/events"
+
+// timelineChildren tracks the resources that may be owned by a timeline
+// subject, ie deployment -> replicasets -> pods.
+var timelineChildren = []string{
+	"apps/v1/replicasets",
+	"batch/v1/jobs",
+	"v1/pods",
+}
+
+// TimelineEvent represents an event involving a resource or its children.
+type TimelineEvent struct {
+	At      time.Time
+	Type    string
+	Reason  string
+	Message string
+	Kind    string
+	Name    string
+	Count   int32
+}
+
+// IsWarning returns true if the event reports a problem.
+func (e TimelineEvent) IsWarning() bool {
+	return e.Type == v1.EventTypeWarning
+}
+
+// TimelineEvents represents a chronological collection of events.
+type TimelineEvents []TimelineEvent
+
+// Timeline returns the events involving a resource and the resources it
+// owns, oldest first.
+func Timeline(f Factory, gvr, path string) (TimelineEvents, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	ns := u.GetNamespace()
+	refs := timelineRefs{uids: make(map[types.UID]struct{})}
+	refs.add(u)
+	if ns != "" {
+		for _, c := range timelineChildren {
+			oo, err := f.List(c, ns, true, labels.Everything())
+			if err != nil {
+				continue
+			}
+			refs.addOwned(oo)
+		}
+	}
+
+	evNS := ns
+	if evNS == "" {
+		evNS = client.AllNamespaces
+	}
+	ee, err := f.List(eventGVR, evNS, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return refs.events(ee), nil
+}
+
+type timelineRef struct {
+	kind, ns, name string
+}
+
+type timelineRefs struct {
+	uids map[types.UID]struct{}
+	refs []timelineRef
+}
+
+func (t *timelineRefs) add(u *unstructured.Unstructured) {
+	t.uids[u.GetUID()] = struct{}{}
+	t.refs = append(t.refs, timelineRef{kind: u.GetKind(), ns: u.GetNamespace(), name: u.GetName()})
+}
+
+// addOwned adds the resources owned by any tracked resource. Children kinds
+// are listed in owner order so grand children get picked up too.
+func (t *timelineRefs) addOwned(oo []runtime.Object) {
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if _, ok := t.uids[u.GetUID()]; ok {
+			continue
+		}
+		for _, ref := range u.GetOwnerReferences() {
+			if _, ok := t.uids[ref.UID]; ok {
+				t.add(u)
+				break
+			}
+		}
+	}
+}
+
+func (t *timelineRefs) involves(ev *v1.Event) bool {
+	if _, ok := t.uids[ev.InvolvedObject.UID]; ok && ev.InvolvedObject.UID != "" {
+		return true
+	}
+	for _, r := range t.refs {
+		o := ev.InvolvedObject
+		if o.Kind == r.kind && o.Name == r.name && (r.ns == "" || o.Namespace == r.ns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *timelineRefs) events(oo []runtime.Object) TimelineEvents {
+	ee := make(TimelineEvents, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			continue
+		}
+		if !t.involves(&ev) {
+			continue
+		}
+		ee = append(ee, TimelineEvent{
+			At:      eventTime(&ev),
+			Type:    ev.Type,
+			Reason:  ev.Reason,
+			Message: ev.Message,
+			Kind:    ev.InvolvedObject.Kind,
+			Name:    ev.InvolvedObject.Name,
+			Count:   ev.Count,
+		})
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].At.Before(ee[j].At)
+	})
+
+	return ee
+}
+
+// eventTime returns the last time an event occurred.
+func eventTime(ev *v1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.FirstTimestamp.Time
+	}
+}
@@ -0,0 +1,93 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTimeline(t *testing.T) {
+	at := time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC)
+	f := timelineFactory{oo: map[string][]runtime.Object{
+		"apps/v1/deployments": {makeOwned("Deployment", "dp1", "")},
+		"apps/v1/replicasets": {makeOwned("ReplicaSet", "rs1", "dp1"), makeOwned("ReplicaSet", "rs2", "dp2")},
+		"v1/pods":             {makeOwned("Pod", "p1", "rs1"), makeOwned("Pod", "p2", "rs2")},
+		"v1/events": {
+			makeTimelineEvent("Pod", "p1", v1.EventTypeWarning, "BackOff", at.Add(2*time.Minute)),
+			makeTimelineEvent("Deployment", "dp1", v1.EventTypeNormal, "ScalingReplicaSet", at),
+			makeTimelineEvent("Pod", "p2", v1.EventTypeNormal, "Pulled", at),
+			makeTimelineEvent("ReplicaSet", "rs1", v1.EventTypeNormal, "SuccessfulCreate", at.Add(time.Minute)),
+		},
+	}}
+
+	ee, err := dao.Timeline(f, "apps/v1/deployments", "default/dp1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, []string{"ScalingReplicaSet", "SuccessfulCreate", "BackOff"}, []string{ee[0].Reason, ee[1].Reason, ee[2].Reason})
+	assert.Equal(t, "p1", ee[2].Name)
+	assert.True(t, ee[2].IsWarning())
+	assert.False(t, ee[0].IsWarning())
+}
+
+// Helpers...
+
+type timelineFactory struct {
+	testFactory
+	oo map[string][]runtime.Object
+}
+
+func (f timelineFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	for _, o := range f.oo[gvr] {
+		u := o.(*unstructured.Unstructured)
+		if client.FQN(u.GetNamespace(), u.GetName()) == path {
+			return o, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (f timelineFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	return f.oo[gvr], nil
+}
+
+func makeOwned(kind, name, owner string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetNamespace("default")
+	u.SetUID(types.UID(name))
+	if owner != "" {
+		u.SetOwnerReferences([]metav1.OwnerReference{{Name: owner, UID: types.UID(owner)}})
+	}
+
+	return &u
+}
+
+func makeTimelineEvent(kind, name, typ, reason string, at time.Time) *unstructured.Unstructured {
+	ev := v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: name + "." + reason, Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      kind,
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name),
+		},
+		Type:          typ,
+		Reason:        reason,
+		LastTimestamp: metav1.NewTime(at),
+	}
+	m, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(&ev)
+
+	return &unstructured.Unstructured{Object: m}
+}
@@ -2,10 +2,10 @@ package dao
 
 import (
 	"context"
-	"io"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	restclient "k8s.io/client-go/rest"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 // ResourceMetas represents a collection of resource metadata.
@@ -48,6 +49,49 @@ type Factory interface {
 	Forwarders() watch.Forwarders
 }
 
+// InformerSharer represents a factory sharing informers across views.
+type InformerSharer interface {
+	// Acquire pins a running informer while a view uses it.
+	Acquire(ns, gvr string) bool
+
+	// Release unpins an informer.
+	Release(ns, gvr string)
+}
+
+// CacheSyncer represents a factory reporting its informers cache state.
+type CacheSyncer interface {
+	// HasSynced checks if a resource informer cache is loaded.
+	HasSynced(gvr, ns string) (bool, error)
+}
+
+// ChangeNotifier notifies of resource changes.
+type ChangeNotifier interface {
+	// Subscribe registers a callback fired when a resource changes. It
+	// returns a function to unsubscribe or false if the resource is not watched.
+	Subscribe(ns, gvr string, fn func()) (func(), bool)
+}
+
+// MetricsCache represents a cached metrics source.
+type MetricsCache interface {
+	// PodsMetrics returns cached pods metrics and how long they have been stale.
+	PodsMetrics(ns string) (*mv1beta1.PodMetricsList, time.Duration)
+
+	// NodesMetrics returns cached nodes metrics and how long they have been stale.
+	NodesMetrics() (*mv1beta1.NodeMetricsList, time.Duration)
+}
+
+// VulnCache represents a cached images vulnerabilities source.
+type VulnCache interface {
+	// Vulns returns an image cached vulnerabilities summary if scanned.
+	Vulns(image string) (render.VulnSummary, bool)
+}
+
+// VulnScanner represents an images vulnerabilities scanner.
+type VulnScanner interface {
+	// Scan scans an image for known vulnerabilities.
+	Scan(ctx context.Context, image string) (render.VulnSummary, error)
+}
+
 // Getter represents a resource getter.
 type Getter interface {
 	// Get return a given resource.
@@ -79,6 +123,7 @@ type DrainOptions struct {
 	IgnoreAllDaemonSets bool
 	DeleteLocalData     bool
 	Force               bool
+	DryRun              bool
 }
 
 // NodeMaintainer performs node maintenance operations.
@@ -86,8 +131,53 @@ type NodeMaintainer interface {
 	// ToggleCordon toggles cordon/uncordon a node.
 	ToggleCordon(path string, cordon bool) error
 
-	// Drain drains the given node.
-	Drain(path string, opts DrainOptions, w io.Writer) error
+	// DrainPlan computes the pods evicted, skipped or blocking a node drain.
+	DrainPlan(path string, opts DrainOptions) (DrainPlan, error)
+
+	// Evict evicts a drained pod and waits for its deletion.
+	Evict(ctx context.Context, path string, opts DrainOptions) error
+
+	// DrainImpacts computes the disruption budgets affected by a drain.
+	DrainImpacts(path string) ([]DisruptionImpact, error)
+}
+
+// DisruptionPreviewer computes the disruption budgets affected by deletes.
+type DisruptionPreviewer interface {
+	// DeleteImpacts computes the disruption budgets affected by deleting resources.
+	DeleteImpacts(paths []string) ([]DisruptionImpact, error)
+}
+
+// Creator creates resources.
+type Creator interface {
+	// Create creates a resource.
+	Create(ctx context.Context, o runtime.Object) error
+}
+
+// SecretEditor edits secrets with decoded values.
+type SecretEditor interface {
+	// EditBuffer returns a secret manifest with decoded values.
+	EditBuffer(path string) ([]byte, error)
+
+	// SaveBuffer encodes an edited secret manifest and updates the secret.
+	SaveBuffer(ctx context.Context, path string, raw []byte) error
+}
+
+// Tainter manages node taints.
+type Tainter interface {
+	// Taints returns a node taints.
+	Taints(ctx context.Context, path string) ([]v1.Taint, error)
+
+	// SetTaints replaces a node taints.
+	SetTaints(ctx context.Context, path string, tt []v1.Taint) error
+}
+
+// Debugger attaches ephemeral debug containers to pods.
+type Debugger interface {
+	// Debug injects a debug container in a pod and returns its name.
+	Debug(ctx context.Context, path string, opts DebugOptions) (string, error)
+
+	// IsDebuggerRunning checks if a debug container is running.
+	IsDebuggerRunning(ctx context.Context, path, co string) (bool, error)
 }
 
 // Loggable represents resources with logs.
@@ -111,6 +201,21 @@ type Scalable interface {
 	Scale(ctx context.Context, path string, replicas int32) error
 }
 
+// Labeler represents resources with updatable labels and annotations.
+type Labeler interface {
+	// Relabel adds or removes labels or annotations on a resource.
+	Relabel(ctx context.Context, path, field string, oo []MetaOp) error
+}
+
+// Editor represents resources that can be saved from edited manifests.
+type Editor interface {
+	// Apply saves an edited manifest using a server side apply.
+	Apply(ctx context.Context, path string, manifest []byte, manager string, force bool) error
+
+	// PatchEdit saves the changes between two manifests as a patch.
+	PatchEdit(ctx context.Context, path string, original, modified []byte, manager string) (bool, error)
+}
+
 // Controller represents a pod controller.
 type Controller interface {
 	// Pod returns a pod instance matching the selector.
@@ -135,12 +240,42 @@ type Restartable interface {
 	Restart(ctx context.Context, path string) error
 }
 
+// Pausable represents a resource which rollout can be paused.
+type Pausable interface {
+	// Pause pauses a rollout.
+	Pause(ctx context.Context, path string) error
+
+	// Resume resumes a paused rollout.
+	Resume(ctx context.Context, path string) error
+}
+
+// Undoable represents a resource with a rollout history.
+type Undoable interface {
+	// Revisions returns the rollout history, most recent first.
+	Revisions(path string) ([]RolloutRevision, error)
+
+	// Undo rolls back to a given revision. Zero picks the previous revision.
+	Undo(path string, rev int64) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
 	Run(path string) error
 }
 
+// Suspendable represents a resource with a schedule that can be suspended.
+type Suspendable interface {
+	// SetSuspend suspends or resumes a schedule.
+	SetSuspend(ctx context.Context, path string, suspend bool) error
+}
+
+// Rerunnable represents a resource that can be run again.
+type Rerunnable interface {
+	// Rerun runs a copy of a resource and returns the copy path.
+	Rerun(ctx context.Context, path string) (string, error)
+}
+
 // Logger represents a resource that exposes logs.
 type Logger interface {
 	// Logs tails a resource logs.
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	trivyCritical = "CRITICAL"
+	trivyHigh     = "HIGH"
+	trivyMedium   = "MEDIUM"
+	trivyLow      = "LOW"
+)
+
+var _ VulnScanner = (*TrivyScanner)(nil)
+
+// TrivyScanner scans images by submitting them to a Trivy server.
+type TrivyScanner struct {
+	cfg *config.Trivy
+}
+
+type (
+	trivyReport struct {
+		Results []trivyResult `json:"Results"`
+	}
+
+	trivyResult struct {
+		Target          string      `json:"Target"`
+		Vulnerabilities []trivyVuln `json:"Vulnerabilities"`
+	}
+
+	trivyVuln struct {
+		ID       string `json:"VulnerabilityID"`
+		Severity string `json:"Severity"`
+	}
+)
+
+// NewTrivyScanner returns a new scanner.
+func NewTrivyScanner(cfg *config.Trivy) *TrivyScanner {
+	return &TrivyScanner{cfg: cfg}
+}
+
+// Scan scans an image for known vulnerabilities.
+func (t *TrivyScanner) Scan(ctx context.Context, image string) (render.VulnSummary, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.cfg.Binary, t.args(image)...)
+	cmd.Stderr = &stderr
+	raw, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return render.VulnSummary{}, fmt.Errorf("trivy scan failed for %s: %s", image, msg)
+		}
+		return render.VulnSummary{}, fmt.Errorf("trivy scan failed for %s: %w", image, err)
+	}
+
+	return parseTrivyReport(raw)
+}
+
+func (t *TrivyScanner) args(image string) []string {
+	args := []string{"image", "--server", t.cfg.Server, "--format", "json", "--quiet"}
+	if t.cfg.Token != "" {
+		args = append(args, "--token", t.cfg.Token)
+	}
+
+	return append(args, image)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseTrivyReport tallies a Trivy json report. Older Trivy versions emit
+// the bare results list.
+func parseTrivyReport(raw []byte) (render.VulnSummary, error) {
+	var (
+		r   trivyReport
+		sum render.VulnSummary
+	)
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return sum, errors.New("empty trivy report")
+	}
+	if raw[0] == '[' {
+		if err := json.Unmarshal(raw, &r.Results); err != nil {
+			return sum, fmt.Errorf("invalid trivy report: %w", err)
+		}
+	} else if err := json.Unmarshal(raw, &r); err != nil {
+		return sum, fmt.Errorf("invalid trivy report: %w", err)
+	}
+
+	for _, res := range r.Results {
+		for _, v := range res.Vulnerabilities {
+			switch strings.ToUpper(v.Severity) {
+			case trivyCritical:
+				sum.Critical++
+			case trivyHigh:
+				sum.High++
+			case trivyMedium:
+				sum.Medium++
+			case trivyLow:
+				sum.Low++
+			default:
+				sum.Unknown++
+			}
+		}
+	}
+
+	return sum, nil
+}
+
+// podImages returns a pod distinct images.
+func podImages(u *unstructured.Unstructured) []string {
+	var (
+		ii   []string
+		seen = make(map[string]struct{})
+	)
+	for _, f := range []string{"initContainers", "containers"} {
+		cc, _, _ := unstructured.NestedSlice(u.Object, "spec", f)
+		for _, c := range cc {
+			co, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			i, _ := co["image"].(string)
+			if _, ok := seen[i]; ok || i == "" {
+				continue
+			}
+			seen[i] = struct{}{}
+			ii = append(ii, i)
+		}
+	}
+
+	return ii
+}
+
+// podVulns tallies a pod images known vulnerabilities or nil if none of
+// its images were scanned yet.
+func podVulns(c VulnCache, u *unstructured.Unstructured) *render.VulnSummary {
+	var (
+		sum     render.VulnSummary
+		scanned bool
+	)
+	for _, i := range podImages(u) {
+		v, ok := c.Vulns(i)
+		if !ok {
+			continue
+		}
+		sum, scanned = sum.Add(v), true
+	}
+	if !scanned {
+		return nil
+	}
+
+	return &sum
+}
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseTrivyReport(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   render.VulnSummary
+		err string
+	}{
+		"report": {
+			raw: `{"SchemaVersion":2,"Results":[
+				{"Target":"os","Vulnerabilities":[{"VulnerabilityID":"CVE-1","Severity":"CRITICAL"},{"VulnerabilityID":"CVE-2","Severity":"HIGH"}]},
+				{"Target":"app","Vulnerabilities":[{"VulnerabilityID":"CVE-3","Severity":"LOW"},{"VulnerabilityID":"CVE-4","Severity":"UNKNOWN"}]}
+			]}`,
+			e: render.VulnSummary{Critical: 1, High: 1, Low: 1, Unknown: 1},
+		},
+		"legacy": {
+			raw: `[{"Target":"os","Vulnerabilities":[{"VulnerabilityID":"CVE-1","Severity":"MEDIUM"}]}]`,
+			e:   render.VulnSummary{Medium: 1},
+		},
+		"clean": {
+			raw: `{"Results":[{"Target":"os"}]}`,
+		},
+		"blank": {
+			err: "empty trivy report",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sum, err := parseTrivyReport([]byte(u.raw))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, sum)
+		})
+	}
+}
+
+func TestTrivyArgs(t *testing.T) {
+	s := NewTrivyScanner(&config.Trivy{Server: "http://trivy:4954", Token: "fred"})
+
+	assert.Equal(t, []string{"image", "--server", "http://trivy:4954", "--format", "json", "--quiet", "--token", "fred", "nginx"}, s.args("nginx"))
+}
+
+func TestPodVulns(t *testing.T) {
+	po := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"initContainers": []interface{}{
+				map[string]interface{}{"name": "i1", "image": "busybox"},
+			},
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "nginx"},
+				map[string]interface{}{"name": "c2", "image": "nginx"},
+				map[string]interface{}{"name": "c3", "image": "redis"},
+			},
+		},
+	}}
+	assert.Equal(t, []string{"busybox", "nginx", "redis"}, podImages(po))
+
+	c := fakeVulnCache{
+		"nginx": {Critical: 1, High: 2},
+		"redis": {High: 1},
+	}
+	assert.Equal(t, &render.VulnSummary{Critical: 1, High: 3}, podVulns(c, po))
+	assert.Nil(t, podVulns(fakeVulnCache{}, po))
+}
+
+// Helpers...
+
+type fakeVulnCache map[string]render.VulnSummary
+
+func (f fakeVulnCache) Vulns(image string) (render.VulnSummary, bool) {
+	v, ok := f[image]
+	return v, ok
+}
@@ -0,0 +1,212 @@
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// YAMLStreamer represents a resource that can stream its YAML representation.
+type YAMLStreamer interface {
+	// StreamYAML writes a resource YAML one entry at a time.
+	StreamYAML(path string, showManaged bool, w io.Writer) error
+}
+
+// EncodeYAML streams a resource YAML representation one entry at a time so
+// consumers can start rendering large manifests before encoding completes.
+func EncodeYAML(o runtime.Object, showManaged bool, w io.Writer) error {
+	if o == nil {
+		return errors.New("no object to yamlize")
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		raw, err := ToYAML(o, showManaged)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, raw)
+		return err
+	}
+
+	m := u.Object
+	if !showManaged {
+		m = withoutManagedFields(m)
+	}
+
+	return encodeYAMLMap(w, m, 0, false)
+}
+
+// encodeYAMLMap writes a map entries at a given nesting level. Nested maps
+// and lists are walked so each leaf entry is written as soon as encoded.
+func encodeYAMLMap(w io.Writer, m map[string]interface{}, level int, item bool) error {
+	kk, err := yamlKeys(m)
+	if err != nil {
+		return err
+	}
+	if kk == nil {
+		raw, err := yamlAt(m, level)
+		if err != nil {
+			return err
+		}
+		return writeYAML(w, raw, level, item)
+	}
+
+	for i, k := range kk {
+		first := item && i == 0
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				break
+			}
+			if err := writeYAMLKey(w, k, level, first); err != nil {
+				return err
+			}
+			if err := encodeYAMLMap(w, v, level+1, false); err != nil {
+				return err
+			}
+			continue
+		case []interface{}:
+			if len(v) == 0 {
+				break
+			}
+			if err := writeYAMLKey(w, k, level, first); err != nil {
+				return err
+			}
+			if err := encodeYAMLList(w, k, v, level); err != nil {
+				return err
+			}
+			continue
+		}
+		raw, err := yamlAt(map[string]interface{}{k: m[k]}, level)
+		if err != nil {
+			return err
+		}
+		if err := writeYAML(w, raw, level, first); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeYAMLList writes a list items. Lists are laid out at their key level.
+func encodeYAMLList(w io.Writer, k string, ll []interface{}, level int) error {
+	for _, v := range ll {
+		if m, ok := v.(map[string]interface{}); ok && len(m) > 0 {
+			if err := encodeYAMLMap(w, m, level+1, true); err != nil {
+				return err
+			}
+			continue
+		}
+		raw, err := yamlAt(map[string]interface{}{k: []interface{}{v}}, level)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(raw[bytes.IndexByte(raw, '\n')+1:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helpers...
+
+func withoutManagedFields(m map[string]interface{}) map[string]interface{} {
+	meta, ok := m["metadata"].(map[string]interface{})
+	if !ok {
+		return m
+	}
+	if _, ok := meta["managedFields"]; !ok {
+		return m
+	}
+
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	mm := make(map[string]interface{}, len(meta))
+	for k, v := range meta {
+		if k != "managedFields" {
+			mm[k] = v
+		}
+	}
+	out["metadata"] = mm
+
+	return out
+}
+
+// yamlAt encodes a value nested at a given level so indentation and line
+// folding match a whole document encoding.
+func yamlAt(v interface{}, level int) ([]byte, error) {
+	for i := 0; i < level; i++ {
+		v = map[string]interface{}{"x": v}
+	}
+	raw, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < level; i++ {
+		raw = raw[bytes.IndexByte(raw, '\n')+1:]
+	}
+
+	return raw, nil
+}
+
+// yamlKeys returns a map keys in encoding order or nil if keys can not be
+// walked one at a time.
+func yamlKeys(m map[string]interface{}) ([]string, error) {
+	kk := make([]string, 0, len(m))
+	idx := make(map[string]interface{}, len(m))
+	for k := range m {
+		idx[k] = len(kk)
+		kk = append(kk, k)
+	}
+	raw, err := yaml.Marshal(idx)
+	if err != nil {
+		return nil, err
+	}
+
+	ll := bytes.Split(bytes.TrimSuffix(raw, []byte("\n")), []byte("\n"))
+	if len(ll) != len(kk) {
+		return nil, nil
+	}
+	out := make([]string, 0, len(kk))
+	for _, l := range ll {
+		i := bytes.LastIndex(l, []byte(": "))
+		if i < 0 {
+			return nil, nil
+		}
+		n, err := strconv.Atoi(string(l[i+2:]))
+		if err != nil || n < 0 || n >= len(kk) {
+			return nil, nil
+		}
+		out = append(out, kk[n])
+	}
+
+	return out, nil
+}
+
+func writeYAMLKey(w io.Writer, k string, level int, item bool) error {
+	raw, err := yamlAt(map[string]interface{}{k: nil}, level)
+	if err != nil {
+		return err
+	}
+
+	return writeYAML(w, append(bytes.TrimSuffix(raw, []byte(" null\n")), '\n'), level, item)
+}
+
+// writeYAML writes an encoded entry, flagging list items on the first line.
+func writeYAML(w io.Writer, raw []byte, level int, item bool) error {
+	if item && level > 0 {
+		raw = append(append(bytes.Repeat([]byte(" "), 2*(level-1)), "- "...), raw[2*level:]...)
+	}
+	_, err := w.Write(raw)
+
+	return err
+}
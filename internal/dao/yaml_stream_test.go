@@ -0,0 +1,134 @@
+package dao
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestEncodeYAML(t *testing.T) {
+	uu := map[string]struct {
+		o           *unstructured.Unstructured
+		showManaged bool
+	}{
+		"pod": {
+			o: load(t, "p1"),
+		},
+		"node": {
+			o: load(t, "n1"),
+		},
+		"managed": {
+			o:           managedObject(),
+			showManaged: true,
+		},
+		"unmanaged": {
+			o: managedObject(),
+		},
+		"nested": {
+			o: nestedObject(),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := ToYAML(u.o, u.showManaged)
+			assert.Nil(t, err)
+
+			var buff bytes.Buffer
+			assert.Nil(t, EncodeYAML(u.o, u.showManaged, &buff))
+			assert.Equal(t, e, buff.String())
+		})
+	}
+}
+
+func TestEncodeYAMLKeepsObject(t *testing.T) {
+	o := managedObject()
+
+	var buff bytes.Buffer
+	assert.Nil(t, EncodeYAML(o, false, &buff))
+	assert.NotContains(t, buff.String(), "managedFields")
+	_, ok := o.Object["metadata"].(map[string]interface{})["managedFields"]
+	assert.True(t, ok)
+}
+
+func TestEncodeYAMLIncremental(t *testing.T) {
+	var w countWriter
+	assert.Nil(t, EncodeYAML(nestedObject(), false, &w))
+	assert.True(t, w.writes > 10)
+}
+
+// Helpers...
+
+type countWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (w *countWriter) Write(b []byte) (int, error) {
+	w.writes++
+	return w.Buffer.Write(b)
+}
+
+func nestedObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name": "fred",
+			"annotations": map[string]interface{}{
+				"a10":         "ten",
+				"a2":          "two",
+				"description": "The quick brown fox jumps over the lazy dog because the dog is lazy and the fox is quick",
+			},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":    "c1",
+					"args":    []interface{}{"-v", int64(10), []interface{}{"a", "b"}, map[string]interface{}{}},
+					"command": []interface{}{},
+					"ports": []interface{}{
+						map[string]interface{}{"containerPort": int64(80), "protocol": "TCP"},
+						map[string]interface{}{"containerPort": int64(443)},
+					},
+					"env": []interface{}{
+						map[string]interface{}{
+							"valueFrom": map[string]interface{}{
+								"secretKeyRef": map[string]interface{}{"key": "k", "name": "s"},
+							},
+							"name": "SECRET",
+						},
+					},
+					"resources": map[string]interface{}{},
+				},
+			},
+			"script":  "#!/bin/sh\necho hello\n",
+			"enabled": true,
+			"ratio":   0.5,
+			"nothing": nil,
+		},
+	}}
+}
+
+func managedObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "fred",
+			"namespace": "blee",
+			"labels":    map[string]interface{}{"app.kubernetes.io/name": "fred", "123": "duh"},
+			"managedFields": []interface{}{
+				map[string]interface{}{"manager": "kubectl"},
+			},
+		},
+		"data": map[string]interface{}{
+			"script": "#!/bin/sh\necho hello\n",
+			"empty":  "",
+		},
+		"spec": map[string]interface{}{},
+	}}
+}
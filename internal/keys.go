@@ -5,29 +5,45 @@ type ContextKey string
 
 // A collection of context keys.
 const (
-	KeyFactory     ContextKey = "factory"
-	KeyLabels      ContextKey = "labels"
-	KeyFields      ContextKey = "fields"
-	KeyTable       ContextKey = "table"
-	KeyDir         ContextKey = "dir"
-	KeyPath        ContextKey = "path"
-	KeySubject     ContextKey = "subject"
-	KeyGVR         ContextKey = "gvr"
-	KeyForwards    ContextKey = "forwards"
-	KeyContainers  ContextKey = "containers"
-	KeyBenchCfg    ContextKey = "benchcfg"
-	KeyAliases     ContextKey = "aliases"
-	KeyUID         ContextKey = "uid"
-	KeySubjectKind ContextKey = "subjectKind"
-	KeySubjectName ContextKey = "subjectName"
-	KeyNamespace   ContextKey = "namespace"
-	KeyCluster     ContextKey = "cluster"
-	KeyApp         ContextKey = "app"
-	KeyStyles      ContextKey = "styles"
-	KeyMetrics     ContextKey = "metrics"
-	KeyHasMetrics  ContextKey = "has-metrics"
-	KeyToast       ContextKey = "toast"
-	KeyWithMetrics ContextKey = "withMetrics"
-	KeyViewConfig  ContextKey = "viewConfig"
-	KeyWait        ContextKey = "wait"
+	KeyFactory      ContextKey = "factory"
+	KeyLabels       ContextKey = "labels"
+	KeyFields       ContextKey = "fields"
+	KeyTable        ContextKey = "table"
+	KeyDir          ContextKey = "dir"
+	KeyPath         ContextKey = "path"
+	KeySubject      ContextKey = "subject"
+	KeyGVR          ContextKey = "gvr"
+	KeyForwards     ContextKey = "forwards"
+	KeyContainers   ContextKey = "containers"
+	KeyBenchCfg     ContextKey = "benchcfg"
+	KeyAliases      ContextKey = "aliases"
+	KeyUID          ContextKey = "uid"
+	KeySubjectKind  ContextKey = "subjectKind"
+	KeySubjectName  ContextKey = "subjectName"
+	KeyNamespace    ContextKey = "namespace"
+	KeyCluster      ContextKey = "cluster"
+	KeyApp          ContextKey = "app"
+	KeyStyles       ContextKey = "styles"
+	KeyMetrics      ContextKey = "metrics"
+	KeyHasMetrics   ContextKey = "has-metrics"
+	KeyToast        ContextKey = "toast"
+	KeyWithMetrics  ContextKey = "withMetrics"
+	KeyViewConfig   ContextKey = "viewConfig"
+	KeyWait         ContextKey = "wait"
+	KeyMetricsCache ContextKey = "metricsCache"
+	KeyServerTables ContextKey = "serverTables"
+	KeyPageSize     ContextKey = "pageSize"
+	KeyRetryPolicy  ContextKey = "retryPolicy"
+	KeyOwnerGVR     ContextKey = "ownerGVR"
+	KeyAlerts       ContextKey = "alerts"
+	KeyRevealed     ContextKey = "revealed"
+	KeyStats        ContextKey = "stats"
+	KeyWhoCan       ContextKey = "whoCan"
+	KeyGrep         ContextKey = "grep"
+	KeyDeprecations ContextKey = "deprecations"
+	KeyPluginJobs   ContextKey = "pluginJobs"
+	KeyPluginOut    ContextKey = "pluginOut"
+	KeyAudit        ContextKey = "audit"
+	KeyNSDiff       ContextKey = "nsdiff"
+	KeyVulnCache    ContextKey = "vulnCache"
 )
@@ -88,6 +88,12 @@ func (c *Cluster) UserName() string {
 	return n
 }
 
+// Impersonation returns the impersonated identity or blank if none.
+func (c *Cluster) Impersonation() string {
+	id, _ := c.factory.Client().Config().Impersonation()
+	return id
+}
+
 // Metrics gathers node level metrics and compute utilization percentages.
 func (c *Cluster) Metrics(ctx context.Context, mx *client.ClusterMetrics) error {
 	var nn *v1.NodeList
@@ -20,6 +20,7 @@ type ClusterInfoListener interface {
 type ClusterMeta struct {
 	Context, Cluster    string
 	User                string
+	Impersonate         string
 	K9sVer, K8sVer      string
 	Cpu, Mem, Ephemeral int
 }
@@ -53,6 +54,7 @@ func (c ClusterMeta) Deltas(n ClusterMeta) bool {
 	return c.Context != n.Context ||
 		c.Cluster != n.Cluster ||
 		c.User != n.User ||
+		c.Impersonate != n.Impersonate ||
 		c.K8sVer != n.K8sVer ||
 		c.K9sVer != n.K9sVer
 }
@@ -86,6 +88,7 @@ func (c *ClusterInfo) Refresh() {
 	data.Context = c.cluster.ContextName()
 	data.Cluster = c.cluster.ClusterName()
 	data.User = c.cluster.UserName()
+	data.Impersonate = c.cluster.Impersonation()
 	data.K9sVer = c.version
 	data.K8sVer = c.cluster.Version()
 
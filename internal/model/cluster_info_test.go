@@ -30,6 +30,15 @@ func TestClusterMetaDelta(t *testing.T) {
 			n: makeClusterMeta("freddie"),
 			e: true,
 		},
+		"impersonated": {
+			o: makeClusterMeta("fred"),
+			n: func() model.ClusterMeta {
+				m := makeClusterMeta("fred")
+				m.Impersonate = "blee[g1]"
+				return m
+			}(),
+			e: true,
+		},
 	}
 
 	for k := range uu {
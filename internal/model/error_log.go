@@ -0,0 +1,51 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultErrorLogSize tracks the number of recent errors kept around.
+const DefaultErrorLogSize = 50
+
+// ErrorLog tracks the most recent errors reported via the logger.
+type ErrorLog struct {
+	size   int
+	errors []string
+	mx     sync.RWMutex
+}
+
+// NewErrorLog returns a new recent errors log.
+func NewErrorLog(size int) *ErrorLog {
+	return &ErrorLog{size: size}
+}
+
+// Run records error level log entries. It implements zerolog.Hook.
+func (l *ErrorLog) Run(_ *zerolog.Event, level zerolog.Level, msg string) {
+	if level < zerolog.ErrorLevel || level > zerolog.PanicLevel || msg == "" {
+		return
+	}
+	l.Add(fmt.Sprintf("%s %s", time.Now().Format(time.RFC3339), msg))
+}
+
+// Add appends an error, evicting the oldest one when full.
+func (l *ErrorLog) Add(e string) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.errors = append(l.errors, e)
+	if len(l.errors) > l.size {
+		l.errors = l.errors[len(l.errors)-l.size:]
+	}
+}
+
+// List returns recent errors, oldest first.
+func (l *ErrorLog) List() []string {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return append([]string(nil), l.errors...)
+}
@@ -0,0 +1,30 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorLogAdd(t *testing.T) {
+	l := model.NewErrorLog(2)
+	l.Add("e1")
+	l.Add("e2")
+	l.Add("e3")
+
+	assert.Equal(t, []string{"e2", "e3"}, l.List())
+}
+
+func TestErrorLogRun(t *testing.T) {
+	l := model.NewErrorLog(5)
+	l.Run(nil, zerolog.InfoLevel, "blee")
+	l.Run(nil, zerolog.ErrorLevel, "")
+	l.Run(nil, zerolog.ErrorLevel, "boom")
+
+	ee := l.List()
+	assert.Equal(t, 1, len(ee))
+	assert.True(t, strings.HasSuffix(ee[0], " boom"))
+}
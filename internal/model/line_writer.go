@@ -0,0 +1,47 @@
+package model
+
+import "bytes"
+
+// yamlPageSize tracks the number of lines published before encoding completes.
+const yamlPageSize = 200
+
+// lineWriter collects streamed content as lines and publishes the first page
+// as soon as it is available.
+type lineWriter struct {
+	lines   []string
+	partial []byte
+	size    int
+	onPage  func([]string)
+	paged   bool
+}
+
+func newLineWriter(size int, onPage func([]string)) *lineWriter {
+	return &lineWriter{size: size, onPage: onPage}
+}
+
+// Write collects content lines.
+func (l *lineWriter) Write(b []byte) (int, error) {
+	n := len(b)
+	for {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			l.partial = append(l.partial, b...)
+			break
+		}
+		l.lines = append(l.lines, string(append(l.partial, b[:i]...)))
+		l.partial, b = l.partial[:0], b[i+1:]
+	}
+	if !l.paged && l.onPage != nil && len(l.lines) >= l.size {
+		l.paged = true
+		page := make([]string, len(l.lines))
+		copy(page, l.lines)
+		l.onPage(page)
+	}
+
+	return n, nil
+}
+
+// Lines returns all collected lines.
+func (l *lineWriter) Lines() []string {
+	return append(l.lines, string(l.partial))
+}
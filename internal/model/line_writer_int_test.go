@@ -0,0 +1,44 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineWriter(t *testing.T) {
+	uu := map[string]struct {
+		chunks []string
+		pages  int
+	}{
+		"empty": {},
+		"single": {
+			chunks: []string{"a: b\n"},
+		},
+		"split": {
+			chunks: []string{"a: b\nc:", " d\ne", ": f\n"},
+			pages:  1,
+		},
+		"noTrailer": {
+			chunks: []string{"a: b\nc: d"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var pages int
+			w := newLineWriter(2, func(page []string) {
+				assert.True(t, len(page) >= 2)
+				pages++
+			})
+			for _, c := range u.chunks {
+				_, err := w.Write([]byte(c))
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, strings.Split(strings.Join(u.chunks, ""), "\n"), w.Lines())
+			assert.Equal(t, u.pages, pages)
+		})
+	}
+}
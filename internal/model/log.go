@@ -64,6 +64,30 @@ func (l *Log) ToggleShowTimestamp(b bool) {
 	l.Refresh()
 }
 
+// ToggleInterleave toggles logs interleaving by timestamp with per container
+// colors.
+func (l *Log) ToggleInterleave(b bool) {
+	l.mx.Lock()
+	{
+		l.logOptions.Interleave = b
+		if b {
+			l.lines.SortByTime()
+		}
+	}
+	l.mx.Unlock()
+	l.Refresh()
+}
+
+// ToggleStructured toggles json logs pretty printing.
+func (l *Log) ToggleStructured(b bool) {
+	l.mx.Lock()
+	{
+		l.logOptions.Structured = b
+	}
+	l.mx.Unlock()
+	l.Refresh()
+}
+
 // SetSinceSeconds sets the logs retrieval time.
 func (l *Log) SetSinceSeconds(i int64) {
 	l.logOptions.SinceSeconds = i
@@ -74,6 +98,8 @@ func (l *Log) SetSinceSeconds(i int64) {
 func (l *Log) Configure(opts *config.Logger) {
 	l.logOptions.Lines = int64(opts.TailCount)
 	l.logOptions.SinceSeconds = opts.SinceSeconds
+	l.logOptions.Fields = opts.JSONFields
+	l.logOptions.MaxStreams = opts.MaxStreams
 }
 
 // GetPath returns resource path.
@@ -106,7 +132,7 @@ func (l *Log) Clear() {
 func (l *Log) Refresh() {
 	l.fireLogCleared()
 	ll := make([][]byte, len(l.lines))
-	l.lines.Render(l.logOptions.ShowTimestamp, ll)
+	l.render(l.lines, ll)
 	l.fireLogChanged(ll)
 }
 
@@ -144,7 +170,7 @@ func (l *Log) Set(items dao.LogItems) {
 
 	l.fireLogCleared()
 	ll := make([][]byte, len(l.lines))
-	l.lines.Render(l.logOptions.ShowTimestamp, ll)
+	l.render(l.lines, ll)
 	l.fireLogChanged(ll)
 }
 
@@ -158,7 +184,7 @@ func (l *Log) ClearFilter() {
 
 	l.fireLogCleared()
 	ll := make([][]byte, len(l.lines))
-	l.lines.Render(l.logOptions.ShowTimestamp, ll)
+	l.render(l.lines, ll)
 	l.fireLogChanged(ll)
 }
 
@@ -245,6 +271,9 @@ func (l *Log) Notify() {
 	defer l.mx.Unlock()
 
 	if l.lastSent < len(l.lines) {
+		if l.logOptions.Interleave {
+			l.lines[l.lastSent:].SortByTime()
+		}
 		l.fireLogBuffChanged(l.lines[l.lastSent:])
 		l.lastSent = len(l.lines)
 	}
@@ -305,7 +334,17 @@ func (l *Log) applyFilter(q string) ([][]byte, error) {
 	if q == "" {
 		return nil, nil
 	}
-	matches, indices, err := l.lines.Filter(q, l.logOptions.ShowTimestamp)
+	if l.logOptions.Structured {
+		ff, ok, err := dao.ParseFieldFilter(q)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return l.applyFieldFilter(ff), nil
+		}
+	}
+	items := l.items(l.lines)
+	matches, indices, err := items.Filter(q, l.logOptions.ShowTimestamp)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +352,7 @@ func (l *Log) applyFilter(q string) ([][]byte, error) {
 	// No filter!
 	if matches == nil {
 		ll := make([][]byte, len(l.lines))
-		l.lines.Render(l.logOptions.ShowTimestamp, ll)
+		l.render(l.lines, ll)
 		return ll, nil
 	}
 	// Blank filter
@@ -321,7 +360,7 @@ func (l *Log) applyFilter(q string) ([][]byte, error) {
 		return nil, nil
 	}
 	filtered := make([][]byte, 0, len(matches))
-	lines := l.lines.Lines(l.logOptions.ShowTimestamp)
+	lines := items.Lines(l.logOptions.ShowTimestamp)
 	for i, idx := range matches {
 		filtered = append(filtered, color.Highlight(lines[idx], indices[i], 209))
 	}
@@ -329,10 +368,43 @@ func (l *Log) applyFilter(q string) ([][]byte, error) {
 	return filtered, nil
 }
 
+func (l *Log) applyFieldFilter(ff dao.FieldFilter) [][]byte {
+	matches := l.lines.FilterFields(ff)
+	if len(matches) == 0 {
+		return nil
+	}
+	lines := make(dao.LogItems, 0, len(matches))
+	for _, idx := range matches {
+		lines = append(lines, l.lines[idx])
+	}
+	ll := make([][]byte, len(lines))
+	l.render(lines, ll)
+
+	return ll
+}
+
+// items returns the log items as displayed.
+func (l *Log) items(lines dao.LogItems) dao.LogItems {
+	if !l.logOptions.Structured {
+		return lines
+	}
+
+	return lines.Structured(l.logOptions.Fields)
+}
+
+func (l *Log) render(lines dao.LogItems, ll [][]byte) {
+	lines = l.items(lines)
+	if l.logOptions.Interleave {
+		lines.RenderPerContainer(l.logOptions.ShowTimestamp, ll)
+		return
+	}
+	lines.Render(l.logOptions.ShowTimestamp, ll)
+}
+
 func (l *Log) fireLogBuffChanged(lines dao.LogItems) {
 	ll := make([][]byte, len(lines))
 	if l.filter == "" {
-		lines.Render(l.logOptions.ShowTimestamp, ll)
+		l.render(lines, ll)
 	} else {
 		ff, err := l.applyFilter(l.filter)
 		if err != nil {
@@ -0,0 +1,208 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	defaultMetricsRefreshRate = 15 * time.Second
+	metricsStaleFactor        = 3
+
+	// metricsIdleTTL tracks how long a namespace keeps being polled once no
+	// longer requested.
+	metricsIdleTTL = 2 * time.Minute
+)
+
+type mxEntry struct {
+	pods      *mv1beta1.PodMetricsList
+	nodes     *mv1beta1.NodeMetricsList
+	fetched   time.Time
+	requested time.Time
+}
+
+// MetricsCache polls metrics-server on its own cadence so that table
+// refreshes never block on a slow metrics api.
+type MetricsCache struct {
+	conn        client.Connection
+	refreshRate time.Duration
+	pods        map[string]mxEntry
+	nodes       *mxEntry
+	wantNodes   bool
+	history     mxHistory
+	kick        chan struct{}
+	mx          sync.RWMutex
+}
+
+// NewMetricsCache returns a new metrics cache.
+func NewMetricsCache(c client.Connection) *MetricsCache {
+	return &MetricsCache{
+		conn:        c,
+		refreshRate: defaultMetricsRefreshRate,
+		pods:        make(map[string]mxEntry),
+		history:     make(mxHistory),
+		kick:        make(chan struct{}, 1),
+	}
+}
+
+// SetRefreshRate sets the metrics polling rate.
+func (m *MetricsCache) SetRefreshRate(d time.Duration) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.refreshRate = d
+}
+
+// Reset clears out all cached metrics.
+func (m *MetricsCache) Reset() {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	m.pods, m.nodes, m.wantNodes = make(map[string]mxEntry), nil, false
+	m.history = make(mxHistory)
+}
+
+// PodsMetrics returns the cached pods metrics for a namespace and how long
+// these have been stale. The namespace gets polled from now on.
+func (m *MetricsCache) PodsMetrics(ns string) (*mv1beta1.PodMetricsList, time.Duration) {
+	m.mx.Lock()
+	e, ok := m.pods[ns]
+	e.requested = time.Now()
+	m.pods[ns] = e
+	stale := m.staleFor(e)
+	m.mx.Unlock()
+
+	if !ok {
+		m.refreshNow()
+	}
+
+	return e.pods, stale
+}
+
+// NodesMetrics returns the cached nodes metrics and how long these have been stale.
+func (m *MetricsCache) NodesMetrics() (*mv1beta1.NodeMetricsList, time.Duration) {
+	m.mx.Lock()
+	first := !m.wantNodes
+	m.wantNodes = true
+	var e mxEntry
+	if m.nodes != nil {
+		e = *m.nodes
+	}
+	stale := m.staleFor(e)
+	m.mx.Unlock()
+
+	if first {
+		m.refreshNow()
+	}
+
+	return e.nodes, stale
+}
+
+// Watch polls metrics until the context is canceled.
+func (m *MetricsCache) Watch(ctx context.Context) {
+	go m.poller(ctx)
+}
+
+func (m *MetricsCache) poller(ctx context.Context) {
+	defer log.Debug().Msgf("Metrics cache poller canceled")
+	for {
+		m.refresh(ctx)
+
+		m.mx.RLock()
+		rate := m.refreshRate
+		m.mx.RUnlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.kick:
+		case <-time.After(rate):
+		}
+	}
+}
+
+func (m *MetricsCache) refreshNow() {
+	select {
+	case m.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (m *MetricsCache) refresh(ctx context.Context) {
+	if m.conn == nil || !m.conn.HasMetrics() {
+		return
+	}
+	dial := client.DialMetrics(m.conn)
+
+	nss := m.polledNamespaces(time.Now())
+	m.mx.RLock()
+	wantNodes := m.wantNodes
+	m.mx.RUnlock()
+
+	if wantNodes {
+		nmx, err := dial.FetchNodesMetrics(ctx)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Nodes metrics poll failed")
+		} else {
+			at := time.Now()
+			m.mx.Lock()
+			m.nodes = &mxEntry{nodes: nmx, fetched: at}
+			m.history.recordNodes(nmx, at, m.refreshRate/2)
+			m.mx.Unlock()
+		}
+	}
+	for _, ns := range nss {
+		pmx, err := dial.FetchPodsMetrics(ctx, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Pods metrics poll failed for %q", ns)
+			continue
+		}
+		at := time.Now()
+		m.mx.Lock()
+		if e, ok := m.pods[ns]; ok {
+			e.pods, e.fetched = pmx, at
+			m.pods[ns] = e
+		}
+		m.history.recordPods(pmx, at, m.refreshRate/2)
+		m.mx.Unlock()
+	}
+
+	m.mx.Lock()
+	m.history.prune(time.Now().Add(-mxHistoryTTL))
+	m.mx.Unlock()
+}
+
+// polledNamespaces returns the namespaces to poll pods metrics for and drops
+// the ones no longer requested.
+func (m *MetricsCache) polledNamespaces(now time.Time) []string {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+
+	nss := make([]string, 0, len(m.pods))
+	for ns, e := range m.pods {
+		if now.Sub(e.requested) > metricsIdleTTL {
+			delete(m.pods, ns)
+			continue
+		}
+		nss = append(nss, ns)
+	}
+
+	return nss
+}
+
+// staleFor returns how long an entry has been around when it has aged out,
+// zero otherwise.
+func (m *MetricsCache) staleFor(e mxEntry) time.Duration {
+	if e.fetched.IsZero() {
+		return 0
+	}
+	if age := time.Since(e.fetched); age > metricsStaleFactor*m.refreshRate {
+		return age
+	}
+
+	return 0
+}
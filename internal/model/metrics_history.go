@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// MetricsHistorySize tracks the number of samples kept per resource ie 30mins
+// at the default metrics refresh rate.
+const MetricsHistorySize = 120
+
+// mxHistoryTTL tracks how long samples are kept once a resource stops reporting.
+const mxHistoryTTL = 5 * time.Minute
+
+// MetricsSample represents a resource usage at a given time.
+type MetricsSample struct {
+	At time.Time
+
+	// CPU tracks cpu usage in millicores.
+	CPU int64
+
+	// MEM tracks memory usage in MiB.
+	MEM int64
+}
+
+// mxHistory tracks rolling metrics samples per resource.
+type mxHistory map[string][]MetricsSample
+
+func podHistoryKey(fqn string) string {
+	return "po:" + fqn
+}
+
+func nodeHistoryKey(name string) string {
+	return "no:" + name
+}
+
+// record appends a sample unless one was recently taken. Concurrent polls ie
+// all namespaces and a single namespace may report the same resource.
+func (h mxHistory) record(key string, s MetricsSample, minGap time.Duration) {
+	ss := h[key]
+	if n := len(ss); n > 0 && s.At.Sub(ss[n-1].At) < minGap {
+		return
+	}
+	if len(ss) >= MetricsHistorySize {
+		ss = append(ss[:0], ss[len(ss)-MetricsHistorySize+1:]...)
+	}
+	h[key] = append(ss, s)
+}
+
+func (h mxHistory) recordPods(mx *mv1beta1.PodMetricsList, at time.Time, minGap time.Duration) {
+	if mx == nil {
+		return
+	}
+	for _, p := range mx.Items {
+		var s MetricsSample
+		for _, c := range p.Containers {
+			s.CPU += c.Usage.Cpu().MilliValue()
+			s.MEM += client.ToMB(c.Usage.Memory().Value())
+		}
+		s.At = at
+		h.record(podHistoryKey(client.FQN(p.Namespace, p.Name)), s, minGap)
+	}
+}
+
+func (h mxHistory) recordNodes(mx *mv1beta1.NodeMetricsList, at time.Time, minGap time.Duration) {
+	if mx == nil {
+		return
+	}
+	for _, n := range mx.Items {
+		h.record(nodeHistoryKey(n.Name), MetricsSample{
+			At:  at,
+			CPU: n.Usage.Cpu().MilliValue(),
+			MEM: client.ToMB(n.Usage.Memory().Value()),
+		}, minGap)
+	}
+}
+
+// prune drops resources that did not report since the given time.
+func (h mxHistory) prune(since time.Time) {
+	for k, ss := range h {
+		if len(ss) == 0 || ss[len(ss)-1].At.Before(since) {
+			delete(h, k)
+		}
+	}
+}
+
+func (h mxHistory) samples(key string) []MetricsSample {
+	ss := h[key]
+	if len(ss) == 0 {
+		return nil
+	}
+
+	return append(make([]MetricsSample, 0, len(ss)), ss...)
+}
+
+// PodHistory returns a pod recent metrics samples, oldest first.
+func (m *MetricsCache) PodHistory(fqn string) []MetricsSample {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.history.samples(podHistoryKey(fqn))
+}
+
+// NodeHistory returns a node recent metrics samples, oldest first.
+func (m *MetricsCache) NodeHistory(name string) []MetricsSample {
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	return m.history.samples(nodeHistoryKey(name))
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestMetricsCachePods(t *testing.T) {
+	m := NewMetricsCache(nil)
+
+	mx, stale := m.PodsMetrics("fred")
+	assert.Nil(t, mx)
+	assert.Equal(t, time.Duration(0), stale)
+	assert.Equal(t, 1, len(m.pods))
+
+	pmx := new(mv1beta1.PodMetricsList)
+	m.pods["fred"] = mxEntry{pods: pmx, fetched: time.Now()}
+	mx, stale = m.PodsMetrics("fred")
+	assert.Equal(t, pmx, mx)
+	assert.Equal(t, time.Duration(0), stale)
+
+	m.pods["fred"] = mxEntry{pods: pmx, fetched: time.Now().Add(-metricsStaleFactor * 2 * m.refreshRate)}
+	mx, stale = m.PodsMetrics("fred")
+	assert.Equal(t, pmx, mx)
+	assert.True(t, stale >= metricsStaleFactor*2*m.refreshRate)
+}
+
+func TestMetricsCachePolledNamespaces(t *testing.T) {
+	m, now := NewMetricsCache(nil), time.Now()
+	m.PodsMetrics("fred")
+	m.PodsMetrics("blee")
+	assert.Equal(t, 2, len(m.polledNamespaces(now)))
+
+	e := m.pods["blee"]
+	e.requested = now.Add(-2 * metricsIdleTTL)
+	m.pods["blee"] = e
+	assert.Equal(t, []string{"fred"}, m.polledNamespaces(now))
+	assert.Equal(t, 1, len(m.pods))
+}
+
+func TestMetricsCacheNodes(t *testing.T) {
+	m := NewMetricsCache(nil)
+
+	mx, stale := m.NodesMetrics()
+	assert.Nil(t, mx)
+	assert.Equal(t, time.Duration(0), stale)
+	assert.True(t, m.wantNodes)
+
+	m.Reset()
+	assert.False(t, m.wantNodes)
+	assert.Equal(t, 0, len(m.pods))
+}
+
+func TestMetricsHistoryRecord(t *testing.T) {
+	h, now := make(mxHistory), time.Now()
+
+	for i := 0; i < MetricsHistorySize+10; i++ {
+		h.record("fred", MetricsSample{At: now.Add(time.Duration(i) * time.Second), CPU: int64(i)}, time.Second)
+	}
+	ss := h.samples("fred")
+	assert.Equal(t, MetricsHistorySize, len(ss))
+	assert.Equal(t, int64(10), ss[0].CPU)
+	assert.Equal(t, int64(MetricsHistorySize+9), ss[len(ss)-1].CPU)
+
+	h.record("fred", MetricsSample{At: ss[len(ss)-1].At.Add(100 * time.Millisecond), CPU: 1000}, time.Second)
+	assert.Equal(t, int64(MetricsHistorySize+9), h.samples("fred")[MetricsHistorySize-1].CPU)
+
+	h.record("blee", MetricsSample{At: now.Add(-time.Hour)}, time.Second)
+	h.prune(now.Add(-time.Minute))
+	assert.Nil(t, h.samples("blee"))
+	assert.Equal(t, MetricsHistorySize, len(h.samples("fred")))
+}
+
+func TestMetricsHistoryRecordPods(t *testing.T) {
+	m, now := NewMetricsCache(nil), time.Now()
+	pmx := mv1beta1.PodMetricsList{
+		Items: []mv1beta1.PodMetrics{
+			{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+				Containers: []mv1beta1.ContainerMetrics{
+					{Name: "c1", Usage: makeUsage("10m", "2Mi")},
+					{Name: "c2", Usage: makeUsage("20m", "3Mi")},
+				},
+			},
+		},
+	}
+	m.history.recordPods(&pmx, now, time.Second)
+	m.history.recordNodes(&mv1beta1.NodeMetricsList{
+		Items: []mv1beta1.NodeMetrics{
+			{ObjectMeta: metav1.ObjectMeta{Name: "n1"}, Usage: makeUsage("1", "1Gi")},
+		},
+	}, now, time.Second)
+
+	assert.Equal(t, []MetricsSample{{At: now, CPU: 30, MEM: 5}}, m.PodHistory("default/fred"))
+	assert.Equal(t, []MetricsSample{{At: now, CPU: 1000, MEM: 1024}}, m.NodeHistory("n1"))
+	assert.Nil(t, m.PodHistory("default/blee"))
+
+	m.Reset()
+	assert.Nil(t, m.NodeHistory("n1"))
+}
+
+// Helpers...
+
+func makeUsage(cpu, mem string) v1.ResourceList {
+	return v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse(cpu),
+		v1.ResourceMemory: resource.MustParse(mem),
+	}
+}
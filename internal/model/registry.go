@@ -22,6 +22,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Helm{},
 		Renderer: &render.Helm{},
 	},
+	"helmhistory": {
+		DAO:      &dao.HelmHistory{},
+		Renderer: &render.HelmHistory{},
+	},
 	"pulses": {
 		DAO: &dao.Pulse{},
 	},
@@ -34,6 +38,10 @@ var Registry = map[string]ResourceMeta{
 		Renderer:     &render.Container{},
 		TreeRenderer: &xray.Container{},
 	},
+	"datakeys": {
+		DAO:      &dao.DataKey{},
+		Renderer: &render.DataKey{},
+	},
 	"contexts": {
 		DAO:      &dao.Context{},
 		Renderer: &render.Context{},
@@ -50,6 +58,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Policy{},
 		Renderer: &render.Policy{},
 	},
+	"whocan": {
+		DAO:      &dao.WhoCan{},
+		Renderer: &render.WhoCan{},
+	},
 	"users": {
 		DAO:      &dao.Subject{},
 		Renderer: &render.Subject{},
@@ -62,6 +74,26 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.PortForward{},
 		Renderer: &render.PortForward{},
 	},
+	"alerts": {
+		DAO:      &dao.Alert{},
+		Renderer: &render.Alert{},
+	},
+	"pluginjobs": {
+		DAO:      &dao.PluginJob{},
+		Renderer: &render.PluginJob{},
+	},
+	"pluginoutput": {
+		DAO:      &dao.PluginOutput{},
+		Renderer: &render.Generic{},
+	},
+	"audits": {
+		DAO:      &dao.Audit{},
+		Renderer: &render.Audit{},
+	},
+	"stats": {
+		DAO:      &dao.Stat{},
+		Renderer: &render.Stat{},
+	},
 	"benchmarks": {
 		DAO:      &dao.Benchmark{},
 		Renderer: &render.Benchmark{},
@@ -70,6 +102,22 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
 	},
+	"grep": {
+		DAO:      &dao.Grep{},
+		Renderer: &render.Grep{},
+	},
+	"deprecations": {
+		DAO:      &dao.Deprecation{},
+		Renderer: &render.Deprecation{},
+	},
+	"nsdiff": {
+		DAO:      &dao.NSDiff{},
+		Renderer: &render.NSDiff{},
+	},
+	"netpolsim": {
+		DAO:      &dao.NetPolSim{},
+		Renderer: &render.NetPolSim{},
+	},
 	"popeye": {
 		DAO:      &dao.Popeye{},
 		Renderer: &render.Popeye{},
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	maxRolloutFailures = 5
+	dpRevisionKey      = "deployment.kubernetes.io/revision"
+)
+
+// RolloutStatus represents a workload rollout progress.
+type RolloutStatus struct {
+	Desired    int32
+	Updated    int32
+	Ready      int32
+	Available  int32
+	Old        int32
+	Observed   bool
+	Paused     bool
+	Revision   string
+	Conditions []string
+	Failures   []string
+	Started    time.Time
+}
+
+// Done returns true once all replicas are updated and ready.
+func (s RolloutStatus) Done() bool {
+	return s.Observed && s.Updated >= s.Desired && s.Ready >= s.Desired && s.Old == 0
+}
+
+// Progress returns the number of updated replicas that are ready.
+func (s RolloutStatus) Progress() int32 {
+	if !s.Observed {
+		return 0
+	}
+	p := s.Updated
+	if s.Ready < p {
+		p = s.Ready
+	}
+	if p > s.Desired {
+		p = s.Desired
+	}
+
+	return p
+}
+
+// ETA returns the estimated time left to complete the rollout based on the
+// progress made so far. Returns false if no estimates are available.
+func (s RolloutStatus) ETA(now time.Time) (time.Duration, bool) {
+	p := s.Progress()
+	if p <= 0 || s.Done() {
+		return 0, false
+	}
+	elapsed := now.Sub(s.Started)
+
+	return elapsed * time.Duration(s.Desired-p) / time.Duration(p), true
+}
+
+// RolloutFunc gets notified on rollout progress.
+type RolloutFunc func(RolloutStatus, error)
+
+// RolloutTracker polls a workload until its rollout completes.
+type RolloutTracker struct {
+	gvr     client.GVR
+	path    string
+	rate    time.Duration
+	fn      RolloutFunc
+	started time.Time
+}
+
+// NewRolloutTracker returns a new rollout tracker.
+func NewRolloutTracker(gvr client.GVR, path string, rate time.Duration, fn RolloutFunc) *RolloutTracker {
+	return &RolloutTracker{
+		gvr:  gvr,
+		path: path,
+		rate: rate,
+		fn:   fn,
+	}
+}
+
+// Track polls the rollout until it completes or the context is canceled.
+func (r *RolloutTracker) Track(ctx context.Context) {
+	r.started = time.Now()
+	go func() {
+		for {
+			s, err := r.poll(ctx)
+			r.fn(s, err)
+			if err == nil && s.Done() {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(r.rate):
+			}
+		}
+	}()
+}
+
+func (r *RolloutTracker) poll(ctx context.Context) (RolloutStatus, error) {
+	s := RolloutStatus{Started: r.started}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return s, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+	o, err := f.Get(r.gvr.String(), r.path, true, labels.Everything())
+	if err != nil {
+		return s, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return s, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	sel, err := rolloutStatus(u, &s)
+	if err != nil {
+		return s, err
+	}
+
+	ns, _ := client.Namespaced(r.path)
+	oo, err := f.List("v1/pods", ns, false, sel)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Rollout pods list failed for %s", r.path)
+		return s, nil
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		// Zero rules flag all failures regardless of the watchdog thresholds.
+		for _, i := range podIssues(&po, config.AlertRules{}) {
+			s.Failures = append(s.Failures, fmt.Sprintf("%s %s: %s", po.Name, i.subject, i.reason))
+		}
+	}
+	sort.Strings(s.Failures)
+	if len(s.Failures) > maxRolloutFailures {
+		s.Failures = s.Failures[:maxRolloutFailures]
+	}
+
+	return s, nil
+}
+
+// Helpers...
+
+// rolloutStatus extracts a workload rollout status and returns its pods selector.
+func rolloutStatus(u *unstructured.Unstructured, s *RolloutStatus) (labels.Selector, error) {
+	var sel *metav1.LabelSelector
+	switch u.GetKind() {
+	case "Deployment":
+		var dp appsv1.Deployment
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dp); err != nil {
+			return nil, err
+		}
+		s.Desired = replicasOrOne(dp.Spec.Replicas)
+		s.Updated, s.Ready = dp.Status.UpdatedReplicas, dp.Status.ReadyReplicas
+		s.Available = dp.Status.AvailableReplicas
+		s.Old = dp.Status.Replicas - dp.Status.UpdatedReplicas
+		s.Observed = dp.Status.ObservedGeneration >= dp.Generation
+		s.Paused, s.Revision = dp.Spec.Paused, dp.Annotations[dpRevisionKey]
+		for _, c := range dp.Status.Conditions {
+			s.Conditions = append(s.Conditions, conditionString(string(c.Type), string(c.Status), c.Reason))
+		}
+		sel = dp.Spec.Selector
+	case "StatefulSet":
+		var sts appsv1.StatefulSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sts); err != nil {
+			return nil, err
+		}
+		s.Desired = replicasOrOne(sts.Spec.Replicas)
+		s.Updated, s.Ready = sts.Status.UpdatedReplicas, sts.Status.ReadyReplicas
+		// StatefulSets do not report available replicas.
+		s.Available = sts.Status.ReadyReplicas
+		s.Old = sts.Status.Replicas - sts.Status.UpdatedReplicas
+		s.Observed = sts.Status.ObservedGeneration >= sts.Generation
+		s.Revision = sts.Status.UpdateRevision
+		for _, c := range sts.Status.Conditions {
+			s.Conditions = append(s.Conditions, conditionString(string(c.Type), string(c.Status), c.Reason))
+		}
+		sel = sts.Spec.Selector
+	case "DaemonSet":
+		var ds appsv1.DaemonSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ds); err != nil {
+			return nil, err
+		}
+		s.Desired = ds.Status.DesiredNumberScheduled
+		s.Updated, s.Ready = ds.Status.UpdatedNumberScheduled, ds.Status.NumberReady
+		s.Available = ds.Status.NumberAvailable
+		s.Old = ds.Status.CurrentNumberScheduled - ds.Status.UpdatedNumberScheduled
+		s.Observed = ds.Status.ObservedGeneration >= ds.Generation
+		for _, c := range ds.Status.Conditions {
+			s.Conditions = append(s.Conditions, conditionString(string(c.Type), string(c.Status), c.Reason))
+		}
+		sel = ds.Spec.Selector
+	default:
+		return nil, fmt.Errorf("no rollout status for kind %q", u.GetKind())
+	}
+	if s.Old < 0 {
+		s.Old = 0
+	}
+
+	return metav1.LabelSelectorAsSelector(sel)
+}
+
+func conditionString(kind, status, reason string) string {
+	if reason == "" {
+		return kind + "=" + status
+	}
+
+	return fmt.Sprintf("%s=%s (%s)", kind, status, reason)
+}
+
+func replicasOrOne(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+
+	return *r
+}
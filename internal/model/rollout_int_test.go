@@ -0,0 +1,161 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRolloutStatus(t *testing.T) {
+	uu := map[string]struct {
+		o    map[string]interface{}
+		e    RolloutStatus
+		sel  string
+		done bool
+		err  bool
+	}{
+		"dpInProgress": {
+			o: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(2)},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "fred"}},
+				},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(4),
+					"updatedReplicas":    int64(1),
+					"readyReplicas":      int64(3),
+				},
+			},
+			e:   RolloutStatus{Desired: 3, Updated: 1, Ready: 3, Old: 3, Observed: true},
+			sel: "app=fred",
+		},
+		"dpNotObserved": {
+			o: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"generation": int64(3)},
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "fred"}},
+				},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(1),
+					"updatedReplicas":    int64(1),
+					"readyReplicas":      int64(1),
+				},
+			},
+			e:   RolloutStatus{Desired: 1, Updated: 1, Ready: 1},
+			sel: "app=fred",
+		},
+		"dpPaused": {
+			o: map[string]interface{}{
+				"kind": "Deployment",
+				"metadata": map[string]interface{}{
+					"generation":  int64(4),
+					"annotations": map[string]interface{}{"deployment.kubernetes.io/revision": "3"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+					"paused":   true,
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "fred"}},
+				},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(4),
+					"replicas":           int64(2),
+					"updatedReplicas":    int64(1),
+					"readyReplicas":      int64(2),
+					"availableReplicas":  int64(2),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Available", "status": "True"},
+						map[string]interface{}{"type": "Progressing", "status": "Unknown", "reason": "DeploymentPaused"},
+					},
+				},
+			},
+			e: RolloutStatus{
+				Desired:    2,
+				Updated:    1,
+				Ready:      2,
+				Available:  2,
+				Old:        1,
+				Observed:   true,
+				Paused:     true,
+				Revision:   "3",
+				Conditions: []string{"Available=True", "Progressing=Unknown (DeploymentPaused)"},
+			},
+			sel: "app=fred",
+		},
+		"dsDone": {
+			o: map[string]interface{}{
+				"kind":     "DaemonSet",
+				"metadata": map[string]interface{}{"generation": int64(1)},
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "blee"}},
+				},
+				"status": map[string]interface{}{
+					"observedGeneration":     int64(1),
+					"desiredNumberScheduled": int64(2),
+					"currentNumberScheduled": int64(2),
+					"updatedNumberScheduled": int64(2),
+					"numberReady":            int64(2),
+				},
+			},
+			e:    RolloutStatus{Desired: 2, Updated: 2, Ready: 2, Observed: true},
+			sel:  "app=blee",
+			done: true,
+		},
+		"unsupported": {
+			o:   map[string]interface{}{"kind": "Pod"},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var s RolloutStatus
+			sel, err := rolloutStatus(&unstructured.Unstructured{Object: u.o}, &s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+			assert.Equal(t, u.sel, sel.String())
+			assert.Equal(t, u.done, s.Done())
+		})
+	}
+}
+
+func TestRolloutStatusETA(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		s   RolloutStatus
+		eta time.Duration
+		ok  bool
+	}{
+		"noProgress": {
+			s: RolloutStatus{Desired: 4, Observed: true, Started: now.Add(-time.Minute)},
+		},
+		"halfway": {
+			s:   RolloutStatus{Desired: 4, Updated: 2, Ready: 3, Old: 2, Observed: true, Started: now.Add(-time.Minute)},
+			eta: time.Minute,
+			ok:  true,
+		},
+		"done": {
+			s: RolloutStatus{Desired: 2, Updated: 2, Ready: 2, Observed: true, Started: now.Add(-time.Minute)},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			eta, ok := u.s.ETA(now)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.eta, eta)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+)
+
+// APISnapshotter represents a source of api calls stats.
+type APISnapshotter interface {
+	// Snapshot returns the current api calls counts.
+	Snapshot() map[client.APICall]client.APICount
+}
+
+// SessionStats tracks api calls, time spent per view and actions taken
+// during a session.
+type SessionStats struct {
+	api     APISnapshotter
+	current string
+	since   time.Time
+	views   map[string]time.Duration
+	visits  map[string]int
+	actions map[string]int
+	mx      sync.RWMutex
+}
+
+// NewSessionStats returns a new instance.
+func NewSessionStats(api APISnapshotter) *SessionStats {
+	return &SessionStats{
+		api:     api,
+		views:   make(map[string]time.Duration),
+		visits:  make(map[string]int),
+		actions: make(map[string]int),
+	}
+}
+
+// Action records an action was taken.
+func (s *SessionStats) Action(name string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.actions[name]++
+}
+
+// StackPushed notifies a new view was added.
+func (s *SessionStats) StackPushed(c Component) {
+	s.viewChanged(componentName(c), time.Now())
+}
+
+// StackPopped notifies a view was removed.
+func (s *SessionStats) StackPopped(_, top Component) {
+	s.viewChanged(componentName(top), time.Now())
+}
+
+// StackTop notifies for the top view.
+func (s *SessionStats) StackTop(top Component) {
+	s.viewChanged(componentName(top), time.Now())
+}
+
+func (s *SessionStats) viewChanged(name string, t time.Time) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.current != "" {
+		s.views[s.current] += t.Sub(s.since)
+	}
+	if name != "" && name != s.current {
+		s.visits[name]++
+	}
+	s.current, s.since = name, t
+}
+
+// Stats returns the session stats sorted by kind and name.
+func (s *SessionStats) Stats() []render.SessionStat {
+	return s.statsAt(time.Now())
+}
+
+func (s *SessionStats) statsAt(t time.Time) []render.SessionStat {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	ss := make([]render.SessionStat, 0, len(s.views)+len(s.actions))
+	if s.api != nil {
+		for call, count := range s.api.Snapshot() {
+			ss = append(ss, render.SessionStat{
+				Kind:   render.StatAPI,
+				Name:   call.Resource,
+				Verb:   call.Verb,
+				Count:  count.Calls,
+				Errors: count.Errors,
+				Bytes:  count.Bytes,
+			})
+		}
+	}
+	views := make(map[string]time.Duration, len(s.views)+1)
+	for k, v := range s.views {
+		views[k] = v
+	}
+	if s.current != "" {
+		views[s.current] += t.Sub(s.since)
+	}
+	for n, d := range views {
+		ss = append(ss, render.SessionStat{Kind: render.StatView, Name: n, Count: s.visits[n], Time: d})
+	}
+	for n, c := range s.actions {
+		ss = append(ss, render.SessionStat{Kind: render.StatAction, Name: n, Count: c})
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].ID() < ss[j].ID()
+	})
+
+	return ss
+}
+
+// Helpers...
+
+func componentName(c Component) string {
+	if c == nil {
+		return ""
+	}
+
+	return c.Name()
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionStats(t *testing.T) {
+	api := client.NewAPIStats()
+	api.Record(client.APICall{Verb: "list", Resource: "v1/pods"}, false)
+	api.Record(client.APICall{Verb: "list", Resource: "v1/pods"}, true)
+	s := NewSessionStats(api)
+
+	t0 := time.Now()
+	s.viewChanged("c1", t0)
+	s.viewChanged("c2", t0.Add(time.Minute))
+	s.viewChanged("c1", t0.Add(2*time.Minute))
+	s.Action("delete")
+	s.Action("delete")
+
+	assert.Equal(t, []render.SessionStat{
+		{Kind: render.StatAction, Name: "delete", Count: 2},
+		{Kind: render.StatAPI, Name: "v1/pods", Verb: "list", Count: 2, Errors: 1},
+		{Kind: render.StatView, Name: "c1", Count: 2, Time: 2 * time.Minute},
+		{Kind: render.StatView, Name: "c2", Count: 1, Time: time.Minute},
+	}, s.statsAt(t0.Add(3*time.Minute)))
+}
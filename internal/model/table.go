@@ -17,7 +17,18 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const initRefreshRate = 300 * time.Millisecond
+const (
+	initRefreshRate = 300 * time.Millisecond
+
+	// watchSettleRate coalesces bursts of resource changes into a single refresh.
+	watchSettleRate = 100 * time.Millisecond
+
+	// watchResyncRate refreshes watched tables so computed columns ie ages stay current.
+	watchResyncRate = 15 * time.Second
+
+	// pageRefreshRate refreshes tables while their collection is listed in pages.
+	pageRefreshRate = time.Second
+)
 
 // TableListener represents a table model listener.
 type TableListener interface {
@@ -39,6 +50,13 @@ type Table struct {
 	instance    string
 	mx          sync.RWMutex
 	labelFilter string
+	watching    int32
+	polling     int32
+	loading     int32
+	modeChanged chan struct{}
+	columns     render.CustomColumns
+	condition   string
+	kick        chan struct{}
 }
 
 // NewTable returns a new table model.
@@ -47,6 +65,8 @@ func NewTable(gvr client.GVR) *Table {
 		gvr:         gvr,
 		data:        render.NewTableData(),
 		refreshRate: 2 * time.Second,
+		modeChanged: make(chan struct{}, 1),
+		kick:        make(chan struct{}, 1),
 	}
 }
 
@@ -57,6 +77,31 @@ func (t *Table) SetLabelFilter(f string) {
 	t.mx.Unlock()
 }
 
+// SetCustomColumns sets user defined columns and refreshes the table.
+func (t *Table) SetCustomColumns(cc render.CustomColumns) {
+	t.mx.Lock()
+	t.columns = cc
+	t.data.Clear()
+	t.mx.Unlock()
+	select {
+	case t.kick <- struct{}{}:
+	default:
+	}
+}
+
+// SetConditionType sets the status condition type summarized for generic
+// resources and refreshes the table.
+func (t *Table) SetConditionType(c string) {
+	t.mx.Lock()
+	t.condition = c
+	t.data.Clear()
+	t.mx.Unlock()
+	select {
+	case t.kick <- struct{}{}:
+	default:
+	}
+}
+
 // SetInstance sets a single entry table.
 func (t *Table) SetInstance(path string) {
 	t.instance = path
@@ -89,11 +134,78 @@ func (t *Table) Watch(ctx context.Context) error {
 	if err := t.refresh(ctx); err != nil {
 		return err
 	}
-	go t.updater(ctx)
+	t.pin(ctx)
+	go t.updater(ctx, t.subscribe(ctx))
 
 	return nil
 }
 
+// subscribe listens for resource changes when the resource supports watches.
+// It returns a nil channel when the table must be polled.
+func (t *Table) subscribe(ctx context.Context) <-chan struct{} {
+	n, ok := ctx.Value(internal.KeyFactory).(dao.ChangeNotifier)
+	if !ok || !IsWatchable(t.gvr) || t.instance != "" {
+		return nil
+	}
+	changed := make(chan struct{}, 1)
+	unsub, ok := n.Subscribe(client.CleanseNamespace(t.GetNamespace()), t.gvr.String(), func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	})
+	if !ok {
+		return nil
+	}
+	atomic.StoreInt32(&t.watching, 1)
+	go func() {
+		<-ctx.Done()
+		atomic.StoreInt32(&t.watching, 0)
+		unsub()
+	}()
+
+	return changed
+}
+
+// SetPolling toggles between periodic and change driven refreshes.
+func (t *Table) SetPolling(b bool) {
+	var v int32
+	if b {
+		v = 1
+	}
+	atomic.StoreInt32(&t.polling, v)
+	select {
+	case t.modeChanged <- struct{}{}:
+	default:
+	}
+}
+
+// IsPolling returns true if the table is refreshed on a fixed rate.
+func (t *Table) IsPolling() bool {
+	return atomic.LoadInt32(&t.polling) == 1
+}
+
+// IsWatching returns true if the table refreshes on resource changes.
+func (t *Table) IsWatching() bool {
+	return atomic.LoadInt32(&t.watching) == 1 && !t.IsPolling()
+}
+
+// pin keeps the resource informer around while the model is being watched.
+func (t *Table) pin(ctx context.Context) {
+	s, ok := ctx.Value(internal.KeyFactory).(dao.InformerSharer)
+	if !ok {
+		return
+	}
+	ns, gvr := client.CleanseNamespace(t.GetNamespace()), t.gvr.String()
+	if !s.Acquire(ns, gvr) {
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		s.Release(ns, gvr)
+	}()
+}
+
 // Refresh updates the table content.
 func (t *Table) Refresh(ctx context.Context) error {
 	return t.refresh(ctx)
@@ -106,7 +218,13 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 		return nil, err
 	}
 
-	return meta.DAO.Get(ctx, path)
+	var o runtime.Object
+	err = dao.Retry(ctx, func() error {
+		o, err = meta.DAO.Get(ctx, path)
+		return err
+	})
+
+	return o, err
 }
 
 // Delete deletes a resource.
@@ -121,7 +239,9 @@ func (t *Table) Delete(ctx context.Context, path string, cascade, force bool) er
 		return fmt.Errorf("no nuker for %q", meta.DAO.GVR())
 	}
 
-	return nuker.Delete(path, cascade, force)
+	return dao.RetryDelete(ctx, func() error {
+		return nuker.Delete(path, cascade, force)
+	})
 }
 
 // GetNamespace returns the model namespace.
@@ -163,18 +283,33 @@ func (t *Table) Peek() render.TableData {
 	return t.data.Clone()
 }
 
-func (t *Table) updater(ctx context.Context) {
+func (t *Table) updater(ctx context.Context, changed <-chan struct{}) {
 	defer log.Debug().Msgf("TABLE-MODEL canceled -- %q", t.gvr)
 
 	bf := backoff.NewExponentialBackOff()
 	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
-	rate := initRefreshRate
+	timer := time.NewTimer(initRefreshRate)
+	defer timer.Stop()
+	var settling bool
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(rate):
-			rate = t.refreshRate
+		case <-t.modeChanged:
+			resetTimer(timer, t.nextRate(changed))
+			settling = false
+		case <-t.kick:
+			resetTimer(timer, 0)
+			settling = false
+		case <-changed:
+			if settling || t.IsPolling() {
+				continue
+			}
+			resetTimer(timer, watchSettleRate)
+			settling = true
+		case <-timer.C:
+			timer.Reset(t.nextRate(changed))
+			settling = false
 			err := backoff.Retry(func() error {
 				return t.refresh(ctx)
 			}, backoff.WithContext(bf, ctx))
@@ -187,6 +322,24 @@ func (t *Table) updater(ctx context.Context) {
 	}
 }
 
+// IsLoading returns true while the table collection is listed in pages.
+func (t *Table) IsLoading() bool {
+	return atomic.LoadInt32(&t.loading) == 1
+}
+
+// nextRate returns the delay until the next refresh. Watched tables only
+// resync periodically since changes trigger refreshes.
+func (t *Table) nextRate(changed <-chan struct{}) time.Duration {
+	if t.IsLoading() {
+		return pageRefreshRate
+	}
+	if changed == nil || t.IsPolling() {
+		return t.refreshRate
+	}
+
+	return watchResyncRate
+}
+
 func (t *Table) refresh(ctx context.Context) error {
 	if !atomic.CompareAndSwapInt32(&t.inUpdate, 0, 1) {
 		log.Debug().Msgf("Dropping update...")
@@ -209,18 +362,42 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 	}
 	a.Init(factory, t.gvr)
 
-	ns := client.CleanseNamespace(t.namespace)
+	ns := t.listNamespace()
+	var oo []runtime.Object
+	err := dao.Retry(ctx, func() error {
+		var err error
+		oo, err = a.List(ctx, ns)
+		return err
+	})
+
+	return oo, err
+}
+
+func (t *Table) listNamespace() string {
 	if client.IsClusterScoped(t.namespace) {
-		ns = client.AllNamespaces
+		return client.AllNamespaces
 	}
 
-	return a.List(ctx, ns)
+	return client.CleanseNamespace(t.namespace)
+}
+
+// trackProgress flags the table as loading while its collection is listed
+// in api pages.
+func (t *Table) trackProgress(ctx context.Context) {
+	p, ok := dao.PageProgress(ctx, t.gvr.String(), t.listNamespace())
+	loading := ok && !p.Done && t.instance == ""
+	t.data.Loading, t.data.Total = loading, p.Total
+	var v int32
+	if loading {
+		v = 1
+	}
+	atomic.StoreInt32(&t.loading, v)
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
 	t.mx.Lock()
 	defer t.mx.Unlock()
-	meta := resourceMeta(t.gvr)
+	meta := t.meta(ctx)
 	if t.labelFilter != "" {
 		ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
 	}
@@ -262,8 +439,17 @@ func (t *Table) reconcile(ctx context.Context) error {
 	if ok && sel != "" {
 		t.data.Clear()
 	}
+	header := meta.Renderer.Header(t.namespace)
+	if len(t.columns) > 0 {
+		customize(t.columns, header, oo, rows)
+		header = t.columns.Header(header)
+	}
+	if c, ok := t.apiCheck(ctx); ok {
+		header = checkAPIs(c, header, oo, rows)
+	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.data.SetHeader(t.namespace, header)
+	t.trackProgress(ctx)
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -272,6 +458,20 @@ func (t *Table) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// meta returns the resource dao and renderer. Builtin resources get listed
+// and rendered via the server side Table api when enabled.
+func (t *Table) meta(ctx context.Context) ResourceMeta {
+	meta := resourceMeta(t.gvr)
+	if t.instance == "" && useServerTable(ctx, t.gvr) {
+		meta = ResourceMeta{DAO: &dao.Table{}, Renderer: &render.Generic{ServerTable: true}}
+	}
+	if g, ok := meta.Renderer.(*render.Generic); ok {
+		g.SetConditionType(t.condition)
+	}
+
+	return meta
+}
+
 func (t *Table) fireTableChanged(data render.TableData) {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -290,6 +490,48 @@ func (t *Table) fireTableLoadFailed(err error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
+
+// IsWatchable checks if a resource supports change notifications.
+func IsWatchable(gvr client.GVR) bool {
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil || dao.IsK9sMeta(meta) {
+		return false
+	}
+	for _, v := range meta.Verbs {
+		if v == "watch" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// useServerTable checks if a K8s resource should be rendered via the
+// server side Table api rather than a client side renderer.
+func useServerTable(ctx context.Context, gvr client.GVR) bool {
+	if on, ok := ctx.Value(internal.KeyServerTables).(bool); !ok || !on {
+		return false
+	}
+	if _, ok := resourceMeta(gvr).Renderer.(*render.Generic); ok {
+		return false
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return false
+	}
+
+	return dao.IsK8sMeta(meta) && !dao.IsK9sMeta(meta) && meta.Kind != ""
+}
+
 func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error {
 	for i, o := range oo {
 		if err := re.Render(o, ns, &rr[i]); err != nil {
@@ -300,6 +542,75 @@ func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error
 	return nil
 }
 
+// customize merges user defined columns into the rendered rows.
+func customize(cc render.CustomColumns, h render.Header, oo []runtime.Object, rr render.Rows) {
+	if table, ok := singleTable(oo); ok {
+		for i := range table.Rows {
+			cc.Render(h, table.Rows[i], &rr[i])
+		}
+		return
+	}
+	for i, o := range oo {
+		cc.Render(h, o, &rr[i])
+	}
+}
+
+// apiCheck returns the listed resource api lifecycle check if its kind has
+// versions scheduled for removal.
+func (t *Table) apiCheck(ctx context.Context) (render.APICheck, bool) {
+	meta, err := dao.MetaAccess.MetaFor(t.gvr)
+	if err != nil || !render.HasAPILifecycle(meta.Kind) {
+		return render.APICheck{}, false
+	}
+	c := render.APICheck{Kind: meta.Kind}
+	if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok && f.Client() != nil {
+		if info, err := f.Client().ServerVersion(); err == nil {
+			c.Target = info.GitVersion
+		}
+	}
+
+	return c, true
+}
+
+// checkAPIs adds an api badge column when some rows use deprecated or
+// removed api versions.
+func checkAPIs(c render.APICheck, h render.Header, oo []runtime.Object, rr render.Rows) render.Header {
+	bb := make([]string, len(rr))
+	var flagged bool
+	badge := func(i int, o interface{}) {
+		if i < len(bb) {
+			bb[i] = c.Badge(o)
+			flagged = flagged || bb[i] != ""
+		}
+	}
+	if table, ok := singleTable(oo); ok {
+		for i := range table.Rows {
+			badge(i, table.Rows[i])
+		}
+	} else {
+		for i, o := range oo {
+			badge(i, o)
+		}
+	}
+	if !flagged {
+		return h
+	}
+	for i := range rr {
+		c.Render(h, bb[i], &rr[i])
+	}
+
+	return c.Header(h)
+}
+
+func singleTable(oo []runtime.Object) (*metav1beta1.Table, bool) {
+	if len(oo) != 1 {
+		return nil, false
+	}
+	table, ok := oo[0].(*metav1beta1.Table)
+
+	return table, ok
+}
+
 func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Renderer) error {
 	gr, ok := re.(*render.Generic)
 	if !ok {
@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -21,6 +22,118 @@ import (
 	"k8s.io/client-go/informers"
 )
 
+func TestUseServerTable(t *testing.T) {
+	uu := map[string]struct {
+		ctx context.Context
+		gvr string
+		e   bool
+	}{
+		"off": {
+			ctx: context.Background(),
+			gvr: "v1/pods",
+		},
+		"disabled": {
+			ctx: context.WithValue(context.Background(), internal.KeyServerTables, false),
+			gvr: "v1/pods",
+		},
+		"generic": {
+			ctx: context.WithValue(context.Background(), internal.KeyServerTables, true),
+			gvr: "fred.com/v1/blees",
+		},
+		"builtin": {
+			ctx: context.WithValue(context.Background(), internal.KeyServerTables, true),
+			gvr: "v1/serviceaccounts",
+			e:   true,
+		},
+	}
+
+	registerSAMeta()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, useServerTable(u.ctx, client.NewGVR(u.gvr)))
+		})
+	}
+}
+
+func TestTableMetaServerTable(t *testing.T) {
+	registerSAMeta()
+	on := context.WithValue(context.Background(), internal.KeyServerTables, true)
+
+	ta := NewTable(client.NewGVR("v1/serviceaccounts"))
+	meta := ta.meta(context.Background())
+	assert.IsType(t, &render.ServiceAccount{}, meta.Renderer)
+
+	meta = ta.meta(on)
+	assert.IsType(t, &dao.Table{}, meta.DAO)
+	g, ok := meta.Renderer.(*render.Generic)
+	assert.True(t, ok)
+	assert.True(t, g.ServerTable)
+
+	ta.SetInstance("default/fred")
+	meta = ta.meta(on)
+	assert.IsType(t, &render.ServiceAccount{}, meta.Renderer)
+}
+
+func TestTableSubscribe(t *testing.T) {
+	dao.MetaAccess.RegisterMeta("fred.com/v1/watched", metav1.APIResource{
+		Name:  "watched",
+		Kind:  "Watched",
+		Verbs: []string{"get", "list", "watch"},
+	})
+	dao.MetaAccess.RegisterMeta("fred.com/v1/polled", metav1.APIResource{
+		Name:  "polled",
+		Kind:  "Polled",
+		Verbs: []string{"get", "list"},
+	})
+
+	uu := map[string]struct {
+		gvr      string
+		running  bool
+		watching bool
+	}{
+		"watched": {
+			gvr:      "fred.com/v1/watched",
+			running:  true,
+			watching: true,
+		},
+		"noInformer": {
+			gvr: "fred.com/v1/watched",
+		},
+		"noWatch": {
+			gvr:     "fred.com/v1/polled",
+			running: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := &notifierFactory{running: u.running}
+			ctx, cancel := context.WithCancel(context.WithValue(context.Background(), internal.KeyFactory, f))
+			defer cancel()
+
+			ta := NewTable(client.NewGVR(u.gvr))
+			changed := ta.subscribe(ctx)
+			assert.Equal(t, u.watching, changed != nil)
+			assert.Equal(t, u.watching, ta.IsWatching())
+			if !u.watching {
+				assert.Equal(t, ta.refreshRate, ta.nextRate(changed))
+				return
+			}
+
+			f.fn()
+			f.fn()
+			assert.Equal(t, 1, len(changed))
+			assert.Equal(t, watchResyncRate, ta.nextRate(changed))
+
+			ta.SetPolling(true)
+			assert.False(t, ta.IsWatching())
+			assert.Equal(t, ta.refreshRate, ta.nextRate(changed))
+		})
+	}
+}
+
 func TestTableReconcile(t *testing.T) {
 	ta := NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace(client.NamespaceAll)
@@ -33,7 +146,7 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 20, len(data.Header))
+	assert.Equal(t, 22, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
@@ -106,7 +219,7 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 20, len(rr[0].Fields))
+	assert.Equal(t, 22, len(rr[0].Fields))
 }
 
 func TestTableGenericHydrate(t *testing.T) {
@@ -133,9 +246,33 @@ func TestTableGenericHydrate(t *testing.T) {
 
 	assert.Nil(t, genericHydrate("blee", &tt, rr, &re))
 	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, 6, len(rr[0].Fields))
+
+	re.SetConditionType(render.NoConditions)
+	re.SetTable(&tt)
+	assert.Nil(t, genericHydrate("blee", &tt, rr, &re))
 	assert.Equal(t, 3, len(rr[0].Fields))
 }
 
+func TestTableCheckAPIs(t *testing.T) {
+	var o1, o2 unstructured.Unstructured
+	o1.SetManagedFields([]metav1.ManagedFieldsEntry{{APIVersion: "batch/v1beta1"}})
+	o2.SetManagedFields([]metav1.ManagedFieldsEntry{{APIVersion: "batch/v1"}})
+	h := render.Header{render.HeaderColumn{Name: "NAME"}, render.HeaderColumn{Name: "AGE", Time: true}}
+	c := render.APICheck{Kind: "CronJob", Target: "v1.21.0"}
+
+	rr := render.Rows{{Fields: render.Fields{"o1", "1m"}}, {Fields: render.Fields{"o2", "2m"}}}
+	hh := checkAPIs(c, h, []runtime.Object{&o1, &o2}, rr)
+	assert.Equal(t, 3, len(hh))
+	assert.Equal(t, "API", hh[1].Name)
+	assert.Equal(t, render.Fields{"o1", "⚠ batch/v1beta1 deprecated, removed in 1.25", "1m"}, rr[0].Fields)
+	assert.Equal(t, render.Fields{"o2", "", "2m"}, rr[1].Fields)
+
+	rr = render.Rows{{Fields: render.Fields{"o2", "2m"}}}
+	assert.Equal(t, h, checkAPIs(c, h, []runtime.Object{&o2}, rr))
+	assert.Equal(t, render.Fields{"o2", "2m"}, rr[0].Fields)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -205,6 +342,24 @@ func (f testFactory) Forwarders() watch.Forwarders {
 }
 func (f testFactory) DeleteForwarder(string) {}
 
+type notifierFactory struct {
+	testFactory
+
+	running bool
+	fn      func()
+}
+
+var _ dao.ChangeNotifier = (*notifierFactory)(nil)
+
+func (f *notifierFactory) Subscribe(ns, gvr string, fn func()) (func(), bool) {
+	if !f.running {
+		return nil, false
+	}
+	f.fn = fn
+
+	return func() {}, true
+}
+
 // ----------------------------------------------------------------------------
 
 type accessor struct {
@@ -227,3 +382,12 @@ func (a *accessor) Init(_ dao.Factory, gvr client.GVR) {
 func (a *accessor) GVR() string {
 	return a.gvr.String()
 }
+
+func registerSAMeta() {
+	dao.MetaAccess.RegisterMeta("v1/serviceaccounts", metav1.APIResource{
+		Name:       "serviceaccounts",
+		Kind:       "ServiceAccount",
+		Namespaced: true,
+		Verbs:      []string{"get", "list", "watch"},
+	})
+}
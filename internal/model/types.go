@@ -38,6 +38,13 @@ type ResourceViewer interface {
 	RemoveListener(ResourceViewerListener)
 }
 
+// Formatter represents a viewed resource with switchable output formats.
+type Formatter interface {
+	// SetFormat sets the output format. Query formats only display the
+	// subtrees matching the given expression.
+	SetFormat(ctx context.Context, format, expr string) error
+}
+
 // Igniter represents a runnable view.
 type Igniter interface {
 	// Start starts a component.
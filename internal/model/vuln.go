@@ -0,0 +1,112 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxConcurrentScans = 2
+	vulnScanTimeout    = 5 * time.Minute
+	vulnRetryDelay     = 5 * time.Minute
+)
+
+type vulnEntry struct {
+	summary render.VulnSummary
+	err     error
+	scanned time.Time
+}
+
+// VulnCache scans images in the background so that table refreshes never
+// block on a slow vulnerability scan.
+type VulnCache struct {
+	scanner dao.VulnScanner
+	ttl     time.Duration
+	entries map[string]vulnEntry
+	pending map[string]struct{}
+	sem     chan struct{}
+	mx      sync.Mutex
+}
+
+// NewVulnCache returns a new vulnerabilities cache.
+func NewVulnCache(s dao.VulnScanner, ttl time.Duration) *VulnCache {
+	return &VulnCache{
+		scanner: s,
+		ttl:     ttl,
+		entries: make(map[string]vulnEntry),
+		pending: make(map[string]struct{}),
+		sem:     make(chan struct{}, maxConcurrentScans),
+	}
+}
+
+// Vulns returns an image cached vulnerabilities summary. Unknown or stale
+// images get scanned in the background.
+func (v *VulnCache) Vulns(image string) (render.VulnSummary, bool) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	e, ok := v.entries[image]
+	if !ok || v.isStale(e) {
+		v.schedule(image)
+	}
+
+	return e.summary, ok && e.err == nil
+}
+
+// Scan scans an image unless a fresh report is cached.
+func (v *VulnCache) Scan(ctx context.Context, image string) (render.VulnSummary, error) {
+	v.mx.Lock()
+	e, ok := v.entries[image]
+	v.mx.Unlock()
+	if ok && e.err == nil && !v.isStale(e) {
+		return e.summary, nil
+	}
+
+	sum, err := v.scanner.Scan(ctx, image)
+	v.store(image, sum, err)
+
+	return sum, err
+}
+
+func (v *VulnCache) isStale(e vulnEntry) bool {
+	ttl := v.ttl
+	if e.err != nil && vulnRetryDelay < ttl {
+		ttl = vulnRetryDelay
+	}
+
+	return time.Since(e.scanned) > ttl
+}
+
+// schedule scans an image in the background. Callers must hold the lock.
+func (v *VulnCache) schedule(image string) {
+	if _, ok := v.pending[image]; ok {
+		return
+	}
+	v.pending[image] = struct{}{}
+
+	go func() {
+		v.sem <- struct{}{}
+		defer func() { <-v.sem }()
+
+		ctx, cancel := context.WithTimeout(context.Background(), vulnScanTimeout)
+		defer cancel()
+		sum, err := v.scanner.Scan(ctx, image)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Vulnerability scan failed for %q", image)
+		}
+		v.store(image, sum, err)
+	}()
+}
+
+func (v *VulnCache) store(image string, sum render.VulnSummary, err error) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.entries[image] = vulnEntry{summary: sum, err: err, scanned: time.Now()}
+	delete(v.pending, image)
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulnCacheVulns(t *testing.T) {
+	s := &fakeScanner{sum: render.VulnSummary{Critical: 1}}
+	c := NewVulnCache(s, time.Hour)
+
+	_, ok := c.Vulns("nginx")
+	assert.False(t, ok)
+	assert.Eventually(t, func() bool {
+		_, ok := c.Vulns("nginx")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	sum, ok := c.Vulns("nginx")
+	assert.True(t, ok)
+	assert.Equal(t, render.VulnSummary{Critical: 1}, sum)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.calls))
+}
+
+func TestVulnCacheScan(t *testing.T) {
+	s := &fakeScanner{sum: render.VulnSummary{High: 2}}
+	c := NewVulnCache(s, time.Hour)
+
+	sum, err := c.Scan(context.Background(), "nginx")
+	assert.Nil(t, err)
+	assert.Equal(t, render.VulnSummary{High: 2}, sum)
+	_, err = c.Scan(context.Background(), "nginx")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.calls))
+
+	c.entries["nginx"] = vulnEntry{summary: sum, scanned: time.Now().Add(-2 * time.Hour)}
+	_, err = c.Scan(context.Background(), "nginx")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.calls))
+}
+
+func TestVulnCacheFailed(t *testing.T) {
+	s := &fakeScanner{err: errors.New("boom")}
+	c := NewVulnCache(s, time.Hour)
+
+	_, err := c.Scan(context.Background(), "nginx")
+	assert.EqualError(t, err, "boom")
+	_, ok := c.Vulns("nginx")
+	assert.False(t, ok)
+	assert.False(t, c.isStale(c.entries["nginx"]))
+
+	c.entries["nginx"] = vulnEntry{err: err, scanned: time.Now().Add(-vulnRetryDelay - time.Second)}
+	assert.True(t, c.isStale(c.entries["nginx"]))
+}
+
+// Helpers...
+
+type fakeScanner struct {
+	sum   render.VulnSummary
+	err   error
+	calls int32
+}
+
+func (f *fakeScanner) Scan(context.Context, string) (render.VulnSummary, error) {
+	atomic.AddInt32(&f.calls, 1)
+	return f.sum, f.err
+}
@@ -0,0 +1,193 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// statusCols tracks the columns used to figure out a resource status.
+var statusCols = []string{"STATUS", "READY", "AVAILABLE", "COMPLETIONS"}
+
+const deletedStatus = "Deleted"
+
+// ResourceWatch represents a watched resource or label selector.
+type ResourceWatch struct {
+	GVR       client.GVR
+	Namespace string
+	Path      string
+	Selector  string
+}
+
+// ID returns a watch unique identifier.
+func (w ResourceWatch) ID() string {
+	return strings.Join([]string{w.GVR.String(), w.Namespace, w.Path, w.Selector}, "|")
+}
+
+// String returns a watch friendly name.
+func (w ResourceWatch) String() string {
+	if w.Path != "" {
+		return w.GVR.R() + " " + w.Path
+	}
+
+	return w.GVR.R() + " " + w.Selector
+}
+
+// StatusChange represents a watched resource status transition.
+type StatusChange struct {
+	GVR      client.GVR
+	Path     string
+	From, To string
+}
+
+// StatusChangeFunc gets notified on watched resources status changes.
+type StatusChangeFunc func(StatusChange)
+
+type watchState struct {
+	watch  ResourceWatch
+	table  *Table
+	status map[string]string
+}
+
+// WatchList polls watched resources and reports status changes.
+type WatchList struct {
+	watches map[string]*watchState
+	rate    time.Duration
+	fn      StatusChangeFunc
+	mx      sync.RWMutex
+}
+
+// NewWatchList returns a new watch list.
+func NewWatchList(rate time.Duration, fn StatusChangeFunc) *WatchList {
+	return &WatchList{
+		watches: make(map[string]*watchState),
+		rate:    rate,
+		fn:      fn,
+	}
+}
+
+// Toggle adds a watch or removes it if already watched. Returns true if the
+// resource is now watched.
+func (l *WatchList) Toggle(w ResourceWatch) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if _, ok := l.watches[w.ID()]; ok {
+		delete(l.watches, w.ID())
+		return false
+	}
+	t := NewTable(w.GVR)
+	t.SetNamespace(w.Namespace)
+	t.SetLabelFilter(w.Selector)
+	l.watches[w.ID()] = &watchState{watch: w, table: t}
+
+	return true
+}
+
+// List returns all watches.
+func (l *WatchList) List() []ResourceWatch {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	ww := make([]ResourceWatch, 0, len(l.watches))
+	for _, s := range l.watches {
+		ww = append(ww, s.watch)
+	}
+	sort.Slice(ww, func(i, j int) bool {
+		return ww[i].ID() < ww[j].ID()
+	})
+
+	return ww
+}
+
+// Watch polls watched resources until the context is canceled.
+func (l *WatchList) Watch(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(l.rate):
+				l.poll(ctx)
+			}
+		}
+	}()
+}
+
+func (l *WatchList) poll(ctx context.Context) {
+	l.mx.RLock()
+	ss := make([]*watchState, 0, len(l.watches))
+	for _, s := range l.watches {
+		ss = append(ss, s)
+	}
+	l.mx.RUnlock()
+
+	for _, s := range ss {
+		if err := s.table.Refresh(ctx); err != nil {
+			log.Warn().Err(err).Msgf("Watch refresh failed for %s", s.watch)
+			continue
+		}
+		status, cc := statusChanges(s.status, s.table.Peek(), s.watch.Path)
+		s.status = status
+		for _, c := range cc {
+			c.GVR = s.watch.GVR
+			l.fn(c)
+		}
+	}
+}
+
+// Helpers...
+
+// statusChanges computes resources statuses and reports transitions
+// from the previous statuses. No changes are reported on the first pass.
+// A non blank path restricts the statuses to a single resource.
+func statusChanges(prev map[string]string, data render.TableData, path string) (map[string]string, []StatusChange) {
+	ii := make([]int, 0, len(statusCols))
+	for i, h := range data.Header {
+		for _, c := range statusCols {
+			if h.Name == c {
+				ii = append(ii, i)
+			}
+		}
+	}
+
+	status := make(map[string]string, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		if path != "" && re.Row.ID != path {
+			continue
+		}
+		ss := make([]string, 0, len(ii))
+		for _, i := range ii {
+			if i < len(re.Row.Fields) {
+				ss = append(ss, re.Row.Fields[i])
+			}
+		}
+		status[re.Row.ID] = strings.Join(ss, " ")
+	}
+	if prev == nil {
+		return status, nil
+	}
+
+	var cc []StatusChange
+	for id, s := range status {
+		if p, ok := prev[id]; ok && p != s {
+			cc = append(cc, StatusChange{Path: id, From: p, To: s})
+		}
+	}
+	for id, p := range prev {
+		if _, ok := status[id]; !ok {
+			cc = append(cc, StatusChange{Path: id, From: p, To: deletedStatus})
+		}
+	}
+	sort.Slice(cc, func(i, j int) bool {
+		return cc[i].Path < cc[j].Path
+	})
+
+	return status, cc
+}
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusChanges(t *testing.T) {
+	header := render.Header{{Name: "NAME"}, {Name: "READY"}, {Name: "STATUS"}}
+	data := func(ss ...string) render.TableData {
+		d := render.TableData{Header: header}
+		for i := 0; i < len(ss); i += 2 {
+			d.RowEvents = append(d.RowEvents, render.RowEvent{
+				Row: render.Row{ID: ss[i], Fields: render.Fields{ss[i], "1/1", ss[i+1]}},
+			})
+		}
+		return d
+	}
+
+	uu := map[string]struct {
+		prev map[string]string
+		data render.TableData
+		path string
+		e    []StatusChange
+	}{
+		"first": {
+			data: data("p1", "Running"),
+		},
+		"same": {
+			prev: map[string]string{"p1": "1/1 Running"},
+			data: data("p1", "Running"),
+		},
+		"changed": {
+			prev: map[string]string{"p1": "1/1 Pending", "p2": "1/1 Running"},
+			data: data("p1", "Running", "p2", "Error"),
+			e: []StatusChange{
+				{Path: "p1", From: "1/1 Pending", To: "1/1 Running"},
+				{Path: "p2", From: "1/1 Running", To: "1/1 Error"},
+			},
+		},
+		"deleted": {
+			prev: map[string]string{"p1": "1/1 Running"},
+			data: data(),
+			e:    []StatusChange{{Path: "p1", From: "1/1 Running", To: deletedStatus}},
+		},
+		"path": {
+			prev: map[string]string{"p1": "1/1 Running"},
+			data: data("p1", "Running", "p2", "Error"),
+			path: "p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, cc := statusChanges(u.prev, u.data, u.path)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
+
+func TestWatchListToggle(t *testing.T) {
+	l := NewWatchList(0, func(StatusChange) {})
+	w := ResourceWatch{GVR: client.NewGVR("v1/pods"), Namespace: "default", Path: "default/p1"}
+
+	assert.True(t, l.Toggle(w))
+	assert.Equal(t, []ResourceWatch{w}, l.List())
+	assert.False(t, l.Toggle(w))
+	assert.Equal(t, 0, len(l.List()))
+}
@@ -0,0 +1,447 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CrashLoopBackOff tracks a crashlooping container.
+	CrashLoopBackOff = "CrashLoopBackOff"
+
+	// ImagePullBackOff tracks a container failing to pull its image.
+	ImagePullBackOff = "ImagePullBackOff"
+
+	// OOMKilled tracks a container killed for exceeding its memory limit.
+	OOMKilled = "OOMKilled"
+
+	errImagePull = "ErrImagePull"
+)
+
+// AlertFunc gets notified when a new watchdog alert is raised.
+type AlertFunc func(render.ClusterAlert)
+
+const (
+	// JobFailed tracks a job with failed pods.
+	JobFailed = "JobFailed"
+
+	// NodeNotReady tracks a node not ready.
+	NodeNotReady = "NotReady"
+)
+
+// pressureConditions lists the node conditions raising an alert when true.
+var pressureConditions = []v1.NodeConditionType{
+	v1.NodeMemoryPressure,
+	v1.NodeDiskPressure,
+	v1.NodePIDPressure,
+	v1.NodeNetworkUnavailable,
+}
+
+// alertIssue represents a watchdog worthy condition on a resource.
+type alertIssue struct {
+	kind, path, subject string
+	reason, message     string
+
+	// level grows on recurrences ie container restarts or event counts.
+	level int32
+}
+
+func (i alertIssue) alert() render.ClusterAlert {
+	return render.ClusterAlert{
+		Kind:    i.kind,
+		Path:    i.path,
+		Subject: i.subject,
+		Reason:  i.reason,
+		Message: i.message,
+	}
+}
+
+// Watchdog monitors pods, jobs, warning events and nodes and raises alerts
+// on failures. Alerts persist until they are dismissed.
+type Watchdog struct {
+	scopes    []ResourceWatch
+	rules     config.AlertRules
+	rate      time.Duration
+	fn        AlertFunc
+	alerts    map[string]*render.ClusterAlert
+	levels    map[string]int32
+	dismissed map[string]int32
+	listeners []AlertsListener
+	mx        sync.RWMutex
+}
+
+// AlertsListener gets notified when the alerts count changes.
+type AlertsListener interface {
+	AlertsChanged(count int)
+}
+
+// NewWatchdog returns a new cluster watchdog. Scopes track the monitored
+// pods namespaces and selectors.
+func NewWatchdog(scopes []ResourceWatch, rules config.AlertRules, rate time.Duration, fn AlertFunc) *Watchdog {
+	return &Watchdog{
+		scopes:    scopes,
+		rules:     rules,
+		rate:      rate,
+		fn:        fn,
+		alerts:    make(map[string]*render.ClusterAlert),
+		levels:    make(map[string]int32),
+		dismissed: make(map[string]int32),
+	}
+}
+
+// AddListener registers an alerts listener.
+func (w *Watchdog) AddListener(l AlertsListener) {
+	w.mx.Lock()
+	defer w.mx.Unlock()
+
+	w.listeners = append(w.listeners, l)
+}
+
+// Alerts returns all current alerts.
+func (w *Watchdog) Alerts() []render.ClusterAlert {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	aa := make([]render.ClusterAlert, 0, len(w.alerts))
+	for _, a := range w.alerts {
+		aa = append(aa, *a)
+	}
+	sort.Slice(aa, func(i, j int) bool {
+		return aa[i].ID() < aa[j].ID()
+	})
+
+	return aa
+}
+
+// Alert returns an alert by id.
+func (w *Watchdog) Alert(id string) (render.ClusterAlert, bool) {
+	w.mx.RLock()
+	defer w.mx.RUnlock()
+
+	a, ok := w.alerts[id]
+	if !ok {
+		return render.ClusterAlert{}, false
+	}
+
+	return *a, true
+}
+
+// Dismiss acknowledges an alert. The alert is raised again on a new occurrence.
+func (w *Watchdog) Dismiss(id string) {
+	w.mx.Lock()
+	if _, ok := w.alerts[id]; !ok {
+		w.mx.Unlock()
+		return
+	}
+	w.dismissed[id] = w.levels[id]
+	delete(w.alerts, id)
+	w.mx.Unlock()
+
+	w.fireAlertsChanged()
+}
+
+// Watch polls the monitored resources until the context is canceled.
+func (w *Watchdog) Watch(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.rate):
+				if err := w.poll(ctx); err != nil {
+					log.Warn().Err(err).Msgf("Watchdog poll failed")
+				}
+			}
+		}
+	}()
+}
+
+func (w *Watchdog) poll(ctx context.Context) error {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	var (
+		r        = w.rules
+		ii       []alertIssue
+		complete = true
+	)
+	collect := func(gvr string, scopes []ResourceWatch, fn issuesFunc) {
+		iss, ok := w.list(f, client.NewGVR(gvr), scopes, fn)
+		ii, complete = append(ii, iss...), complete && ok
+	}
+	if !r.CrashLoop.Disabled || !r.ImagePull.Disabled || !r.OOMKilled.Disabled {
+		collect("v1/pods", w.scopes, func(o map[string]interface{}) ([]alertIssue, error) {
+			var po v1.Pod
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &po)
+			return podIssues(&po, r), err
+		})
+	}
+	if !r.FailedJobs.Disabled {
+		collect("batch/v1/jobs", w.scopes, func(o map[string]interface{}) ([]alertIssue, error) {
+			var job batchv1.Job
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &job)
+			return jobIssues(&job, r.FailedJobs.Threshold), err
+		})
+	}
+	if !r.WarningEvents.Disabled {
+		collect("v1/events", namespaceScopes(w.scopes), func(o map[string]interface{}) ([]alertIssue, error) {
+			var ev v1.Event
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &ev)
+			return eventIssues(&ev, r.WarningEvents.Threshold), err
+		})
+	}
+	if !r.NodePressure.Disabled {
+		collect("v1/nodes", []ResourceWatch{{}}, func(o map[string]interface{}) ([]alertIssue, error) {
+			var no v1.Node
+			err := runtime.DefaultUnstructuredConverter.FromUnstructured(o, &no)
+			return nodeIssues(&no), err
+		})
+	}
+	w.record(ii, time.Now(), complete)
+
+	return nil
+}
+
+// issuesFunc extracts the issues from a raw resource.
+type issuesFunc func(map[string]interface{}) ([]alertIssue, error)
+
+// list collects a resource issues in the given scopes. It returns false if
+// a listing failed.
+func (w *Watchdog) list(f dao.Factory, gvr client.GVR, scopes []ResourceWatch, fn issuesFunc) ([]alertIssue, bool) {
+	var ii []alertIssue
+	complete := true
+	for _, s := range scopes {
+		sel, err := labels.Parse(s.Selector)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Watchdog invalid selector %q", s.Selector)
+			continue
+		}
+		oo, err := f.List(gvr.String(), s.Namespace, false, sel)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Watchdog list failed for %s in %q", gvr, s.Namespace)
+			complete = false
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			iss, err := fn(u.Object)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Watchdog %s conversion failed", gvr)
+				continue
+			}
+			ii = append(ii, iss...)
+		}
+	}
+
+	return ii, complete
+}
+
+// record tracks issues and notifies on newly raised alerts. Dismissed alerts
+// no longer observed are forgotten when all sources were polled so that a
+// recurrence raises them again.
+func (w *Watchdog) record(ii []alertIssue, t time.Time, complete bool) {
+	var raised []render.ClusterAlert
+	seen := make(map[string]struct{}, len(ii))
+	w.mx.Lock()
+	for _, i := range ii {
+		a := i.alert()
+		id := a.ID()
+		seen[id] = struct{}{}
+		if d, ok := w.dismissed[id]; ok {
+			if i.level <= d {
+				continue
+			}
+			delete(w.dismissed, id)
+		}
+		if cur, ok := w.alerts[id]; ok {
+			if i.level > w.levels[id] {
+				cur.Count++
+			}
+			cur.LastSeen, cur.Message, w.levels[id] = t, i.message, i.level
+			continue
+		}
+		a.Count, a.FirstSeen, a.LastSeen = 1, t, t
+		w.alerts[id], w.levels[id] = &a, i.level
+		raised = append(raised, a)
+	}
+	if complete {
+		for id := range w.dismissed {
+			if _, ok := seen[id]; !ok {
+				delete(w.dismissed, id)
+			}
+		}
+	}
+	w.mx.Unlock()
+
+	for _, a := range raised {
+		w.fn(a)
+	}
+	if len(raised) > 0 {
+		w.fireAlertsChanged()
+	}
+}
+
+func (w *Watchdog) fireAlertsChanged() {
+	w.mx.RLock()
+	count, ll := len(w.alerts), w.listeners
+	w.mx.RUnlock()
+
+	for _, l := range ll {
+		l.AlertsChanged(count)
+	}
+}
+
+// Helpers...
+
+// namespaceScopes returns the unique scopes namespaces.
+func namespaceScopes(ss []ResourceWatch) []ResourceWatch {
+	set := make(map[string]struct{}, len(ss))
+	nn := make([]ResourceWatch, 0, len(ss))
+	for _, s := range ss {
+		if _, ok := set[s.Namespace]; ok {
+			continue
+		}
+		set[s.Namespace] = struct{}{}
+		nn = append(nn, ResourceWatch{Namespace: s.Namespace})
+	}
+
+	return nn
+}
+
+// podIssues returns the watchdog worthy issues for a given pod.
+func podIssues(po *v1.Pod, r config.AlertRules) []alertIssue {
+	ss := make([]v1.ContainerStatus, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+	ss = append(ss, po.Status.InitContainerStatuses...)
+	ss = append(ss, po.Status.ContainerStatuses...)
+
+	path := client.FQN(po.Namespace, po.Name)
+	issue := func(s v1.ContainerStatus, reason, msg string) alertIssue {
+		return alertIssue{kind: "Pod", path: path, subject: s.Name, reason: reason, message: msg, level: s.RestartCount}
+	}
+	var ii []alertIssue
+	for _, s := range ss {
+		if w := s.State.Waiting; w != nil {
+			switch w.Reason {
+			case CrashLoopBackOff:
+				if !r.CrashLoop.Disabled && int(s.RestartCount) >= r.CrashLoop.Threshold {
+					ii = append(ii, issue(s, CrashLoopBackOff, w.Message))
+				}
+			case ImagePullBackOff, errImagePull:
+				if !r.ImagePull.Disabled {
+					ii = append(ii, issue(s, ImagePullBackOff, w.Message))
+				}
+			}
+		}
+		if !r.OOMKilled.Disabled && oomKilled(s) {
+			ii = append(ii, issue(s, OOMKilled, ""))
+		}
+	}
+
+	return ii
+}
+
+func oomKilled(s v1.ContainerStatus) bool {
+	if t := s.State.Terminated; t != nil && t.Reason == OOMKilled {
+		return true
+	}
+	if t := s.LastTerminationState.Terminated; t != nil && t.Reason == OOMKilled {
+		return true
+	}
+
+	return false
+}
+
+// jobIssues flags failed jobs or jobs with too many failed pods.
+func jobIssues(job *batchv1.Job, threshold int) []alertIssue {
+	i := alertIssue{kind: "Job", path: client.FQN(job.Namespace, job.Name), level: job.Status.Failed}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == v1.ConditionTrue {
+			i.reason, i.message = c.Reason, c.Message
+			if i.reason == "" {
+				i.reason = JobFailed
+			}
+			return []alertIssue{i}
+		}
+	}
+	if job.Status.Failed == 0 || int(job.Status.Failed) < threshold {
+		return nil
+	}
+	i.reason, i.message = JobFailed, fmt.Sprintf("%d failed pods", job.Status.Failed)
+
+	return []alertIssue{i}
+}
+
+// eventIssues flags recurring warning events.
+func eventIssues(ev *v1.Event, threshold int) []alertIssue {
+	if ev.Type != v1.EventTypeWarning {
+		return nil
+	}
+	count := ev.Count
+	if ev.Series != nil && ev.Series.Count > count {
+		count = ev.Series.Count
+	}
+	if count == 0 {
+		count = 1
+	}
+	if int(count) < threshold {
+		return nil
+	}
+	o := ev.InvolvedObject
+
+	return []alertIssue{{
+		kind:    o.Kind,
+		path:    client.FQN(o.Namespace, o.Name),
+		reason:  ev.Reason,
+		message: ev.Message,
+		level:   count,
+	}}
+}
+
+// nodeIssues flags nodes under pressure or not ready.
+func nodeIssues(no *v1.Node) []alertIssue {
+	var ii []alertIssue
+	for _, c := range no.Status.Conditions {
+		i := alertIssue{kind: "Node", path: no.Name, message: c.Message}
+		switch {
+		case c.Type == v1.NodeReady && c.Status != v1.ConditionTrue:
+			i.reason = NodeNotReady
+		case isPressure(c.Type) && c.Status == v1.ConditionTrue:
+			i.reason = string(c.Type)
+		default:
+			continue
+		}
+		ii = append(ii, i)
+	}
+
+	return ii
+}
+
+func isPressure(t v1.NodeConditionType) bool {
+	for _, p := range pressureConditions {
+		if p == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodIssues(t *testing.T) {
+	uu := map[string]struct {
+		po    v1.Pod
+		rules config.AlertRules
+		e     []alertIssue
+	}{
+		"healthy": {
+			po: makeWatchdogPod(v1.ContainerStatus{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}}),
+		},
+		"crashloop": {
+			po: makeWatchdogPod(v1.ContainerStatus{
+				Name:         "c1",
+				RestartCount: 3,
+				State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: CrashLoopBackOff}},
+			}),
+			e: []alertIssue{{kind: "Pod", path: "default/p1", subject: "c1", reason: CrashLoopBackOff, level: 3}},
+		},
+		"crashloopThreshold": {
+			po: makeWatchdogPod(v1.ContainerStatus{
+				Name:         "c1",
+				RestartCount: 3,
+				State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: CrashLoopBackOff}},
+			}),
+			rules: config.AlertRules{CrashLoop: config.AlertRule{Threshold: 5}},
+		},
+		"imagePull": {
+			po: makeWatchdogPod(v1.ContainerStatus{
+				Name:  "c1",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"}},
+			}),
+			e: []alertIssue{{kind: "Pod", path: "default/p1", subject: "c1", reason: ImagePullBackOff, message: "not found"}},
+		},
+		"imagePullDisabled": {
+			po: makeWatchdogPod(v1.ContainerStatus{
+				Name:  "c1",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+			}),
+			rules: config.AlertRules{ImagePull: config.AlertRule{Disabled: true}},
+		},
+		"oomLoop": {
+			po: makeWatchdogPod(v1.ContainerStatus{
+				Name:                 "c1",
+				RestartCount:         1,
+				State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: CrashLoopBackOff}},
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: OOMKilled}},
+			}),
+			e: []alertIssue{
+				{kind: "Pod", path: "default/p1", subject: "c1", reason: CrashLoopBackOff, level: 1},
+				{kind: "Pod", path: "default/p1", subject: "c1", reason: OOMKilled, level: 1},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, podIssues(&u.po, u.rules))
+		})
+	}
+}
+
+func TestJobIssues(t *testing.T) {
+	uu := map[string]struct {
+		job       batchv1.Job
+		threshold int
+		e         []alertIssue
+	}{
+		"healthy": {
+			job:       makeWatchdogJob(0),
+			threshold: 1,
+		},
+		"failedPods": {
+			job:       makeWatchdogJob(2),
+			threshold: 1,
+			e:         []alertIssue{{kind: "Job", path: "default/j1", reason: JobFailed, message: "2 failed pods", level: 2}},
+		},
+		"belowThreshold": {
+			job:       makeWatchdogJob(2),
+			threshold: 3,
+		},
+		"failed": {
+			job: makeWatchdogJob(1, batchv1.JobCondition{
+				Type:    batchv1.JobFailed,
+				Status:  v1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			}),
+			threshold: 3,
+			e: []alertIssue{{
+				kind:    "Job",
+				path:    "default/j1",
+				reason:  "BackoffLimitExceeded",
+				message: "Job has reached the specified backoff limit",
+				level:   1,
+			}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, jobIssues(&u.job, u.threshold))
+		})
+	}
+}
+
+func TestEventIssues(t *testing.T) {
+	uu := map[string]struct {
+		ev v1.Event
+		e  []alertIssue
+	}{
+		"normal": {
+			ev: makeWatchdogEvent(v1.EventTypeNormal, 10),
+		},
+		"belowThreshold": {
+			ev: makeWatchdogEvent(v1.EventTypeWarning, 2),
+		},
+		"warning": {
+			ev: makeWatchdogEvent(v1.EventTypeWarning, 5),
+			e:  []alertIssue{{kind: "Pod", path: "default/p1", reason: "BackOff", message: "Back-off restarting", level: 5}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, eventIssues(&u.ev, 5))
+		})
+	}
+}
+
+func TestNodeIssues(t *testing.T) {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{Conditions: []v1.NodeCondition{
+			{Type: v1.NodeReady, Status: v1.ConditionUnknown, Message: "kubelet stopped"},
+			{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+			{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue, Message: "disk full"},
+		}},
+	}
+
+	assert.Equal(t, []alertIssue{
+		{kind: "Node", path: "n1", reason: NodeNotReady, message: "kubelet stopped"},
+		{kind: "Node", path: "n1", reason: "DiskPressure", message: "disk full"},
+	}, nodeIssues(&no))
+}
+
+func TestWatchdogRecord(t *testing.T) {
+	var raised []render.ClusterAlert
+	w := NewWatchdog(nil, config.AlertRules{}, time.Second, func(a render.ClusterAlert) {
+		raised = append(raised, a)
+	})
+	t0 := time.Now()
+
+	w.record([]alertIssue{crashLoopIssue(1)}, t0, true)
+	w.record([]alertIssue{crashLoopIssue(1)}, t0.Add(time.Second), true)
+	w.record([]alertIssue{crashLoopIssue(2)}, t0.Add(2*time.Second), true)
+	assert.Equal(t, 1, len(raised))
+
+	aa := w.Alerts()
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, 2, aa[0].Count)
+	assert.Equal(t, t0, aa[0].FirstSeen)
+	assert.Equal(t, t0.Add(2*time.Second), aa[0].LastSeen)
+
+	w.Dismiss(aa[0].ID())
+	assert.Equal(t, 0, len(w.Alerts()))
+	w.record([]alertIssue{crashLoopIssue(2)}, t0.Add(3*time.Second), true)
+	assert.Equal(t, 0, len(w.Alerts()))
+	w.record([]alertIssue{crashLoopIssue(3)}, t0.Add(4*time.Second), true)
+	assert.Equal(t, 1, len(w.Alerts()))
+	assert.Equal(t, 2, len(raised))
+}
+
+func TestWatchdogRecordRecurrence(t *testing.T) {
+	var raised int
+	w := NewWatchdog(nil, config.AlertRules{}, time.Second, func(render.ClusterAlert) {
+		raised++
+	})
+	t0 := time.Now()
+	ii := []alertIssue{{kind: "Node", path: "n1", reason: "DiskPressure"}}
+
+	w.record(ii, t0, true)
+	w.Dismiss(w.Alerts()[0].ID())
+	w.record(ii, t0.Add(time.Second), true)
+	assert.Equal(t, 0, len(w.Alerts()))
+	w.record(nil, t0.Add(2*time.Second), false)
+	w.record(ii, t0.Add(3*time.Second), true)
+	assert.Equal(t, 0, len(w.Alerts()))
+	w.record(nil, t0.Add(4*time.Second), true)
+	w.record(ii, t0.Add(5*time.Second), true)
+	assert.Equal(t, 1, len(w.Alerts()))
+	assert.Equal(t, 2, raised)
+}
+
+// Helpers...
+
+func crashLoopIssue(restarts int32) alertIssue {
+	return alertIssue{kind: "Pod", path: "default/p1", subject: "c1", reason: CrashLoopBackOff, level: restarts}
+}
+
+func makeWatchdogPod(ss ...v1.ContainerStatus) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1"},
+		Status:     v1.PodStatus{ContainerStatuses: ss},
+	}
+}
+
+func makeWatchdogJob(failed int32, cc ...batchv1.JobCondition) batchv1.Job {
+	return batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "j1"},
+		Status:     batchv1.JobStatus{Failed: failed, Conditions: cc},
+	}
+}
+
+func makeWatchdogEvent(kind string, count int32) v1.Event {
+	return v1.Event{
+		Type:           kind,
+		Reason:         "BackOff",
+		Message:        "Back-off restarting",
+		Count:          count,
+		InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: "p1"},
+	}
+}
@@ -30,6 +30,8 @@ type YAML struct {
 	lines     []string
 	listeners []ResourceViewerListener
 	options   ViewerToggleOpts
+	format    string
+	expr      string
 }
 
 // NewYAML return a new yaml resource model.
@@ -53,6 +55,18 @@ func (y *YAML) SetOptions(ctx context.Context, opts ViewerToggleOpts) {
 	}
 }
 
+// SetFormat sets the manifest output format.
+func (y *YAML) SetFormat(ctx context.Context, format, expr string) error {
+	if format == dao.QueryFormat {
+		if _, err := dao.CompileQuery(expr); err != nil {
+			return err
+		}
+	}
+	y.format, y.expr = format, expr
+
+	return y.refresh(ctx)
+}
+
 // Filter filters the model.
 func (y *YAML) Filter(q string) {
 	y.query = q
@@ -163,11 +177,10 @@ func (y *YAML) refresh(ctx context.Context) error {
 }
 
 func (y *YAML) reconcile(ctx context.Context) error {
-	s, err := y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts])
+	lines, err := y.streamLines(ctx)
 	if err != nil {
 		return err
 	}
-	lines := strings.Split(s, "\n")
 	if reflect.DeepEqual(lines, y.lines) {
 		return nil
 	}
@@ -177,6 +190,66 @@ func (y *YAML) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// streamLines encodes the resource YAML. On initial load, the first page is
+// published as soon as it is encoded so large manifests show up right away.
+func (y *YAML) streamLines(ctx context.Context) ([]string, error) {
+	if y.format != "" && y.format != dao.YAMLFormat {
+		return y.formatLines(ctx)
+	}
+	meta, err := getMeta(ctx, y.gvr)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := meta.DAO.(dao.YAMLStreamer)
+	if !ok {
+		s, err := y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts])
+		if err != nil {
+			return nil, err
+		}
+		return strings.Split(s, "\n"), nil
+	}
+
+	w := newLineWriter(yamlPageSize, nil)
+	if y.lines == nil {
+		w.onPage = func(page []string) {
+			y.fireResourceChanged(page, nil)
+		}
+	}
+	if err := st.StreamYAML(y.path, y.options[ManagedFieldsOpts], w); err != nil {
+		return nil, err
+	}
+
+	return w.Lines(), nil
+}
+
+// formatLines renders the resource in the current output format.
+func (y *YAML) formatLines(ctx context.Context) ([]string, error) {
+	meta, err := getMeta(ctx, y.gvr)
+	if err != nil {
+		return nil, err
+	}
+	g, ok := meta.DAO.(dao.Getter)
+	if !ok {
+		return nil, fmt.Errorf("no getter for %q", y.gvr)
+	}
+	o, err := g.Get(ctx, y.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var s string
+	if y.format == dao.QueryFormat {
+		s, err = dao.QueryObject(o, y.expr, y.options[ManagedFieldsOpts])
+	} else {
+		s, err = dao.ToJSON(o, y.options[ManagedFieldsOpts])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(s, "\n"), nil
+}
+
 // AddListener adds a new model listener.
 func (y *YAML) AddListener(l ResourceViewerListener) {
 	y.listeners = append(y.listeners, l)
@@ -33,6 +33,9 @@ type Benchmark struct {
 	canceled bool
 	config   config.BenchConfig
 	worker   *requester.Work
+	ctx      context.Context
+	request  requestFn
+	closeFn  func()
 	cancelFn context.CancelFunc
 	mx       sync.RWMutex
 }
@@ -47,8 +50,15 @@ func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, err
 }
 
 func (b *Benchmark) init(base, version string) error {
+	if err := b.config.Load.Validate(); err != nil {
+		return err
+	}
 	var ctx context.Context
 	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
+	if b.config.IsGRPC() || !b.config.Load.IsConstant() || hasTemplates(b.config) {
+		return b.initLoad(ctx, base, version)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, nil)
 	if err != nil {
 		return err
@@ -84,6 +94,28 @@ func (b *Benchmark) init(base, version string) error {
 	return nil
 }
 
+// initLoad sets up a grpc, variable load or templated requests benchmark.
+func (b *Benchmark) initLoad(ctx context.Context, base, version string) error {
+	b.ctx = ctx
+	ua := userAgent(b.config.HTTP.Headers, version)
+	var err error
+	if b.config.IsGRPC() {
+		target := grpcTarget(base)
+		log.Debug().Msgf("Benchmarking gRPC %s%s", target, b.config.GRPCMethod())
+		b.request, b.closeFn, err = newGRPCRequestFn(ctx, target, ua, b.config)
+	} else {
+		log.Debug().Msgf("Benchmarking Request %s", base)
+		b.request, err = newHTTPRequestFn(base, ua, b.config)
+	}
+	if err != nil {
+		b.cancelFn()
+		return err
+	}
+	log.Debug().Msgf("Using bench config N:%d--C:%d--Load:%q", b.config.N, b.config.C, b.config.Load.Profile)
+
+	return nil
+}
+
 // Cancel kills the benchmark in progress.
 func (b *Benchmark) Cancel() {
 	if b == nil {
@@ -108,10 +140,17 @@ func (b *Benchmark) Canceled() bool {
 func (b *Benchmark) Run(cluster string, done func()) {
 	log.Debug().Msgf("Running benchmark on cluster %s", cluster)
 	buff := new(bytes.Buffer)
-	b.worker.Writer = buff
-	// this call will block until the benchmark is complete or timesout.
-	b.worker.Run()
-	b.worker.Stop()
+	if b.worker != nil {
+		b.worker.Writer = buff
+		// this call will block until the benchmark is complete or timesout.
+		b.worker.Run()
+		b.worker.Stop()
+	} else {
+		writeReport(buff, runStages(b.ctx, loadStages(b.config.Load, b.config.C, b.config.N), b.request))
+		if b.closeFn != nil {
+			b.closeFn()
+		}
+	}
 	if len(buff.Bytes()) > 0 {
 		if err := b.save(cluster, buff); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
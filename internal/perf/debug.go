@@ -0,0 +1,138 @@
+package perf
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	debugHost            = "localhost"
+	debugShutdownTimeout = 2 * time.Second
+	procFDs              = "/proc/self/fd"
+)
+
+// InformerStatsFunc returns active informer counts keyed by namespace.
+type InformerStatsFunc func() map[string]int
+
+// RuntimeStats represents a K9s process runtime snapshot.
+type RuntimeStats struct {
+	Goroutines  int            `json:"goroutines"`
+	HeapAlloc   uint64         `json:"heapAlloc"`
+	HeapInuse   uint64         `json:"heapInuse"`
+	HeapObjects uint64         `json:"heapObjects"`
+	Sys         uint64         `json:"sys"`
+	NumGC       uint32         `json:"numGC"`
+	FDs         int            `json:"fds"`
+	Informers   map[string]int `json:"informers"`
+}
+
+// DebugServer exposes pprof and runtime stats endpoints on a local address.
+type DebugServer struct {
+	addr    string
+	statsFn InformerStatsFunc
+	server  *http.Server
+}
+
+// NewDebugServer returns a new debug server.
+func NewDebugServer(addr string, f InformerStatsFunc) *DebugServer {
+	return &DebugServer{
+		addr:    localAddr(addr),
+		statsFn: f,
+	}
+}
+
+// Addr returns the server listening address.
+func (d *DebugServer) Addr() string {
+	return d.addr
+}
+
+// Start launches the debug server in the background.
+func (d *DebugServer) Start() {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/stats", d.statsHandler)
+
+	d.server = &http.Server{Addr: d.addr, Handler: mux}
+	go func() {
+		log.Info().Msgf("Debug server listening on %s", d.addr)
+		if err := d.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msgf("Debug server failed")
+		}
+	}()
+}
+
+// Stop terminates the debug server.
+func (d *DebugServer) Stop() {
+	if d.server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), debugShutdownTimeout)
+	defer cancel()
+	if err := d.server.Shutdown(ctx); err != nil {
+		log.Error().Err(err).Msgf("Debug server shutdown failed")
+	}
+	d.server = nil
+}
+
+// Stats returns the current runtime stats.
+func (d *DebugServer) Stats() RuntimeStats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := RuntimeStats{
+		Goroutines:  runtime.NumGoroutine(),
+		HeapAlloc:   mem.HeapAlloc,
+		HeapInuse:   mem.HeapInuse,
+		HeapObjects: mem.HeapObjects,
+		Sys:         mem.Sys,
+		NumGC:       mem.NumGC,
+		FDs:         openFDs(),
+	}
+	if d.statsFn != nil {
+		stats.Informers = d.statsFn()
+	}
+
+	return stats
+}
+
+func (d *DebugServer) statsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(d.Stats()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// localAddr pins the debug server to the loopback interface.
+func localAddr(addr string) string {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		port = addr
+	}
+
+	return net.JoinHostPort(debugHost, port)
+}
+
+// openFDs returns the number of open file descriptors or -1 if unknown.
+func openFDs() int {
+	ff, err := ioutil.ReadDir(procFDs)
+	if err != nil {
+		return -1
+	}
+
+	return len(ff)
+}
@@ -0,0 +1,51 @@
+package perf
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalAddr(t *testing.T) {
+	uu := map[string]struct {
+		addr, e string
+	}{
+		"port": {
+			addr: "6060",
+			e:    "localhost:6060",
+		},
+		"colon": {
+			addr: ":6060",
+			e:    "localhost:6060",
+		},
+		"remote": {
+			addr: "0.0.0.0:6060",
+			e:    "localhost:6060",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, localAddr(u.addr))
+		})
+	}
+}
+
+func TestDebugServerStats(t *testing.T) {
+	d := NewDebugServer(":0", func() map[string]int {
+		return map[string]int{"default": 2}
+	})
+
+	w := httptest.NewRecorder()
+	d.statsHandler(w, httptest.NewRequest(http.MethodGet, "/debug/stats", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var s RuntimeStats
+	assert.Nil(t, json.NewDecoder(w.Body).Decode(&s))
+	assert.True(t, s.Goroutines > 0)
+	assert.Equal(t, map[string]int{"default": 2}, s.Informers)
+}
@@ -0,0 +1,296 @@
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const (
+	histogramBuckets = 10
+	histogramWidth   = 40
+)
+
+// requestFn issues a benchmark request and returns its status code. An
+// error means no response was received.
+type requestFn func(ctx context.Context, seq int) (string, error)
+
+type stage struct {
+	C, N int
+}
+
+type sample struct {
+	took time.Duration
+	code string
+	err  error
+}
+
+type stageResult struct {
+	stage
+	took    time.Duration
+	samples []sample
+}
+
+// loadStages splits a benchmark requests into stages of rising concurrency
+// according to a load profile.
+func loadStages(l config.Load, c, n int) []stage {
+	if c <= 0 {
+		c = 1
+	}
+	if c > n {
+		c = n
+	}
+	steps := 1
+	switch l.Profile {
+	case config.RampLoad:
+		steps = c
+	case config.StepLoad:
+		steps = l.StepsCount()
+	}
+	if steps > n {
+		steps = n
+	}
+	if steps <= 1 {
+		return []stage{{C: c, N: n}}
+	}
+
+	ss := make([]stage, 0, steps)
+	for i := 1; i <= steps; i++ {
+		cc := (c*i + steps - 1) / steps
+		if l.Profile == config.RampLoad {
+			cc = i
+		}
+		nn := n/steps + boolToInt(i <= n%steps)
+		ss = append(ss, stage{C: cc, N: nn})
+	}
+
+	return ss
+}
+
+// runStages runs each stage in turn until completion or cancelation.
+func runStages(ctx context.Context, ss []stage, fn requestFn) []stageResult {
+	rr := make([]stageResult, 0, len(ss))
+	var seq int
+	for _, s := range ss {
+		if ctx.Err() != nil {
+			break
+		}
+		rr = append(rr, runStage(ctx, s, seq, fn))
+		seq += s.N
+	}
+
+	return rr
+}
+
+func runStage(ctx context.Context, s stage, seq int, fn requestFn) stageResult {
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := 0; i < s.N; i++ {
+			select {
+			case jobs <- seq + i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		mx sync.Mutex
+		wg sync.WaitGroup
+		ss = make([]sample, 0, s.N)
+	)
+	t := time.Now()
+	wg.Add(s.C)
+	for i := 0; i < s.C; i++ {
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				start := time.Now()
+				code, err := fn(ctx, j)
+				if ctx.Err() != nil {
+					return
+				}
+				mx.Lock()
+				ss = append(ss, sample{took: time.Since(start), code: code, err: err})
+				mx.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return stageResult{stage: s, took: time.Since(t), samples: ss}
+}
+
+// writeReport writes a hey compatible benchmark report.
+func writeReport(w io.Writer, rr []stageResult) {
+	var (
+		total time.Duration
+		lats  []float64
+		codes = make(map[string]int)
+		errs  = make(map[string]int)
+	)
+	for _, r := range rr {
+		total += r.took
+		for _, s := range r.samples {
+			if s.err != nil {
+				errs[s.err.Error()]++
+				continue
+			}
+			codes[s.code]++
+			lats = append(lats, s.took.Seconds())
+		}
+	}
+	sort.Float64s(lats)
+
+	fmt.Fprintf(w, "\nSummary:\n")
+	fmt.Fprintf(w, "  Total:\t%4.4f secs\n", total.Seconds())
+	if len(lats) > 0 {
+		fmt.Fprintf(w, "  Slowest:\t%4.4f secs\n", lats[len(lats)-1])
+		fmt.Fprintf(w, "  Fastest:\t%4.4f secs\n", lats[0])
+		fmt.Fprintf(w, "  Average:\t%4.4f secs\n", average(lats))
+	}
+	if total > 0 {
+		fmt.Fprintf(w, "  Requests/sec:\t%4.4f\n", float64(len(lats)+count(errs))/total.Seconds())
+	}
+
+	if len(lats) > 0 {
+		fmt.Fprintf(w, "\nResponse time histogram:\n%s", histogram(lats))
+		fmt.Fprintf(w, "\nLatency distribution:\n")
+		for _, p := range []int{10, 25, 50, 75, 90, 95, 99} {
+			fmt.Fprintf(w, "  %d%% in %4.4f secs\n", p, percentile(lats, p))
+		}
+	}
+
+	if len(rr) > 1 {
+		fmt.Fprintf(w, "\nLoad profile:\n")
+		for i, r := range rr {
+			writeStage(w, i+1, r)
+		}
+	}
+
+	if len(codes) > 0 {
+		fmt.Fprintf(w, "\nStatus code distribution:\n")
+		for _, k := range sortedKeys(codes) {
+			fmt.Fprintf(w, "  [%s]\t%d responses\n", k, codes[k])
+		}
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "\nError distribution:\n")
+		for _, k := range sortedKeys(errs) {
+			fmt.Fprintf(w, "  [%d]\t%s\n", errs[k], k)
+		}
+	}
+}
+
+func writeStage(w io.Writer, i int, r stageResult) {
+	lats := make([]float64, 0, len(r.samples))
+	var errs int
+	for _, s := range r.samples {
+		if s.err != nil {
+			errs++
+			continue
+		}
+		lats = append(lats, s.took.Seconds())
+	}
+	sort.Float64s(lats)
+	var rps float64
+	if r.took > 0 {
+		rps = float64(len(r.samples)) / r.took.Seconds()
+	}
+	fmt.Fprintf(w, "  Stage %d\tconcurrency %d\trequests %d\t%4.4f req/s\tp50 %4.4f secs\tp99 %4.4f secs\terrors %d\n",
+		i, r.C, len(r.samples), rps, percentile(lats, 50), percentile(lats, 99), errs)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// histogram renders latencies in evenly sized buckets like hey does.
+func histogram(lats []float64) string {
+	fastest, slowest := lats[0], lats[len(lats)-1]
+	bs := (slowest - fastest) / histogramBuckets
+	buckets := make([]float64, histogramBuckets+1)
+	counts := make([]int, histogramBuckets+1)
+	for i := range buckets {
+		buckets[i] = fastest + bs*float64(i)
+	}
+	buckets[histogramBuckets] = slowest
+
+	var bi, max int
+	for i := 0; i < len(lats); {
+		if lats[i] <= buckets[bi] {
+			counts[bi]++
+			if counts[bi] > max {
+				max = counts[bi]
+			}
+			i++
+		} else if bi < len(buckets)-1 {
+			bi++
+		}
+	}
+
+	var b strings.Builder
+	for i := range buckets {
+		var bar int
+		if max > 0 {
+			bar = counts[i] * histogramWidth / max
+		}
+		fmt.Fprintf(&b, "  %4.3f [%d]\t|%s\n", buckets[i], counts[i], strings.Repeat("■", bar))
+	}
+
+	return b.String()
+}
+
+// percentile returns a sorted latencies percentile.
+func percentile(lats []float64, p int) float64 {
+	if len(lats) == 0 {
+		return 0
+	}
+	i := (len(lats)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return lats[i]
+}
+
+func average(ff []float64) float64 {
+	var sum float64
+	for _, f := range ff {
+		sum += f
+	}
+
+	return sum / float64(len(ff))
+}
+
+func count(m map[string]int) int {
+	var n int
+	for _, v := range m {
+		n += v
+	}
+
+	return n
+}
+
+func sortedKeys(m map[string]int) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,196 @@
+package perf
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestLoadStages(t *testing.T) {
+	uu := map[string]struct {
+		load config.Load
+		c, n int
+		e    []stage
+	}{
+		"constant": {
+			c: 3, n: 10,
+			e: []stage{{C: 3, N: 10}},
+		},
+		"ramp": {
+			load: config.Load{Profile: config.RampLoad},
+			c:    3, n: 10,
+			e: []stage{{C: 1, N: 4}, {C: 2, N: 3}, {C: 3, N: 3}},
+		},
+		"step": {
+			load: config.Load{Profile: config.StepLoad},
+			c:    10, n: 100,
+			e: []stage{{C: 3, N: 25}, {C: 5, N: 25}, {C: 8, N: 25}, {C: 10, N: 25}},
+		},
+		"stepCount": {
+			load: config.Load{Profile: config.StepLoad, Steps: 2},
+			c:    4, n: 10,
+			e: []stage{{C: 2, N: 5}, {C: 4, N: 5}},
+		},
+		"fewRequests": {
+			load: config.Load{Profile: config.StepLoad},
+			c:    5, n: 2,
+			e: []stage{{C: 1, N: 1}, {C: 2, N: 1}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, loadStages(u.load, u.c, u.n))
+		})
+	}
+}
+
+func TestRunStages(t *testing.T) {
+	rr := runStages(context.Background(), []stage{{C: 1, N: 4}, {C: 2, N: 6}}, func(_ context.Context, seq int) (string, error) {
+		if seq == 3 {
+			return "", errors.New("boom")
+		}
+		if seq%2 == 0 {
+			return "500", nil
+		}
+		return "200", nil
+	})
+
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, 4, len(rr[0].samples))
+	assert.Equal(t, 6, len(rr[1].samples))
+
+	var buff bytes.Buffer
+	writeReport(&buff, rr)
+	for _, s := range []string{
+		"Requests/sec:",
+		"Response time histogram:",
+		"Stage 2\tconcurrency 2\trequests 6",
+		"[200]\t4 responses",
+		"[500]\t5 responses",
+		"Error distribution:\n  [1]\tboom",
+	} {
+		assert.Contains(t, buff.String(), s)
+	}
+}
+
+func TestRequestTemplate(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		err bool
+		rx  string
+	}{
+		"plain": {
+			raw: `{"fred": "blee"}`,
+			rx:  `\A\{"fred": "blee"\}\z`,
+		},
+		"seq": {
+			raw: `{"id": {{ .Seq }}}`,
+			rx:  `\A\{"id": 3\}\z`,
+		},
+		"funcs": {
+			raw: `{{ uuid }} {{ randInt 1 5 }}`,
+			rx:  `\A[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12} [1-5]\z`,
+		},
+		"toast": {
+			raw: `{{ .Seq`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tpl, err := newRequestTemplate("body", u.raw)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			s, err := tpl.render(3)
+			assert.NoError(t, err)
+			assert.Regexp(t, regexp.MustCompile(u.rx), s)
+		})
+	}
+}
+
+func TestHTTPRequestFn(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Seq") != "7" || r.Header.Get("User-Agent") != "k9s/test" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	cfg := config.DefaultBenchSpec()
+	cfg.HTTP.Headers = http.Header{"X-Seq": []string{"{{ .Seq }}"}}
+	fn, err := newHTTPRequestFn(srv.URL, "k9s/test", cfg)
+	assert.NoError(t, err)
+
+	code, err := fn(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "202", code)
+
+	code, err = fn(context.Background(), 8)
+	assert.NoError(t, err)
+	assert.Equal(t, "400", code)
+}
+
+func TestGRPCRequestFn(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Stop()
+
+	uu := map[string]struct {
+		grpc config.GRPC
+		e    string
+	}{
+		"serving": {
+			e: "OK",
+		},
+		"unknownService": {
+			// HealthCheckRequest{Service: "zorg"}
+			grpc: config.GRPC{Body: "CgR6b3Jn"},
+			e:    "NotFound",
+		},
+		"unknownMethod": {
+			grpc: config.GRPC{Method: "/zorg.Blee/Duh"},
+			e:    "Unimplemented",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.DefaultBenchSpec()
+			cfg.GRPC = &u.grpc
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			fn, closeFn, err := newGRPCRequestFn(ctx, grpcTarget("http://"+l.Addr().String()+"/"), "k9s/test", cfg)
+			assert.NoError(t, err)
+			defer closeFn()
+
+			code, err := fn(ctx, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, code)
+		})
+	}
+}
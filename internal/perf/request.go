@@ -0,0 +1,298 @@
+package perf
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestData represents a request template data.
+type requestData struct {
+	// Seq tracks the request sequence number.
+	Seq int
+}
+
+// templateFuncs lists the functions available to request templates.
+var templateFuncs = template.FuncMap{
+	"uuid":    newUUID,
+	"randInt": randInt,
+	"now": func() string {
+		return time.Now().UTC().Format(time.RFC3339)
+	},
+}
+
+// requestTemplate renders a request body or header value.
+type requestTemplate struct {
+	raw string
+	tpl *template.Template
+}
+
+func newRequestTemplate(name, raw string) (*requestTemplate, error) {
+	t := requestTemplate{raw: raw}
+	if !strings.Contains(raw, "{{") {
+		return &t, nil
+	}
+	var err error
+	if t.tpl, err = template.New(name).Funcs(templateFuncs).Parse(raw); err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return &t, nil
+}
+
+func (t *requestTemplate) render(seq int) (string, error) {
+	if t.tpl == nil {
+		return t.raw, nil
+	}
+	var b strings.Builder
+	if err := t.tpl.Execute(&b, requestData{Seq: seq}); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+type headerTemplate struct {
+	key string
+	tpl *requestTemplate
+}
+
+func newHeaderTemplates(hh map[string][]string) ([]headerTemplate, error) {
+	tt := make([]headerTemplate, 0, len(hh))
+	for k, vv := range hh {
+		for _, v := range vv {
+			t, err := newRequestTemplate("header "+k, v)
+			if err != nil {
+				return nil, err
+			}
+			tt = append(tt, headerTemplate{key: k, tpl: t})
+		}
+	}
+
+	return tt, nil
+}
+
+func renderHeaders(tt []headerTemplate, seq int, add func(k, v string)) error {
+	for _, t := range tt {
+		v, err := t.tpl.render(seq)
+		if err != nil {
+			return err
+		}
+		add(t.key, v)
+	}
+
+	return nil
+}
+
+// hasTemplates checks if a benchmark requests vary per request.
+func hasTemplates(cfg config.BenchConfig) bool {
+	if strings.Contains(cfg.HTTP.Body, "{{") {
+		return true
+	}
+	for _, vv := range cfg.HTTP.Headers {
+		for _, v := range vv {
+			if strings.Contains(v, "{{") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// newHTTPRequestFn returns an http benchmark request issuer.
+func newHTTPRequestFn(base, ua string, cfg config.BenchConfig) (requestFn, error) {
+	body, err := newRequestTemplate("body", cfg.HTTP.Body)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := newHeaderTemplates(cfg.HTTP.Headers)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := url.Parse(base); err != nil {
+		return nil, err
+	}
+	clt := http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+			MaxIdleConnsPerHost: cfg.C,
+			ForceAttemptHTTP2:   cfg.HTTP.HTTP2,
+		},
+		Timeout: benchTimeout,
+	}
+
+	return func(ctx context.Context, seq int) (string, error) {
+		b, err := body.render(seq)
+		if err != nil {
+			return "", err
+		}
+		req, err := http.NewRequestWithContext(ctx, cfg.HTTP.Method, base, strings.NewReader(b))
+		if err != nil {
+			return "", err
+		}
+		if err := renderHeaders(headers, seq, req.Header.Add); err != nil {
+			return "", err
+		}
+		if cfg.Auth.User != "" || cfg.Auth.Password != "" {
+			req.SetBasicAuth(cfg.Auth.User, cfg.Auth.Password)
+		}
+		req.Header.Set("User-Agent", ua)
+		resp, err := clt.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+			return "", err
+		}
+
+		return strconv.Itoa(resp.StatusCode), nil
+	}, nil
+}
+
+// newGRPCRequestFn returns a grpc benchmark unary call issuer. Messages are
+// sent as is so no protobuf descriptors are required.
+func newGRPCRequestFn(ctx context.Context, target, ua string, cfg config.BenchConfig) (requestFn, func(), error) {
+	body, err := newRequestTemplate("body", cfg.GRPC.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	hh := make(map[string][]string, len(cfg.GRPC.Metadata))
+	for k, v := range cfg.GRPC.Metadata {
+		hh[k] = []string{v}
+	}
+	md, err := newHeaderTemplates(hh)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	creds := grpc.WithInsecure()
+	if cfg.GRPC.TLS {
+		creds = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true}))
+	}
+	conn, err := grpc.DialContext(ctx, target, creds, grpc.WithUserAgent(ua))
+	if err != nil {
+		return nil, nil, err
+	}
+	method := cfg.GRPCMethod()
+
+	return func(ctx context.Context, seq int) (string, error) {
+		b, err := body.render(seq)
+		if err != nil {
+			return "", err
+		}
+		in, err := base64.StdEncoding.DecodeString(strings.TrimSpace(b))
+		if err != nil {
+			return "", fmt.Errorf("invalid base64 grpc body: %w", err)
+		}
+		pairs := make([]string, 0, 2*len(md))
+		if err := renderHeaders(md, seq, func(k, v string) { pairs = append(pairs, k, v) }); err != nil {
+			return "", err
+		}
+		if cfg.Auth.User != "" || cfg.Auth.Password != "" {
+			pairs = append(pairs, "authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(cfg.Auth.User+":"+cfg.Auth.Password)))
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, pairs...)
+
+		var out []byte
+		err = conn.Invoke(ctx, method, &in, &out, grpc.ForceCodec(rawCodec{}))
+		st, ok := status.FromError(err)
+		if !ok {
+			return "", err
+		}
+
+		return st.Code().String(), nil
+	}, func() { conn.Close() }, nil
+}
+
+// grpcTarget returns a grpc dial target from a benchmark url.
+func grpcTarget(base string) string {
+	if u, err := url.Parse(base); err == nil && u.Host != "" {
+		return u.Host
+	}
+
+	return strings.TrimSuffix(base, "/")
+}
+
+// rawCodec passes protobuf messages thru as bytes.
+type rawCodec struct{}
+
+// Marshal returns the message bytes.
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("expecting bytes message but got %T", v)
+	}
+
+	return *b, nil
+}
+
+// Unmarshal copies the message bytes.
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("expecting bytes message but got %T", v)
+	}
+	*b = append((*b)[:0], data...)
+
+	return nil
+}
+
+// Name returns the codec content subtype.
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+func randInt(min, max int) (int, error) {
+	if max <= min {
+		return min, nil
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max-min+1)))
+	if err != nil {
+		return 0, err
+	}
+
+	return min + int(n.Int64()), nil
+}
+
+// userAgent returns the benchmark requests user agent.
+func userAgent(hh http.Header, version string) string {
+	ua := hh.Get("User-Agent")
+	if ua == "" {
+		return k9sUA + version
+	}
+
+	return ua + " " + k9sUA + version
+}
@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Alert renders watchdog alerts to screen.
+type Alert struct{}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return ErrColor
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "SUBJECT"},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "MESSAGE", Wide: true},
+		HeaderColumn{Name: "LAST-SEEN", Decorator: AgeDecorator},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Alert) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(ClusterAlert)
+	if !ok {
+		return fmt.Errorf("expecting a ClusterAlert but got %T", o)
+	}
+
+	pns, n := client.Namespaced(a.Path)
+	r.ID = a.ID()
+	r.Fields = Fields{
+		pns,
+		n,
+		a.Kind,
+		a.Subject,
+		a.Reason,
+		strconv.Itoa(a.Count),
+		a.Message,
+		timeToAge(a.LastSeen),
+		"",
+		timeToAge(a.FirstSeen),
+	}
+
+	return nil
+}
+
+// Helpers...
+
+// ClusterAlert represents a watchdog alert raised on a failing resource.
+type ClusterAlert struct {
+	// Kind tracks the failing resource kind ie Pod, Job or Node.
+	Kind string
+	Path string
+
+	// Subject tracks the failing part of the resource ie a pod container.
+	Subject   string
+	Reason    string
+	Message   string
+	Count     int
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// ID returns an alert unique identifier.
+func (a ClusterAlert) ID() string {
+	return strings.ToLower(a.Kind) + "/" + a.Path + ":" + a.Subject + ":" + a.Reason
+}
+
+// GetObjectKind returns a schema object.
+func (ClusterAlert) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a ClusterAlert) DeepCopyObject() runtime.Object {
+	return a
+}
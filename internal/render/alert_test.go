@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRender(t *testing.T) {
+	var a render.Alert
+	var r render.Row
+	o := render.ClusterAlert{
+		Kind:      "Pod",
+		Path:      "blee/fred",
+		Subject:   "c1",
+		Reason:    "CrashLoopBackOff",
+		Message:   "back-off restarting failed container",
+		Count:     3,
+		FirstSeen: testTime(),
+		LastSeen:  testTime(),
+	}
+
+	assert.Nil(t, a.Render(o, "", &r))
+	assert.Equal(t, "pod/blee/fred:c1:CrashLoopBackOff", r.ID)
+	assert.Equal(t, render.Fields{
+		"blee",
+		"fred",
+		"Pod",
+		"c1",
+		"CrashLoopBackOff",
+		"3",
+		"back-off restarting failed container",
+	}, r.Fields[:7])
+}
@@ -0,0 +1,109 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Audit action outcomes.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+const auditTimeFmt = "2006-01-02 15:04:05"
+
+// Audit renders audit log entries to screen.
+type Audit struct{}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		idx := h.IndexOf("OUTCOME", true)
+		if idx == -1 {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) == AuditFailure {
+			return ErrColor
+		}
+		if idx = h.IndexOf("DRY-RUN", true); idx != -1 && re.Row.Fields[idx] == "true" {
+			return PendingColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "TIME"},
+		HeaderColumn{Name: "CONTEXT"},
+		HeaderColumn{Name: "USER"},
+		HeaderColumn{Name: "ACTION"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "PATH"},
+		HeaderColumn{Name: "OUTCOME"},
+		HeaderColumn{Name: "DRY-RUN", Wide: true},
+		HeaderColumn{Name: "DETAILS", Wide: true},
+		HeaderColumn{Name: "ERROR", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Audit) Render(o interface{}, _ string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expecting AuditRes but got %T", o)
+	}
+
+	r.ID = a.ID
+	r.Fields = Fields{
+		a.Time.Local().Format(auditTimeFmt),
+		a.Context,
+		a.User,
+		a.Action,
+		a.GVR,
+		a.Path,
+		a.Outcome,
+		boolToStr(a.DryRun),
+		a.Details,
+		a.Error,
+		"",
+		timeToAge(a.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditRes represents an audit log entry.
+type AuditRes struct {
+	ID                      string
+	Time                    time.Time
+	Context, Cluster, User  string
+	Action, GVR, Path       string
+	Details, Outcome, Error string
+	Diff                    string
+	DryRun                  bool
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
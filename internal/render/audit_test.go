@@ -0,0 +1,44 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	var a render.Audit
+
+	var r render.Row
+	at := time.Date(2020, 9, 1, 10, 30, 0, 0, time.Local)
+	o := render.AuditRes{
+		ID:      "12",
+		Time:    at,
+		Context: "dev",
+		User:    "fred",
+		Action:  "delete",
+		GVR:     "v1/pods",
+		Path:    "default/nginx",
+		Outcome: render.AuditFailure,
+		Error:   "forbidden",
+		DryRun:  true,
+	}
+
+	assert.Nil(t, a.Render(o, "", &r))
+	assert.Equal(t, "12", r.ID)
+	assert.Equal(t, render.Fields{
+		"2020-09-01 10:30:00",
+		"dev",
+		"fred",
+		"delete",
+		"v1/pods",
+		"default/nginx",
+		render.AuditFailure,
+		"true",
+		"",
+		"forbidden",
+		"",
+	}, r.Fields[:11])
+}
@@ -19,8 +19,8 @@ import (
 var (
 	totalRx = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
 	reqRx   = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
-	okRx    = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
-	errRx   = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
+	okRx    = regexp.MustCompile(`\[(?:2\d{2}|OK)\]\s+(\d+)\s+responses`)
+	errRx   = regexp.MustCompile(`\[(?:[4-5]\d{2}|[A-Z][a-z][A-Za-z]*)\]\s+(\d+)\s+responses`)
 	toastRx = regexp.MustCompile(`Error distribution`)
 )
 
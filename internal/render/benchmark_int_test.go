@@ -29,6 +29,10 @@ func TestAugmentRow(t *testing.T) {
 			"testdata/b2.txt",
 			Fields{"pass", "3.3544", "29.8116", "100", "12"},
 		},
+		"grpc": {
+			"testdata/b5.txt",
+			Fields{"pass", "1.2000", "100.0000", "90", "10"},
+		},
 		"toast": {
 			"testdata/b3.txt",
 			Fields{"fail", "2.3688", "35.4606", "0", "0"},
@@ -50,3 +50,41 @@ func DefaultColorer(ns string, h Header, re RowEvent) tcell.Color {
 		return StdColor
 	}
 }
+
+// StatusGlyphs tracks status glyphs used to tell row statuses apart without
+// relying on colors.
+var StatusGlyphs = map[string]string{
+	"OK":   "✓",
+	"ERR":  "✗",
+	"DEL":  "−",
+	"PEND": "◷",
+	"NEW":  "+",
+	"MOD":  "~",
+	"DONE": "■",
+	"HIGH": "★",
+}
+
+// StatusLabel returns a textual status matching a row color so that status
+// is not conveyed by color alone.
+func StatusLabel(c tcell.Color) string {
+	switch c {
+	case ErrColor:
+		return "ERR"
+	case StdColor:
+		return "OK"
+	case KillColor:
+		return "DEL"
+	case PendingColor:
+		return "PEND"
+	case AddColor:
+		return "NEW"
+	case ModColor:
+		return "MOD"
+	case CompletedColor:
+		return "DONE"
+	case HighlightColor:
+		return "HIGH"
+	default:
+		return "OK"
+	}
+}
@@ -61,3 +61,29 @@ func TestDefaultColorer(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusLabel(t *testing.T) {
+	defer func(err, std, add, pending tcell.Color) {
+		render.ErrColor, render.StdColor, render.AddColor, render.PendingColor = err, std, add, pending
+	}(render.ErrColor, render.StdColor, render.AddColor, render.PendingColor)
+	render.ErrColor, render.StdColor = tcell.ColorRed, tcell.ColorWhite
+	render.AddColor, render.PendingColor = tcell.ColorBlue, tcell.ColorOrange
+
+	uu := map[string]struct {
+		c tcell.Color
+		e string
+	}{
+		"err":     {c: tcell.ColorRed, e: "ERR"},
+		"std":     {c: tcell.ColorWhite, e: "OK"},
+		"add":     {c: tcell.ColorBlue, e: "NEW"},
+		"pending": {c: tcell.ColorOrange, e: "PEND"},
+		"unknown": {c: tcell.ColorPink, e: "OK"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.StatusLabel(u.c))
+		})
+	}
+}
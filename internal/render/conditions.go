@@ -0,0 +1,102 @@
+package render
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NoConditions disables the status conditions columns.
+const NoConditions = "none"
+
+// DefaultConditionTypes lists the condition types surfaced by precedence
+// when none is configured.
+var DefaultConditionTypes = []string{"Ready", "Available", "Succeeded", "Complete", "Established", "Synced"}
+
+// Conditions summarizes a resource status conditions into READY and STATUS
+// columns. Type names the condition to surface.
+type Conditions struct {
+	Type string
+}
+
+// Enabled checks if the conditions columns are shown.
+func (c Conditions) Enabled() bool {
+	return !strings.EqualFold(c.Type, NoConditions)
+}
+
+// Header returns the conditions columns.
+func (Conditions) Header() Header {
+	return Header{
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Fields returns a resource conditions summary. The resource is flagged
+// invalid when the surfaced condition is false.
+func (c Conditions) Fields(o map[string]interface{}) Fields {
+	cond, ok := c.find(o)
+	if !ok {
+		return Fields{NAValue, NAValue, ""}
+	}
+	t, _ := cond["type"].(string)
+	status, _ := cond["status"].(string)
+	reason, _ := cond["reason"].(string)
+	if reason == "" {
+		reason = t
+	}
+	var valid string
+	if status == "False" {
+		valid = t + " is False"
+		if msg, _ := cond["message"].(string); msg != "" {
+			valid += ": " + msg
+		}
+	}
+
+	return Fields{status, reason, valid}
+}
+
+// find returns the surfaced condition. It defaults to the first well known
+// condition type, then the last reported one.
+func (c Conditions) find(o map[string]interface{}) (map[string]interface{}, bool) {
+	cc := statusConditions(o)
+	mm := make([]map[string]interface{}, 0, len(cc))
+	for _, cond := range cc {
+		if m, ok := cond.(map[string]interface{}); ok {
+			mm = append(mm, m)
+		}
+	}
+	if len(mm) == 0 {
+		return nil, false
+	}
+
+	tt := DefaultConditionTypes
+	if c.Type != "" {
+		tt = []string{c.Type}
+	}
+	for _, t := range tt {
+		for _, m := range mm {
+			if ct, _ := m["type"].(string); strings.EqualFold(ct, t) {
+				return m, true
+			}
+		}
+	}
+	if c.Type != "" {
+		return nil, false
+	}
+
+	return mm[len(mm)-1], true
+}
+
+// HasConditions checks if a resource reports status conditions.
+func HasConditions(o map[string]interface{}) bool {
+	return len(statusConditions(o)) > 0
+}
+
+func statusConditions(o map[string]interface{}) []interface{} {
+	v, _, _ := unstructured.NestedFieldNoCopy(o, "status", "conditions")
+	cc, _ := v.([]interface{})
+
+	return cc
+}
@@ -0,0 +1,81 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionsFields(t *testing.T) {
+	uu := map[string]struct {
+		cond string
+		o    map[string]interface{}
+		e    render.Fields
+	}{
+		"none": {
+			o: map[string]interface{}{},
+			e: render.Fields{render.NAValue, render.NAValue, ""},
+		},
+		"ready": {
+			o: makeConditions(map[string]interface{}{"type": "Ready", "status": "True"}),
+			e: render.Fields{"True", "Ready", ""},
+		},
+		"precedence": {
+			o: makeConditions(
+				map[string]interface{}{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"},
+				map[string]interface{}{"type": "Available", "status": "False", "reason": "MinimumReplicasUnavailable", "message": "blee"},
+			),
+			e: render.Fields{"False", "MinimumReplicasUnavailable", "Available is False: blee"},
+		},
+		"fallback": {
+			o: makeConditions(
+				map[string]interface{}{"type": "Fred", "status": "True"},
+				map[string]interface{}{"type": "Synced", "status": "Unknown", "reason": "Reconciling"},
+			),
+			e: render.Fields{"Unknown", "Reconciling", ""},
+		},
+		"last": {
+			o: makeConditions(
+				map[string]interface{}{"type": "Fred", "status": "True"},
+				map[string]interface{}{"type": "Blee", "status": "False"},
+			),
+			e: render.Fields{"False", "Blee", "Blee is False"},
+		},
+		"custom": {
+			cond: "fred",
+			o: makeConditions(
+				map[string]interface{}{"type": "Ready", "status": "True"},
+				map[string]interface{}{"type": "Fred", "status": "True", "reason": "Zorg"},
+			),
+			e: render.Fields{"True", "Zorg", ""},
+		},
+		"customMissing": {
+			cond: "Fred",
+			o:    makeConditions(map[string]interface{}{"type": "Ready", "status": "True"}),
+			e:    render.Fields{render.NAValue, render.NAValue, ""},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Conditions{Type: u.cond}.Fields(u.o))
+		})
+	}
+}
+
+// Helpers...
+
+func makeConditions(cc ...map[string]interface{}) map[string]interface{} {
+	ii := make([]interface{}, 0, len(cc))
+	for _, c := range cc {
+		ii = append(ii, c)
+	}
+
+	return map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": ii,
+		},
+	}
+}
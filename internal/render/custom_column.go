@@ -0,0 +1,162 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// CustomColumn represents a user defined column extracted from a resource
+// using a JSONPath expression.
+type CustomColumn struct {
+	Name string
+	Wide bool
+
+	path *jsonpath.JSONPath
+}
+
+// NewCustomColumn returns a new column given a JSONPath expression ie
+// `.spec.containers[0].image` or `{.metadata.annotations.fred}`.
+func NewCustomColumn(name, expr string, wide bool) (CustomColumn, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return CustomColumn{}, fmt.Errorf("custom column %q must be named", expr)
+	}
+	p := jsonpath.New(name).AllowMissingKeys(true)
+	if err := p.Parse(relaxedJSONPath(expr)); err != nil {
+		return CustomColumn{}, fmt.Errorf("invalid custom column %s expression %q: %w", name, expr, err)
+	}
+
+	return CustomColumn{Name: name, Wide: wide, path: p}, nil
+}
+
+// Value extracts the column value from a resource.
+func (c CustomColumn) Value(o map[string]interface{}) string {
+	if o == nil {
+		return NAValue
+	}
+	rr, err := c.path.FindResults(o)
+	if err != nil {
+		return NAValue
+	}
+	vv := make([]string, 0, len(rr))
+	for _, r := range rr {
+		for _, v := range r {
+			if !v.IsValid() || !v.CanInterface() || v.Interface() == nil {
+				continue
+			}
+			vv = append(vv, printValue(v.Interface()))
+		}
+	}
+	if len(vv) == 0 {
+		return NAValue
+	}
+
+	return strings.Join(vv, ",")
+}
+
+// CustomColumns represents a collection of user defined columns.
+type CustomColumns []CustomColumn
+
+// Header merges the custom columns into a renderer header. Custom columns
+// are laid out ahead of the age column if any.
+func (cc CustomColumns) Header(h Header) Header {
+	if len(cc) == 0 {
+		return h
+	}
+	idx := cc.index(h)
+	hh := make(Header, 0, len(h)+len(cc))
+	hh = append(hh, h[:idx]...)
+	for _, c := range cc {
+		hh = append(hh, HeaderColumn{Name: c.Name, Wide: c.Wide})
+	}
+
+	return append(hh, h[idx:]...)
+}
+
+// Render merges the custom columns values into a rendered row.
+func (cc CustomColumns) Render(h Header, o interface{}, r *Row) {
+	if len(cc) == 0 {
+		return
+	}
+	m := asMap(o)
+	idx := cc.index(h)
+	if idx > len(r.Fields) {
+		idx = len(r.Fields)
+	}
+	ff := make(Fields, 0, len(r.Fields)+len(cc))
+	ff = append(ff, r.Fields[:idx]...)
+	for _, c := range cc {
+		ff = append(ff, c.Value(m))
+	}
+	r.Fields = append(ff, r.Fields[idx:]...)
+}
+
+func (cc CustomColumns) index(h Header) int {
+	if idx := h.IndexOf(ageCol, true); idx >= 0 {
+		return idx
+	}
+
+	return len(h)
+}
+
+// Helpers...
+
+func relaxedJSONPath(expr string) string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "{") && strings.HasSuffix(expr, "}") {
+		return expr
+	}
+	if !strings.HasPrefix(expr, ".") {
+		expr = "." + expr
+	}
+
+	return "{" + expr + "}"
+}
+
+func printValue(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case map[string]interface{}, []interface{}:
+		var b bytes.Buffer
+		if err := json.NewEncoder(&b).Encode(t); err != nil {
+			return fmt.Sprintf("%v", t)
+		}
+		return strings.TrimSpace(b.String())
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+func asMap(o interface{}) map[string]interface{} {
+	switch t := o.(type) {
+	case *unstructured.Unstructured:
+		return t.Object
+	case *PodWithMetrics:
+		if t.Raw != nil {
+			return t.Raw.Object
+		}
+	case *NodeWithMetrics:
+		if t.Raw != nil {
+			return t.Raw.Object
+		}
+	case metav1beta1.TableRow:
+		var m map[string]interface{}
+		if len(t.Object.Raw) > 0 && json.Unmarshal(t.Object.Raw, &m) == nil {
+			return m
+		}
+	case runtime.Object:
+		if m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(t); err == nil {
+			return m
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomColumnValue(t *testing.T) {
+	uu := map[string]struct {
+		expr string
+		e    string
+	}{
+		"relaxed": {
+			expr: "spec.nodeName",
+			e:    "minikube",
+		},
+		"dotted": {
+			expr: ".spec.containers[0].image",
+			e:    "nginx:alpine",
+		},
+		"braces": {
+			expr: "{.metadata.namespace}",
+			e:    "default",
+		},
+		"multi": {
+			expr: ".spec.containers[*].name",
+			e:    "nginx",
+		},
+		"missing": {
+			expr: ".metadata.labels.fred",
+			e:    render.NAValue,
+		},
+	}
+
+	o := load(t, "po")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := render.NewCustomColumn("fred", u.expr, false)
+			assert.NoError(t, err)
+			assert.Equal(t, "FRED", c.Name)
+			assert.Equal(t, u.e, c.Value(o.Object))
+		})
+	}
+}
+
+func TestNewCustomColumnFails(t *testing.T) {
+	_, err := render.NewCustomColumn("", ".spec", false)
+	assert.Error(t, err)
+
+	_, err = render.NewCustomColumn("fred", ".spec[", false)
+	assert.Error(t, err)
+}
+
+func TestCustomColumnsMerge(t *testing.T) {
+	img, err := render.NewCustomColumn("image", ".spec.containers[0].image", false)
+	assert.NoError(t, err)
+	node, err := render.NewCustomColumn("node", ".spec.nodeName", true)
+	assert.NoError(t, err)
+	cc := render.CustomColumns{img, node}
+
+	h := render.Header{{Name: "NAME"}, {Name: "AGE", Time: true}}
+	assert.Equal(t, render.Header{
+		{Name: "NAME"},
+		{Name: "IMAGE"},
+		{Name: "NODE", Wide: true},
+		{Name: "AGE", Time: true},
+	}, cc.Header(h))
+
+	r := render.Row{ID: "default/nginx", Fields: render.Fields{"nginx", "1m"}}
+	cc.Render(h, load(t, "po"), &r)
+	assert.Equal(t, render.Fields{"nginx", "nginx:alpine", "minikube", "1m"}, r.Fields)
+}
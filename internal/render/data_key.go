@@ -0,0 +1,128 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// LargeValueSize tracks the value size above which loading is confirmed.
+	LargeValueSize = 1024 * 1024
+
+	// HiddenValue tracks a data key value that has not been revealed.
+	HiddenValue = "********"
+
+	// BinaryValue tracks a revealed data key value that is not printable.
+	BinaryValue = "<binary>"
+
+	maxValueWidth = 80
+)
+
+// DataKey renders a secret or configmap data keys to screen.
+type DataKey struct{}
+
+// ColorerFunc colors a resource row.
+func (DataKey) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if IsLargeDataKey(h, re.Row) {
+			return PendingColor
+		}
+
+		return DefaultColorer(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (DataKey) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "KEY"},
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "SIZE", Align: tview.AlignRight},
+		HeaderColumn{Name: "VALUE"},
+		HeaderColumn{Name: "BYTES", Align: tview.AlignRight, Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (DataKey) Render(o interface{}, ns string, r *Row) error {
+	k, ok := o.(DataKeyRes)
+	if !ok {
+		return fmt.Errorf("expected DataKeyRes, but got %T", o)
+	}
+
+	r.ID = k.Key
+	r.Fields = Fields{
+		k.Key,
+		k.Source,
+		toBytes(k.Size),
+		k.value(),
+		strconv.Itoa(k.Size),
+	}
+
+	return nil
+}
+
+// IsLargeDataKey checks if a data key row value exceeds the large value size.
+func IsLargeDataKey(h Header, r Row) bool {
+	idx := h.IndexOf("BYTES", true)
+	if idx < 0 || idx >= len(r.Fields) {
+		return false
+	}
+	n, err := strconv.Atoi(r.Fields[idx])
+
+	return err == nil && n > LargeValueSize
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toBytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := unit, 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// DataKeyRes represents a secret or configmap data key.
+type DataKeyRes struct {
+	Key      string
+	Source   string
+	Size     int
+	Value    []byte
+	Revealed bool
+}
+
+func (d DataKeyRes) value() string {
+	if !d.Revealed {
+		return HiddenValue
+	}
+	if !utf8.Valid(d.Value) {
+		return BinaryValue
+	}
+
+	return Truncate(strings.ReplaceAll(string(d.Value), "\n", "\\n"), maxValueWidth)
+}
+
+// GetObjectKind returns a schema object.
+func (DataKeyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a data key copy.
+func (d DataKeyRes) DeepCopyObject() runtime.Object {
+	return d
+}
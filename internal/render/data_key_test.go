@@ -0,0 +1,50 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataKeyRender(t *testing.T) {
+	uu := map[string]struct {
+		res   render.DataKeyRes
+		e     render.Fields
+		large bool
+	}{
+		"bytes": {
+			res: render.DataKeyRes{Key: "fred", Source: "data", Size: 10},
+			e:   render.Fields{"fred", "data", "10B", render.HiddenValue, "10"},
+		},
+		"kilo": {
+			res: render.DataKeyRes{Key: "fred", Source: "binaryData", Size: 1536},
+			e:   render.Fields{"fred", "binaryData", "1.5KiB", render.HiddenValue, "1536"},
+		},
+		"large": {
+			res:   render.DataKeyRes{Key: "fred", Source: "data", Size: 3 * 1024 * 1024},
+			e:     render.Fields{"fred", "data", "3.0MiB", render.HiddenValue, "3145728"},
+			large: true,
+		},
+		"revealed": {
+			res: render.DataKeyRes{Key: "fred", Source: "data", Size: 9, Value: []byte("blee\nzorg"), Revealed: true},
+			e:   render.Fields{"fred", "data", "9B", "blee\\nzorg", "9"},
+		},
+		"binary": {
+			res: render.DataKeyRes{Key: "fred", Source: "binaryData", Size: 2, Value: []byte{0xff, 0xfe}, Revealed: true},
+			e:   render.Fields{"fred", "binaryData", "2B", render.BinaryValue, "2"},
+		},
+	}
+
+	var d render.DataKey
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, d.Render(u.res, "", &r))
+			assert.Equal(t, "fred", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+			assert.Equal(t, u.large, render.IsLargeDataKey(d.Header(""), r))
+		})
+	}
+}
@@ -24,6 +24,21 @@ func NewDeltaRow(o, n Row, excludeLast bool) DeltaRow {
 	return deltas
 }
 
+// hasDeltas checks if a row changed without allocating a delta row.
+func hasDeltas(o, n Row, excludeLast bool) bool {
+	oldFields := o.Fields
+	if excludeLast && len(oldFields) > 0 {
+		oldFields = oldFields[:len(oldFields)-1]
+	}
+	for i, old := range oldFields {
+		if old != "" && old != n.Fields[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Labelize returns a new deltaRow based on labels.
 func (d DeltaRow) Labelize(cols []int, labelCol int) DeltaRow {
 	if len(d) == 0 {
@@ -0,0 +1,368 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// APIDeprecated tracks an API version deprecated on the target cluster.
+	APIDeprecated = "Deprecated"
+
+	// APIRemoved tracks an API version no longer served on the target cluster.
+	APIRemoved = "Removed"
+
+	apiBadgeCol    = "API"
+	lastAppliedKey = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// APILifecycle represents the deprecation schedule of a group version kinds.
+type APILifecycle struct {
+	GroupVersion string
+	Kinds        []string
+	Deprecated   string
+	Removed      string
+	Replacement  string
+}
+
+// APILifecycles tracks the built-in Kubernetes API deprecations schedule.
+var APILifecycles = []APILifecycle{
+	{GroupVersion: "extensions/v1beta1", Kinds: []string{"Deployment", "DaemonSet", "ReplicaSet"}, Deprecated: "1.9", Removed: "1.16", Replacement: "apps/v1"},
+	{GroupVersion: "extensions/v1beta1", Kinds: []string{"NetworkPolicy"}, Deprecated: "1.9", Removed: "1.16", Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "extensions/v1beta1", Kinds: []string{"PodSecurityPolicy"}, Deprecated: "1.10", Removed: "1.16", Replacement: "policy/v1beta1"},
+	{GroupVersion: "extensions/v1beta1", Kinds: []string{"Ingress"}, Deprecated: "1.14", Removed: "1.22", Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "apps/v1beta1", Kinds: []string{"Deployment", "StatefulSet"}, Deprecated: "1.9", Removed: "1.16", Replacement: "apps/v1"},
+	{GroupVersion: "apps/v1beta2", Kinds: []string{"Deployment", "StatefulSet", "DaemonSet", "ReplicaSet"}, Deprecated: "1.9", Removed: "1.16", Replacement: "apps/v1"},
+	{GroupVersion: "scheduling.k8s.io/v1beta1", Kinds: []string{"PriorityClass"}, Deprecated: "1.14", Removed: "1.22", Replacement: "scheduling.k8s.io/v1"},
+	{GroupVersion: "apiextensions.k8s.io/v1beta1", Kinds: []string{"CustomResourceDefinition"}, Deprecated: "1.16", Removed: "1.22", Replacement: "apiextensions.k8s.io/v1"},
+	{GroupVersion: "admissionregistration.k8s.io/v1beta1", Kinds: []string{"MutatingWebhookConfiguration", "ValidatingWebhookConfiguration"}, Deprecated: "1.16", Removed: "1.22", Replacement: "admissionregistration.k8s.io/v1"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1alpha1", Kinds: []string{"ClusterRole", "ClusterRoleBinding", "Role", "RoleBinding"}, Deprecated: "1.17", Removed: "1.22", Replacement: "rbac.authorization.k8s.io/v1"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Kinds: []string{"ClusterRole", "ClusterRoleBinding", "Role", "RoleBinding"}, Deprecated: "1.17", Removed: "1.22", Replacement: "rbac.authorization.k8s.io/v1"},
+	{GroupVersion: "networking.k8s.io/v1beta1", Kinds: []string{"Ingress", "IngressClass"}, Deprecated: "1.19", Removed: "1.22", Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "storage.k8s.io/v1beta1", Kinds: []string{"CSIDriver", "CSINode", "StorageClass", "VolumeAttachment"}, Deprecated: "1.19", Removed: "1.22", Replacement: "storage.k8s.io/v1"},
+	{GroupVersion: "certificates.k8s.io/v1beta1", Kinds: []string{"CertificateSigningRequest"}, Deprecated: "1.19", Removed: "1.22", Replacement: "certificates.k8s.io/v1"},
+	{GroupVersion: "coordination.k8s.io/v1beta1", Kinds: []string{"Lease"}, Deprecated: "1.19", Removed: "1.22", Replacement: "coordination.k8s.io/v1"},
+	{GroupVersion: "apiregistration.k8s.io/v1beta1", Kinds: []string{"APIService"}, Deprecated: "1.19", Removed: "1.22", Replacement: "apiregistration.k8s.io/v1"},
+	{GroupVersion: "events.k8s.io/v1beta1", Kinds: []string{"Event"}, Deprecated: "1.19", Removed: "1.25", Replacement: "events.k8s.io/v1"},
+	{GroupVersion: "node.k8s.io/v1beta1", Kinds: []string{"RuntimeClass"}, Deprecated: "1.20", Removed: "1.25", Replacement: "node.k8s.io/v1"},
+	{GroupVersion: "batch/v1beta1", Kinds: []string{"CronJob"}, Deprecated: "1.21", Removed: "1.25", Replacement: "batch/v1"},
+	{GroupVersion: "discovery.k8s.io/v1beta1", Kinds: []string{"EndpointSlice"}, Deprecated: "1.21", Removed: "1.25", Replacement: "discovery.k8s.io/v1"},
+	{GroupVersion: "policy/v1beta1", Kinds: []string{"PodDisruptionBudget"}, Deprecated: "1.21", Removed: "1.25", Replacement: "policy/v1"},
+	{GroupVersion: "policy/v1beta1", Kinds: []string{"PodSecurityPolicy"}, Deprecated: "1.21", Removed: "1.25"},
+	{GroupVersion: "autoscaling/v2beta1", Kinds: []string{"HorizontalPodAutoscaler"}, Deprecated: "1.22", Removed: "1.25", Replacement: "autoscaling/v2"},
+	{GroupVersion: "autoscaling/v2beta2", Kinds: []string{"HorizontalPodAutoscaler"}, Deprecated: "1.23", Removed: "1.26", Replacement: "autoscaling/v2"},
+	{GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", Kinds: []string{"FlowSchema", "PriorityLevelConfiguration"}, Deprecated: "1.23", Removed: "1.26", Replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{GroupVersion: "storage.k8s.io/v1beta1", Kinds: []string{"CSIStorageCapacity"}, Deprecated: "1.24", Removed: "1.27", Replacement: "storage.k8s.io/v1"},
+	{GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", Kinds: []string{"FlowSchema", "PriorityLevelConfiguration"}, Deprecated: "1.26", Removed: "1.29", Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// LookupAPILifecycle returns a given api version kind deprecation schedule.
+func LookupAPILifecycle(apiVersion, kind string) (APILifecycle, bool) {
+	for _, l := range APILifecycles {
+		if l.GroupVersion != apiVersion {
+			continue
+		}
+		for _, k := range l.Kinds {
+			if k == kind {
+				return l, true
+			}
+		}
+	}
+
+	return APILifecycle{}, false
+}
+
+// HasAPILifecycle checks if some api versions of a kind are scheduled for removal.
+func HasAPILifecycle(kind string) bool {
+	for _, l := range APILifecycles {
+		for _, k := range l.Kinds {
+			if k == kind {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Status returns the api version status on a given cluster version. An
+// unknown target version assumes the api version is deprecated.
+func (l APILifecycle) Status(target string) string {
+	if target == "" {
+		return APIDeprecated
+	}
+	switch {
+	case versionAtLeast(target, l.Removed):
+		return APIRemoved
+	case versionAtLeast(target, l.Deprecated):
+		return APIDeprecated
+	default:
+		return ""
+	}
+}
+
+// Badge returns a short lifecycle warning.
+func (l APILifecycle) Badge(status string) string {
+	if status == APIRemoved {
+		return "⚠ " + l.GroupVersion + " removed in " + l.Removed
+	}
+
+	return "⚠ " + l.GroupVersion + " deprecated, removed in " + l.Removed
+}
+
+// APIIssue returns the most pressing lifecycle issue among the api versions
+// a kind was written with.
+func APIIssue(kind string, vv []string, target string) (APILifecycle, string, bool) {
+	var (
+		issue  APILifecycle
+		status string
+	)
+	for _, v := range vv {
+		l, ok := LookupAPILifecycle(v, kind)
+		if !ok {
+			continue
+		}
+		s := l.Status(target)
+		if s == "" || status == APIRemoved {
+			continue
+		}
+		if status == "" || s == APIRemoved {
+			issue, status = l, s
+		}
+	}
+
+	return issue, status, status != ""
+}
+
+// ObjectAPIVersions returns the api versions a resource was written with per
+// its last applied configuration and managed fields.
+func ObjectAPIVersions(o metav1.Object) []string {
+	set := make(map[string]struct{})
+	if raw, ok := o.GetAnnotations()[lastAppliedKey]; ok {
+		var tm metav1.TypeMeta
+		if err := json.Unmarshal([]byte(raw), &tm); err == nil && tm.APIVersion != "" {
+			set[tm.APIVersion] = struct{}{}
+		}
+	}
+	for _, f := range o.GetManagedFields() {
+		if f.APIVersion != "" {
+			set[f.APIVersion] = struct{}{}
+		}
+	}
+	vv := make([]string, 0, len(set))
+	for v := range set {
+		vv = append(vv, v)
+	}
+	sort.Strings(vv)
+
+	return vv
+}
+
+// APICheck flags listed resources written with deprecated or removed api
+// versions.
+type APICheck struct {
+	Kind string
+
+	// Target tracks the cluster version.
+	Target string
+}
+
+// Badge returns a resource lifecycle warning if any.
+func (c APICheck) Badge(o interface{}) string {
+	m, ok := apiObject(o)
+	if !ok {
+		return ""
+	}
+	l, status, ok := APIIssue(c.Kind, ObjectAPIVersions(m), c.Target)
+	if !ok {
+		return ""
+	}
+
+	return l.Badge(status)
+}
+
+// Header inserts the api badge column.
+func (APICheck) Header(h Header) Header {
+	idx := apiBadgeIndex(h, len(h))
+	hh := make(Header, 0, len(h)+1)
+	hh = append(hh, h[:idx]...)
+	hh = append(hh, HeaderColumn{Name: apiBadgeCol})
+
+	return append(hh, h[idx:]...)
+}
+
+// Render merges an api badge into a rendered row.
+func (APICheck) Render(h Header, badge string, r *Row) {
+	idx := apiBadgeIndex(h, len(r.Fields))
+	ff := make(Fields, 0, len(r.Fields)+1)
+	ff = append(ff, r.Fields[:idx]...)
+	ff = append(ff, badge)
+	r.Fields = append(ff, r.Fields[idx:]...)
+}
+
+func apiBadgeIndex(h Header, max int) int {
+	idx := h.IndexOf(ageCol, true)
+	if idx < 0 || idx > max {
+		return max
+	}
+
+	return idx
+}
+
+// Deprecation renders resources using deprecated api versions to screen.
+type Deprecation struct{}
+
+// ColorerFunc colors a resource row.
+func (Deprecation) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] == APIRemoved {
+			return ErrColor
+		}
+
+		return PendingColor
+	}
+}
+
+// Header returns a header row.
+func (Deprecation) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "API-VERSION"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "DEPRECATED-IN"},
+		HeaderColumn{Name: "REMOVED-IN"},
+		HeaderColumn{Name: "REPLACEMENT"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Deprecation) Render(o interface{}, _ string, r *Row) error {
+	d, ok := o.(APIDeprecation)
+	if !ok {
+		return fmt.Errorf("expecting APIDeprecation but got %T", o)
+	}
+
+	r.ID = d.ID()
+	r.Fields = Fields{
+		d.Namespace,
+		d.Name,
+		d.GVR,
+		d.Kind,
+		d.Lifecycle.GroupVersion,
+		d.Status,
+		d.Lifecycle.Deprecated,
+		d.Lifecycle.Removed,
+		d.Lifecycle.Replacement,
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// APIDeprecation represents a resource written with a deprecated api version.
+type APIDeprecation struct {
+	GVR, Namespace, Name, Kind string
+
+	Lifecycle APILifecycle
+	Status    string
+}
+
+// ID returns the deprecation identifier.
+func (d APIDeprecation) ID() string {
+	return d.GVR + ":" + client.FQN(d.Namespace, d.Name)
+}
+
+// GetObjectKind returns a schema object.
+func (APIDeprecation) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d APIDeprecation) DeepCopyObject() runtime.Object {
+	return d
+}
+
+// apiMeta tracks the metadata needed to sort out api versions.
+type apiMeta struct {
+	metav1.ObjectMeta `json:"metadata"`
+}
+
+func apiObject(o interface{}) (metav1.Object, bool) {
+	switch t := o.(type) {
+	case *PodWithMetrics:
+		if t.Raw != nil {
+			return t.Raw, true
+		}
+	case *NodeWithMetrics:
+		if t.Raw != nil {
+			return t.Raw, true
+		}
+	case metav1beta1.TableRow:
+		var m apiMeta
+		if len(t.Object.Raw) > 0 && json.Unmarshal(t.Object.Raw, &m) == nil {
+			return &m.ObjectMeta, true
+		}
+	case runtime.Object:
+		if m, err := meta.Accessor(t); err == nil {
+			return m, true
+		}
+	}
+
+	return nil, false
+}
+
+// versionAtLeast checks if a version ie v1.22.3-gke.1 is at least a given
+// major.minor version.
+func versionAtLeast(v, min string) bool {
+	maj, mi, ok := majorMinor(v)
+	if !ok {
+		return false
+	}
+	minMaj, minMi, ok := majorMinor(min)
+	if !ok {
+		return false
+	}
+	if maj != minMaj {
+		return maj > minMaj
+	}
+
+	return mi >= minMi
+}
+
+func majorMinor(v string) (int, int, bool) {
+	tokens := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".", 3)
+	if len(tokens) < 2 {
+		return 0, 0, false
+	}
+	maj, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	mi, err := strconv.Atoi(strings.TrimRightFunc(tokens[1], func(r rune) bool {
+		return r < '0' || r > '9'
+	}))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return maj, mi, true
+}
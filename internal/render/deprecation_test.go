@@ -0,0 +1,146 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAPILifecycleStatus(t *testing.T) {
+	l, ok := render.LookupAPILifecycle("networking.k8s.io/v1beta1", "Ingress")
+	assert.True(t, ok)
+
+	uu := map[string]struct {
+		target, e string
+	}{
+		"unknown":    {e: render.APIDeprecated},
+		"before":     {target: "v1.18.9", e: ""},
+		"deprecated": {target: "v1.19.3-gke.100", e: render.APIDeprecated},
+		"removed":    {target: "1.22", e: render.APIRemoved},
+		"plus":       {target: "v1.23+", e: render.APIRemoved},
+		"major":      {target: "v2.0.0", e: render.APIRemoved},
+		"bozo":       {target: "fred", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, l.Status(u.target))
+		})
+	}
+}
+
+func TestLookupAPILifecycle(t *testing.T) {
+	_, ok := render.LookupAPILifecycle("networking.k8s.io/v1", "Ingress")
+	assert.False(t, ok)
+	_, ok = render.LookupAPILifecycle("extensions/v1beta1", "Blee")
+	assert.False(t, ok)
+	assert.True(t, render.HasAPILifecycle("CronJob"))
+	assert.False(t, render.HasAPILifecycle("Pod"))
+}
+
+func TestAPIIssue(t *testing.T) {
+	uu := map[string]struct {
+		kind, target string
+		vv           []string
+		gv, status   string
+	}{
+		"none": {
+			kind: "Ingress", target: "v1.20.0",
+			vv: []string{"networking.k8s.io/v1"},
+		},
+		"deprecated": {
+			kind: "Ingress", target: "v1.20.0",
+			vv: []string{"networking.k8s.io/v1", "networking.k8s.io/v1beta1"},
+			gv: "networking.k8s.io/v1beta1", status: render.APIDeprecated,
+		},
+		"first": {
+			kind: "Ingress", target: "v1.20.0",
+			vv: []string{"extensions/v1beta1", "networking.k8s.io/v1beta1"},
+			gv: "extensions/v1beta1", status: render.APIDeprecated,
+		},
+		"removedWins": {
+			kind: "HorizontalPodAutoscaler", target: "v1.25.0",
+			vv: []string{"autoscaling/v2beta2", "autoscaling/v2beta1"},
+			gv: "autoscaling/v2beta1", status: render.APIRemoved,
+		},
+		"future": {
+			kind: "HorizontalPodAutoscaler", target: "v1.19.0",
+			vv: []string{"autoscaling/v2beta2"},
+		},
+		"upgrade": {
+			kind: "CronJob", target: "1.25",
+			vv: []string{"batch/v1beta1"},
+			gv: "batch/v1beta1", status: render.APIRemoved,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, status, ok := render.APIIssue(u.kind, u.vv, u.target)
+			assert.Equal(t, u.status != "", ok)
+			assert.Equal(t, u.status, status)
+			assert.Equal(t, u.gv, l.GroupVersion)
+		})
+	}
+}
+
+func TestObjectAPIVersions(t *testing.T) {
+	o := metav1.ObjectMeta{
+		Annotations: map[string]string{
+			"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"extensions/v1beta1","kind":"Ingress"}`,
+		},
+		ManagedFields: []metav1.ManagedFieldsEntry{
+			{Manager: "kubectl", APIVersion: "extensions/v1beta1"},
+			{Manager: "nginx", APIVersion: "networking.k8s.io/v1"},
+		},
+	}
+
+	assert.Equal(t, []string{"extensions/v1beta1", "networking.k8s.io/v1"}, render.ObjectAPIVersions(&o))
+	assert.Equal(t, []string{}, render.ObjectAPIVersions(&metav1.ObjectMeta{}))
+}
+
+func TestAPICheck(t *testing.T) {
+	var o unstructured.Unstructured
+	o.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "batch/v1beta1"}})
+	c := render.APICheck{Kind: "CronJob", Target: "v1.25.1"}
+
+	assert.Equal(t, "⚠ batch/v1beta1 removed in 1.25", c.Badge(&o))
+	assert.Equal(t, "", c.Badge(&unstructured.Unstructured{}))
+	assert.Equal(t, "⚠ batch/v1beta1 deprecated, removed in 1.25", render.APICheck{Kind: "CronJob"}.Badge(&o))
+
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "AGE", Time: true},
+	}
+	r := render.Row{Fields: render.Fields{"fred", "2m"}}
+	c.Render(h, "⚠", &r)
+	assert.Equal(t, render.Fields{"fred", "⚠", "2m"}, r.Fields)
+	assert.Equal(t, render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "API"},
+		render.HeaderColumn{Name: "AGE", Time: true},
+	}, c.Header(h))
+}
+
+func TestDeprecationRender(t *testing.T) {
+	l, _ := render.LookupAPILifecycle("batch/v1beta1", "CronJob")
+	d := render.APIDeprecation{
+		GVR:       "batch/v1/cronjobs",
+		Namespace: "default",
+		Name:      "fred",
+		Kind:      "CronJob",
+		Lifecycle: l,
+		Status:    render.APIRemoved,
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Deprecation{}.Render(d, "", &r))
+	assert.Equal(t, "batch/v1/cronjobs:default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "batch/v1/cronjobs", "CronJob", "batch/v1beta1", "Removed", "1.21", "1.25", "batch/v1", ""}, r.Fields)
+	assert.Error(t, render.Deprecation{}.Render("blee", "", &r))
+}
@@ -0,0 +1,149 @@
+package render
+
+import (
+	"regexp"
+	"strings"
+)
+
+type indexEntry struct {
+	fields Fields
+	text   string
+}
+
+// FilterIndex tracks lower cased rows content so that filtering large tables
+// does not need to rescan and re-lower every cell on each keystroke.
+type FilterIndex struct {
+	entries map[string]indexEntry
+	query   string
+	matches map[string]struct{}
+}
+
+// NewFilterIndex returns a new filter index.
+func NewFilterIndex() *FilterIndex {
+	return &FilterIndex{
+		entries: make(map[string]indexEntry),
+	}
+}
+
+// Len returns the number of indexed rows.
+func (f *FilterIndex) Len() int {
+	return len(f.entries)
+}
+
+// Update syncs the index with the given rows. Only new or changed rows are
+// re-indexed.
+func (f *FilterIndex) Update(rr RowEvents) {
+	var changed bool
+	for _, re := range rr {
+		e, ok := f.entries[re.Row.ID]
+		if ok && sameFields(e.fields, re.Row.Fields) {
+			continue
+		}
+		changed = true
+		f.entries[re.Row.ID] = indexEntry{
+			fields: append(Fields(nil), re.Row.Fields...),
+			text:   strings.ToLower(strings.Join(re.Row.Fields, " ")),
+		}
+	}
+	if len(f.entries) > len(rr) {
+		changed = true
+		kk := make(map[string]struct{}, len(rr))
+		for _, re := range rr {
+			kk[re.Row.ID] = struct{}{}
+		}
+		for id := range f.entries {
+			if _, ok := kk[id]; !ok {
+				delete(f.entries, id)
+			}
+		}
+	}
+	if changed {
+		f.query, f.matches = "", nil
+	}
+}
+
+// Filter returns the rows matching a regular expression. Inverse queries are
+// expected to be prefixed with a bang.
+func (f *FilterIndex) Filter(q string, inverse bool, data TableData) (TableData, error) {
+	if inverse {
+		q = q[1:]
+	}
+	f.Update(data.RowEvents)
+
+	match, err := f.matcher(q)
+	if err != nil {
+		return data, err
+	}
+
+	lq := strings.ToLower(q)
+	var prev map[string]struct{}
+	if !inverse && f.query != "" && strings.HasPrefix(lq, f.query) && isLiteral(q) {
+		prev = f.matches
+	}
+	var matches map[string]struct{}
+	if !inverse && isLiteral(q) {
+		matches = make(map[string]struct{}, len(data.RowEvents))
+	}
+
+	filtered := TableData{
+		Header:    data.Header,
+		RowEvents: make(RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	for _, re := range data.RowEvents {
+		if prev != nil {
+			if _, ok := prev[re.Row.ID]; !ok {
+				continue
+			}
+		}
+		if match(f.entries[re.Row.ID].text) == inverse {
+			continue
+		}
+		filtered.RowEvents = append(filtered.RowEvents, re)
+		if matches != nil {
+			matches[re.Row.ID] = struct{}{}
+		}
+	}
+	if matches != nil {
+		f.query, f.matches = lq, matches
+	} else {
+		f.query, f.matches = "", nil
+	}
+
+	return filtered, nil
+}
+
+func (f *FilterIndex) matcher(q string) (func(string) bool, error) {
+	if isLiteral(q) {
+		lq := strings.ToLower(q)
+		return func(s string) bool {
+			return strings.Contains(s, lq)
+		}, nil
+	}
+
+	rx, err := regexp.Compile(`(?i)(` + q + `)`)
+	if err != nil {
+		return nil, err
+	}
+
+	return rx.MatchString, nil
+}
+
+// Helpers...
+
+func isLiteral(q string) bool {
+	return regexp.QuoteMeta(q) == q
+}
+
+func sameFields(a, b Fields) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+package render_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterIndexFilter(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"Fred", "Running"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"Blee", "Pending"}}},
+			{Row: render.Row{ID: "c", Fields: render.Fields{"Freddy", "Pending"}}},
+		},
+	}
+
+	uu := map[string]struct {
+		q       string
+		inverse bool
+		e       []string
+		err     bool
+	}{
+		"literal": {
+			q: "fred",
+			e: []string{"a", "c"},
+		},
+		"caseInsensitive": {
+			q: "PENDING",
+			e: []string{"b", "c"},
+		},
+		"regex": {
+			q: "^blee|y ",
+			e: []string{"b", "c"},
+		},
+		"inverse": {
+			q:       "!fred",
+			inverse: true,
+			e:       []string{"b"},
+		},
+		"none": {
+			q: "zorg",
+			e: []string{},
+		},
+		"bad": {
+			q:   "(fred",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := render.NewFilterIndex()
+			res, err := f.Filter(u.q, u.inverse, data)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ids(res.RowEvents))
+		})
+	}
+}
+
+func TestFilterIndexNarrow(t *testing.T) {
+	data := render.TableData{
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"fred"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"freddy"}}},
+		},
+	}
+
+	f := render.NewFilterIndex()
+	res, err := f.Filter("fre", false, data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids(res.RowEvents))
+	assert.Equal(t, 2, f.Len())
+
+	res, err = f.Filter("fredd", false, data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b"}, ids(res.RowEvents))
+
+	data.RowEvents[0].Row.Fields = render.Fields{"freddo"}
+	res, err = f.Filter("fredd", false, data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids(res.RowEvents))
+
+	data.RowEvents = data.RowEvents[1:]
+	res, err = f.Filter("fre", false, data)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b"}, ids(res.RowEvents))
+	assert.Equal(t, 1, f.Len())
+}
+
+func BenchmarkFilterIndex(b *testing.B) {
+	data := render.TableData{RowEvents: make(render.RowEvents, 0, 10_000)}
+	for i := 0; i < 10_000; i++ {
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Row: render.Row{
+				ID:     fmt.Sprintf("ns1/p%d", i),
+				Fields: render.Fields{"ns1", fmt.Sprintf("Pod-%d", i), "Running", "1/1", "2m"},
+			},
+		})
+	}
+	f := render.NewFilterIndex()
+	f.Update(data.RowEvents)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = f.Filter("pod-99", false, data)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 )
 
@@ -14,9 +15,16 @@ const ageTableCol = "Age"
 
 // Generic renders a generic resource to screen.
 type Generic struct {
-	table *metav1beta1.Table
+	// ServerTable flags builtin resources rendered via the server side Table
+	// api. Numeric columns are then right aligned and low priority columns
+	// only shown in wide mode.
+	ServerTable bool
 
-	ageIndex int
+	table      *metav1beta1.Table
+	conditions Conditions
+
+	ageIndex       int
+	withConditions bool
 }
 
 // Happy returns true if resource is happy, false otherwise
@@ -27,6 +35,34 @@ func (Generic) Happy(ns string, r Row) bool {
 // SetTable sets the tabular resource.
 func (g *Generic) SetTable(t *metav1beta1.Table) {
 	g.table = t
+	g.withConditions = g.showConditions()
+}
+
+// SetConditionType sets the status condition type surfaced in the READY
+// and STATUS columns.
+func (g *Generic) SetConditionType(t string) {
+	g.conditions = Conditions{Type: t}
+}
+
+// showConditions checks if the conditions columns apply. Resources already
+// printing a READY or STATUS column or reporting no conditions are skipped.
+func (g *Generic) showConditions() bool {
+	if g.table == nil || !g.conditions.Enabled() {
+		return false
+	}
+	for _, c := range g.table.ColumnDefinitions {
+		if n := strings.ToUpper(c.Name); n == "READY" || n == "STATUS" {
+			return false
+		}
+	}
+	for _, r := range g.table.Rows {
+		var o map[string]interface{}
+		if len(r.Object.Raw) > 0 && json.Unmarshal(r.Object.Raw, &o) == nil && HasConditions(o) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ColorerFunc colors a resource row.
@@ -46,7 +82,14 @@ func (g *Generic) Header(ns string) Header {
 			g.ageIndex = i
 			continue
 		}
-		h = append(h, HeaderColumn{Name: strings.ToUpper(c.Name)})
+		col := HeaderColumn{Name: strings.ToUpper(c.Name)}
+		if g.ServerTable {
+			col.Align, col.Wide = columnAlign(c.Type), c.Priority > 0
+		}
+		h = append(h, col)
+	}
+	if g.withConditions {
+		h = append(h, g.conditions.Header()...)
 	}
 	if g.ageIndex > 0 {
 		h = append(h, HeaderColumn{Name: "AGE", Time: true})
@@ -85,6 +128,13 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 		}
 		r.Fields = append(r.Fields, fmt.Sprintf("%v", c))
 	}
+	if g.withConditions {
+		var o map[string]interface{}
+		if err := json.Unmarshal(row.Object.Raw, &o); err != nil {
+			return err
+		}
+		r.Fields = append(r.Fields, g.conditions.Fields(o)...)
+	}
 	if ageCell != nil {
 		r.Fields = append(r.Fields, fmt.Sprintf("%v", ageCell))
 	}
@@ -95,6 +145,15 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func columnAlign(colType string) int {
+	switch colType {
+	case "integer", "number":
+		return tview.AlignRight
+	default:
+		return tview.AlignLeft
+	}
+}
+
 func resourceNS(raw []byte) (string, error) {
 	var obj map[string]interface{}
 	err := json.Unmarshal(raw, &obj)
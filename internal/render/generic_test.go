@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -17,6 +18,7 @@ func TestGenericRender(t *testing.T) {
 		eID     string
 		eFields render.Fields
 		eHeader render.Header
+		server  bool
 	}{
 		"withNS": {
 			ns:      "ns1",
@@ -78,6 +80,31 @@ func TestGenericRender(t *testing.T) {
 				render.HeaderColumn{Name: "AGE", Time: true},
 			},
 		},
+		"crd": {
+			ns:      "ns1",
+			table:   makeWideGeneric(),
+			eID:     "ns1/c1",
+			eFields: render.Fields{"ns1", "c1", "2", "c3"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "B"},
+				render.HeaderColumn{Name: "C"},
+			},
+		},
+		"serverTable": {
+			ns:      "ns1",
+			table:   makeWideGeneric(),
+			server:  true,
+			eID:     "ns1/c1",
+			eFields: render.Fields{"ns1", "c1", "2", "c3"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "B", Align: tview.AlignRight},
+				render.HeaderColumn{Name: "C", Wide: true},
+			},
+		},
 	}
 
 	for k := range uu {
@@ -85,6 +112,7 @@ func TestGenericRender(t *testing.T) {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
 			var r render.Row
+			re.ServerTable = u.server
 			re.SetTable(u.table)
 
 			assert.Equal(t, u.eHeader, re.Header(u.ns))
@@ -95,6 +123,78 @@ func TestGenericRender(t *testing.T) {
 	}
 }
 
+func TestGenericConditions(t *testing.T) {
+	uu := map[string]struct {
+		table   *metav1beta1.Table
+		cond    string
+		eFields render.Fields
+		eHeader render.Header
+	}{
+		"conditions": {
+			table:   makeConditionsGeneric("a", "b"),
+			eFields: render.Fields{"ns1", "c1", "c2", "False", "NotIssued", "Ready is False: blee", "Age"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "B"},
+				render.HeaderColumn{Name: "READY"},
+				render.HeaderColumn{Name: "STATUS"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
+				render.HeaderColumn{Name: "AGE", Time: true},
+			},
+		},
+		"custom": {
+			table:   makeConditionsGeneric("a", "b"),
+			cond:    "Issuing",
+			eFields: render.Fields{"ns1", "c1", "c2", "True", "Issuing", "", "Age"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "B"},
+				render.HeaderColumn{Name: "READY"},
+				render.HeaderColumn{Name: "STATUS"},
+				render.HeaderColumn{Name: "VALID", Wide: true},
+				render.HeaderColumn{Name: "AGE", Time: true},
+			},
+		},
+		"disabled": {
+			table:   makeConditionsGeneric("a", "b"),
+			cond:    render.NoConditions,
+			eFields: render.Fields{"ns1", "c1", "c2", "Age"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "B"},
+				render.HeaderColumn{Name: "AGE", Time: true},
+			},
+		},
+		"printed": {
+			table:   makeConditionsGeneric("a", "Ready"),
+			eFields: render.Fields{"ns1", "c1", "c2", "Age"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAMESPACE"},
+				render.HeaderColumn{Name: "A"},
+				render.HeaderColumn{Name: "READY"},
+				render.HeaderColumn{Name: "AGE", Time: true},
+			},
+		},
+	}
+
+	for k := range uu {
+		var re render.Generic
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			re.SetConditionType(u.cond)
+			re.SetTable(u.table)
+
+			assert.Equal(t, u.eHeader, re.Header("ns1"))
+			assert.Nil(t, re.Render(u.table.Rows[0], "ns1", &r))
+			assert.Equal(t, u.eFields, r.Fields)
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -179,3 +279,66 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makeWideGeneric() *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "a", Type: "string"},
+			{Name: "b", Type: "integer"},
+			{Name: "c", Type: "string", Priority: 1},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+        "kind": "fred",
+        "apiVersion": "v1",
+        "metadata": {
+          "namespace": "ns1",
+          "name": "fred"
+        }}`),
+				},
+				Cells: []interface{}{
+					"c1",
+					2,
+					"c3",
+				},
+			},
+		},
+	}
+}
+
+func makeConditionsGeneric(cols ...string) *metav1beta1.Table {
+	t := metav1beta1.Table{
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+        "kind": "Certificate",
+        "apiVersion": "cert-manager.io/v1",
+        "metadata": {
+          "namespace": "ns1",
+          "name": "fred"
+        },
+        "status": {
+          "conditions": [
+            {"type": "Issuing", "status": "True"},
+            {"type": "Ready", "status": "False", "reason": "NotIssued", "message": "blee"}
+          ]
+        }}`),
+				},
+				Cells: []interface{}{
+					"c1",
+					"c2",
+					"Age",
+				},
+			},
+		},
+	}
+	for _, c := range cols {
+		t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: c})
+	}
+	t.ColumnDefinitions = append(t.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: "Age"})
+
+	return &t
+}
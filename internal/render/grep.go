@@ -0,0 +1,77 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Grep renders resources matching a cluster wide search.
+type Grep struct{}
+
+// ColorerFunc colors a resource row.
+func (Grep) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Grep) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "MATCHES"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Grep) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(GrepRes)
+	if !ok {
+		return fmt.Errorf("expecting GrepRes but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Namespace,
+		res.Name,
+		res.GVR,
+		strings.Join(res.Matches, ","),
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// GrepRes represents a resource matching a search pattern.
+type GrepRes struct {
+	GVR, Namespace, Name string
+
+	// Matches lists the matching name, labels or annotations.
+	Matches []string
+}
+
+// ID returns the match identifier.
+func (g GrepRes) ID() string {
+	return g.GVR + ":" + client.FQN(g.Namespace, g.Name)
+}
+
+// GetObjectKind returns a schema object.
+func (GrepRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (g GrepRes) DeepCopyObject() runtime.Object {
+	return g
+}
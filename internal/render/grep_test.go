@@ -0,0 +1,30 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrepRender(t *testing.T) {
+	var g render.Grep
+
+	var r render.Row
+	o := render.GrepRes{
+		GVR:       "apps/v1/deployments",
+		Namespace: "default",
+		Name:      "fred",
+		Matches:   []string{"name", "label:app=fred"},
+	}
+
+	assert.Nil(t, g.Render(o, "", &r))
+	assert.Equal(t, "apps/v1/deployments:default/fred", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"fred",
+		"apps/v1/deployments",
+		"name,label:app=fred",
+		"",
+	}, r.Fields)
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"helm.sh/helm/v3/pkg/release"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,6 +63,66 @@ func (c Helm) Render(o interface{}, ns string, r *Row) error {
 	return nil
 }
 
+// HelmHistory renders a helm release revisions to screen.
+type HelmHistory struct{}
+
+// ColorerFunc colors a resource row.
+func (HelmHistory) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		if statusCol := h.IndexOf("STATUS", true); statusCol != -1 && re.Row.Fields[statusCol] == release.StatusDeployed.String() {
+			return tcell.ColorMediumSpringGreen
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (HelmHistory) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "CHART"},
+		HeaderColumn{Name: "APP VERSION"},
+		HeaderColumn{Name: "DESCRIPTION"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a release revision to screen.
+func (c HelmHistory) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HelmRes)
+	if !ok {
+		return fmt.Errorf("expected HelmRes, but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(h.Release.Version)
+	r.Fields = Fields{
+		strconv.Itoa(h.Release.Version),
+		h.Release.Info.Status.String(),
+		h.Release.Chart.Metadata.Name + "-" + h.Release.Chart.Metadata.Version,
+		h.Release.Chart.Metadata.AppVersion,
+		h.Release.Info.Description,
+		asStatus(c.diagnose(h.Release.Info.Status)),
+		toAge(metav1.Time{Time: h.Release.Info.LastDeployed.Time}),
+	}
+
+	return nil
+}
+
+func (HelmHistory) diagnose(s release.Status) error {
+	switch s {
+	case release.StatusDeployed, release.StatusSuperseded, release.StatusUninstalled:
+		return nil
+	default:
+		return fmt.Errorf("revision is in an invalid state")
+	}
+}
+
 func (c Helm) diagnose(s string) error {
 	if s != "deployed" {
 		return fmt.Errorf("chart is in an invalid state")
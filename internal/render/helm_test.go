@@ -0,0 +1,46 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+)
+
+func TestHelmHistoryRender(t *testing.T) {
+	uu := map[string]struct {
+		status release.Status
+		valid  string
+	}{
+		"deployed":   {status: release.StatusDeployed},
+		"superseded": {status: release.StatusSuperseded},
+		"failed":     {status: release.StatusFailed, valid: "revision is in an invalid state"},
+	}
+
+	var h render.HelmHistory
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			o := render.HelmRes{Release: &release.Release{
+				Name:      "fred",
+				Namespace: "blee",
+				Version:   3,
+				Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "zorg", Version: "1.2.0", AppVersion: "2.0"}},
+				Info: &release.Info{
+					Status:       u.status,
+					Description:  "Upgrade complete",
+					LastDeployed: helmtime.Time{Time: time.Now()},
+				},
+			}}
+
+			assert.Nil(t, h.Render(o, "", &r))
+			assert.Equal(t, "3", r.ID)
+			assert.Equal(t, render.Fields{"3", u.status.String(), "zorg-1.2.0", "2.0", "Upgrade complete", u.valid}, r.Fields[:6])
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -194,28 +195,55 @@ func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
 }
 
-func mapToStr(m map[string]string) (s string) {
+// keysPool recycles map keys buffers used while stringifying maps.
+var keysPool = sync.Pool{
+	New: func() interface{} {
+		kk := make([]string, 0, 10)
+		return &kk
+	},
+}
+
+func getKeys(n int) *[]string {
+	kk := keysPool.Get().(*[]string)
+	if cap(*kk) < n {
+		*kk = make([]string, 0, n)
+	}
+	*kk = (*kk)[:0]
+
+	return kk
+}
+
+func mapToStr(m map[string]string) string {
 	if len(m) == 0 {
 		return ""
 	}
 
-	kk := make([]string, 0, len(m))
+	kk := getKeys(len(m))
+	defer keysPool.Put(kk)
 	for k := range m {
-		kk = append(kk, k)
+		*kk = append(*kk, k)
 	}
-	sort.Strings(kk)
+	sort.Strings(*kk)
 
-	for i, k := range kk {
-		s += k + "=" + m[k]
-		if i < len(kk)-1 {
-			s += " "
+	size := len(m) - 1
+	for k, v := range m {
+		size += len(k) + len(v) + 1
+	}
+	var b strings.Builder
+	b.Grow(size)
+	for i, k := range *kk {
+		if i > 0 {
+			b.WriteByte(' ')
 		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(m[k])
 	}
 
-	return
+	return b.String()
 }
 
-func mapToIfc(m interface{}) (s string) {
+func mapToIfc(m interface{}) string {
 	if m == nil {
 		return ""
 	}
@@ -228,24 +256,28 @@ func mapToIfc(m interface{}) (s string) {
 		return ""
 	}
 
-	kk := make([]string, 0, len(mm))
+	kk := getKeys(len(mm))
+	defer keysPool.Put(kk)
 	for k := range mm {
-		kk = append(kk, k)
+		*kk = append(*kk, k)
 	}
-	sort.Strings(kk)
+	sort.Strings(*kk)
 
-	for i, k := range kk {
+	var b strings.Builder
+	for i, k := range *kk {
 		str, ok := mm[k].(string)
 		if !ok {
 			continue
 		}
-		s += k + "=" + str
-		if i < len(kk)-1 {
-			s += " "
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(str)
+		if i < len(*kk)-1 {
+			b.WriteByte(' ')
 		}
 	}
 
-	return
+	return b.String()
 }
 
 func toMcPerc(v1, v2 int64) string {
@@ -256,6 +288,15 @@ func toMiPerc(v1, v2 int64) string {
 	return toMi(v1) + " (" + strconv.Itoa(client.ToPercentage(v1, v2)) + "%)"
 }
 
+// toStale returns how long metrics have aged out of the cache if any.
+func toStale(age time.Duration) string {
+	if age <= 0 {
+		return ""
+	}
+
+	return duration.HumanDuration(age)
+}
+
 func toMc(v int64) string {
 	if v == 0 {
 		return ZeroValue
@@ -361,6 +361,20 @@ func BenchmarkMapToStr(b *testing.B) {
 	}
 }
 
+func BenchmarkMapToIfc(b *testing.B) {
+	ll := map[string]interface{}{
+		"blee": "duh",
+		"aa":   "bb",
+		"zz":   "yy",
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		mapToIfc(ll)
+	}
+}
+
 func TestToMc(t *testing.T) {
 	uu := []struct {
 		v int64
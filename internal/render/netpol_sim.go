@@ -0,0 +1,96 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Network policy verdicts.
+const (
+	AllowVerdict = "allow"
+	DenyVerdict  = "deny"
+)
+
+// NetPolSim renders the network traffic allowed in and out of a pod.
+type NetPolSim struct{}
+
+// ColorerFunc colors a resource row.
+func (NetPolSim) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("VERDICT", true)
+		if idx == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) == DenyVerdict {
+			return ErrColor
+		}
+
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (NetPolSim) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "DIRECTION"},
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "PEER"},
+		HeaderColumn{Name: "PORTS"},
+		HeaderColumn{Name: "VERDICT"},
+		HeaderColumn{Name: "REASON", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NetPolSim) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(NetPolRuleRes)
+	if !ok {
+		return fmt.Errorf("expecting NetPolRuleRes but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Direction,
+		res.Policy,
+		res.Peer,
+		res.Ports,
+		res.Verdict,
+		res.Reason,
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NetPolRuleRes represents a network policy rule applying to a pod.
+type NetPolRuleRes struct {
+	Direction, Policy, Peer, Ports string
+	Verdict, Reason                string
+
+	// Index differentiates rules within a policy.
+	Index int
+}
+
+// ID returns the rule identifier.
+func (n NetPolRuleRes) ID() string {
+	return strings.Join([]string{n.Direction, n.Policy, strconv.Itoa(n.Index), n.Peer, n.Ports}, ":")
+}
+
+// GetObjectKind returns a schema object.
+func (NetPolRuleRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NetPolRuleRes) DeepCopyObject() runtime.Object {
+	return n
+}
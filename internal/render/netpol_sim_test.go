@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetPolSimRender(t *testing.T) {
+	var n render.NetPolSim
+
+	var r render.Row
+	o := render.NetPolRuleRes{
+		Direction: "Ingress",
+		Policy:    "default/fred",
+		Peer:      "pods app=blee",
+		Ports:     "TCP:80",
+		Verdict:   render.AllowVerdict,
+		Reason:    "rule #1",
+		Index:     1,
+	}
+
+	assert.Nil(t, n.Render(o, "", &r))
+	assert.Equal(t, "Ingress:default/fred:1:pods app=blee:TCP:80", r.ID)
+	assert.Equal(t, render.Fields{
+		"Ingress",
+		"default/fred",
+		"pods app=blee",
+		"TCP:80",
+		"allow",
+		"rule #1",
+		"",
+	}, r.Fields)
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
@@ -51,7 +52,9 @@ func (Node) Header(_ string) Header {
 		HeaderColumn{Name: "MEM/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "CPU/A", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "MEM/A", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "STALE", MX: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "TAINTS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
 	}
@@ -115,7 +118,9 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		toMiPerc(tlm.Value(), a.mem),
 		toMc(a.cpu),
 		toMi(a.mem),
+		toStale(oo.staleFor()),
 		mapToStr(no.Labels),
+		taintsToStr(no.Spec.Taints),
 		asStatus(n.diagnose(statuses)),
 		toAge(no.ObjectMeta.CreationTimestamp),
 	}
@@ -123,6 +128,20 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	return nil
 }
 
+// taintsToStr returns a node taints as key[=value]:effect.
+func taintsToStr(tt []v1.Taint) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		s := t.Key
+		if t.Value != "" {
+			s += "=" + t.Value
+		}
+		ss = append(ss, s+":"+string(t.Effect))
+	}
+
+	return strings.Join(ss, ",")
+}
+
 func (Node) diagnose(ss []string) error {
 	if len(ss) == 0 {
 		return nil
@@ -156,6 +175,16 @@ type NodeWithMetrics struct {
 	Raw  *unstructured.Unstructured
 	MX   *mv1beta1.NodeMetrics
 	Pods []*v1.Pod
+	// StaleFor tracks how long metrics have been stale, zero when fresh.
+	StaleFor time.Duration
+}
+
+func (n *NodeWithMetrics) staleFor() time.Duration {
+	if n.MX == nil {
+		return 0
+	}
+
+	return n.StaleFor
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,98 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Namespaces comparison states.
+const (
+	NSDiffMissing = "Missing"
+	NSDiffChanged = "Changed"
+)
+
+// NSDiff renders resources differing between two namespaces.
+type NSDiff struct{}
+
+// ColorerFunc colors a resource row.
+func (NSDiff) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if strings.TrimSpace(re.Row.Fields[idx]) == NSDiffMissing {
+			return ErrColor
+		}
+
+		return ModColor
+	}
+}
+
+// Header returns a header row.
+func (NSDiff) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "LEFT"},
+		HeaderColumn{Name: "RIGHT"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NSDiff) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(NSDiffRes)
+	if !ok {
+		return fmt.Errorf("expecting NSDiffRes but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Name,
+		res.GVR,
+		nsDiffSide(res.Left),
+		nsDiffSide(res.Right),
+		res.Status,
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NSDiffRes represents a resource differing between two namespaces. The
+// namespace of the side missing the resource is blank.
+type NSDiffRes struct {
+	GVR, Name, Left, Right, Status string
+}
+
+// ID returns a unique resource identifier.
+func (r NSDiffRes) ID() string {
+	return r.GVR + ":" + r.Name
+}
+
+// GetObjectKind returns a schema object.
+func (NSDiffRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r NSDiffRes) DeepCopyObject() runtime.Object {
+	return r
+}
+
+func nsDiffSide(ns string) string {
+	if ns == "" {
+		return MissingValue
+	}
+
+	return ns
+}
@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSDiffRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.NSDiffRes
+		e   render.Fields
+	}{
+		"missing": {
+			res: render.NSDiffRes{GVR: "v1/configmaps", Name: "fred", Left: "dev", Status: render.NSDiffMissing},
+			e:   render.Fields{"fred", "v1/configmaps", "dev", render.MissingValue, render.NSDiffMissing, ""},
+		},
+		"changed": {
+			res: render.NSDiffRes{GVR: "v1/configmaps", Name: "fred", Left: "dev", Right: "prod", Status: render.NSDiffChanged},
+			e:   render.Fields{"fred", "v1/configmaps", "dev", "prod", render.NSDiffChanged, ""},
+		},
+	}
+
+	var n render.NSDiff
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, n.Render(u.res, "", &r))
+			assert.Equal(t, "v1/configmaps:fred", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// TableOutput renders resources as an aligned text table.
+	TableOutput = "table"
+
+	// WideOutput renders resources as an aligned text table including wide columns.
+	WideOutput = "wide"
+
+	// JSONOutput renders resources as a json list.
+	JSONOutput = "json"
+)
+
+// IsValidOutput checks if an output format is supported.
+func IsValidOutput(o string) bool {
+	switch o {
+	case TableOutput, WideOutput, JSONOutput:
+		return true
+	default:
+		return false
+	}
+}
+
+// WriteTable writes table data as aligned text columns.
+func WriteTable(w io.Writer, data TableData) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	hh := make([]string, 0, len(data.Header))
+	for _, h := range data.Header {
+		hh = append(hh, h.Name)
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(hh, "\t")); err != nil {
+		return err
+	}
+	for _, re := range data.RowEvents {
+		if _, err := fmt.Fprintln(tw, strings.Join(outputFields(data.Header, re.Row.Fields), "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// WriteJSON writes table rows as a json list keyed by column names.
+func WriteJSON(w io.Writer, data TableData) error {
+	rr := make([]map[string]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		ff, r := outputFields(data.Header, re.Row.Fields), make(map[string]string, len(data.Header))
+		for i, h := range data.Header {
+			if i < len(ff) {
+				r[h.Name] = ff[i]
+			}
+		}
+		rr = append(rr, r)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(rr)
+}
+
+// Helpers...
+
+func outputFields(h Header, ff Fields) []string {
+	if !h.HasAge() {
+		return ff
+	}
+	out := make([]string, len(ff))
+	for i, f := range ff {
+		if h.IsAgeCol(i) {
+			f = toAgeHuman(f)
+		}
+		out[i] = f
+	}
+
+	return out
+}
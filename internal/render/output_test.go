@@ -0,0 +1,55 @@
+package render_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTable(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"fred", "Running"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"blee-duh", "Pending"}}},
+		},
+	}
+
+	var buff bytes.Buffer
+	assert.Nil(t, render.WriteTable(&buff, data))
+	assert.Equal(t, "NAME       STATUS\nfred       Running\nblee-duh   Pending\n", buff.String())
+}
+
+func TestWriteJSON(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE", Time: true}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"fred", "Running", "2m0s"}}},
+		},
+	}
+
+	var buff bytes.Buffer
+	assert.Nil(t, render.WriteJSON(&buff, data))
+	assert.JSONEq(t, `[{"NAME":"fred","STATUS":"Running","AGE":"2m"}]`, buff.String())
+}
+
+func TestIsValidOutput(t *testing.T) {
+	uu := map[string]struct {
+		o string
+		e bool
+	}{
+		"table": {o: render.TableOutput, e: true},
+		"wide":  {o: render.WideOutput, e: true},
+		"json":  {o: render.JSONOutput, e: true},
+		"yaml":  {o: "yaml"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.IsValidOutput(u.o))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Plugin job states.
+const (
+	JobRunning   = "Running"
+	JobSucceeded = "Succeeded"
+	JobFailed    = "Failed"
+	JobCanceled  = "Canceled"
+)
+
+// PluginJob renders plugin background jobs to screen.
+type PluginJob struct{}
+
+// ColorerFunc colors a resource row.
+func (PluginJob) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch strings.TrimSpace(re.Row.Fields[idx]) {
+		case JobRunning:
+			return PendingColor
+		case JobFailed:
+			return ErrColor
+		case JobCanceled:
+			return KillColor
+		default:
+			return CompletedColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (PluginJob) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PLUGIN"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "COMMAND"},
+		HeaderColumn{Name: "ERROR", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PluginJob) Render(o interface{}, _ string, r *Row) error {
+	j, ok := o.(PluginJobRes)
+	if !ok {
+		return fmt.Errorf("expecting PluginJobRes but got %T", o)
+	}
+
+	r.ID = j.ID
+	r.Fields = Fields{
+		j.ID,
+		j.Plugin,
+		j.Status,
+		j.duration(time.Now()),
+		j.Command,
+		j.Error,
+		"",
+		timeToAge(j.Started),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PluginJobRes represents a plugin command running in the background.
+type PluginJobRes struct {
+	ID, Plugin, Command string
+	Status, Error       string
+	Started, Ended      time.Time
+}
+
+// IsRunning checks if the job is still running.
+func (j PluginJobRes) IsRunning() bool {
+	return j.Status == JobRunning
+}
+
+func (j PluginJobRes) duration(now time.Time) string {
+	if !j.Ended.IsZero() {
+		now = j.Ended
+	}
+
+	return duration.HumanDuration(now.Sub(j.Started))
+}
+
+// GetObjectKind returns a schema object.
+func (PluginJobRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (j PluginJobRes) DeepCopyObject() runtime.Object {
+	return j
+}
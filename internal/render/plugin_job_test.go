@@ -0,0 +1,38 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginJobRender(t *testing.T) {
+	var p render.PluginJob
+
+	var r render.Row
+	start := time.Now().Add(-time.Minute)
+	o := render.PluginJobRes{
+		ID:      "purge-1",
+		Plugin:  "purge",
+		Command: "purge fred",
+		Status:  render.JobFailed,
+		Error:   "exit status 1",
+		Started: start,
+		Ended:   start.Add(30 * time.Second),
+	}
+
+	assert.Nil(t, p.Render(o, "", &r))
+	assert.Equal(t, "purge-1", r.ID)
+	assert.Equal(t, render.Fields{
+		"purge-1",
+		"purge",
+		render.JobFailed,
+		"30s",
+		"purge fred",
+		"exit status 1",
+		"",
+	}, r.Fields[:7])
+	assert.False(t, o.IsRunning())
+}
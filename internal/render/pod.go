@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
@@ -99,10 +100,12 @@ func (Pod) Header(ns string) Header {
 		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "STALE", MX: true},
 		HeaderColumn{Name: "IP"},
 		HeaderColumn{Name: "NODE"},
 		HeaderColumn{Name: "QOS", Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VULNS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true, Decorator: AgeDecorator},
 	}
@@ -140,10 +143,12 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(perc.lCPU()),
 		strconv.Itoa(perc.rMEM()),
 		strconv.Itoa(perc.lMEM()),
+		toStale(pwm.staleFor()),
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
 		mapToStr(po.Labels),
+		vulnsToStr(pwm.Vulns),
 		asStatus(p.diagnose(phase, cr, len(ss))),
 		toAge(po.ObjectMeta.CreationTimestamp),
 	}
@@ -169,6 +174,18 @@ func (p Pod) diagnose(phase string, cr, ct int) error {
 type PodWithMetrics struct {
 	Raw *unstructured.Unstructured
 	MX  *mv1beta1.PodMetrics
+	// StaleFor tracks how long metrics have been stale, zero when fresh.
+	StaleFor time.Duration
+	// Vulns tallies the pod images known vulnerabilities if scanned.
+	Vulns *VulnSummary
+}
+
+func (p *PodWithMetrics) staleFor() time.Duration {
+	if p.MX == nil {
+		return 0
+	}
+
+	return p.StaleFor
 }
 
 // GetObjectKind returns a schema object.
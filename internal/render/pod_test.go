@@ -2,6 +2,7 @@ package render_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/gdamore/tcell"
@@ -159,8 +160,25 @@ func TestPodRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Running", "100", "50", "100:0", "70:170", "100", "0", "71", "29", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:17])
+	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Running", "100", "50", "100:0", "70:170", "100", "0", "71", "29", "", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:18])
+}
+
+func TestPodRenderStale(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:      load(t, "po"),
+		MX:       makePodMX("nginx", "100m", "50Mi"),
+		StaleFor: 2 * time.Minute,
+	}
+
+	var po render.Pod
+	r := render.NewRow(14)
+	assert.Nil(t, po.Render(&pom, "", &r))
+
+	h := po.Header("")
+	assert.Equal(t, "100", r.Fields[h.IndexOf("CPU", true)])
+	assert.Equal(t, "50", r.Fields[h.IndexOf("MEM", true)])
+	assert.Equal(t, "2m", r.Fields[h.IndexOf("STALE", true)])
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -190,8 +208,8 @@ func TestPodInitRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "0", "14", "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:17])
+	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "0", "14", "5", "", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:18])
 }
 
 // ----------------------------------------------------------------------------
@@ -28,6 +28,8 @@ func TestPortForwardRender(t *testing.T) {
 		"co",
 		"p1",
 		"http://0.0.0.0:p1/",
+		"Reconnecting",
+		"2",
 		"1",
 		"1",
 		"",
@@ -58,3 +60,11 @@ func (f fwd) Active() bool {
 func (f fwd) Age() string {
 	return "2m"
 }
+
+func (f fwd) Status() string {
+	return "Reconnecting"
+}
+
+func (f fwd) Retries() int {
+	return 2
+}
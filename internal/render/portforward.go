@@ -2,9 +2,11 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +28,12 @@ type Forwarder interface {
 
 	// Age returns forwarder age.
 	Age() string
+
+	// Status returns the forwarder streaming status.
+	Status() string
+
+	// Retries returns the number of reconnect attempts.
+	Retries() int
 }
 
 // PortForward renders a portforwards to screen.
@@ -33,8 +41,19 @@ type PortForward struct{}
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() ColorerFunc {
-	return func(ns string, _ Header, re RowEvent) tcell.Color {
-		return tcell.ColorSkyblue
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return tcell.ColorSkyblue
+		}
+		switch strings.TrimSpace(re.Row.Fields[statusCol]) {
+		case "Reconnecting":
+			return PendingColor
+		case "Inactive":
+			return ErrColor
+		default:
+			return tcell.ColorSkyblue
+		}
 	}
 }
 
@@ -46,6 +65,8 @@ func (PortForward) Header(ns string) Header {
 		HeaderColumn{Name: "CONTAINER"},
 		HeaderColumn{Name: "PORTS"},
 		HeaderColumn{Name: "URL"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "RETRIES", Align: tview.AlignRight},
 		HeaderColumn{Name: "C"},
 		HeaderColumn{Name: "N"},
 		HeaderColumn{Name: "VALID", Wide: true},
@@ -70,6 +91,8 @@ func (f PortForward) Render(o interface{}, gvr string, r *Row) error {
 		pf.Container(),
 		strings.Join(pf.Ports(), ","),
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0]),
+		pf.Status(),
+		strconv.Itoa(pf.Retries()),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
 		"",
@@ -12,6 +12,11 @@ import (
 const allVerbs = "*"
 
 var (
+	// AllowedGlyph tracks the granted verb indicator.
+	AllowedGlyph = "✓"
+	// DeniedGlyph tracks the denied verb indicator.
+	DeniedGlyph = "×"
+
 	k8sVerbs = []string{
 		"get",
 		"list",
@@ -99,9 +104,9 @@ func asVerbs(verbs []string) []string {
 
 func toVerbIcon(ok bool) string {
 	if ok {
-		return "[green::b] ✓ [::]"
+		return "[green::b] " + AllowedGlyph + " [::]"
 	}
-	return "[orangered::b] × [::]"
+	return "[orangered::b] " + DeniedGlyph + " [::]"
 }
 
 func hasVerb(verbs []string, verb string) bool {
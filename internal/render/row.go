@@ -60,7 +60,7 @@ func NewRow(size int) Row {
 
 // Labelize returns a new row based on labels.
 func (r Row) Labelize(cols []int, labelCol int, labels []string) Row {
-	out := NewRow(len(cols) + len(labels))
+	out := Row{ID: r.ID, Fields: make(Fields, 0, len(cols)+len(labels))}
 	for _, col := range cols {
 		out.Fields = append(out.Fields, r.Fields[col])
 	}
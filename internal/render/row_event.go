@@ -128,7 +128,7 @@ func (r RowEvents) Labelize(cols []int, labelCol int, labels []string) RowEvents
 
 // Customize returns custom row events based on columns layout.
 func (r RowEvents) Customize(cols []int) RowEvents {
-	ee := make(RowEvents, 0, len(cols))
+	ee := make(RowEvents, 0, len(r))
 	for _, re := range r {
 		ee = append(ee, re.Customize(cols))
 	}
@@ -184,6 +184,16 @@ func (r RowEvents) Clear() RowEvents {
 	return RowEvents{}
 }
 
+// IndexByID returns a lookup of row ids to their position.
+func (r RowEvents) IndexByID() map[string]int {
+	idx := make(map[string]int, len(r))
+	for i, re := range r {
+		idx[re.Row.ID] = i
+	}
+
+	return idx
+}
+
 // FindIndex locates a row index by id. Returns false is not found.
 func (r RowEvents) FindIndex(id string) (int, bool) {
 	for i, re := range r {
@@ -211,20 +221,21 @@ func (r RowEvents) Sort(ns string, sortCol int, ageCol, numCol, asc bool) {
 	}
 	sort.Sort(t)
 
-	iids, fields := map[string][]string{}, make(StringSet, 0, len(r))
+	iids, fields := map[string][]string{}, make([]string, 0, len(r))
 	for _, re := range r {
-		field := re.Row.Fields[sortCol]
-		if ageCol {
-			field = toAgeDuration(field)
+		field := sortKey(re, sortCol, ageCol)
+		if _, ok := iids[field]; !ok {
+			fields = append(fields, field)
 		}
-		fields = fields.Add(field)
 		iids[field] = append(iids[field], re.Row.ID)
 	}
 
-	ids := make([]string, 0, len(r))
+	ids := make(map[string]int, len(r))
 	for _, field := range fields {
 		sort.StringSlice(iids[field]).Sort()
-		ids = append(ids, iids[field]...)
+		for _, id := range iids[field] {
+			ids[id] = len(ids)
+		}
 	}
 	s := IdSorter{Ids: ids, Events: r}
 	sort.Sort(s)
@@ -259,7 +270,7 @@ func (r RowEventSorter) Less(i, j int) bool {
 
 // IdSorter sorts row events by a given id.
 type IdSorter struct {
-	Ids    []string
+	Ids    map[string]int
 	Events RowEvents
 }
 
@@ -273,17 +284,14 @@ func (s IdSorter) Swap(i, j int) {
 
 func (s IdSorter) Less(i, j int) bool {
 	id1, id2 := s.Events[i].Row.ID, s.Events[j].Row.ID
-	i1, i2 := findIndex(s.Ids, id1), findIndex(s.Ids, id2)
-	return i1 < i2
+	return s.indexOf(id1) < s.indexOf(id2)
 }
 
-func findIndex(ss []string, s string) int {
-	for i := range ss {
-		if ss[i] == s {
-			return i
-		}
+func (s IdSorter) indexOf(id string) int {
+	if i, ok := s.Ids[id]; ok {
+		return i
 	}
-	log.Error().Err(fmt.Errorf("Doh! index not found for %s", s))
+	log.Error().Err(fmt.Errorf("Doh! index not found for %s", id))
 	return -1
 }
 
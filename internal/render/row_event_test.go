@@ -7,6 +7,22 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func BenchmarkRowEventsCustomize(b *testing.B) {
+	ee := make(render.RowEvents, 0, 100)
+	for i := 0; i < 100; i++ {
+		ee = append(ee, render.NewRowEvent(render.EventAdd, render.Row{
+			ID:     "fred",
+			Fields: render.Fields{"f1", "f2", "f3"},
+		}))
+	}
+	cols := []int{0, 2}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = ee.Customize(cols)
+	}
+}
+
 func TestRowEventCustomize(t *testing.T) {
 	uu := map[string]struct {
 		re1, e render.RowEvent
@@ -514,6 +530,36 @@ func TestRowEventsClone(t *testing.T) {
 	}
 }
 
+func TestRowEventsIndexByID(t *testing.T) {
+	uu := map[string]struct {
+		r render.RowEvents
+		e map[string]int
+	}{
+		"empty": {
+			r: render.RowEvents{},
+			e: map[string]int{},
+		},
+		"full": {
+			r: makeRowEvents(),
+			e: map[string]int{
+				"ns1/A": 0,
+				"ns1/B": 1,
+				"ns1/C": 2,
+				"ns2/A": 3,
+				"ns2/B": 4,
+				"ns2/C": 5,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.IndexByID())
+		})
+	}
+}
+
 // Helpers...
 
 func makeRowEvents() render.RowEvents {
@@ -0,0 +1,117 @@
+package render
+
+import (
+	"sort"
+)
+
+// incrementalSortRatio tracks the ratio of changed rows above which a full
+// sort is cheaper than incremental insertions.
+const incrementalSortRatio = 4
+
+// RowEventsSorter keeps row events sorted across refreshes by only
+// repositioning rows that were added or whose sort column changed.
+type RowEventsSorter struct {
+	ns             string
+	col            int
+	asc            bool
+	ageCol, numCol bool
+	ids            []string
+	keys           map[string]string
+}
+
+// NewRowEventsSorter returns a new incremental sorter.
+func NewRowEventsSorter() *RowEventsSorter {
+	return &RowEventsSorter{col: -1}
+}
+
+// Reset clears out the previous ordering.
+func (s *RowEventsSorter) Reset() {
+	s.ids, s.keys = nil, nil
+}
+
+// Sort sorts row events in place based on column index and order.
+func (s *RowEventsSorter) Sort(r RowEvents, ns string, sortCol int, ageCol, numCol, asc bool) {
+	if sortCol == -1 {
+		s.Reset()
+		return
+	}
+	if s.ids == nil || s.ns != ns || s.col != sortCol || s.asc != asc || s.ageCol != ageCol || s.numCol != numCol {
+		s.ns, s.col, s.asc, s.ageCol, s.numCol = ns, sortCol, asc, ageCol, numCol
+		s.fullSort(r)
+		return
+	}
+
+	idx := r.IndexByID()
+	ids, changed := make([]string, 0, len(r)), make([]string, 0, len(r)/incrementalSortRatio)
+	for _, id := range s.ids {
+		i, ok := idx[id]
+		if !ok {
+			continue
+		}
+		if sortKey(r[i], sortCol, ageCol) != s.keys[id] {
+			changed = append(changed, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	for _, re := range r {
+		if _, ok := s.keys[re.Row.ID]; !ok {
+			changed = append(changed, re.Row.ID)
+		}
+	}
+	if len(changed)*incrementalSortRatio > len(r) {
+		s.fullSort(r)
+		return
+	}
+
+	for _, id := range changed {
+		re := r[idx[id]]
+		i := sort.Search(len(ids), func(i int) bool {
+			return s.less(re, r[idx[ids[i]]])
+		})
+		ids = append(ids, "")
+		copy(ids[i+1:], ids[i:])
+		ids[i] = id
+	}
+
+	rr := make(RowEvents, len(r))
+	for i, id := range ids {
+		rr[i] = r[idx[id]]
+	}
+	copy(r, rr)
+	s.record(r)
+}
+
+func (s *RowEventsSorter) fullSort(r RowEvents) {
+	r.Sort(s.ns, s.col, s.ageCol, s.numCol, s.asc)
+	s.record(r)
+}
+
+func (s *RowEventsSorter) record(r RowEvents) {
+	s.ids, s.keys = make([]string, len(r)), make(map[string]string, len(r))
+	for i, re := range r {
+		s.ids[i], s.keys[re.Row.ID] = re.Row.ID, sortKey(re, s.col, s.ageCol)
+	}
+}
+
+// Less checks if re1 sorts before re2. Rows with the same sort value are
+// ordered by id.
+func (s *RowEventsSorter) less(re1, re2 RowEvent) bool {
+	k1, k2 := sortKey(re1, s.col, s.ageCol), sortKey(re2, s.col, s.ageCol)
+	if k1 == k2 {
+		return re1.Row.ID < re2.Row.ID
+	}
+
+	return Less(s.asc, s.numCol, s.ageCol, re1.Row.Fields[s.col], re2.Row.Fields[s.col])
+}
+
+func sortKey(re RowEvent, col int, ageCol bool) string {
+	if col < 0 || col >= len(re.Row.Fields) {
+		return ""
+	}
+	if ageCol {
+		return toAgeDuration(re.Row.Fields[col])
+	}
+
+	return re.Row.Fields[col]
+}
@@ -0,0 +1,82 @@
+package render_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowEventsSorter(t *testing.T) {
+	uu := map[string]struct {
+		asc    bool
+		update func(render.RowEvents) render.RowEvents
+	}{
+		"unchanged": {
+			asc:    true,
+			update: func(r render.RowEvents) render.RowEvents { return r },
+		},
+		"changed": {
+			asc: true,
+			update: func(r render.RowEvents) render.RowEvents {
+				r[3].Row.Fields[1] = "0"
+				return r
+			},
+		},
+		"added": {
+			update: func(r render.RowEvents) render.RowEvents {
+				return append(r, makeSortEvent("z", "5"))
+			},
+		},
+		"deleted": {
+			asc: true,
+			update: func(r render.RowEvents) render.RowEvents {
+				return r.Delete(r[2].Row.ID)
+			},
+		},
+		"dups": {
+			update: func(r render.RowEvents) render.RowEvents {
+				r[0].Row.Fields[1], r[5].Row.Fields[1] = "7", "7"
+				return append(r, makeSortEvent("a", "7"))
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := render.NewRowEventsSorter()
+			rr := makeSortEvents(20)
+			s.Sort(rr, "", 1, false, true, u.asc)
+
+			rr = u.update(rr)
+			e := rr.Clone()
+			e.Sort("", 1, false, true, u.asc)
+			s.Sort(rr, "", 1, false, true, u.asc)
+			assert.Equal(t, ids(e), ids(rr))
+		})
+	}
+}
+
+func makeSortEvents(n int) render.RowEvents {
+	rr := make(render.RowEvents, 0, n)
+	for i := 0; i < n; i++ {
+		rr = append(rr, makeSortEvent(fmt.Sprintf("r%02d", i), fmt.Sprintf("%d", (i*7)%n)))
+	}
+
+	return rr
+}
+
+func makeSortEvent(id, v string) render.RowEvent {
+	return render.RowEvent{Row: render.Row{ID: id, Fields: render.Fields{id, v}}}
+}
+
+func ids(rr render.RowEvents) []string {
+	ii := make([]string, 0, len(rr))
+	for _, re := range rr {
+		ii = append(ii, re.Row.ID)
+	}
+
+	return ii
+}
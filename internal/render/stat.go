@@ -0,0 +1,107 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// StatAPI tracks api calls stats.
+	StatAPI = "api"
+
+	// StatView tracks time spent in views.
+	StatView = "view"
+
+	// StatAction tracks actions taken.
+	StatAction = "action"
+)
+
+// Stat renders session stats to screen.
+type Stat struct{}
+
+// ColorerFunc colors a resource row.
+func (Stat) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("ERRORS", true)
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "0" {
+			return ErrColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Stat) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "VERB"},
+		HeaderColumn{Name: "CALLS", Align: tview.AlignRight},
+		HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		HeaderColumn{Name: "BYTES", Align: tview.AlignRight},
+		HeaderColumn{Name: "TIME", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Stat) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(SessionStat)
+	if !ok {
+		return fmt.Errorf("expecting a SessionStat but got %T", o)
+	}
+
+	r.ID = s.ID()
+	r.Fields = Fields{
+		s.Kind,
+		s.Name,
+		s.Verb,
+		strconv.Itoa(s.Count),
+		strconv.Itoa(s.Errors),
+		toBytes(int(s.Bytes)),
+		toElapsed(s.Time),
+	}
+
+	return nil
+}
+
+// Helpers...
+
+func toElapsed(d time.Duration) string {
+	if d == 0 {
+		return NAValue
+	}
+
+	return d.Round(time.Second).String()
+}
+
+// SessionStat represents a session usage stat.
+type SessionStat struct {
+	Kind   string
+	Name   string
+	Verb   string
+	Count  int
+	Errors int
+	Bytes  int64
+	Time   time.Duration
+}
+
+// ID returns a stat unique identifier.
+func (s SessionStat) ID() string {
+	return s.Kind + ":" + s.Name + ":" + s.Verb
+}
+
+// GetObjectKind returns a schema object.
+func (SessionStat) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SessionStat) DeepCopyObject() runtime.Object {
+	return s
+}
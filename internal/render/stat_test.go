@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatRender(t *testing.T) {
+	uu := map[string]struct {
+		s  render.SessionStat
+		id string
+		e  render.Fields
+	}{
+		"api": {
+			s:  render.SessionStat{Kind: render.StatAPI, Name: "v1/pods", Verb: "list", Count: 10, Errors: 1, Bytes: 2048},
+			id: "api:v1/pods:list",
+			e:  render.Fields{"api", "v1/pods", "list", "10", "1", "2.0KiB", "n/a"},
+		},
+		"view": {
+			s:  render.SessionStat{Kind: render.StatView, Name: "pods", Count: 2, Time: 90 * time.Second},
+			id: "view:pods:",
+			e:  render.Fields{"view", "pods", "", "2", "0", "0B", "1m30s"},
+		},
+	}
+
+	var s render.Stat
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, s.Render(u.s, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -7,6 +7,12 @@ type TableData struct {
 	Header    Header
 	RowEvents RowEvents
 	Namespace string
+
+	// Loading indicates the collection is still listed in api pages.
+	Loading bool
+
+	// Total tracks the estimated collection size while loading or 0 if unknown.
+	Total int
 }
 
 // NewTableData returns a new table.
@@ -58,6 +64,8 @@ func (t *TableData) Clone() TableData {
 		Header:    t.Header.Clone(),
 		RowEvents: t.RowEvents.Clone(),
 		Namespace: t.Namespace,
+		Loading:   t.Loading,
+		Total:     t.Total,
 	}
 }
 
@@ -70,7 +78,12 @@ func (t *TableData) SetHeader(ns string, h Header) {
 func (t *TableData) Update(rows Rows) {
 	empty := len(t.RowEvents) == 0
 	kk := make(map[string]struct{}, len(rows))
+	if empty {
+		t.RowEvents = make(RowEvents, 0, len(rows))
+	}
+	idx := t.RowEvents.IndexByID()
 	var blankDelta DeltaRow
+	hasAge := t.Header.HasAge()
 	for _, row := range rows {
 		kk[row.ID] = struct{}{}
 		if empty {
@@ -78,13 +91,12 @@ func (t *TableData) Update(rows Rows) {
 			continue
 		}
 
-		if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-			delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
-			if delta.IsBlank() {
+		if index, ok := idx[row.ID]; ok {
+			if !hasDeltas(t.RowEvents[index].Row, row, hasAge) {
 				t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
 				t.RowEvents[index].Row = row
 			} else {
-				t.RowEvents[index] = NewRowEventWithDeltas(row, delta)
+				t.RowEvents[index] = NewRowEventWithDeltas(row, NewDeltaRow(t.RowEvents[index].Row, row, hasAge))
 			}
 			continue
 		}
@@ -96,18 +108,16 @@ func (t *TableData) Update(rows Rows) {
 	}
 }
 
-// Delete removes items in cache that are no longer valid.
+// Delete removes items in cache that are no longer valid. Surviving rows are
+// copied so slices shared with previous snapshots are left untouched.
 func (t *TableData) Delete(newKeys map[string]struct{}) {
-	var victims []string
+	rr := make(RowEvents, 0, len(newKeys))
 	for _, re := range t.RowEvents {
-		if _, ok := newKeys[re.Row.ID]; !ok {
-			victims = append(victims, re.Row.ID)
+		if _, ok := newKeys[re.Row.ID]; ok {
+			rr = append(rr, re)
 		}
 	}
-
-	for _, id := range victims {
-		t.RowEvents = t.RowEvents.Delete(id)
-	}
+	t.RowEvents = rr
 }
 
 // Diff checks if two tables are equal.
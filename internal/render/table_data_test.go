@@ -1,12 +1,30 @@
 package render_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
+func BenchmarkTableDataUpdate(b *testing.B) {
+	rows := make(render.Rows, 0, 100)
+	for i := 0; i < 100; i++ {
+		rows = append(rows, render.Row{
+			ID:     fmt.Sprintf("ns1/p%d", i),
+			Fields: render.Fields{"ns1", fmt.Sprintf("p%d", i), "Running", "1/1", "2m"},
+		})
+	}
+	t := render.NewTableData()
+	t.Update(rows)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		t.Update(rows)
+	}
+}
+
 func TestTableDataCustomize(t *testing.T) {
 	uu := map[string]struct {
 		t1   render.TableData
@@ -387,9 +405,11 @@ func TestTableDataDelete(t *testing.T) {
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
+			re := append(render.RowEvents{}, u.re...)
 			table.RowEvents = u.re
 			table.Delete(u.kk)
 			assert.Equal(t, u.e, table.RowEvents)
+			assert.Equal(t, re, u.re)
 		})
 	}
 
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+)
+
+// VulnSummary tallies an image known vulnerabilities by severity.
+type VulnSummary struct {
+	Critical, High, Medium, Low, Unknown int
+}
+
+// Add returns the sum of two summaries.
+func (v VulnSummary) Add(o VulnSummary) VulnSummary {
+	return VulnSummary{
+		Critical: v.Critical + o.Critical,
+		High:     v.High + o.High,
+		Medium:   v.Medium + o.Medium,
+		Low:      v.Low + o.Low,
+		Unknown:  v.Unknown + o.Unknown,
+	}
+}
+
+// Total returns the vulnerabilities count.
+func (v VulnSummary) Total() int {
+	return v.Critical + v.High + v.Medium + v.Low + v.Unknown
+}
+
+// String returns a compact severity breakdown ie C:1 H:2 M:0 L:3.
+func (v VulnSummary) String() string {
+	ss := []string{
+		"C:" + strconv.Itoa(v.Critical),
+		"H:" + strconv.Itoa(v.High),
+		"M:" + strconv.Itoa(v.Medium),
+		"L:" + strconv.Itoa(v.Low),
+	}
+	if v.Unknown > 0 {
+		ss = append(ss, "U:"+strconv.Itoa(v.Unknown))
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func vulnsToStr(v *VulnSummary) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.String()
+}
@@ -0,0 +1,44 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulnSummary(t *testing.T) {
+	uu := map[string]struct {
+		v     render.VulnSummary
+		e     string
+		total int
+	}{
+		"none": {
+			e: "C:0 H:0 M:0 L:0",
+		},
+		"plain": {
+			v:     render.VulnSummary{Critical: 1, High: 2, Low: 3},
+			e:     "C:1 H:2 M:0 L:3",
+			total: 6,
+		},
+		"unknown": {
+			v:     render.VulnSummary{Medium: 1, Unknown: 2},
+			e:     "C:0 H:0 M:1 L:0 U:2",
+			total: 3,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.v.String())
+			assert.Equal(t, u.total, u.v.Total())
+		})
+	}
+}
+
+func TestVulnSummaryAdd(t *testing.T) {
+	v := render.VulnSummary{Critical: 1, High: 1}.Add(render.VulnSummary{High: 2, Unknown: 1})
+
+	assert.Equal(t, render.VulnSummary{Critical: 1, High: 3, Unknown: 1}, v)
+}
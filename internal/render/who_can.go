@@ -0,0 +1,81 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// WhoCan renders the subjects allowed to perform an action.
+type WhoCan struct{}
+
+// ColorerFunc colors a resource row.
+func (WhoCan) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (WhoCan) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "SA NAMESPACE"},
+		HeaderColumn{Name: "SCOPE"},
+		HeaderColumn{Name: "BINDING"},
+		HeaderColumn{Name: "ROLE"},
+		HeaderColumn{Name: "RESOURCE NAMES", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (WhoCan) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(WhoCanRes)
+	if !ok {
+		return fmt.Errorf("expecting WhoCanRes but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Name,
+		res.Kind,
+		res.SubjectNamespace,
+		res.Scope,
+		res.Binding,
+		res.Role,
+		strings.Join(res.ResourceNames, ","),
+		"",
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WhoCanRes represents a subject allowed to perform an action via a binding.
+type WhoCanRes struct {
+	Name, Kind, SubjectNamespace string
+	Scope, Binding, Role         string
+	ResourceNames                []string
+}
+
+// ID returns the subject binding identifier.
+func (w WhoCanRes) ID() string {
+	return strings.Join([]string{w.Kind, w.SubjectNamespace, w.Name, w.Scope, w.Binding}, ":")
+}
+
+// GetObjectKind returns a schema object.
+func (WhoCanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WhoCanRes) DeepCopyObject() runtime.Object {
+	return w
+}
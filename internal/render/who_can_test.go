@@ -0,0 +1,36 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWhoCanRender(t *testing.T) {
+	var w render.WhoCan
+
+	var r render.Row
+	o := render.WhoCanRes{
+		Name:             "fred",
+		Kind:             "ServiceAccount",
+		SubjectNamespace: "blee",
+		Scope:            "default",
+		Binding:          "RB:blee/rb1",
+		Role:             "CR:edit",
+		ResourceNames:    []string{"s1", "s2"},
+	}
+
+	assert.Nil(t, w.Render(o, "", &r))
+	assert.Equal(t, "ServiceAccount:blee:fred:default:RB:blee/rb1", r.ID)
+	assert.Equal(t, render.Fields{
+		"fred",
+		"ServiceAccount",
+		"blee",
+		"default",
+		"RB:blee/rb1",
+		"CR:edit",
+		"s1,s2",
+		"",
+	}, r.Fields)
+}
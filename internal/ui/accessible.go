@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+// StateColumn tracks the textual row status column shown in screen reader mode.
+const StateColumn = "STATE"
+
+var tagRX = regexp.MustCompile(`\[[a-zA-Z0-9#:\-]*\]`)
+
+// AnnounceFunc gets called with a plain text description to announce.
+type AnnounceFunc func(string)
+
+// LinearizeRow returns a single line row description suitable for screen
+// readers, skipping blank values.
+func LinearizeRow(names, values []string) string {
+	ss := make([]string, 0, len(names))
+	for i, n := range names {
+		if i >= len(values) {
+			break
+		}
+		v := strings.TrimSpace(tagRX.ReplaceAllString(values[i], ""))
+		if v == "" || n == "" {
+			continue
+		}
+		ss = append(ss, n+" "+v)
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+// StatusSummary returns a plain text breakdown of rows by status.
+func StatusSummary(total int, counts map[string]int) string {
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss := make([]string, 0, len(kk)+1)
+	ss = append(ss, fmt.Sprintf("%d rows", total))
+	for _, k := range kk {
+		ss = append(ss, fmt.Sprintf("%d %s", counts[k], k))
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+// SetAccessible turns screen reader mode on. Rows get a textual status
+// column and selected rows are announced in plain text.
+func (t *Table) SetAccessible(f AnnounceFunc) {
+	t.accessible, t.announceFn = true, f
+	t.stateFn = func(l string) string { return l }
+	t.SetSelectedRowFn(t.announce)
+}
+
+// SetStatusGlyphs shows a status column using the given glyphs so row
+// statuses are not conveyed by color alone.
+func (t *Table) SetStatusGlyphs(gg map[string]string) {
+	t.stateFn = func(l string) string {
+		if g, ok := gg[l]; ok {
+			return g
+		}
+		return l
+	}
+}
+
+// IsAccessible returns true if screen reader mode is on.
+func (t *Table) IsAccessible() bool {
+	return t.accessible
+}
+
+// StatusCounts returns the number of filtered rows per status.
+func (t *Table) StatusCounts() (int, map[string]int) {
+	color := render.DefaultColorer
+	if t.colorerFn != nil {
+		color = t.colorerFn
+	}
+	data := t.GetFilteredData()
+	counts := make(map[string]int)
+	for _, re := range data.RowEvents {
+		if l := render.StatusLabel(color(t.GetModel().GetNamespace(), data.Header, re)); l != "OK" {
+			counts[l]++
+		}
+	}
+
+	return len(data.RowEvents), counts
+}
+
+// SelectedRowText returns the selected row in plain text.
+func (t *Table) SelectedRowText() string {
+	r := t.GetSelectedRowIndex()
+	if r <= 0 || r >= t.GetRowCount() {
+		return ""
+	}
+	names := t.visibleCols
+	if t.stateFn != nil {
+		names = append(names[:len(names):len(names)], StateColumn)
+	}
+	values := make([]string, 0, len(names))
+	for c := 0; c < t.GetColumnCount(); c++ {
+		values = append(values, t.GetCell(r, c).Text)
+	}
+
+	return LinearizeRow(names, values)
+}
+
+func (t *Table) announce(r int) {
+	if t.announceFn == nil || r <= 0 || r >= t.GetRowCount() {
+		return
+	}
+	id, _ := t.GetCell(r, 0).GetReference().(string)
+	if id == "" || id == t.announced {
+		return
+	}
+	t.announced = id
+	t.announceFn(t.SelectedRowText())
+}
+
+func (t *Table) rowState(l string, marked bool) string {
+	s := t.stateFn(l)
+	switch {
+	case marked && t.accessible:
+		return s + " MARKED"
+	case marked:
+		return s + " *"
+	default:
+		return s
+	}
+}
@@ -0,0 +1,68 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinearizeRow(t *testing.T) {
+	uu := map[string]struct {
+		names, values []string
+		e             string
+	}{
+		"empty": {},
+		"plain": {
+			names:  []string{"NAME", "READY", "STATUS"},
+			values: []string{"fred   ", "1/1", "Running"},
+			e:      "NAME fred, READY 1/1, STATUS Running",
+		},
+		"blanks": {
+			names:  []string{"NAME", "IP", "STATUS"},
+			values: []string{"fred", " ", "Running"},
+			e:      "NAME fred, STATUS Running",
+		},
+		"tags": {
+			names:  []string{"NAME", "STATUS"},
+			values: []string{"[red::b]fred[-::-]", "Running"},
+			e:      "NAME fred, STATUS Running",
+		},
+		"short": {
+			names:  []string{"NAME", "STATUS"},
+			values: []string{"fred"},
+			e:      "NAME fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.LinearizeRow(u.names, u.values))
+		})
+	}
+}
+
+func TestStatusSummary(t *testing.T) {
+	uu := map[string]struct {
+		total  int
+		counts map[string]int
+		e      string
+	}{
+		"empty": {
+			e: "0 rows",
+		},
+		"counts": {
+			total:  10,
+			counts: map[string]int{"PEND": 1, "ERR": 2},
+			e:      "10 rows, 2 ERR, 1 PEND",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.StatusSummary(u.total, u.counts))
+		})
+	}
+}
@@ -41,13 +41,33 @@ func NewApp(cfg *config.Config, context string) *App {
 	a.views = map[string]tview.Primitive{
 		"menu":   NewMenu(a.Styles),
 		"logo":   NewLogo(a.Styles),
-		"prompt": NewPrompt(a.Config.K9s.NoIcons, a.Styles),
+		"prompt": NewPrompt(a.Config.K9s.IsNoIcons(), a.Styles),
 		"crumbs": NewCrumbs(a.Styles),
 	}
 
 	return &a
 }
 
+// Fork returns an app sharing this app screen, styles and prompt but using
+// its own configuration and key bindings.
+func (a *App) Fork(cfg *config.Config) *App {
+	return &App{
+		Application: a.Application,
+		Configurator: Configurator{
+			Config:     cfg,
+			Styles:     a.Styles,
+			CustomView: a.CustomView,
+			BenchFile:  a.BenchFile,
+		},
+		Main:    a.Main,
+		flash:   a.flash,
+		actions: make(KeyActions),
+		views:   a.views,
+		cmdBuff: a.cmdBuff,
+		running: a.IsRunning(),
+	}
+}
+
 // Init initializes the application.
 func (a *App) Init() {
 	a.bindKeys()
@@ -276,13 +296,26 @@ func (a *App) Flash() *model.Flash {
 // Helpers...
 
 // AsKey converts rune to keyboard key.,
+// Some terminals ie Windows Terminal report control keys as runes with a
+// ctrl modifier, shifted runes with a shift modifier and AltGr as ctrl+alt.
 func AsKey(evt *tcell.EventKey) tcell.Key {
 	if evt.Key() != tcell.KeyRune {
 		return evt.Key()
 	}
-	key := tcell.Key(evt.Rune())
-	if evt.Modifiers() == tcell.ModAlt {
-		key = tcell.Key(int16(evt.Rune()) * int16(evt.Modifiers()))
+	r, mods := evt.Rune(), evt.Modifiers()&^tcell.ModShift
+	switch mods {
+	case tcell.ModAlt:
+		return tcell.Key(int16(r) * int16(tcell.ModAlt))
+	case tcell.ModCtrl:
+		switch {
+		case r == ' ':
+			return tcell.KeyCtrlSpace
+		case r >= 'a' && r <= 'z':
+			return tcell.KeyCtrlA + tcell.Key(r-'a')
+		case r >= 'A' && r <= 'Z':
+			return tcell.KeyCtrlA + tcell.Key(r-'A')
+		}
 	}
-	return key
+
+	return tcell.Key(r)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -73,3 +74,27 @@ func TestAppViews(t *testing.T) {
 	assert.NotNil(t, a.Prompt())
 	assert.NotNil(t, a.Menu())
 }
+
+func TestAsKey(t *testing.T) {
+	uu := map[string]struct {
+		evt *tcell.EventKey
+		e   tcell.Key
+	}{
+		"key":       {evt: tcell.NewEventKey(tcell.KeyCtrlD, 0, tcell.ModCtrl), e: tcell.KeyCtrlD},
+		"rune":      {evt: tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone), e: tcell.Key('a')},
+		"shifted":   {evt: tcell.NewEventKey(tcell.KeyRune, 'A', tcell.ModShift), e: tcell.Key('A')},
+		"alt":       {evt: tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModAlt), e: tcell.Key(int16('a') * int16(tcell.ModAlt))},
+		"altShift":  {evt: tcell.NewEventKey(tcell.KeyRune, 'A', tcell.ModAlt|tcell.ModShift), e: tcell.Key(int16('A') * int16(tcell.ModAlt))},
+		"ctrlRune":  {evt: tcell.NewEventKey(tcell.KeyRune, 'd', tcell.ModCtrl), e: tcell.KeyCtrlD},
+		"ctrlUpper": {evt: tcell.NewEventKey(tcell.KeyRune, 'D', tcell.ModCtrl|tcell.ModShift), e: tcell.KeyCtrlD},
+		"ctrlSpace": {evt: tcell.NewEventKey(tcell.KeyRune, ' ', tcell.ModCtrl), e: tcell.KeyCtrlSpace},
+		"altGr":     {evt: tcell.NewEventKey(tcell.KeyRune, '@', tcell.ModCtrl|tcell.ModAlt), e: tcell.Key('@')},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.AsKey(u.evt))
+		})
+	}
+}
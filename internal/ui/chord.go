@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/gdamore/tcell"
+)
+
+// KeyChords tracks multi keystrokes bindings ie `g d`.
+type KeyChords struct {
+	bindings map[string]KeyAction
+	pending  []tcell.Key
+	mx       sync.Mutex
+}
+
+// NewKeyChords returns a new instance.
+func NewKeyChords() *KeyChords {
+	return &KeyChords{bindings: make(map[string]KeyAction)}
+}
+
+// Add binds an action to a keys sequence.
+func (c *KeyChords) Add(kk []tcell.Key, a KeyAction) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.bindings[chordName(kk)] = a
+}
+
+// Clear removes all chords.
+func (c *KeyChords) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.bindings, c.pending = make(map[string]KeyAction), nil
+}
+
+// Get returns the action bound to a keys sequence.
+func (c *KeyChords) Get(kk []tcell.Key) (KeyAction, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	a, ok := c.bindings[chordName(kk)]
+
+	return a, ok
+}
+
+// IsPrefix checks if a keys sequence starts a chord.
+func (c *KeyChords) IsPrefix(kk []tcell.Key) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.isPrefix(chordName(kk))
+}
+
+// Pending returns the chord keys typed so far.
+func (c *KeyChords) Pending() string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return chordName(c.pending)
+}
+
+// Dispatch feeds a keystroke to the chords. It returns the completed chord
+// action if any and whether the keystroke was consumed. A keystroke not
+// matching a started chord aborts it.
+func (c *KeyChords) Dispatch(k tcell.Key) (KeyAction, bool, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if len(c.bindings) == 0 {
+		return KeyAction{}, false, false
+	}
+
+	started := len(c.pending) > 0
+	kk := append(append([]tcell.Key{}, c.pending...), k)
+	name := chordName(kk)
+	if a, ok := c.bindings[name]; ok {
+		c.pending = nil
+		return a, true, true
+	}
+	if c.isPrefix(name) {
+		c.pending = kk
+		return KeyAction{}, false, true
+	}
+	c.pending = nil
+
+	return KeyAction{}, false, started
+}
+
+// Hints returns the chords hints.
+func (c *KeyChords) Hints() model.MenuHints {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	kk := make([]string, 0, len(c.bindings))
+	for k := range c.bindings {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	hh := make(model.MenuHints, 0, len(kk))
+	for _, k := range kk {
+		hh = append(hh, model.MenuHint{
+			Mnemonic:    k,
+			Description: c.bindings[k].Description,
+			Visible:     c.bindings[k].Visible,
+		})
+	}
+
+	return hh
+}
+
+func (c *KeyChords) isPrefix(name string) bool {
+	for k := range c.bindings {
+		if strings.HasPrefix(k, name+" ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helpers...
+
+func chordName(kk []tcell.Key) string {
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		if n, ok := tcell.KeyNames[k]; ok {
+			ss = append(ss, n)
+		}
+	}
+
+	return strings.Join(ss, " ")
+}
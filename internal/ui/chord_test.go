@@ -0,0 +1,51 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyChordsDispatch(t *testing.T) {
+	c := ui.NewKeyChords()
+	_, ok, consumed := c.Dispatch(ui.KeyG)
+	assert.False(t, ok)
+	assert.False(t, consumed)
+
+	c.Add([]tcell.Key{ui.KeyG, ui.KeyD}, ui.NewKeyAction("Describe", nil, true))
+	c.Add([]tcell.Key{ui.KeyG, ui.KeyL}, ui.NewKeyAction("Logs", nil, false))
+
+	_, ok, consumed = c.Dispatch(ui.KeyD)
+	assert.False(t, ok)
+	assert.False(t, consumed)
+
+	_, ok, consumed = c.Dispatch(ui.KeyG)
+	assert.False(t, ok)
+	assert.True(t, consumed)
+	assert.Equal(t, "g", c.Pending())
+	a, ok, consumed := c.Dispatch(ui.KeyD)
+	assert.True(t, ok)
+	assert.True(t, consumed)
+	assert.Equal(t, "Describe", a.Description)
+	assert.Equal(t, "", c.Pending())
+
+	_, _, _ = c.Dispatch(ui.KeyG)
+	_, ok, consumed = c.Dispatch(tcell.KeyEscape)
+	assert.False(t, ok)
+	assert.True(t, consumed)
+	assert.Equal(t, "", c.Pending())
+
+	assert.True(t, c.IsPrefix([]tcell.Key{ui.KeyG}))
+	assert.False(t, c.IsPrefix([]tcell.Key{ui.KeyG, ui.KeyD}))
+	assert.Equal(t, model.MenuHints{
+		{Mnemonic: "g d", Description: "Describe", Visible: true},
+		{Mnemonic: "g l", Description: "Logs"},
+	}, c.Hints())
+
+	c.Clear()
+	_, ok = c.Get([]tcell.Key{ui.KeyG, ui.KeyD})
+	assert.False(t, ok)
+}
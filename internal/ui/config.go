@@ -149,6 +149,9 @@ func (c *Configurator) updateStyles(f string) {
 	if !c.HasSkin() {
 		c.Styles.DefaultSkin()
 	}
+	if c.Config != nil && c.Config.K9s != nil && c.Config.K9s.ColorBlind != "" {
+		c.Styles.ApplyPalette(c.Config.K9s.ColorBlind)
+	}
 	c.Styles.Update()
 
 	render.ModColor = c.Styles.Frame().Status.ModifyColor.Color()
@@ -159,4 +162,27 @@ func (c *Configurator) updateStyles(f string) {
 	render.HighlightColor = c.Styles.Frame().Status.HighlightColor.Color()
 	render.KillColor = c.Styles.Frame().Status.KillColor.Color()
 	render.CompletedColor = c.Styles.Frame().Status.CompletedColor.Color()
+	c.updateGlyphs()
+}
+
+func (c *Configurator) updateGlyphs() {
+	if c.Config == nil || c.Config.K9s == nil || c.Config.K9s.Glyphs == nil {
+		return
+	}
+	gg := c.Config.K9s.Glyphs.Resolve()
+
+	render.StatusGlyphs = map[string]string{
+		"OK":   gg[config.GlyphOK],
+		"ERR":  gg[config.GlyphError],
+		"DEL":  gg[config.GlyphDeleted],
+		"PEND": gg[config.GlyphPending],
+		"NEW":  gg[config.GlyphNew],
+		"MOD":  gg[config.GlyphModified],
+		"DONE": gg[config.GlyphCompleted],
+		"HIGH": gg[config.GlyphHighlight],
+	}
+	render.AllowedGlyph, render.DeniedGlyph = gg[config.GlyphAllowed], gg[config.GlyphDenied]
+	DeltaSign = gg[config.GlyphDelta]
+	PlusSign, MinusSign = "[red::b]"+gg[config.GlyphUp], "[green::b]"+gg[config.GlyphDown]
+	emoHappy, emoDoh, emoRed = gg[config.GlyphInfo], gg[config.GlyphWarn], gg[config.GlyphFault]
 }
@@ -0,0 +1,132 @@
+package dialog
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	filePickerKey = "filePicker"
+	parentDir     = "../"
+)
+
+// PickFunc gets called with the selected file path.
+type PickFunc func(path string)
+
+// FilePicker represents a file selection dialog.
+type FilePicker struct {
+	*tview.List
+
+	pages   *ui.Pages
+	dir     string
+	entries []string
+	ok      PickFunc
+}
+
+// ShowFilePicker pops a file picker starting at the given directory. Cancel
+// is called when the dialog is dismissed without a selection.
+func ShowFilePicker(styles config.Dialog, pages *ui.Pages, dir string, ok PickFunc, cancel cancelFunc) (*FilePicker, error) {
+	p := FilePicker{
+		List:  tview.NewList(),
+		pages: pages,
+		ok:    ok,
+	}
+	p.SetBorder(true)
+	p.ShowSecondaryText(false)
+	p.SetMainTextColor(styles.FgColor.Color())
+	p.SetBackgroundColor(styles.BgColor.Color())
+	p.SetSelectedBackgroundColor(styles.ButtonFocusBgColor.Color())
+	p.SetSelectedTextColor(styles.ButtonFocusFgColor.Color())
+	p.SetSelectedFunc(func(i int, _, _ string, _ rune) {
+		p.pick(i)
+	})
+	p.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if evt.Key() == tcell.KeyEscape {
+			p.Dismiss()
+			cancel()
+			return nil
+		}
+		return evt
+	})
+	if err := p.load(dir); err != nil {
+		return nil, err
+	}
+	pages.AddPage(filePickerKey, &p, true, false)
+	pages.ShowPage(filePickerKey)
+
+	return &p, nil
+}
+
+// Dir returns the current directory.
+func (p *FilePicker) Dir() string {
+	return p.dir
+}
+
+// Entries returns the current directory entries.
+func (p *FilePicker) Entries() []string {
+	return p.entries
+}
+
+// Dismiss closes the dialog.
+func (p *FilePicker) Dismiss() {
+	p.pages.RemovePage(filePickerKey)
+}
+
+func (p *FilePicker) pick(i int) {
+	if i < 0 || i >= len(p.entries) {
+		return
+	}
+	e := p.entries[i]
+	if strings.HasSuffix(e, "/") {
+		if err := p.load(filepath.Join(p.dir, e)); err != nil {
+			p.SetTitle(" [red::b]" + err.Error() + " ")
+		}
+		return
+	}
+	p.Dismiss()
+	p.ok(filepath.Join(p.dir, e))
+}
+
+func (p *FilePicker) load(dir string) error {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var dd, fs []string
+	for _, f := range ff {
+		if f.IsDir() {
+			dd = append(dd, f.Name()+"/")
+			continue
+		}
+		fs = append(fs, f.Name())
+	}
+	sort.Strings(dd)
+	sort.Strings(fs)
+
+	p.dir, p.entries = dir, make([]string, 0, len(dd)+len(fs)+1)
+	if filepath.Dir(dir) != dir {
+		p.entries = append(p.entries, parentDir)
+	}
+	p.entries = append(p.entries, dd...)
+	p.entries = append(p.entries, fs...)
+
+	p.Clear()
+	for _, e := range p.entries {
+		p.AddItem(e, "", 0, nil)
+	}
+	p.SetTitle(" <Select File> " + dir + " ")
+
+	return nil
+}
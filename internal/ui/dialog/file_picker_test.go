@@ -0,0 +1,42 @@
+package dialog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilePicker(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-picker")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.Mkdir(filepath.Join(dir, "sub"), 0700))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "b.pem"), []byte("b"), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "sub", "a.pem"), []byte("a"), 0600))
+
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var picked string
+	fp, err := ShowFilePicker(config.Dialog{}, p, dir, func(path string) {
+		picked = path
+	}, func() {})
+	assert.Nil(t, err)
+	assert.NotNil(t, p.GetPrimitive(filePickerKey))
+	assert.Equal(t, []string{"../", "sub/", "b.pem"}, fp.Entries())
+
+	fp.pick(1)
+	assert.Equal(t, filepath.Join(dir, "sub"), fp.Dir())
+	assert.Equal(t, []string{"../", "a.pem"}, fp.Entries())
+
+	fp.pick(1)
+	assert.Equal(t, filepath.Join(dir, "sub", "a.pem"), picked)
+	assert.Nil(t, p.GetPrimitive(filePickerKey))
+}
@@ -0,0 +1,113 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	progressKey   = "progress"
+	progressWidth = 30
+	maxFailures   = 5
+)
+
+// Progress represents a long running operation progress dialog.
+type Progress struct {
+	pages    *ui.Pages
+	modal    *tview.ModalForm
+	title    string
+	total    int
+	done     int
+	failures []string
+}
+
+// ShowProgress pops a progress dialog. Cancel is called when the operation
+// is aborted or the dialog is dismissed.
+func ShowProgress(styles config.Dialog, pages *ui.Pages, title string, total int, cancel cancelFunc) *Progress {
+	p := Progress{
+		pages: pages,
+		title: title,
+		total: total,
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton("Cancel", func() {
+		p.Dismiss()
+		cancel()
+	})
+	if b := f.GetButton(0); b != nil {
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	p.modal = tview.NewModalForm("<"+title+">", f)
+	p.modal.SetTextColor(styles.FgColor.Color())
+	p.modal.SetBackgroundColor(styles.BgColor.Color())
+	p.modal.SetDoneFunc(func(int, string) {
+		p.Dismiss()
+		cancel()
+	})
+	p.modal.SetText(p.String())
+	pages.AddPage(progressKey, p.modal, false, false)
+	pages.ShowPage(progressKey)
+
+	return &p
+}
+
+// Report records an individual operation outcome.
+func (p *Progress) Report(path string, err error) {
+	p.done++
+	if err != nil {
+		p.failures = append(p.failures, fmt.Sprintf("%s: %s", path, err))
+	}
+	p.modal.SetText(p.String())
+}
+
+// Failures returns the number of failed operations.
+func (p *Progress) Failures() int {
+	return len(p.failures)
+}
+
+// Dismiss closes the dialog.
+func (p *Progress) Dismiss() {
+	p.pages.RemovePage(progressKey)
+}
+
+// String returns the dialog content.
+func (p *Progress) String() string {
+	var perc int
+	if p.total > 0 {
+		perc = p.done * 100 / p.total
+	}
+	ticks := perc * progressWidth / 100
+	s := fmt.Sprintf("[%s%s] %d/%d (%d%%)",
+		strings.Repeat("█", ticks),
+		strings.Repeat("░", progressWidth-ticks),
+		p.done, p.total, perc,
+	)
+	if len(p.failures) == 0 {
+		return s
+	}
+
+	s += fmt.Sprintf("\n%d failed", len(p.failures))
+	for i, f := range p.failures {
+		if i >= maxFailures {
+			s += "\n..."
+			break
+		}
+		s += "\n" + f
+	}
+
+	return s
+}
@@ -0,0 +1,29 @@
+package dialog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	pr := ShowProgress(config.Dialog{}, p, "Blee", 4, func() {})
+	assert.NotNil(t, p.GetPrimitive(progressKey).(*tview.ModalForm))
+	assert.Equal(t, "[░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░] 0/4 (0%)", pr.String())
+
+	pr.Report("ns1/a", nil)
+	pr.Report("ns1/b", errors.New("boom"))
+	assert.Equal(t, 1, pr.Failures())
+	assert.Equal(t, "[███████████████░░░░░░░░░░░░░░░] 2/4 (50%)\n1 failed\nns1/b: boom", pr.String())
+
+	pr.Dismiss()
+	assert.Nil(t, p.GetPrimitive(progressKey))
+}
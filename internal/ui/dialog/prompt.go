@@ -0,0 +1,71 @@
+package dialog
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const promptKey = "prompt"
+
+type (
+	promptFunc func(string)
+)
+
+// ShowPrompt pops a dialog asking for a single value.
+func ShowPrompt(styles config.Dialog, pages *ui.Pages, title, label, value string, ack promptFunc, cancel cancelFunc) {
+	ShowPromptMsg(styles, pages, title, "", label, value, ack, cancel)
+}
+
+// ShowPromptMsg pops a dialog asking for a single value with an explanatory message.
+func ShowPromptMsg(styles config.Dialog, pages *ui.Pages, title, msg, label, value string, ack promptFunc, cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddInputField(label, value, 50, nil, func(changed string) {
+		value = changed
+	})
+	f.AddButton("Cancel", func() {
+		dismissPrompt(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		dismissPrompt(pages)
+		if v := strings.TrimSpace(value); v != "" {
+			ack(v)
+		}
+		cancel()
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	if msg != "" {
+		modal.SetText(msg)
+	}
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismissPrompt(pages)
+		cancel()
+	})
+	pages.AddPage(promptKey, modal, false, false)
+	pages.ShowPage(promptKey)
+}
+
+func dismissPrompt(pages *ui.Pages) {
+	pages.RemovePage(promptKey)
+}
@@ -0,0 +1,42 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromptDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ackFunc := func(string) {
+		assert.True(t, true)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowPrompt(config.Dialog{}, p, "Blee", "Path:", "/tmp/fred.log", ackFunc, caFunc)
+
+	d := p.GetPrimitive(promptKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissPrompt(p)
+	assert.Nil(t, p.GetPrimitive(promptKey))
+}
+
+func TestPromptMsgDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowPromptMsg(config.Dialog{}, p, "Blee", "Pick one of 1, 2", "Rev:", "1", func(string) {}, func() {})
+	assert.NotNil(t, p.GetPrimitive(promptKey).(*tview.ModalForm))
+
+	dismissPrompt(p)
+	assert.Nil(t, p.GetPrimitive(promptKey))
+}
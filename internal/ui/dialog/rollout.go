@@ -0,0 +1,112 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const rolloutKey = "rollout"
+
+// Rollout represents a workload rollout progress dialog.
+type Rollout struct {
+	pages *ui.Pages
+	modal *tview.ModalForm
+	path  string
+}
+
+// ShowRollout pops a rollout progress dialog. Cancel is called when the
+// dialog is dismissed.
+func ShowRollout(styles config.Dialog, pages *ui.Pages, path string, cancel cancelFunc) *Rollout {
+	r := Rollout{
+		pages: pages,
+		path:  path,
+	}
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddButton("Dismiss", func() {
+		r.Dismiss()
+		cancel()
+	})
+	if b := f.GetButton(0); b != nil {
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	r.modal = tview.NewModalForm("<Rollout "+path+">", f)
+	r.modal.SetTextColor(styles.FgColor.Color())
+	r.modal.SetBackgroundColor(styles.BgColor.Color())
+	r.modal.SetDoneFunc(func(int, string) {
+		r.Dismiss()
+		cancel()
+	})
+	r.modal.SetText("Waiting for rollout...")
+	pages.AddPage(rolloutKey, r.modal, false, false)
+	pages.ShowPage(rolloutKey)
+
+	return &r
+}
+
+// Update refreshes the dialog with the latest rollout status.
+func (r *Rollout) Update(s model.RolloutStatus, err error) {
+	if err != nil {
+		r.modal.SetText(fmt.Sprintf("Rollout status failed: %s", err))
+		return
+	}
+	r.modal.SetText(RolloutString(s, time.Now()))
+}
+
+// Dismiss closes the dialog.
+func (r *Rollout) Dismiss() {
+	r.pages.RemovePage(rolloutKey)
+}
+
+// RolloutString returns a rollout status progress report.
+func RolloutString(s model.RolloutStatus, now time.Time) string {
+	var perc int32
+	if s.Desired > 0 {
+		perc = s.Progress() * 100 / s.Desired
+	}
+	ticks := int(perc) * progressWidth / 100
+	lines := []string{
+		fmt.Sprintf("[%s%s] %d/%d (%d%%)",
+			strings.Repeat("█", ticks),
+			strings.Repeat("░", progressWidth-ticks),
+			s.Progress(), s.Desired, perc,
+		),
+		fmt.Sprintf("Updated: %d Ready: %d Available: %d Old: %d", s.Updated, s.Ready, s.Available, s.Old),
+	}
+	if s.Revision != "" {
+		lines = append(lines, "Revision: "+s.Revision)
+	}
+	switch {
+	case s.Paused:
+		lines = append(lines, "Rollout is paused")
+	case s.Done():
+		lines = append(lines, fmt.Sprintf("Completed in %s", now.Sub(s.Started).Round(time.Second)))
+	case !s.Observed:
+		lines = append(lines, "Waiting for rollout...")
+	default:
+		if eta, ok := s.ETA(now); ok {
+			lines = append(lines, fmt.Sprintf("ETA %s", eta.Round(time.Second)))
+		}
+	}
+	lines = append(lines, s.Conditions...)
+	for _, f := range s.Failures {
+		lines = append(lines, f)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,77 @@
+package dialog
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	r := ShowRollout(config.Dialog{}, p, "default/fred", func() {})
+	assert.NotNil(t, p.GetPrimitive(rolloutKey).(*tview.ModalForm))
+	r.Update(model.RolloutStatus{}, errors.New("boom"))
+
+	r.Dismiss()
+	assert.Nil(t, p.GetPrimitive(rolloutKey))
+}
+
+func TestRolloutString(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		s model.RolloutStatus
+		e string
+	}{
+		"pending": {
+			s: model.RolloutStatus{Desired: 2, Updated: 2, Ready: 2, Started: now},
+			e: "[░░░░░░░░░░░░░░░░░░░░░░░░░░░░░░] 0/2 (0%)\nUpdated: 2 Ready: 2 Available: 0 Old: 0\nWaiting for rollout...",
+		},
+		"inProgress": {
+			s: model.RolloutStatus{
+				Desired:  4,
+				Updated:  2,
+				Ready:    4,
+				Old:      2,
+				Observed: true,
+				Started:  now.Add(-time.Minute),
+				Failures: []string{"fred-1 c1: CrashLoopBackOff"},
+			},
+			e: "[███████████████░░░░░░░░░░░░░░░] 2/4 (50%)\nUpdated: 2 Ready: 4 Available: 0 Old: 2\nETA 1m0s\nfred-1 c1: CrashLoopBackOff",
+		},
+		"done": {
+			s: model.RolloutStatus{Desired: 1, Updated: 1, Ready: 1, Observed: true, Started: now.Add(-10 * time.Second)},
+			e: "[██████████████████████████████] 1/1 (100%)\nUpdated: 1 Ready: 1 Available: 0 Old: 0\nCompleted in 10s",
+		},
+		"paused": {
+			s: model.RolloutStatus{
+				Desired:    2,
+				Updated:    1,
+				Ready:      2,
+				Available:  2,
+				Old:        1,
+				Observed:   true,
+				Paused:     true,
+				Revision:   "3",
+				Conditions: []string{"Progressing=Unknown (DeploymentPaused)"},
+				Started:    now,
+			},
+			e: "[███████████████░░░░░░░░░░░░░░░] 1/2 (50%)\nUpdated: 1 Ready: 2 Available: 2 Old: 1\nRevision: 3\nRollout is paused\nProgressing=Unknown (DeploymentPaused)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, RolloutString(u.s, now))
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const (
+var (
 	emoHappy = "😎"
 	emoDoh   = "😗"
 	emoRed   = "😡"
@@ -82,7 +82,10 @@ func (f *Flash) SetMessage(m model.LevelMessage) {
 }
 
 func (f *Flash) flashEmoji(l model.FlashLevel) string {
-	if f.app.Config.K9s.NoIcons {
+	if f.app.Config.K9s.IsScreenReader() {
+		return flashLabel(l)
+	}
+	if f.app.Config.K9s.IsNoIcons() {
 		return ""
 	}
 	switch l {
@@ -97,6 +100,18 @@ func (f *Flash) flashEmoji(l model.FlashLevel) string {
 
 // Helpers...
 
+// flashLabel spells out a flash level so it is not conveyed by color alone.
+func flashLabel(l model.FlashLevel) string {
+	switch l {
+	case model.FlashWarn:
+		return "Warning:"
+	case model.FlashErr:
+		return "Error:"
+	default:
+		return "Info:"
+	}
+}
+
 func flashColor(l model.FlashLevel) tcell.Color {
 	switch l {
 	case model.FlashWarn:
@@ -54,7 +54,7 @@ func (s *StatusIndicator) ClusterInfoUpdated(data model.ClusterMeta) {
 			statusIndicatorFmt,
 			data.K9sVer,
 			data.Cluster,
-			data.User,
+			identity(data),
 			data.K8sVer,
 			render.PrintPerc(data.Cpu),
 			render.PrintPerc(data.Mem),
@@ -72,7 +72,7 @@ func (s *StatusIndicator) ClusterInfoChanged(prev, cur model.ClusterMeta) {
 			statusIndicatorFmt,
 			cur.K9sVer,
 			cur.Cluster,
-			cur.User,
+			identity(cur),
 			cur.K8sVer,
 			AsPercDelta(prev.Cpu, cur.Cpu),
 			AsPercDelta(prev.Cpu, cur.Mem),
@@ -80,6 +80,15 @@ func (s *StatusIndicator) ClusterInfoChanged(prev, cur model.ClusterMeta) {
 	})
 }
 
+// identity returns the active user, highlighting impersonations.
+func identity(m model.ClusterMeta) string {
+	if m.Impersonate == "" {
+		return m.User
+	}
+
+	return "[orangered::b]as " + m.Impersonate + "[white::-]"
+}
+
 // SetPermanent sets permanent title to be reset to after updates
 func (s *StatusIndicator) SetPermanent(info string) {
 	s.permanent = info
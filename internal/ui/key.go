@@ -10,6 +10,8 @@ func initKeys() {
 	tcell.KeyNames[tcell.Key(KeyHelp)] = "?"
 	tcell.KeyNames[tcell.Key(KeySlash)] = "/"
 	tcell.KeyNames[tcell.Key(KeySpace)] = "space"
+	tcell.KeyNames[tcell.Key(KeyLeftBracket)] = "["
+	tcell.KeyNames[tcell.Key(KeyRightBracket)] = "]"
 
 	initNumbKeys()
 	initStdKeys()
@@ -73,10 +75,12 @@ const (
 	KeyX
 	KeyY
 	KeyZ
-	KeyHelp  = 63
-	KeySlash = 47
-	KeyColon = 58
-	KeySpace = 32
+	KeyHelp         = 63
+	KeySlash        = 47
+	KeyColon        = 58
+	KeySpace        = 32
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
 )
 
 // Define Shift Keys
@@ -11,6 +11,7 @@ type SelectTable struct {
 
 	model      Tabular
 	selectedFn func(string) string
+	selRowFn   SelectedRowFunc
 	marks      map[string]struct{}
 	fgColor    tcell.Color
 }
@@ -89,6 +90,11 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetSelectedRowFn defines a function called when the selected row changes.
+func (s *SelectTable) SetSelectedRowFn(f SelectedRowFunc) {
+	s.selRowFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	r, _ := s.GetSelection()
@@ -116,6 +122,9 @@ func (s *SelectTable) selectionChanged(r, c int) {
 	}
 	cell := s.GetCell(r, c)
 	s.SetSelectedStyle(s.fgColor, cell.Color, tcell.AttrBold)
+	if s.selRowFn != nil {
+		s.selRowFn(r)
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -31,6 +31,8 @@ type (
 type Table struct {
 	gvr     client.GVR
 	sortCol SortColumn
+	sorter  *render.RowEventsSorter
+	index   *render.FilterIndex
 	header  render.Header
 	Path    string
 	Extras  string
@@ -44,6 +46,12 @@ type Table struct {
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	accessible  bool
+	stateFn     func(string) string
+	announceFn  AnnounceFunc
+	announced   string
+	visibleCols []string
+	progress    string
 }
 
 // NewTable returns a new table view.
@@ -58,6 +66,8 @@ func NewTable(gvr client.GVR) *Table {
 		actions: make(KeyActions),
 		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
 		sortCol: SortColumn{asc: true},
+		sorter:  render.NewRowEventsSorter(),
+		index:   render.NewFilterIndex(),
 	}
 }
 
@@ -89,9 +99,29 @@ func (t *Table) GVR() client.GVR { return t.gvr }
 // ViewSettingsChanged notifies listener the view configuration changed.
 func (t *Table) ViewSettingsChanged(settings config.ViewSetting) {
 	t.viewSetting = &settings
+	if m, ok := t.GetModel().(Customizable); ok {
+		m.SetCustomColumns(CustomColumns(settings.CustomColumns))
+		m.SetConditionType(settings.Condition)
+	}
 	t.Refresh()
 }
 
+// CustomColumns returns the renderable user defined columns. Invalid
+// column definitions are skipped.
+func CustomColumns(specs []config.CustomColumn) render.CustomColumns {
+	cc := make(render.CustomColumns, 0, len(specs))
+	for _, s := range specs {
+		c, err := render.NewCustomColumn(s.Name, s.JSONPath, s.Wide)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping custom column")
+			continue
+		}
+		cc = append(cc, c)
+	}
+
+	return cc
+}
+
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
 	t.SetBackgroundColor(s.Table().BgColor.Color())
@@ -124,6 +154,16 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// SetWide sets wide col display.
+func (t *Table) SetWide(b bool) {
+	t.wide = b
+}
+
+// IsWide returns true if wide cols are displayed.
+func (t *Table) IsWide() bool {
+	return t.wide
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() KeyActions {
 	return t.actions
@@ -186,9 +226,15 @@ func (t *Table) SetSortCol(name string, asc bool) {
 	t.sortCol.name, t.sortCol.asc = name, asc
 }
 
+// SortCol returns the current sort column and order.
+func (t *Table) SortCol() (string, bool) {
+	return t.sortCol.name, t.sortCol.asc
+}
+
 // Update table content.
 func (t *Table) Update(data render.TableData) {
 	t.header = data.Header
+	t.progress = loadingTitle(data)
 	if t.decorateFn != nil {
 		data = t.decorateFn(data)
 	}
@@ -216,11 +262,7 @@ func (t *Table) doUpdate(data render.TableData) {
 		t.sortCol.name = custData.Header[0].Name
 	}
 
-	t.Clear()
-	fg := t.styles.Table().Header.FgColor.Color()
-	bg := t.styles.Table().Header.BgColor.Color()
-
-	var col int
+	hh := make(render.Header, 0, len(custData.Header))
 	for _, h := range custData.Header {
 		if h.Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
 			continue
@@ -228,14 +270,30 @@ func (t *Table) doUpdate(data render.TableData) {
 		if h.MX && !t.hasMetrics {
 			continue
 		}
+		hh = append(hh, h)
+	}
+	t.visibleCols = t.visibleCols[:0]
+	for _, h := range hh {
+		t.visibleCols = append(t.visibleCols, h.Name)
+	}
+	if t.stateFn != nil {
+		hh = append(hh, render.HeaderColumn{Name: StateColumn})
+	}
+	// Column layout changed. Start from a clean slate.
+	if t.GetColumnCount() != len(hh) {
+		t.Clear()
+	}
+	fg := t.styles.Table().Header.FgColor.Color()
+	bg := t.styles.Table().Header.BgColor.Color()
+	for col, h := range hh {
 		t.AddHeaderCell(col, h)
 		c := t.GetCell(0, col)
 		c.SetBackgroundColor(bg)
 		c.SetTextColor(fg)
-		col++
 	}
 	colIndex := custData.Header.IndexOf(t.sortCol.name, false)
-	custData.RowEvents.Sort(
+	t.sorter.Sort(
+		custData.RowEvents,
 		custData.Namespace,
 		colIndex,
 		t.sortCol.name == "AGE",
@@ -245,9 +303,12 @@ func (t *Table) doUpdate(data render.TableData) {
 
 	pads := make(MaxyPad, len(custData.Header))
 	ComputeMaxColumns(pads, t.sortCol.name, custData.Header, custData.RowEvents)
+	index := data.RowEvents.IndexByID()
 	for row, re := range custData.RowEvents {
-		idx, _ := data.RowEvents.FindIndex(re.Row.ID)
-		t.buildRow(row+1, re, data.RowEvents[idx], custData.Header, pads)
+		t.buildRow(row+1, re, data.RowEvents[index[re.Row.ID]], custData.Header, pads)
+	}
+	for r := t.GetRowCount() - 1; r > len(custData.RowEvents); r-- {
+		t.RemoveRow(r)
 	}
 	t.updateSelection(true)
 }
@@ -284,20 +345,52 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 			field = formatCell(field, pads[c])
 		}
 
-		cell := tview.NewTableCell(field)
-		cell.SetExpansion(1)
-		cell.SetAlign(h[c].Align)
 		fgColor := color(t.GetModel().GetNamespace(), t.header, ore)
-		cell.SetTextColor(fgColor)
 		if marked {
-			cell.SetTextColor(t.styles.Table().MarkColor.Color())
+			fgColor = t.styles.Table().MarkColor.Color()
 		}
+		var ref interface{}
 		if col == 0 {
-			cell.SetReference(re.Row.ID)
+			ref = re.Row.ID
 		}
-		t.SetCell(r, col, cell)
+		t.updateCell(r, col, field, h[c].Align, fgColor, ref)
 		col++
 	}
+	if t.stateFn != nil {
+		fgColor := color(t.GetModel().GetNamespace(), t.header, ore)
+		t.updateCell(r, col, t.rowState(render.StatusLabel(fgColor), marked), tview.AlignLeft, fgColor, nil)
+	}
+}
+
+// updateCell reuses a previously rendered cell and only touches the
+// attributes that changed, sparing large tables a full rebuild.
+func (t *Table) updateCell(r, c int, field string, align int, fg tcell.Color, ref interface{}) {
+	var cell *tview.TableCell
+	if r < t.GetRowCount() {
+		cell = t.GetCell(r, c)
+	}
+	if cell == nil {
+		cell = tview.NewTableCell(field)
+	}
+	if cell.Text != field {
+		cell.SetText(field)
+	}
+	if cell.Expansion != 1 {
+		cell.SetExpansion(1)
+	}
+	if cell.Align != align {
+		cell.SetAlign(align)
+	}
+	if cell.Color != fg {
+		cell.SetTextColor(fg)
+	}
+	if cell.Attributes != tcell.AttrNone {
+		cell.SetAttributes(tcell.AttrNone)
+	}
+	if cell.GetReference() != ref {
+		cell.SetReference(ref)
+	}
+	t.SetCell(r, c, cell)
 }
 
 // SortColCmd designates a sorted column.
@@ -382,7 +475,7 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 		return fuzzyFilter(q[2:], filtered)
 	}
 
-	filtered, err := rxFilter(q, IsInverseSelector(q), filtered)
+	filtered, err := t.index.Filter(q, IsInverseSelector(q), filtered)
 	if err != nil {
 		log.Error().Err(errors.New("Invalid filter expression")).Msg("Regexp")
 		t.cmdBuff.ClearText(true)
@@ -439,6 +532,9 @@ func (t *Table) styleTitle() string {
 	} else {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
+	if t.progress != "" {
+		title += SkinTitle(t.progress, t.styles.Frame())
+	}
 
 	buff := t.cmdBuff.GetText()
 	if buff == "" {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -20,6 +21,9 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// LoadingFmt represents a paginated list progress title.
+	LoadingFmt = "<[count:bg:b]loading %s…[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
@@ -108,6 +112,20 @@ func SkinTitle(fmat string, style config.Frame) string {
 	return fmat
 }
 
+// loadingTitle returns a paginated list progress or blank once loaded.
+func loadingTitle(data render.TableData) string {
+	if !data.Loading {
+		return ""
+	}
+	n := len(data.RowEvents)
+	p := strconv.Itoa(n)
+	if data.Total > n {
+		p += fmt.Sprintf(" of ~%d", data.Total)
+	}
+
+	return fmt.Sprintf(LoadingFmt, p)
+}
+
 func sortIndicator(sort, asc bool, style config.Table, name string) string {
 	if !sort {
 		return name
@@ -147,31 +165,6 @@ func filterToast(data render.TableData) render.TableData {
 	return toast
 }
 
-func rxFilter(q string, inverse bool, data render.TableData) (render.TableData, error) {
-	if inverse {
-		q = q[1:]
-	}
-	rx, err := regexp.Compile(`(?i)(` + q + `)`)
-	if err != nil {
-		return data, err
-	}
-
-	filtered := render.TableData{
-		Header:    data.Header,
-		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
-		Namespace: data.Namespace,
-	}
-	for _, re := range data.RowEvents {
-		fields := strings.Join(re.Row.Fields, " ")
-		if (inverse && !rx.MatchString(fields)) ||
-			((!inverse) && rx.MatchString(fields)) {
-			filtered.RowEvents = append(filtered.RowEvents, re)
-		}
-	}
-
-	return filtered, nil
-}
-
 func fuzzyFilter(q string, data render.TableData) render.TableData {
 	q = strings.TrimSpace(q)
 	ss := make([]string, 0, len(data.RowEvents))
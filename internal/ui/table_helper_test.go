@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +41,22 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadingTitle(t *testing.T) {
+	rr := render.RowEvents{{Row: render.Row{ID: "a"}}, {Row: render.Row{ID: "b"}}}
+	uu := map[string]struct {
+		data render.TableData
+		e    string
+	}{
+		"loaded":  {render.TableData{RowEvents: rr}, ""},
+		"total":   {render.TableData{RowEvents: rr, Loading: true, Total: 500}, "<[count:bg:b]loading 2 of ~500…[fg:bg:-]> "},
+		"noTotal": {render.TableData{RowEvents: rr, Loading: true}, "<[count:bg:b]loading 2…[fg:bg:-]> "},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, loadingTitle(u.data))
+		})
+	}
+}
@@ -33,6 +33,22 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(t, len(data.Header), v.GetColumnCount())
 }
 
+func TestTableUpdateShrink(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+
+	data := makeTableData()
+	v.Update(data)
+	c := v.GetCell(1, 0)
+
+	data.RowEvents = data.RowEvents[:1]
+	v.Update(data)
+
+	assert.Equal(t, len(data.RowEvents)+1, v.GetRowCount())
+	assert.Equal(t, len(data.Header), v.GetColumnCount())
+	assert.Equal(t, c, v.GetCell(1, 0))
+}
+
 func TestTableSelection(t *testing.T) {
 	v := ui.NewTable(client.NewGVR("fred"))
 	v.Init(makeContext())
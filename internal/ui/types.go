@@ -41,6 +41,15 @@ type Lister interface {
 	Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
+// Customizable represents a model supporting user defined columns.
+type Customizable interface {
+	// SetCustomColumns sets user defined columns.
+	SetCustomColumns(render.CustomColumns)
+
+	// SetConditionType sets the status condition type to summarize.
+	SetConditionType(string)
+}
+
 // Tabular represents a tabular model.
 type Tabular interface {
 	Namespaceable
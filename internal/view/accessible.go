@@ -0,0 +1,61 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// statusSummaryCmd announces a plain text summary of the current view.
+func (a *App) statusSummaryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Prompt().InCmdMode() {
+		return evt
+	}
+	a.Flash().Info(a.statusSummary())
+
+	return nil
+}
+
+func (a *App) statusSummary() string {
+	ns := a.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+	ss := []string{
+		"Context " + a.Config.K9s.CurrentContext,
+		"Cluster " + a.Config.K9s.CurrentCluster,
+		"Namespace " + ns,
+	}
+	if a.Config.K9s.IsReadOnly() {
+		ss = append(ss, "Read only")
+	}
+	if dao.IsDryRun() {
+		ss = append(ss, "Dry run")
+	}
+	if !a.ConOK() {
+		ss = append(ss, "Disconnected")
+	}
+
+	top := a.Content.Top()
+	if top == nil {
+		return strings.Join(ss, ", ")
+	}
+	ss = append(ss, "View "+top.Name())
+	v, ok := top.(TableViewer)
+	if !ok {
+		return strings.Join(ss, ", ")
+	}
+	t := v.GetTable()
+	ss = append(ss, ui.StatusSummary(t.StatusCounts()))
+	if f := t.CmdBuff().GetText(); f != "" {
+		ss = append(ss, "Filter "+f)
+	}
+	if row := t.SelectedRowText(); row != "" {
+		ss = append(ss, "Selected "+row)
+	}
+
+	return strings.Join(ss, ", ")
+}
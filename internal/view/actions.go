@@ -1,10 +1,14 @@
 package view
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
@@ -107,14 +111,18 @@ func pluginActions(r Runner, aa ui.KeyActions) {
 			log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
 			continue
 		}
+		if err := plugin.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Skipping plugin %q", k)
+			continue
+		}
 		aa[key] = ui.NewKeyAction(
 			plugin.Description,
-			pluginAction(r, plugin),
+			pluginAction(r, k, plugin),
 			true)
 	}
 }
 
-func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
+func pluginAction(r Runner, name string, p config.Plugin) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := r.GetSelectedItem()
 		if path == "" {
@@ -124,9 +132,10 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			return nil
 		}
 
+		env := r.EnvFn()()
 		args := make([]string, len(p.Args))
 		for i, a := range p.Args {
-			arg, err := r.EnvFn()().Substitute(a)
+			arg, err := env.Substitute(a)
 			if err != nil {
 				log.Error().Err(err).Msg("Plugin Args match failed")
 				return nil
@@ -135,20 +144,21 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 		}
 
 		cb := func() {
-			opts := shellOpts{
-				clear:      true,
-				binary:     p.Command,
-				background: p.Background,
-				args:       args,
+			switch {
+			case p.Output != "":
+				runPluginOutput(r, p, path, args)
+			case p.Job:
+				runPluginJob(r, name, p, path, args)
+			default:
+				runPlugin(r, p, path, args)
 			}
-			if run(r.App(), opts) {
-				r.App().Flash().Info("Plugin command launched successfully!")
-				return
-			}
-			r.App().Flash().Info("Plugin command failed!")
 		}
-		if p.Confirm {
-			msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
+		if p.NeedsConfirm() {
+			msg, err := pluginConfirmMsg(env, p, args)
+			if err != nil {
+				r.App().Flash().Err(err)
+				return nil
+			}
 			dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+p.Description, msg, cb, func() {})
 			return nil
 		}
@@ -157,3 +167,91 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 		return nil
 	}
 }
+
+// pluginConfirmMsg returns a plugin confirmation message.
+func pluginConfirmMsg(env Env, p config.Plugin, args []string) (string, error) {
+	if p.ConfirmMessage == "" {
+		return fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " ")), nil
+	}
+
+	return env.Substitute(p.ConfirmMessage)
+}
+
+func runPlugin(r Runner, p config.Plugin, path string, args []string) {
+	opts := shellOpts{
+		clear:      true,
+		binary:     config.ExpandHome(p.Command),
+		background: p.Background,
+		args:       args,
+	}
+	ok := run(r.App(), opts)
+	var err error
+	if !ok {
+		err = errors.New("plugin command failed")
+	}
+	auditPlugin(r, p, path, args, err)
+	if ok {
+		r.App().Flash().Info("Plugin command launched successfully!")
+		return
+	}
+	r.App().Flash().Info("Plugin command failed!")
+}
+
+// runPluginOutput runs a plugin without suspending the ui and displays its
+// output once it completes.
+func runPluginOutput(r Runner, p config.Plugin, path string, args []string) {
+	r.App().Flash().Infof("Running %s...", p.Description)
+	go func() {
+		out, err := oneShoot(shellOpts{binary: config.ExpandHome(p.Command), args: args})
+		r.App().QueueUpdateDraw(func() {
+			if err != nil && out != "" {
+				err = errors.New(lastLine(out))
+			}
+			auditPlugin(r, p, path, args, err)
+			if err != nil {
+				r.App().Flash().Errf("%s failed: %s", p.Description, err)
+				return
+			}
+			var v model.Component
+			switch p.Output {
+			case config.PluginTableOutput:
+				v = NewPluginOutput(p.Description+" "+path, out)
+			default:
+				v = NewDetails(r.App(), p.Description, path, true).Update(out)
+			}
+			if err := r.App().inject(v); err != nil {
+				r.App().Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// runPluginJob runs a plugin as a tracked background job.
+func runPluginJob(r Runner, name string, p config.Plugin, path string, args []string) {
+	app := r.App()
+	id := app.pluginJobs.Start(name, config.ExpandHome(p.Command), args, func(j render.PluginJobRes) {
+		app.QueueUpdateDraw(func() {
+			var err error
+			if j.Status != render.JobSucceeded {
+				err = fmt.Errorf("plugin job %s %s", j.ID, strings.ToLower(j.Status))
+			}
+			auditPlugin(r, p, path, args, err)
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("Plugin job %s succeeded", j.ID)
+		})
+	})
+	app.Flash().Infof("Plugin job %s started. Use :pluginjobs to track it", id)
+}
+
+func auditPlugin(r Runner, p config.Plugin, path string, args []string, err error) {
+	var gvr string
+	if v, ok := r.(ResourceViewer); ok {
+		gvr = v.GVR().String()
+	}
+	e := dao.NewAuditEntry("plugin", gvr, path, err)
+	e.Details = strings.TrimSpace(p.Command + " " + strings.Join(args, " "))
+	r.App().audit(e)
+}
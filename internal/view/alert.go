@@ -0,0 +1,92 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Alert presents a watchdog alerts viewer.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new viewer.
+func NewAlert(gvr client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorOrangeRed)
+	a.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorOrangeRed, tcell.AttrNone)
+	a.GetTable().SetColorerFn(render.Alert{}.ColorerFunc())
+	a.GetTable().SetSortCol(ageCol, true)
+	a.SetContextFn(a.alertContext)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+func (a *Alert) alertContext(ctx context.Context) context.Context {
+	if a.App().watchdog == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, internal.KeyAlerts, a.App().watchdog)
+}
+
+func (a *Alert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Dismiss", a.dismissCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Reason", a.GetTable().SortColCmd("REASON", true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Count", a.GetTable().SortColCmd("COUNT", false), false),
+	})
+}
+
+func (a *Alert) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetTable().GetSelectedItem()
+	if id == "" || a.App().watchdog == nil {
+		return evt
+	}
+	al, ok := a.App().watchdog.Alert(id)
+	if !ok {
+		return evt
+	}
+	if err := a.App().gotoResource(strings.ToLower(al.Kind), al.Path, false); err != nil {
+		a.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// alertGVR returns the resource an alert refers to.
+func alertGVR(kind string) string {
+	switch kind {
+	case "Pod":
+		return "v1/pods"
+	case "Node":
+		return "v1/nodes"
+	case "Job":
+		return "batch/v1/jobs"
+	default:
+		return strings.ToLower(kind)
+	}
+}
+
+func (a *Alert) dismissCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := a.GetTable().GetSelectedItem()
+	if path == "" || a.App().watchdog == nil {
+		return evt
+	}
+	a.App().watchdog.Dismiss(path)
+	a.App().Flash().Infof("Alert %s dismissed", path)
+	a.Refresh()
+
+	return nil
+}
@@ -43,7 +43,7 @@ func (a *Alias) Init(ctx context.Context) error {
 }
 
 func (a *Alias) aliasContext(ctx context.Context) context.Context {
-	return context.WithValue(ctx, internal.KeyAliases, a.App().command.alias)
+	return context.WithValue(ctx, internal.KeyAliases, a.App().command.aliases())
 }
 
 func (a *Alias) bindKeys(aa ui.KeyActions) {
@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -16,7 +17,9 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
@@ -28,9 +31,13 @@ import (
 // ExitStatus indicates UI exit conditions.
 var ExitStatus = ""
 
+var spinner = []rune{'⠋', '⠙', '⠹', '⠸', '⠼', '⠴', '⠦', '⠧', '⠇', '⠏'}
+
 const (
 	splashDelay      = 1 * time.Second
+	spinnerRate      = 100 * time.Millisecond
 	clusterRefresh   = 15 * time.Second
+	throttleRefresh  = 1 * time.Second
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
 )
@@ -44,9 +51,26 @@ type App struct {
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
+	mxCache       *model.MetricsCache
+	vulnCache     *model.VulnCache
+	auditor       *dao.Auditor
+	recorder      *dao.Recorder
+	notifier      *dao.Notifier
+	watches       *model.WatchList
+	watchdog      *model.Watchdog
+	stats         *model.SessionStats
+	pluginJobs    *dao.PluginJobs
 	cmdHistory    *model.History
 	filterHistory *model.History
+	states        *config.ViewStates
+	panes         *tview.Flex
+	paneItems     []tview.Primitive
+	split         *split
+	primary       *App
+	exitHooks     []func()
+	exitOnce      sync.Once
 	conRetry      int32
+	credsExpired  int32
 	showHeader    bool
 	showCrumbs    bool
 }
@@ -58,6 +82,7 @@ func NewApp(cfg *config.Config) *App {
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
 		Content:       NewPageStack(),
+		pluginJobs:    dao.NewPluginJobs(),
 	}
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -66,6 +91,15 @@ func NewApp(cfg *config.Config) *App {
 	return &a
 }
 
+// InformerCounts returns the number of active informers per namespace.
+func (a *App) InformerCounts() map[string]int {
+	if a.factory == nil {
+		return nil
+	}
+
+	return a.factory.InformerCounts()
+}
+
 // ConOK checks the connection is cool, returns false otherwise.
 func (a *App) ConOK() bool {
 	return atomic.LoadInt32(&a.conRetry) == 0
@@ -88,6 +122,8 @@ func (a *App) Init(version string, rate int) error {
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
+	a.stats = model.NewSessionStats(a.Conn().Config().Stats())
+	a.Content.Stack.AddListener(a.stats)
 	ns, err := a.Conn().Config().CurrentNamespaceName()
 	log.Debug().Msgf("CURRENT-NS %q -- %v", ns, err)
 	if err != nil {
@@ -102,6 +138,15 @@ func (a *App) Init(version string, rate int) error {
 		return fmt.Errorf("Invalid namespace %s", ns)
 	}
 	a.initFactory(ns)
+	a.mxCache = model.NewMetricsCache(a.Conn())
+	if t := a.Config.K9s.Trivy; t.IsEnabled() {
+		a.vulnCache = model.NewVulnCache(dao.NewTrivyScanner(t), t.TTL())
+	}
+	if audit := a.Config.K9s.Audit; audit != nil && audit.Enabled {
+		a.auditor = dao.NewAuditor(config.K9sAuditFile, audit.Webhook)
+	}
+	a.initNotifications()
+	dao.SetDryRun(a.Config.K9s.IsDryRun())
 
 	a.clusterModel = model.NewClusterInfo(a.factory, version)
 	a.clusterModel.AddListener(a.clusterInfo())
@@ -125,9 +170,12 @@ func (a *App) layout(ctx context.Context, version string) {
 	flash := ui.NewFlash(a.App)
 	go flash.Watch(ctx, a.Flash().Channel())
 
+	a.panes = tview.NewFlex().SetDirection(tview.FlexColumn)
+	a.layoutPanes()
+
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
-	main.AddItem(a.Content, 0, 10, true)
+	main.AddItem(a.panes, 0, 10, true)
 	if !a.Config.K9s.IsCrumbsless() {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
@@ -145,10 +193,26 @@ func (a *App) initSignals() {
 
 	go func(sig chan os.Signal) {
 		<-sig
+		a.Cleanup()
 		os.Exit(0)
 	}(sig)
 }
 
+// OnExit registers a cleanup to run before k9s exits. Cleanups run in
+// reverse registration order.
+func (a *App) OnExit(f func()) {
+	a.exitHooks = append(a.exitHooks, f)
+}
+
+// Cleanup runs the registered exit cleanups once.
+func (a *App) Cleanup() {
+	a.exitOnce.Do(func() {
+		for i := len(a.exitHooks) - 1; i >= 0; i-- {
+			a.exitHooks[i]()
+		}
+	})
+}
+
 func (a *App) suggestCommand() model.SuggestionFunc {
 	return func(s string) (entries sort.StringSlice) {
 		if s == "" {
@@ -158,24 +222,32 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 			return a.cmdHistory.List()
 		}
 
+		if !a.command.IsLoaded() {
+			return nil
+		}
 		s = strings.ToLower(s)
-		for _, k := range a.command.alias.Aliases.Keys() {
+		for _, k := range a.command.alias.Aliases.Prefixed(s) {
 			if k == s {
 				continue
 			}
-			if strings.HasPrefix(k, s) {
-				entries = append(entries, strings.Replace(k, s, "", 1))
-			}
+			entries = append(entries, strings.TrimPrefix(k, s))
 		}
 		if len(entries) == 0 {
 			return nil
 		}
-		entries.Sort()
 		return
 	}
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if p := a.activePane(); p != a {
+		if k, ok := p.HasAction(ui.AsKey(evt)); ok && !p.Content.IsTopDialog() {
+			return k.Action(evt)
+		}
+		if p.Content.IsTopDialog() {
+			return evt
+		}
+	}
 	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
 		return k.Action(evt)
 	}
@@ -190,6 +262,8 @@ func (a *App) bindKeys() {
 		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
 		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlY: ui.NewSharedKeyAction("Status Summary", a.statusSummaryCmd, false),
+		tcell.KeyCtrlN: ui.NewSharedKeyAction("Next Pane", a.nextPaneCmd, false),
 	})
 }
 
@@ -263,8 +337,17 @@ func (a *App) Halt() {
 func (a *App) Resume() {
 	var ctx context.Context
 	ctx, a.cancelFn = context.WithCancel(context.Background())
+	if a.IsPeer() {
+		a.mxCache.Watch(ctx)
+		return
+	}
 
 	go a.clusterUpdater(ctx)
+	go a.throttleUpdater(ctx)
+	if a.mxCache != nil {
+		a.mxCache.Watch(ctx)
+	}
+	a.watchResources(ctx)
 	if err := a.StylesWatcher(ctx, a); err != nil {
 		log.Error().Err(err).Msgf("Styles watcher failed")
 	}
@@ -301,10 +384,40 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 }
 
+// throttleUpdater surfaces client side api-server throttling in the header.
+func (a *App) throttleUpdater(ctx context.Context) {
+	var throttled bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(throttleRefresh):
+			if a.Conn() == nil {
+				continue
+			}
+			if d, ok := a.Conn().Config().Throttled(); ok {
+				throttled = true
+				msg := fmt.Sprintf("Client throttled (%v wait)", d.Round(time.Millisecond))
+				a.QueueUpdateDraw(func() {
+					a.showStatus(model.FlashWarn, msg)
+				})
+				continue
+			}
+			if throttled && a.ConOK() {
+				throttled = false
+				a.QueueUpdateDraw(func() {
+					a.Logo().Reset()
+				})
+			}
+		}
+	}
+}
+
 func (a *App) refreshCluster() error {
 	c := a.Content.Top()
 	if ok := a.Conn().CheckConnectivity(); ok {
-		if atomic.LoadInt32(&a.conRetry) > 0 {
+		expired := atomic.SwapInt32(&a.credsExpired, 0) == 1
+		if atomic.LoadInt32(&a.conRetry) > 0 || expired {
 			atomic.StoreInt32(&a.conRetry, 0)
 			a.Status(model.FlashInfo, "K8s connectivity OK")
 			if c != nil {
@@ -314,6 +427,9 @@ func (a *App) refreshCluster() error {
 			a.ClearStatus(true)
 		}
 		a.factory.ValidatePortForwards()
+	} else if auth, ok := a.Conn().(client.Reauthenticator); ok && auth.Unauthorized() {
+		a.credentialsExpired(c)
+		return nil
 	} else if c != nil {
 		atomic.AddInt32(&a.conRetry, 1)
 		c.Stop()
@@ -390,6 +506,8 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
 		}
 		a.initFactory(ns)
+		a.mxCache.Reset()
+		dao.ResetDescribeCache()
 
 		if err := a.command.Reset(true); err != nil {
 			return err
@@ -415,6 +533,110 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 	return nil
 }
 
+// audit records a mutating action in the audit log.
+func (a *App) audit(e dao.AuditEntry) {
+	if a.stats != nil {
+		a.stats.Action(e.Action)
+	}
+	if a.auditor == nil {
+		return
+	}
+	e.Context, e.Cluster = a.Config.K9s.CurrentContext, a.Config.K9s.CurrentCluster
+	e.User = a.auditUser()
+	if err := a.auditor.Record(e); err != nil {
+		log.Error().Err(err).Msgf("Audit record failed")
+	}
+}
+
+// withRetry returns a context carrying the api retry policy if configured.
+func (a *App) withRetry(ctx context.Context) context.Context {
+	if api := a.Config.K9s.API; api != nil {
+		return context.WithValue(ctx, internal.KeyRetryPolicy, dao.NewRetryPolicy(api.Retry))
+	}
+
+	return ctx
+}
+
+// StartRecording captures the session timeline into the given file.
+func (a *App) StartRecording(path string) error {
+	r, err := dao.NewRecorder(path)
+	if err != nil {
+		return err
+	}
+	a.recorder = r
+
+	return nil
+}
+
+// StopRecording ends the session recording if any.
+func (a *App) StopRecording() {
+	if a.recorder == nil {
+		return
+	}
+	if err := a.recorder.Close(); err != nil {
+		log.Error().Err(err).Msgf("Recording close failed")
+	}
+}
+
+func (a *App) recordCommand(cmd, path string, err error) {
+	if a.recorder == nil {
+		return
+	}
+	if e := a.recorder.RecordCommand(cmd, path, err); e != nil {
+		log.Error().Err(e).Msgf("Recording command failed")
+	}
+}
+
+func (a *App) recordTable(view string, data render.TableData) {
+	if a.recorder == nil {
+		return
+	}
+	if err := a.recorder.RecordTable(view, data); err != nil {
+		log.Error().Err(err).Msgf("Recording table failed")
+	}
+}
+
+func (a *App) recordLog(view string, lines [][]byte) {
+	if a.recorder == nil {
+		return
+	}
+	if err := a.recorder.RecordLog(view, lines); err != nil {
+		log.Error().Err(err).Msgf("Recording logs failed")
+	}
+}
+
+func (a *App) rewindLog(view string) {
+	if a.recorder == nil {
+		return
+	}
+	a.recorder.RewindLog(view)
+}
+
+func (a *App) recordLogCleared(view string) {
+	if a.recorder == nil {
+		return
+	}
+	if err := a.recorder.RecordLogCleared(view); err != nil {
+		log.Error().Err(err).Msgf("Recording logs clear failed")
+	}
+}
+
+// auditUser returns the acting identity, the impersonated one if any.
+func (a *App) auditUser() string {
+	if a.Conn() == nil {
+		return ""
+	}
+	if id, ok := a.Conn().Config().Impersonation(); ok {
+		return id
+	}
+	u, err := a.Conn().Config().CurrentUserName()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve audit user")
+	}
+
+	return u
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -422,6 +644,10 @@ func (a *App) initFactory(ns string) {
 
 // BailOut exists the application.
 func (a *App) BailOut() {
+	if a.IsPeer() {
+		a.primary.BailOut()
+		return
+	}
 	defer func() {
 		if err := recover(); err != nil {
 			log.Error().Msgf("Bailing out %v", err)
@@ -431,7 +657,15 @@ func (a *App) BailOut() {
 	if err := nukeK9sShell(a); err != nil {
 		log.Error().Err(err).Msgf("nuking k9s shell pod")
 	}
+	a.saveSession()
+	a.saveViewStates()
+	if a.split != nil {
+		a.split.peer.saveSession()
+		a.split.peer.saveViewStates()
+		a.split.peer.factory.Terminate()
+	}
 	a.factory.Terminate()
+	a.Cleanup()
 	a.App.BailOut()
 }
 
@@ -446,8 +680,20 @@ func (a *App) Run() error {
 		})
 	}()
 
-	if err := a.command.defaultCmd(); err != nil {
-		return err
+	if a.command.IsLoaded() {
+		if err := a.command.defaultCmd(); err != nil {
+			return err
+		}
+		a.restorePortForwards()
+		a.offerSessionRestore()
+	} else {
+		a.whenLoaded(func() {
+			if err := a.command.defaultCmd(); err != nil {
+				a.Flash().Err(err)
+			}
+			a.restorePortForwards()
+			a.offerSessionRestore()
+		})
 	}
 	a.SetRunning(true)
 	if err := a.Application.Run(); err != nil {
@@ -460,14 +706,20 @@ func (a *App) Run() error {
 // Status reports a new app status for display.
 func (a *App) Status(l model.FlashLevel, msg string) {
 	a.QueueUpdateDraw(func() {
-		if a.showHeader {
-			a.setLogo(l, msg)
-		} else {
-			a.setIndicator(l, msg)
-		}
+		a.showStatus(l, msg)
 	})
 }
 
+// showStatus reports a status in the header or the indicator. Must be called
+// on the ui thread.
+func (a *App) showStatus(l model.FlashLevel, msg string) {
+	if a.showHeader {
+		a.setLogo(l, msg)
+	} else {
+		a.setIndicator(l, msg)
+	}
+}
+
 // IsBenchmarking check if benchmarks are active.
 func (a *App) IsBenchmarking() bool {
 	return a.Logo().IsBenchmarking()
@@ -615,8 +867,39 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// whenLoaded defers f until aliases and resources metadata are loaded while
+// spinning in the flash area.
+func (a *App) whenLoaded(f func()) {
+	go func() {
+		ticker := time.NewTicker(spinnerRate)
+		defer ticker.Stop()
+		for i := 0; ; i++ {
+			select {
+			case <-a.command.loaded:
+				if err := a.command.Await(); err != nil {
+					a.Flash().Errf("Resources loading failed: %s", err)
+				}
+				a.QueueUpdateDraw(f)
+				return
+			case <-ticker.C:
+				a.Flash().Infof("%c Loading resources...", spinner[i%len(spinner)])
+			}
+		}
+	}()
+}
+
 func (a *App) gotoResource(cmd, path string, clearStack bool) error {
+	if !a.command.IsLoaded() {
+		a.whenLoaded(func() {
+			if err := a.gotoResource(cmd, path, clearStack); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+		return nil
+	}
+
 	err := a.command.run(cmd, path, clearStack)
+	a.recordCommand(cmd, path, err)
 	if err == nil {
 		return err
 	}
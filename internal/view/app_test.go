@@ -12,5 +12,17 @@ func TestAppNew(t *testing.T) {
 	a := view.NewApp(config.NewConfig(ks{}))
 	a.Init("blee", 10)
 
-	assert.Equal(t, 10, len(a.GetActions()))
+	assert.Equal(t, 12, len(a.GetActions()))
+}
+
+func TestAppCleanup(t *testing.T) {
+	a := view.NewApp(config.NewConfig(ks{}))
+
+	var calls []string
+	a.OnExit(func() { calls = append(calls, "snapshot") })
+	a.OnExit(func() { calls = append(calls, "recording") })
+	a.Cleanup()
+	a.Cleanup()
+
+	assert.Equal(t, []string{"recording", "snapshot"}, calls)
 }
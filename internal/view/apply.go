@@ -0,0 +1,260 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// kubectl diff exits with 1 when differences are found.
+const diffFoundExitCode = 1
+
+var applyRX = regexp.MustCompile(`\A(\S+/\S+) ([a-z-]+)(?: \((?:server )?dry run\))?\z`)
+
+// applyCmd server side dry-runs a manifest and shows the diff against the
+// live resources. The manifest is applied on confirmation. The manifest is
+// either a file, a directory, a kustomization or a url.
+func (a *App) applyCmd(path string) error {
+	if path == "" {
+		return errors.New("You must specify a manifest path or url")
+	}
+	if a.Config.K9s.IsReadOnly() {
+		return errors.New("Apply is disabled in read-only mode")
+	}
+	if !isURL(path) {
+		if _, err := os.Stat(path); err != nil {
+			return err
+		}
+	}
+
+	res, changed, err := a.diffManifest(path)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		a.Flash().Infof("No changes detected for manifest %s", path)
+		return nil
+	}
+
+	details := NewDetails(a, "Apply Diff", path, true).Update(diffSummary(res) + res)
+	details.Actions().Add(ui.KeyActions{
+		ui.KeyA: ui.NewKeyAction("Apply", func(evt *tcell.EventKey) *tcell.EventKey {
+			a.confirmApply(path)
+			return nil
+		}, true),
+	})
+
+	return a.inject(details)
+}
+
+// diffManifest server side dry-runs a manifest and returns its diff against
+// the live resources.
+func (a *App) diffManifest(path string) (string, bool, error) {
+	args := append([]string{"diff"}, applyOpts(path)...)
+	res, err := runKu(a, shellOpts{clear: false, args: append(args, path)})
+	if err == nil {
+		return "", false, nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != diffFoundExitCode {
+		return "", false, fmt.Errorf("Diff failed for manifest %s: %s", path, res)
+	}
+
+	return res, true, nil
+}
+
+func (a *App) confirmApply(path string) {
+	msg := fmt.Sprintf("Apply manifest %s?", path)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Apply", msg, func() {
+		args := append([]string{"apply"}, applyOpts(path)...)
+		if dao.IsDryRun() {
+			args = append(args, "--dry-run=server")
+		}
+		res, err := runKu(a, shellOpts{clear: false, args: append(args, path)})
+		r := parseApply(res)
+		e := dao.NewAuditEntry("apply", "", path, err)
+		e.Details = r.counts()
+		a.audit(e)
+
+		a.Content.Pop()
+		details := NewDetails(a, dryRunTag("Applied Manifest"), path, true).Update(r.report(err))
+		if err := a.inject(details); err != nil {
+			a.Flash().Err(err)
+		}
+	}, func() {})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type applyResults struct {
+	objects  [][2]string
+	errors   []string
+	messages []string
+}
+
+// parseApply sorts out a kubectl apply output into objects outcomes, errors
+// and any other messages ie warnings.
+func parseApply(res string) applyResults {
+	var r applyResults
+	for _, l := range strings.Split(res, "\n") {
+		l = strings.TrimSpace(l)
+		switch {
+		case l == "":
+		case strings.HasPrefix(l, "Error from server") || strings.HasPrefix(l, "error:"):
+			r.errors = append(r.errors, l)
+		default:
+			if m := applyRX.FindStringSubmatch(l); m != nil {
+				r.objects = append(r.objects, [2]string{m[1], m[2]})
+				continue
+			}
+			r.messages = append(r.messages, l)
+		}
+	}
+
+	return r
+}
+
+func (r applyResults) tally() map[string]int {
+	tt := make(map[string]int)
+	for _, o := range r.objects {
+		tt[o[1]]++
+	}
+	if len(r.errors) > 0 {
+		tt["failed"] = len(r.errors)
+	}
+
+	return tt
+}
+
+// counts returns a one line outcomes summary ie configured 2, created 1.
+func (r applyResults) counts() string {
+	tt := r.tally()
+	kk := make([]string, 0, len(tt))
+	for k := range tt {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, fmt.Sprintf("%s %d", k, tt[k]))
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+// report returns a yaml like per object report of an apply.
+func (r applyResults) report(err error) string {
+	var b strings.Builder
+	if err != nil {
+		fmt.Fprintf(&b, "status:\n  %s\n", err)
+	}
+	if tt := r.tally(); len(tt) > 0 {
+		kk := make([]string, 0, len(tt))
+		for k := range tt {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		b.WriteString("summary:\n")
+		for _, k := range kk {
+			fmt.Fprintf(&b, "  %s: %d\n", k, tt[k])
+		}
+	}
+	if len(r.objects) > 0 {
+		b.WriteString("objects:\n")
+		for _, o := range r.objects {
+			fmt.Fprintf(&b, "  %s: %s\n", o[0], o[1])
+		}
+	}
+	for _, s := range []struct {
+		k  string
+		ll []string
+	}{
+		{"errors", r.errors},
+		{"message", r.messages},
+	} {
+		if len(s.ll) == 0 {
+			continue
+		}
+		b.WriteString(s.k + ":\n")
+		for _, l := range s.ll {
+			b.WriteString("  " + l + "\n")
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// diffSummary lists the objects a kubectl diff output changes.
+func diffSummary(res string) string {
+	var oo []string
+	for _, l := range strings.Split(res, "\n") {
+		if !strings.HasPrefix(l, "diff ") {
+			continue
+		}
+		ff := strings.Fields(l)
+		if o, ok := diffObject(path.Base(ff[len(ff)-1])); ok {
+			oo = append(oo, o)
+		}
+	}
+	if len(oo) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %d object(s) changed\n", len(oo))
+	for _, o := range oo {
+		b.WriteString("#   " + o + "\n")
+	}
+
+	return b.String() + "\n"
+}
+
+// diffObject parses a kubectl diff object name ie apps.v1.Deployment.default.nginx.
+func diffObject(s string) (string, bool) {
+	tt := strings.Split(s, ".")
+	for i := 1; i < len(tt)-2; i++ {
+		if tt[i] == "" || strings.ToLower(tt[i][:1]) == tt[i][:1] {
+			continue
+		}
+		kind, ns, n := tt[i], tt[i+1], strings.Join(tt[i+2:], ".")
+		if ns == "" {
+			return kind + " " + n, true
+		}
+		return kind + " " + ns + "/" + n, true
+	}
+
+	return "", false
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// applyOpts returns the kubectl manifest options. Urls to yaml or json
+// documents are plain manifests, other urls are remote kustomizations.
+func applyOpts(s string) []string {
+	if !isURL(s) {
+		return manifestOpts(s)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return []string{"-f"}
+	}
+	if isManifest(u.Path) || path.Ext(u.Path) == ".json" {
+		return []string{"-f"}
+	}
+
+	return []string{"-k"}
+}
@@ -0,0 +1,77 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyReport(t *testing.T) {
+	r := parseApply(`configmap/fred created
+deployment.apps/nginx configured (server dry run)
+service/nginx unchanged
+Warning: resource is deprecated
+Error from server (Forbidden): error when creating "x.yaml": forbidden`)
+
+	assert.Equal(t, "configured 1, created 1, failed 1, unchanged 1", r.counts())
+	assert.Equal(t, `status:
+  exit status 1
+summary:
+  configured: 1
+  created: 1
+  failed: 1
+  unchanged: 1
+objects:
+  configmap/fred: created
+  deployment.apps/nginx: configured
+  service/nginx: unchanged
+errors:
+  Error from server (Forbidden): error when creating "x.yaml": forbidden
+message:
+  Warning: resource is deprecated`, r.report(errors.New("exit status 1")))
+}
+
+func TestApplyReportEmpty(t *testing.T) {
+	r := parseApply("")
+
+	assert.Equal(t, "", r.counts())
+	assert.Equal(t, "", r.report(nil))
+}
+
+func TestDiffSummary(t *testing.T) {
+	res := `diff -u -N /tmp/LIVE-1/apps.v1.Deployment.default.nginx /tmp/MERGED-2/apps.v1.Deployment.default.nginx
+--- /tmp/LIVE-1/apps.v1.Deployment.default.nginx
++++ /tmp/MERGED-2/apps.v1.Deployment.default.nginx
+diff -u -N /tmp/LIVE-1/v1.Namespace..fred /tmp/MERGED-2/v1.Namespace..fred
+diff -u -N /tmp/LIVE-1/v1.ConfigMap.default.a.b /tmp/MERGED-2/v1.ConfigMap.default.a.b
+`
+
+	assert.Equal(t, `# 3 object(s) changed
+#   Deployment default/nginx
+#   Namespace fred
+#   ConfigMap default/a.b
+
+`, diffSummary(res))
+	assert.Equal(t, "", diffSummary("blee"))
+}
+
+func TestApplyOpts(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    []string
+	}{
+		"yamlURL":      {path: "https://fred.io/blee.yaml?ref=1", e: []string{"-f"}},
+		"jsonURL":      {path: "http://fred.io/blee.json", e: []string{"-f"}},
+		"kustomizeURL": {path: "https://github.com/fred/blee//deploy?ref=v1", e: []string{"-k"}},
+		"manifest":     {path: "testdata/fred.yml", e: []string{"-f"}},
+		"kustomize":    {path: "testdata/kmanifests", e: []string{"-k"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, applyOpts(u.path))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const auditTitle = "Audit"
+
+// Audit presents the mutating actions audit log.
+type Audit struct {
+	ResourceViewer
+}
+
+// NewAudit returns a new viewer.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.Audit{}.ColorerFunc())
+	a.GetTable().SetSortCol(ageCol, true)
+	a.GetTable().SetEnterFn(a.showEntry)
+	a.SetContextFn(auditContext)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+func auditContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAudit, config.K9sAuditFile)
+}
+
+func (a *Audit) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE, ui.KeyY, ui.KeyD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Action", a.GetTable().SortColCmd("ACTION", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort User", a.GetTable().SortColCmd("USER", true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Outcome", a.GetTable().SortColCmd("OUTCOME", true), false),
+	})
+}
+
+func (a *Audit) showEntry(app *App, _ ui.Tabular, gvr, id string) {
+	var res dao.Audit
+	res.Init(app.factory, client.NewGVR(gvr))
+	o, err := res.Get(auditContext(context.Background()), id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	e, ok := o.(render.AuditRes)
+	if !ok {
+		app.Flash().Errf("expecting AuditRes but got %T", o)
+		return
+	}
+	details := NewDetails(app, auditTitle, e.Action+" "+e.Path, true).Update(auditDetails(e))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// auditDetails returns a yaml like report of an audit entry.
+func auditDetails(e render.AuditRes) string {
+	var b strings.Builder
+	for _, f := range []struct{ k, v string }{
+		{"time", e.Time.Local().Format("2006-01-02 15:04:05 MST")},
+		{"context", e.Context},
+		{"cluster", e.Cluster},
+		{"user", e.User},
+		{"action", e.Action},
+		{"gvr", e.GVR},
+		{"path", e.Path},
+		{"details", e.Details},
+		{"outcome", e.Outcome},
+		{"error", e.Error},
+	} {
+		if f.v != "" {
+			fmt.Fprintf(&b, "%s: %s\n", f.k, f.v)
+		}
+	}
+	if e.DryRun {
+		b.WriteString("dryRun: true\n")
+	}
+	if e.Diff != "" {
+		b.WriteString("diff: |\n")
+		for _, l := range splitLines(e.Diff) {
+			b.WriteString("  " + l + "\n")
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
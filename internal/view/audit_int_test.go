@@ -0,0 +1,35 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditDetails(t *testing.T) {
+	e := render.AuditRes{
+		Time:    time.Date(2020, 9, 1, 10, 30, 0, 0, time.Local),
+		Context: "dev",
+		User:    "fred",
+		Action:  "edit",
+		GVR:     "v1/configmaps",
+		Path:    "default/blee",
+		Outcome: render.AuditSuccess,
+		Diff:    "- a: 1\n+ a: 2\n",
+		DryRun:  true,
+	}
+
+	assert.Equal(t, `time: `+e.Time.Format("2006-01-02 15:04:05 MST")+`
+context: dev
+user: fred
+action: edit
+gvr: v1/configmaps
+path: default/blee
+outcome: success
+dryRun: true
+diff: |
+  - a: 1
+  + a: 2`, auditDetails(e))
+}
@@ -2,9 +2,13 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -15,6 +19,10 @@ import (
 	"github.com/gdamore/tcell"
 )
 
+const benchBarWidth = 40
+
+var benchHistogramRX = regexp.MustCompile(`\A\s+([0-9.]+) \[(\d+)\]\s+\|(■*)\z`)
+
 // Benchmark represents a service benchmark results view.
 type Benchmark struct {
 	ResourceViewer
@@ -46,7 +54,7 @@ func (b *Benchmark) viewBench(app *App, model ui.Tabular, gvr, path string) {
 		return
 	}
 
-	details := NewDetails(b.App(), "Results", fileToSubject(path), false).Update(data)
+	details := NewDetails(b.App(), "Results", fileToSubject(path), false).Update(benchHistogram(data))
 	if err := app.inject(details); err != nil {
 		app.Flash().Err(err)
 	}
@@ -66,6 +74,48 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
+// benchHistogram colors a benchmark report latency histogram bars by the
+// cumulative share of requests served: green up to the median, orange up to
+// p90 and red for the tail.
+func benchHistogram(report string) string {
+	ll := strings.Split(report, "\n")
+	var total int
+	for _, l := range ll {
+		if m := benchHistogramRX.FindStringSubmatch(l); m != nil {
+			n, _ := strconv.Atoi(m[2])
+			total += n
+		}
+	}
+	if total == 0 {
+		return report
+	}
+
+	var cum int
+	for i, l := range ll {
+		m := benchHistogramRX.FindStringSubmatch(l)
+		if m == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		cum += n
+		pct := cum * 100 / total
+		color := "green"
+		switch {
+		case pct > 90:
+			color = "red"
+		case pct > 50:
+			color = "orange"
+		}
+		pad := benchBarWidth - utf8.RuneCountInString(m[3])
+		if pad < 0 {
+			pad = 0
+		}
+		ll[i] = fmt.Sprintf("  %ss [%6d] |<<<%s::>>>%s<<<-::>>>%s %3d%%", m[1], n, color, m[3], strings.Repeat(" ", pad), pct)
+	}
+
+	return strings.Join(ll, "\n")
+}
+
 func benchDir(cfg *config.Config) string {
 	return filepath.Join(perf.K9sBenchDir, cfg.K9s.CurrentCluster)
 }
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchHistogram(t *testing.T) {
+	uu := map[string]struct {
+		report, e string
+	}{
+		"none": {
+			report: "Summary:\n  Total:\t1.0000 secs",
+			e:      "Summary:\n  Total:\t1.0000 secs",
+		},
+		"histogram": {
+			report: "Response time histogram:\n  0.001 [5]\t|■■■■\n  0.002 [4]\t|■■■\n  0.003 [1]\t|\n",
+			e: "Response time histogram:\n" +
+				"  0.001s [     5] |<<<green::>>>■■■■<<<-::>>>                                      50%\n" +
+				"  0.002s [     4] |<<<orange::>>>■■■<<<-::>>>                                       90%\n" +
+				"  0.003s [     1] |<<<red::>>><<<-::>>>                                         100%\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, benchHistogram(u.report))
+		})
+	}
+}
@@ -20,8 +20,21 @@ import (
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+// watchToggler represents a model refreshed on resource changes or on a fixed rate.
+type watchToggler interface {
+	// SetPolling toggles between periodic and change driven refreshes.
+	SetPolling(bool)
+
+	// IsPolling returns true if the model is refreshed on a fixed rate.
+	IsPolling() bool
+
+	// IsWatching returns true if the model refreshes on resource changes.
+	IsWatching() bool
+}
+
 // Browser represents a generic resource browser.
 type Browser struct {
 	*Table
@@ -31,6 +44,8 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	rewind     *rewinder
+	stateCtx   string
 }
 
 // NewBrowser returns a new browser.
@@ -71,6 +86,9 @@ func (b *Browser) Init(ctx context.Context) error {
 	}
 
 	b.setNamespace(ns)
+	if cfg := b.app.Config.K9s.Snapshots; cfg != nil && cfg.Active() && !dao.IsK9sMeta(b.meta) {
+		b.rewind = newRewinder(b.snapshotDir, cfg)
+	}
 	row, _ := b.GetSelection()
 	if row == 0 && b.GetRowCount() > 0 {
 		b.Select(1, 0)
@@ -78,6 +96,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
 
 	b.CmdBuff().SetSuggestionFn(b.suggestFilter())
+	b.restoreState()
 
 	return nil
 }
@@ -124,6 +143,9 @@ func (b *Browser) Start() {
 	}
 
 	b.Stop()
+	if b.rewind != nil {
+		b.rewind.stop()
+	}
 	b.GetModel().AddListener(b)
 	b.Table.Start()
 	b.CmdBuff().AddListener(b)
@@ -134,6 +156,7 @@ func (b *Browser) Start() {
 
 // Stop terminates browser updates.
 func (b *Browser) Stop() {
+	b.saveState()
 	if b.cancelFn != nil {
 		b.cancelFn()
 		b.cancelFn = nil
@@ -211,9 +234,16 @@ func (b *Browser) TableDataChanged(data render.TableData) {
 		return
 	}
 
+	b.app.recordTable(b.GVR().String(), data)
 	b.app.QueueUpdateDraw(func() {
+		if b.rewind != nil && b.rewind.active {
+			return
+		}
 		b.refreshActions()
 		b.Update(data)
+		if b.rewind != nil {
+			b.rewind.record(data, time.Now())
+		}
 	})
 }
 
@@ -241,6 +271,52 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) rewindCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.rewind.active {
+		b.rewind.stop()
+		b.refreshActions()
+		b.app.Flash().Info("Rewind off. Back to live view...")
+		b.refresh()
+		return nil
+	}
+
+	if err := b.rewind.start(); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	b.refreshActions()
+	b.showSnapshot()
+
+	return nil
+}
+
+func (b *Browser) rewindStepCmd(delta int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !b.rewind.step(delta) {
+			b.app.Flash().Warn("No more snapshots")
+			return nil
+		}
+		b.showSnapshot()
+
+		return nil
+	}
+}
+
+func (b *Browser) showSnapshot() {
+	data, info, err := b.rewind.current()
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	b.Update(data)
+	b.app.Flash().Infof("Rewind %s (%s ago) [%d/%d]",
+		info.Time.Format("15:04:05"),
+		duration.HumanDuration(time.Since(info.Time)),
+		b.rewind.index+1,
+		len(b.rewind.snaps),
+	)
+}
+
 func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !b.CmdBuff().InCmdMode() {
 		b.CmdBuff().ClearText(false)
@@ -293,6 +369,25 @@ func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) toggleWatchCmd(*tcell.EventKey) *tcell.EventKey {
+	w, ok := b.GetModel().(watchToggler)
+	if !ok {
+		return nil
+	}
+	w.SetPolling(!w.IsPolling())
+	rate := b.app.Config.K9s.GetRefreshRate()
+	switch {
+	case w.IsPolling():
+		b.app.Flash().Infof("Polling %s every %ds", b.GVR(), rate)
+	case w.IsWatching():
+		b.app.Flash().Infof("Watching %s for changes", b.GVR())
+	default:
+		b.app.Flash().Warnf("Watch unavailable for %s. Polling every %ds", b.GVR(), rate)
+	}
+
+	return nil
+}
+
 func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := b.GetSelectedItems()
 	if len(selections) == 0 {
@@ -306,6 +401,9 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if len(selections) > 1 {
 			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
 		}
+		if r := b.deleteImpacts(selections); r != "" {
+			msg += "\n\n" + r
+		}
 		if !dao.IsK8sMeta(b.meta) {
 			b.simpleDelete(selections, msg)
 			return nil
@@ -341,6 +439,15 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	if dao.IsDryRun() {
+		b.dryRunEdit(path)
+		return nil
+	}
+	if b.app.Config.K9s.DiffOnEdit || b.app.Config.K9s.Edit.Mode != config.EditUpdate || b.hasSchema() {
+		b.localEdit(path)
+		return nil
+	}
+
 	b.Stop()
 	defer b.Start()
 	{
@@ -350,14 +457,28 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if ns != client.AllNamespaces {
 			args = append(args, "-n", ns)
 		}
+		var err error
 		if !runK(b.app, shellOpts{clear: true, args: append(args, n)}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
+			err = errors.New("Edit exec failed")
+			b.app.Flash().Err(err)
 		}
+		b.app.audit(dao.NewAuditEntry("edit", b.GVR().String(), path, err))
 	}
 
 	return evt
 }
 
+// hasSchema checks if the resource is a custom resource with a schema. These
+// get edited locally so they can be validated before save.
+func (b *Browser) hasSchema() bool {
+	_, ok, err := dao.FetchSchema(b.app.factory, b.GVR())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Schema lookup failed for %s", b.GVR())
+	}
+
+	return ok
+}
+
 func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
 	i, err := strconv.Atoi(string(evt.Rune()))
 	if err != nil {
@@ -409,9 +530,22 @@ func (b *Browser) setNamespace(ns string) {
 	b.GetModel().SetNamespace(client.CleanseNamespace(ns))
 }
 
+func (b *Browser) snapshotDir() string {
+	return dao.SnapshotDir(config.K9sSnapshotDir, b.app.Config.K9s.CurrentCluster, b.GVR().String(), b.GetModel().GetNamespace())
+}
+
 func (b *Browser) defaultContext() context.Context {
 	ctx := context.WithValue(context.Background(), internal.KeyFactory, b.app.factory)
 	ctx = context.WithValue(ctx, internal.KeyGVR, b.GVR().String())
+	if b.app.mxCache != nil {
+		ctx = context.WithValue(ctx, internal.KeyMetricsCache, b.app.mxCache)
+	}
+	if b.app.vulnCache != nil {
+		ctx = context.WithValue(ctx, internal.KeyVulnCache, b.app.vulnCache)
+	}
+	ctx = context.WithValue(ctx, internal.KeyServerTables, b.app.Config.K9s.ServerTables)
+	ctx = context.WithValue(ctx, internal.KeyPageSize, b.app.Config.K9s.GetListPageSize())
+	ctx = b.app.withRetry(ctx)
 	if b.Path != "" {
 		ctx = context.WithValue(ctx, internal.KeyPath, b.Path)
 	}
@@ -442,12 +576,33 @@ func (b *Browser) refreshActions() {
 			if client.Can(b.meta.Verbs, "delete") {
 				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
 			}
+			if _, ok := b.accessor.(dao.Labeler); ok && dao.IsK8sMeta(b.meta) && client.Can(b.meta.Verbs, "edit") {
+				aa[ui.KeyShiftG] = ui.NewKeyAction("Label/Annotate", b.labelCmd, true)
+			}
 		}
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyShiftV] = ui.NewKeyAction("Diff", b.diffCmd, true)
+		aa[ui.KeyShiftE] = ui.NewKeyAction("Events", timelineCmd(b), true)
+		if _, ok := b.GetModel().(watchToggler); ok && model.IsWatchable(b.GVR()) {
+			aa[tcell.KeyCtrlP] = ui.NewKeyAction("Toggle Watch", b.toggleWatchCmd, false)
+		}
+		if b.app.watches != nil {
+			aa[ui.KeyShiftW] = ui.NewKeyAction("Watch", b.watchCmd, true)
+		}
+	}
+
+	if b.rewind != nil {
+		aa[tcell.KeyCtrlO] = ui.NewKeyAction("Rewind", b.rewindCmd, false)
+		if b.rewind.active {
+			aa[ui.KeyLeftBracket] = ui.NewKeyAction("Older", b.rewindStepCmd(-1), false)
+			aa[ui.KeyRightBracket] = ui.NewKeyAction("Newer", b.rewindStepCmd(1), false)
+		} else {
+			b.Actions().Delete(ui.KeyLeftBracket, ui.KeyRightBracket)
+		}
 	}
 
 	pluginActions(b, aa)
@@ -456,6 +611,7 @@ func (b *Browser) refreshActions() {
 		f(aa)
 	}
 	b.Actions().Add(aa)
+	b.applyKeyMaps(append(b.Aliases(), b.GVR().String()))
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
@@ -479,45 +635,54 @@ func (b *Browser) namespaceActions(aa ui.KeyActions) {
 
 func (b *Browser) simpleDelete(selections []string, msg string) {
 	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Delete", msg, func() {
-		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
-		}
-		for _, sel := range selections {
-			nuker, ok := b.accessor.(dao.Nuker)
-			if !ok {
-				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
-				return
-			}
-			if err := nuker.Delete(sel, true, true); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.GetTable().DeleteMark(sel)
-			}
+		nuker, ok := b.accessor.(dao.Nuker)
+		if !ok {
+			b.app.Flash().Errf("Invalid nuker %T", b.accessor)
+			return
 		}
-		b.refresh()
+		b.ShowDeleted()
+		runBulk(b.defaultContext(), b.app, bulkOp{
+			verb:  "Delete",
+			past:  "deleted",
+			gvr:   b.GVR(),
+			paths: selections,
+			fn: func(_ context.Context, path string) error {
+				err := nuker.Delete(path, true, true)
+				b.app.audit(dao.NewAuditEntry("delete", b.GVR().String(), path, err))
+				return err
+			},
+			ok:   b.GetTable().DeleteMark,
+			done: b.refresh,
+		})
 	}, func() {})
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
 	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, func(cascade, force bool) {
 		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
-		}
-		for _, sel := range selections {
-			if err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), sel)
-				b.app.factory.DeleteForwarder(sel)
-				b.GetTable().DeleteMark(sel)
-			}
-		}
-		b.refresh()
+		b.bulkDelete(selections, cascade, force)
 	}, func() {})
 }
+
+// bulkDelete deletes resources using a rate limited worker pool while
+// reporting progress.
+func (b *Browser) bulkDelete(selections []string, cascade, force bool) {
+	runBulk(b.defaultContext(), b.app, bulkOp{
+		verb:  "Delete",
+		past:  "deleted",
+		gvr:   b.GVR(),
+		paths: selections,
+		fn: func(ctx context.Context, path string) error {
+			err := b.GetModel().Delete(ctx, path, cascade, force)
+			b.app.audit(dao.NewAuditEntry("delete", b.GVR().String(), path, err))
+			return err
+		},
+		ok: func(path string) {
+			if !dao.IsDryRun() {
+				b.app.factory.DeleteForwarder(path)
+			}
+			b.GetTable().DeleteMark(path)
+		},
+		done: b.refresh,
+	})
+}
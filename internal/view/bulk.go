@@ -0,0 +1,116 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// bulkOp represents an action applied to a collection of resources.
+type bulkOp struct {
+	// verb names the action ie Delete.
+	verb string
+
+	// past names the completed action ie deleted.
+	past string
+
+	gvr   client.GVR
+	paths []string
+
+	// fn performs the action on a single resource.
+	fn dao.BulkFunc
+
+	// ok gets called on the ui thread for each successful resource.
+	ok func(path string)
+
+	// done gets called once all resources were processed.
+	done func()
+}
+
+// runBulk applies an operation to all resources, reporting each outcome in a
+// progress dialog. Single resources skip the dialog.
+func runBulk(ctx context.Context, a *App, op bulkOp) {
+	if len(op.paths) == 0 {
+		return
+	}
+	if len(op.paths) == 1 {
+		runSingle(ctx, a, op)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	title := fmt.Sprintf("%s %d %s", op.verb, len(op.paths), op.gvr.R())
+	progress := dialog.ShowProgress(a.Styles.Dialog(), a.Content.Pages, title, len(op.paths), func() { cancel() })
+
+	go func() {
+		defer cancel()
+		var succeeded, failed int
+		for r := range dao.Bulk(ctx, op.paths, dao.NewBulkOptions(), op.fn) {
+			r := r
+			if r.Err != nil {
+				failed++
+				log.Error().Err(r.Err).Msgf("%s failed for %s", op.verb, r.Path)
+			} else {
+				succeeded++
+			}
+			// Queued synchronously so outcomes are reported in order.
+			a.Application.QueueUpdateDraw(func() {
+				progress.Report(r.Path, r.Err)
+				if r.Err == nil && op.ok != nil {
+					op.ok(r.Path)
+				}
+			})
+		}
+
+		canceled := ctx.Err() != nil
+		a.Application.QueueUpdateDraw(func() {
+			switch {
+			case canceled:
+				progress.Dismiss()
+				a.Flash().Warnf("%s canceled. %d/%d %s %s", op.verb, succeeded, len(op.paths), op.gvr, op.past)
+			case failed > 0:
+				a.Flash().Errf("%s failed for %d/%d %s", op.verb, failed, len(op.paths), op.gvr)
+			default:
+				progress.Dismiss()
+				a.Flash().Info(dryRunTag(fmt.Sprintf("%d %s %s successfully", succeeded, op.gvr, op.past)))
+			}
+		})
+		if op.done != nil {
+			op.done()
+		}
+	}()
+}
+
+func runSingle(ctx context.Context, a *App, op bulkOp) {
+	path := op.paths[0]
+	a.Flash().Infof("%s %s %s", op.verb, op.gvr.R(), path)
+	go func() {
+		err := op.fn(ctx, path)
+		a.Application.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("%s failed for %s: %s", op.verb, path, err)
+				return
+			}
+			if op.ok != nil {
+				op.ok(path)
+			}
+			a.Flash().Info(dryRunTag(fmt.Sprintf("%s `%s %s successfully", op.gvr, path, op.past)))
+		})
+		if op.done != nil {
+			op.done()
+		}
+	}()
+}
+
+// bulkSubject describes the resources an action applies to.
+func bulkSubject(gvr client.GVR, paths []string) string {
+	if len(paths) == 1 {
+		return gvr.R() + " " + paths[0]
+	}
+
+	return fmt.Sprintf("%d marked %s", len(paths), gvr.R())
+}
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkSubject(t *testing.T) {
+	uu := map[string]struct {
+		paths []string
+		e     string
+	}{
+		"single": {
+			paths: []string{"default/fred"},
+			e:     "pods default/fred",
+		},
+		"multi": {
+			paths: []string{"default/fred", "default/blee"},
+			e:     "2 marked pods",
+		},
+	}
+
+	gvr := client.NewGVR("v1/pods")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, bulkSubject(gvr, u.paths))
+		})
+	}
+}
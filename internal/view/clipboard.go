@@ -0,0 +1,59 @@
+package view
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/rs/zerolog/log"
+)
+
+// maxOSC52Size tracks the largest payload most terminals accept via OSC52.
+const maxOSC52Size = 100000
+
+var clipboardOut io.Writer = os.Stdout
+
+// copyToClipboard writes to the system clipboard, falling back to OSC52
+// terminal escape sequences when no clipboard is reachable ie over SSH.
+func copyToClipboard(s string) error {
+	if !clipboard.Unsupported {
+		err := clipboard.WriteAll(s)
+		if err == nil {
+			return nil
+		}
+		log.Debug().Err(err).Msg("System clipboard failed. Trying OSC52")
+	}
+
+	return writeOSC52(clipboardOut, s, os.Getenv("TMUX") != "", strings.HasPrefix(os.Getenv("TERM"), "screen"))
+}
+
+func writeOSC52(w io.Writer, s string, tmux, screen bool) error {
+	seq, err := osc52(s, tmux, screen)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, seq)
+
+	return err
+}
+
+// osc52 returns an escape sequence setting the terminal clipboard. Tmux and
+// screen sessions need the sequence wrapped to reach the outer terminal.
+func osc52(s string, tmux, screen bool) (string, error) {
+	b64 := base64.StdEncoding.EncodeToString([]byte(s))
+	if len(b64) > maxOSC52Size {
+		return "", fmt.Errorf("content too large for terminal clipboard (%d bytes)", len(s))
+	}
+	seq := "\x1b]52;c;" + b64 + "\a"
+	switch {
+	case tmux:
+		return "\x1bPtmux;\x1b" + seq + "\x1b\\", nil
+	case screen:
+		return "\x1bP" + seq + "\x1b\\", nil
+	default:
+		return seq, nil
+	}
+}
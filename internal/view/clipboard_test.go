@@ -0,0 +1,52 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOSC52(t *testing.T) {
+	uu := map[string]struct {
+		s            string
+		tmux, screen bool
+		e            string
+		err          bool
+	}{
+		"plain": {
+			s: "fred",
+			e: "\x1b]52;c;ZnJlZA==\a",
+		},
+		"tmux": {
+			s:    "fred",
+			tmux: true,
+			e:    "\x1bPtmux;\x1b\x1b]52;c;ZnJlZA==\a\x1b\\",
+		},
+		"screen": {
+			s:      "fred",
+			screen: true,
+			e:      "\x1bP\x1b]52;c;ZnJlZA==\a\x1b\\",
+		},
+		"tooLarge": {
+			s:   strings.Repeat("x", maxOSC52Size),
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			seq, err := osc52(u.s, u.tmux, u.screen)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, seq)
+		})
+	}
+}
+
+func TestWriteOSC52(t *testing.T) {
+	var b bytes.Buffer
+	assert.Nil(t, writeOSC52(&b, "fred", false, false))
+	assert.Equal(t, "\x1b]52;c;ZnJlZA==\a", b.String())
+}
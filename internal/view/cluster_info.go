@@ -12,7 +12,10 @@ import (
 	"github.com/gdamore/tcell"
 )
 
-var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
+var (
+	_ model.ClusterInfoListener = (*ClusterInfo)(nil)
+	_ model.AlertsListener      = (*ClusterInfo)(nil)
+)
 
 // ClusterInfo represents a cluster info view.
 type ClusterInfo struct {
@@ -20,6 +23,7 @@ type ClusterInfo struct {
 
 	app    *App
 	styles *config.Styles
+	alerts int
 }
 
 // NewClusterInfo returns a new cluster info view.
@@ -93,7 +97,7 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 		c.layout()
 		row := c.setCell(0, curr.Context)
 		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
+		row = c.setCell(row, userInfo(curr))
 		row = c.setCell(row, fmt.Sprintf("%s [%d]", curr.K9sVer, os.Getpid()))
 		row = c.setCell(row, curr.K8sVer)
 		if c.app.Conn().HasMetrics() {
@@ -102,9 +106,50 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 			c.setDefCon(curr.Cpu, curr.Mem)
 		}
 		c.updateStyle()
+		if curr.Impersonate != "" {
+			var s tcell.Style
+			c.GetCell(userRow, 1).SetStyle(s.Bold(true).Foreground(tcell.ColorOrangeRed))
+		}
+		c.updateAlerts()
+	})
+}
+
+// AlertsChanged notifies the watchdog alerts count changed.
+func (c *ClusterInfo) AlertsChanged(count int) {
+	c.app.QueueUpdateDraw(func() {
+		c.alerts = count
+		c.updateAlerts()
 	})
 }
 
+// updateAlerts shows a badge next to the context while alerts are pending.
+func (c *ClusterInfo) updateAlerts() {
+	if c.alerts == 0 {
+		c.SetCell(0, 2, tview.NewTableCell(""))
+		return
+	}
+	unit := "alerts"
+	if c.alerts == 1 {
+		unit = "alert"
+	}
+	cell := tview.NewTableCell(fmt.Sprintf(" %d %s ", c.alerts, unit))
+	cell.SetAlign(tview.AlignRight)
+	var s tcell.Style
+	cell.SetStyle(s.Bold(true).Foreground(tcell.ColorWhite).Background(tcell.ColorOrangeRed))
+	c.SetCell(0, 2, cell)
+}
+
+// userRow tracks the user section row.
+const userRow = 2
+
+func userInfo(m model.ClusterMeta) string {
+	if m.Impersonate == "" {
+		return m.User
+	}
+
+	return "as " + m.Impersonate
+}
+
 const defconFmt = "%s %s level!"
 
 func (c *ClusterInfo) setDefCon(cpu, mem int) {
@@ -27,8 +27,29 @@ func NewConfigMap(gvr client.GVR) ResourceViewer {
 
 func (s *ConfigMap) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Data", s.dataCmd, true),
+		ui.KeyU:      ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Drift", s.driftCmd, true),
 	})
+	if !s.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyA: ui.NewKeyAction("Create", s.createCmd, true),
+		})
+	}
+}
+
+func (s *ConfigMap) dataCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDataKeys(s.App(), s.GVR(), path)
+
+	return nil
+}
+
+func (s *ConfigMap) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return driftCmd(evt, s.App(), s.GetTable(), "v1/configmaps")
 }
 
 func (s *ConfigMap) refCmd(evt *tcell.EventKey) *tcell.EventKey {
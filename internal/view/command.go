@@ -23,31 +23,81 @@ var (
 type Command struct {
 	app *App
 
-	alias *dao.Alias
-	mx    sync.Mutex
+	alias   *dao.Alias
+	loaded  chan struct{}
+	loadErr error
+	mx      *sync.Mutex
 }
 
 // NewCommand returns a new command.
 func NewCommand(app *App) *Command {
 	return &Command{
 		app: app,
+		mx:  &sync.Mutex{},
 	}
 }
 
-// Init initializes the command.
+// Init initializes the command. Aliases and resource metadata are loaded
+// in the background.
 func (c *Command) Init() error {
 	c.alias = dao.NewAlias(c.app.factory)
-	if _, err := c.alias.Ensure(); err != nil {
-		log.Error().Err(err).Msgf("command init failed!")
-		return err
-	}
+	c.loaded = make(chan struct{})
 	customViewers = loadCustomViewers()
+	go func() {
+		defer close(c.loaded)
+		if _, err := c.alias.Ensure(); err != nil {
+			log.Error().Err(err).Msgf("command init failed!")
+			c.loadErr = err
+		}
+	}()
 
 	return nil
 }
 
+// fork returns a command for another app sharing aliases and resources
+// metadata with this one.
+func (c *Command) fork(app *App) *Command {
+	return &Command{
+		app:    app,
+		alias:  c.alias,
+		loaded: c.loaded,
+		mx:     c.mx,
+	}
+}
+
+// IsLoaded checks if aliases are available.
+func (c *Command) IsLoaded() bool {
+	select {
+	case <-c.loaded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Await blocks until aliases are loaded.
+func (c *Command) Await() error {
+	<-c.loaded
+	return c.loadErr
+}
+
+// aliases returns the aliases once loaded. Callers block while aliases are
+// being reloaded.
+func (c *Command) aliases() *dao.Alias {
+	if err := c.Await(); err != nil {
+		log.Warn().Err(err).Msgf("Alias load failed")
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.alias
+}
+
 // Reset resets Command and reload aliases.
 func (c *Command) Reset(clear bool) error {
+	if err := c.Await(); err != nil {
+		log.Warn().Err(err).Msgf("Initial alias load failed. Reloading...")
+	}
 	c.mx.Lock()
 	defer c.mx.Unlock()
 
@@ -107,8 +157,67 @@ func (c *Command) xrayCmd(cmd string) error {
 	return c.exec(cmd, "xrays", x, true)
 }
 
+func (c *Command) whoCanCmd(args []string) error {
+	q, err := parseWhoCan(args, c.alias.AsGVR)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewWhoCan(c.app, q))
+}
+
+func (c *Command) nsDiffCmd(args []string) error {
+	q, err := parseNSDiff(args, c.alias.AsGVR)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewNSDiff(q))
+}
+
+func (c *Command) pressureCmd(args []string) error {
+	ns := c.app.Config.ActiveNamespace()
+	if len(args) > 0 {
+		ns = args[0]
+	}
+	if client.IsAllNamespaces(ns) {
+		return errors.New("Usage: pressure NAMESPACE")
+	}
+
+	return c.app.inject(NewQuotaPressure(ns))
+}
+
+func (c *Command) grepCmd(args []string) error {
+	q, err := parseGrep(args)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewGrep(q))
+}
+
+func (c *Command) deprecationsCmd(args []string) error {
+	q, err := parseDeprecations(args)
+	if err != nil {
+		return err
+	}
+
+	return c.app.inject(NewDeprecations(q))
+}
+
 // Exec the Command by showing associated display.
 func (c *Command) run(cmd, path string, clearStack bool) error {
+	cmd, err := c.alias.Expand(cmd, dao.AliasScope{
+		Context:   c.app.Config.K9s.CurrentContext,
+		Cluster:   c.app.Config.K9s.CurrentCluster,
+		Namespace: c.app.Config.ActiveNamespace(),
+	})
+	if err != nil {
+		return err
+	}
+	if l, r, columns, ok := parseSplit(cmd); ok {
+		return c.app.splitCtx(l, r, columns)
+	}
 	if c.specialCmd(cmd, path) {
 		return nil
 	}
@@ -130,7 +239,9 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 		}
 		return c.app.dirCmd(cmds[1])
 	default:
-		// checks if Command includes a namespace
+		// checks if Command includes a namespace and a trailing filter
+		res, filter := splitFilter(cmd)
+		cmds = strings.Fields(res)
 		ns := c.app.Config.ActiveNamespace()
 		if len(cmds) == 2 {
 			ns = cmds[1]
@@ -141,10 +252,44 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 		if !c.alias.Check(cmds[0]) {
 			return fmt.Errorf("`%s` Command not found", cmd)
 		}
-		return c.exec(cmd, gvr, c.componentFor(gvr, path, v), clearStack)
+		if err := c.exec(cmd, gvr, c.componentFor(gvr, path, v), clearStack); err != nil {
+			return err
+		}
+		c.applyFilter(filter)
+
+		return nil
 	}
 }
 
+// splitFilter splits a resource command from its trailing label selector,
+// fuzzy or regex filter ie `pods fred -l app=blee` or `pods /blee`.
+func splitFilter(cmd string) (string, string) {
+	tokens := strings.Fields(cmd)
+	for i := 1; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t == "-l" || t == "-f":
+			return strings.Join(tokens[:i], " "), strings.Join(tokens[i:], " ")
+		case strings.HasPrefix(t, "/"):
+			return strings.Join(tokens[:i], " "), strings.TrimPrefix(strings.Join(tokens[i:], " "), "/")
+		}
+	}
+
+	return cmd, ""
+}
+
+// applyFilter filters the top view.
+func (c *Command) applyFilter(filter string) {
+	if filter == "" {
+		return
+	}
+	v, ok := c.app.Content.Top().(ResourceViewer)
+	if !ok {
+		return
+	}
+	v.GetTable().CmdBuff().SetText(filter)
+}
+
 func (c *Command) defaultCmd() error {
 	if !c.app.Conn().ConnectionOK() {
 		return c.run("ctx", "", true)
@@ -164,9 +309,24 @@ func (c *Command) defaultCmd() error {
 		log.Error().Err(err).Msgf("Default run command failed")
 		return c.run("meow", err.Error(), true)
 	}
+	c.applySelector()
+
 	return nil
 }
 
+// applySelector filters the launch view using the command line selector.
+func (c *Command) applySelector() {
+	sel := c.app.Config.K9s.GetSelector()
+	if sel == "" {
+		return
+	}
+	v, ok := c.app.Content.Top().(ResourceViewer)
+	if !ok {
+		return
+	}
+	v.GetTable().CmdBuff().SetText("-l " + sel)
+}
+
 func isContextCmd(c string) bool {
 	return c == "ctx" || c == "context"
 }
@@ -191,6 +351,59 @@ func (c *Command) specialCmd(cmd, path string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "unsplit":
+		if err := c.app.unsplitCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "dryrun", "dry-run":
+		c.app.toggleDryRun()
+		return true
+	case "apply":
+		if err := c.app.applyCmd(strings.TrimSpace(strings.TrimPrefix(cmd, cmds[0]))); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "new":
+		if err := c.app.newCmd(strings.TrimSpace(strings.TrimPrefix(cmd, cmds[0]))); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "as":
+		if err := c.app.impersonateCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "who-can", "whocan":
+		if err := c.whoCanCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "grep":
+		if err := c.grepCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "deprecations":
+		if err := c.deprecationsCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "nsdiff":
+		if err := c.nsDiffCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "pressure":
+		if err := c.pressureCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "reauth":
+		if err := c.app.reauthenticate(); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandLoaded(t *testing.T) {
+	c := Command{loaded: make(chan struct{})}
+	assert.False(t, c.IsLoaded())
+
+	c.loadErr = errors.New("boom")
+	close(c.loaded)
+	assert.True(t, c.IsLoaded())
+	assert.Equal(t, c.loadErr, c.Await())
+}
+
+func TestCommandAliases(t *testing.T) {
+	c := Command{alias: dao.NewAlias(nil), loaded: make(chan struct{}), mx: &sync.Mutex{}}
+	got := make(chan *dao.Alias, 1)
+	go func() {
+		got <- c.aliases()
+	}()
+
+	c.mx.Lock()
+	close(c.loaded)
+	select {
+	case <-got:
+		assert.Fail(t, "aliases returned during a reload")
+	case <-time.After(50 * time.Millisecond):
+	}
+	c.mx.Unlock()
+	assert.Equal(t, c.alias, <-got)
+}
+
+func TestSplitFilter(t *testing.T) {
+	uu := map[string]struct {
+		cmd, res, filter string
+	}{
+		"plain":     {cmd: "pods fred", res: "pods fred"},
+		"labels":    {cmd: "pods -l app=blee", res: "pods", filter: "-l app=blee"},
+		"nsLabels":  {cmd: "pods fred -l app=blee,env=dev", res: "pods fred", filter: "-l app=blee,env=dev"},
+		"fuzzy":     {cmd: "pods -f blee", res: "pods", filter: "-f blee"},
+		"regex":     {cmd: "pods fred /blee", res: "pods fred", filter: "blee"},
+		"leadSlash": {cmd: "/blee", res: "/blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, filter := splitFilter(u.cmd)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.filter, filter)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	connectivityTitle   = "Connectivity"
+	connectivityTimeout = 2 * time.Minute
+)
+
+// connectivityChecker represents a resource end to end connectivity checks.
+type connectivityChecker interface {
+	Connectivity(ctx context.Context, path string) (dao.Hops, error)
+}
+
+// showConnectivity runs a resource connectivity checks and reports each hop
+// outcome.
+func showConnectivity(app *App, c connectivityChecker, path string) {
+	details := NewDetails(app, connectivityTitle, path, true).Update("Checking connectivity...")
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), connectivityTimeout)
+		defer cancel()
+		hh, err := c.Connectivity(ctx, path)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				details.Update("error: " + err.Error())
+				return
+			}
+			details.Update(hh.String())
+		})
+	}()
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package view
+
+// defaultEditor tracks the editor to use when none is configured.
+const defaultEditor = ""
+
+// withConsole runs a command on the console.
+func withConsole(fn func() error) error {
+	return fn()
+}
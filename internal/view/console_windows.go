@@ -0,0 +1,37 @@
+package view
+
+import (
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sys/windows"
+)
+
+// defaultEditor tracks the editor to use when none is configured.
+const defaultEditor = "notepad"
+
+// withConsole runs a command on the console, enabling ANSI sequences
+// handling. Console modes are restored afterwards as exec sessions leave the
+// console in raw mode, breaking keys decoding once k9s resumes. No pseudo
+// console is allocated: the command owns the console while it runs so
+// kubectl picks up window resizes on its own.
+func withConsole(fn func() error) error {
+	in, out := windows.Handle(os.Stdin.Fd()), windows.Handle(os.Stdout.Fd())
+	var inMode, outMode uint32
+	inErr, outErr := windows.GetConsoleMode(in, &inMode), windows.GetConsoleMode(out, &outMode)
+	if outErr == nil {
+		if err := windows.SetConsoleMode(out, outMode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
+			log.Warn().Err(err).Msg("Unable to enable console ANSI sequences")
+		}
+	}
+	defer func() {
+		if inErr == nil {
+			_ = windows.SetConsoleMode(in, inMode)
+		}
+		if outErr == nil {
+			_ = windows.SetConsoleMode(out, outMode)
+		}
+	}()
+
+	return fn()
+}
@@ -58,8 +58,12 @@ func (c *Container) Name() string { return containerTitle }
 
 func (c *Container) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Shell", c.shellCmd, true),
-		ui.KeyA: ui.NewKeyAction("Attach", c.attachCmd, true),
+		ui.KeyS:      ui.NewKeyAction("Shell", c.shellCmd, true),
+		ui.KeyA:      ui.NewKeyAction("Attach", c.attachCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Debug", c.debugCmd, true),
+		ui.KeyG:      ui.NewKeyAction("Download", c.downloadCmd, true),
+		ui.KeyU:      ui.NewKeyAction("Upload", c.uploadCmd, true),
+		ui.KeyR:      ui.NewKeyAction("Run Probes", c.probesCmd, true),
 	})
 }
 
@@ -72,6 +76,7 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true),
+		ui.KeyI:      ui.NewKeyAction("Image", c.imageCmd, true),
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 	})
@@ -125,6 +130,46 @@ func (c *Container) portForwardContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyPath, c.GetTable().Path)
 }
 
+func (c *Container) imageCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showImage(c.App(), c.GetTable().Path, sel)
+
+	return nil
+}
+
+func (c *Container) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" || c.App().Prompt().InCmdMode() {
+		return evt
+	}
+	showDownload(c.App(), c.GetTable().Path, sel)
+
+	return nil
+}
+
+func (c *Container) uploadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" || c.App().Prompt().InCmdMode() {
+		return evt
+	}
+	showUpload(c.App(), c.GetTable().Path, sel)
+
+	return nil
+}
+
+func (c *Container) probesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	showProbes(c.App(), c.GetTable().Path, sel)
+
+	return nil
+}
+
 func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
@@ -138,6 +183,21 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	if !podIsRunning(c.App().factory, c.GetTable().Path) {
+		c.App().Flash().Errf("%s is not in a running state", c.GetTable().Path)
+		return nil
+	}
+	debugIn(c.App(), c, c.GetTable().Path, sel)
+
+	return nil
+}
+
 func (c *Container) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
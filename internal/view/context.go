@@ -43,6 +43,9 @@ func (c *Context) useCtx(app *App, model ui.Tabular, gvr, path string) {
 }
 
 func useContext(app *App, name string) error {
+	if app.IsPeer() {
+		return app.openPeer(name, false)
+	}
 	if app.Content.Top() != nil {
 		app.Content.Top().Stop()
 	}
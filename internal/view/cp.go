@@ -0,0 +1,111 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+const (
+	cpDefaultDir   = "/tmp/"
+	cpProgressRate = 500 * time.Millisecond
+)
+
+// showDownload prompts for a container path and its local destination then
+// copies it out of the container.
+func showDownload(app *App, fqn, co string) {
+	dialog.ShowPrompt(app.Styles.Dialog(), app.Content.Pages, "Download", "Container Path:", cpDefaultDir, func(src string) {
+		_, po := client.Namespaced(fqn)
+		dst := filepath.Join(
+			config.K9sDumpDir,
+			sanitizeFilename(app.Config.K9s.CurrentCluster),
+			sanitizeFilename(po),
+			path.Base(path.Clean(src)),
+		)
+		dialog.ShowPrompt(app.Styles.Dialog(), app.Content.Pages, "Download "+src, "Local Path:", dst, func(dst string) {
+			if err := ensureDir(filepath.Dir(dst)); err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			transfer(app, fmt.Sprintf("%s:%s:%s", fqn, co, src), dst, func(cp *dao.Copier, progress dao.CopyProgress) (int64, error) {
+				return cp.Download(context.Background(), fqn, co, src, dst, progress)
+			})
+		}, func() {})
+	}, func() {})
+}
+
+// showUpload prompts for a local path and its container destination then
+// copies it into the container.
+func showUpload(app *App, fqn, co string) {
+	if dao.IsDryRun() {
+		app.Flash().Warn(dryRunTag("Uploads are disabled"))
+		return
+	}
+	dialog.ShowPrompt(app.Styles.Dialog(), app.Content.Pages, "Upload", "Local Path:", "", func(src string) {
+		if _, err := os.Stat(src); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		dst := cpDefaultDir + filepath.Base(src)
+		dialog.ShowPrompt(app.Styles.Dialog(), app.Content.Pages, "Upload "+src, "Container Path:", dst, func(dst string) {
+			transfer(app, src, fmt.Sprintf("%s:%s:%s", fqn, co, dst), func(cp *dao.Copier, progress dao.CopyProgress) (int64, error) {
+				n, err := cp.Upload(context.Background(), fqn, co, src, dst, progress)
+				e := dao.NewAuditEntry("upload", "v1/pods", fqn, err)
+				e.Details = fmt.Sprintf("container=%s path=%s", co, dst)
+				app.audit(e)
+				return n, err
+			})
+		}, func() {})
+	}, func() {})
+}
+
+// transfer runs a copy in the background reporting its progress.
+func transfer(app *App, src, dst string, copyFn func(*dao.Copier, dao.CopyProgress) (int64, error)) {
+	app.Flash().Infof("Copying %s to %s...", src, dst)
+	go func() {
+		var cp dao.Copier
+		cp.Init(app.factory, client.NewGVR("v1/pods"))
+		var last time.Time
+		n, err := copyFn(&cp, func(done, total int64) {
+			if time.Since(last) < cpProgressRate {
+				return
+			}
+			last = time.Now()
+			app.QueueUpdateDraw(func() {
+				app.Flash().Infof("Copying %s %s", src, cpProgress(done, total))
+			})
+		})
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Copy failed: %s", err)
+				return
+			}
+			app.Flash().Infof("Copied %s to %s (%s)", src, dst, imageSize(n))
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cpProgress returns a transfer progress. The total is an estimate and
+// might be exceeded.
+func cpProgress(done, total int64) string {
+	if total <= 0 {
+		return imageSize(done)
+	}
+	pct := done * 100 / total
+	if pct > 99 {
+		pct = 99
+	}
+
+	return fmt.Sprintf("%d%% (%s/%s)", pct, imageSize(done), imageSize(total))
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCpProgress(t *testing.T) {
+	uu := map[string]struct {
+		done, total int64
+		e           string
+	}{
+		"unknown": {done: 2048, e: "2.0KiB"},
+		"partial": {done: 512, total: 2048, e: "25% (512B/2.0KiB)"},
+		"over":    {done: 4096, total: 2048, e: "99% (4.0KiB/2.0KiB)"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cpProgress(u.done, u.total))
+		})
+	}
+}
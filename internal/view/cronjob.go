@@ -3,12 +3,14 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
@@ -62,14 +64,18 @@ func jobCtx(path, uid string) ContextFunc {
 }
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
+	if c.App().Config.K9s.IsReadOnly() {
+		return
+	}
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		ui.KeyS:        ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
 	})
 }
 
 func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
-	sel := c.GetTable().GetSelectedItem()
-	if sel == "" {
+	paths := c.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return evt
 	}
 
@@ -83,11 +89,72 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	if err := runner.Run(sel); err != nil {
-		c.App().Flash().Errf("Cronjob trigger failed %v", err)
+	runBulk(context.Background(), c.App(), bulkOp{
+		verb:  "Trigger",
+		past:  "triggered",
+		gvr:   c.GVR(),
+		paths: paths,
+		fn: func(_ context.Context, path string) error {
+			err := runner.Run(path)
+			c.App().audit(dao.NewAuditEntry("trigger", c.GVR().String(), path, err))
+			return err
+		},
+		ok: c.GetTable().DeleteMark,
+	})
+
+	return nil
+}
+
+// toggleSuspendCmd suspends the selected cronjobs schedule unless the
+// selected cronjob is already suspended, in which case they all get resumed.
+func (c *CronJob) toggleSuspendCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel, paths := c.GetTable().GetSelectedItem(), c.GetTable().GetSelectedItems()
+	if sel == "" || len(paths) == 0 {
 		return evt
 	}
-	c.App().Flash().Infof("Triggering Job %s %s", c.GVR(), sel)
+
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil
+	}
+	s, ok := res.(dao.Suspendable)
+	if !ok {
+		c.App().Flash().Err(fmt.Errorf("expecting a suspendable resource for %q", c.GVR()))
+		return nil
+	}
+
+	suspend, verb, past := !c.isSuspended(sel), "Suspend", "suspended"
+	if !suspend {
+		verb, past = "Resume", "resumed"
+	}
+	msg := fmt.Sprintf("%s %s?", verb, bulkSubject(c.GVR(), paths))
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm "+verb, msg, func() {
+		runBulk(context.Background(), c.App(), bulkOp{
+			verb:  verb,
+			past:  past,
+			gvr:   c.GVR(),
+			paths: paths,
+			fn: func(ctx context.Context, path string) error {
+				ctx, cancel := context.WithTimeout(ctx, c.App().Conn().Config().CallTimeout())
+				defer cancel()
+				err := s.SetSuspend(ctx, path, suspend)
+				c.App().audit(dao.NewAuditEntry(strings.ToLower(verb), c.GVR().String(), path, err))
+				return err
+			},
+			ok: c.GetTable().DeleteMark,
+		})
+	}, func() {})
 
 	return nil
 }
+
+func (c *CronJob) isSuspended(path string) bool {
+	data := c.GetTable().GetModel().Peek()
+	idx, ok := data.RowEvents.FindIndex(path)
+	col := data.Header.IndexOf("SUSPEND", true)
+	if !ok || col == -1 {
+		return false
+	}
+
+	return data.RowEvents[idx].Row.Fields[col] == "true"
+}
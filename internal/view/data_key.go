@@ -0,0 +1,247 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const dataKeyTitle = "DataKeys"
+
+// DataKey represents a secret or configmap keys view. Values are loaded on demand.
+type DataKey struct {
+	ResourceViewer
+
+	owner    client.GVR
+	path     string
+	revealed map[string]bool
+	mx       sync.RWMutex
+}
+
+// NewDataKey returns a new data keys view for a given secret or configmap.
+func NewDataKey(owner client.GVR, path string) ResourceViewer {
+	d := DataKey{
+		ResourceViewer: NewBrowser(client.NewGVR("datakeys")),
+		owner:          owner,
+		path:           path,
+	}
+	d.GetTable().SetEnterFn(d.viewValue)
+	d.GetTable().SetColorerFn(render.DataKey{}.ColorerFunc())
+	d.SetContextFn(d.dataContext)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// Name returns the component name.
+func (d *DataKey) Name() string { return dataKeyTitle }
+
+func (d *DataKey) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyR:      ui.NewKeyAction("Reveal/Hide", d.revealCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Copy Value", d.cpValueCmd, true),
+	})
+	if d.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyE: ui.NewKeyAction("Edit", d.editCmd, true),
+		ui.KeyA: ui.NewKeyAction("Add", d.addCmd, true),
+	})
+}
+
+// cpValueCmd copies the selected key decoded value to the clipboard.
+func (d *DataKey) cpValueCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	b, err := d.value(key)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	if err := copyToClipboard(string(b)); err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	d.App().Flash().Infof("Value of %s copied to clipboard...", key)
+
+	return nil
+}
+
+// editCmd edits the selected key decoded value.
+func (d *DataKey) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	b, err := d.value(key)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	if !utf8.Valid(b) {
+		d.App().Flash().Warnf("Key %s holds a binary value and can not be edited", key)
+		return nil
+	}
+	d.editValue(key, b)
+
+	return nil
+}
+
+// addCmd prompts for a new key and edits its value.
+func (d *DataKey) addCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.Stop()
+	defer d.Start()
+	dialog.ShowPrompt(d.App().Styles.Dialog(), d.App().Content.Pages, "Add Key", "Key:", "", func(key string) {
+		key = strings.TrimSpace(key)
+		data := d.GetTable().GetModel().Peek()
+		if _, ok := data.RowEvents.FindIndex(key); ok {
+			d.App().Flash().Errf("Key %s already exists on %s", key, d.path)
+			return
+		}
+		d.editValue(key, nil)
+	}, func() {})
+
+	return nil
+}
+
+func (d *DataKey) editValue(key string, b []byte) {
+	edited, err := editTempBuffer(d.App(), d, "k9s-datakey-*", b)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	edited = trimEditorNewline(b, edited)
+	if b != nil && bytes.Equal(b, edited) {
+		d.App().Flash().Info("No changes detected for " + key)
+		return
+	}
+
+	var dk dao.DataKey
+	dk.Init(d.App().factory, client.NewGVR("datakeys"))
+	ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+	defer cancel()
+	err = dk.SetValue(d.App().withRetry(ctx), d.owner.String(), d.path, key, edited)
+	e := dao.NewAuditEntry("edit", d.owner.String(), d.path, err)
+	e.Details = "key=" + key
+	d.App().audit(e)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	d.App().Flash().Info(dryRunTag(fmt.Sprintf("Key %s updated on %s", key, d.path)))
+	d.Refresh()
+}
+
+func (d *DataKey) value(key string) ([]byte, error) {
+	var dk dao.DataKey
+	dk.Init(d.App().factory, client.NewGVR("datakeys"))
+
+	return dk.Value(d.owner.String(), d.path, key)
+}
+
+func (d *DataKey) dataContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, d.path)
+	ctx = context.WithValue(ctx, internal.KeyRevealed, d.revealedKeys())
+	return context.WithValue(ctx, internal.KeyOwnerGVR, d.owner.String())
+}
+
+// revealCmd toggles the selected key value visibility.
+func (d *DataKey) revealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := d.GetTable().GetSelectedItem()
+	if key == "" {
+		return evt
+	}
+	data := d.GetTable().GetModel().Peek()
+	if idx, ok := data.RowEvents.FindIndex(key); ok && render.IsLargeDataKey(data.Header, data.RowEvents[idx].Row) {
+		d.App().Flash().Warnf("Key %s is too large to reveal inline. Press enter to view it", key)
+		return nil
+	}
+
+	d.mx.Lock()
+	if d.revealed == nil {
+		d.revealed = make(map[string]bool)
+	}
+	if d.revealed[key] {
+		delete(d.revealed, key)
+	} else {
+		d.revealed[key] = true
+	}
+	d.mx.Unlock()
+	d.Refresh()
+
+	return nil
+}
+
+func (d *DataKey) revealedKeys() map[string]bool {
+	d.mx.RLock()
+	defer d.mx.RUnlock()
+
+	kk := make(map[string]bool, len(d.revealed))
+	for k := range d.revealed {
+		kk[k] = true
+	}
+
+	return kk
+}
+
+func (d *DataKey) viewValue(app *App, _ ui.Tabular, _, key string) {
+	data := d.GetTable().GetModel().Peek()
+	idx, ok := data.RowEvents.FindIndex(key)
+	if !ok || !render.IsLargeDataKey(data.Header, data.RowEvents[idx].Row) {
+		d.showValue(app, key)
+		return
+	}
+
+	size := data.RowEvents[idx].Row.Fields[data.Header.IndexOf("SIZE", true)]
+	msg := fmt.Sprintf("Key %s is %s. Load it anyway?", key, size)
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Large Value", msg, func() {
+		d.showValue(app, key)
+	}, func() {})
+}
+
+func showDataKeys(app *App, owner client.GVR, path string) {
+	if err := app.inject(NewDataKey(owner, path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (d *DataKey) showValue(app *App, key string) {
+	b, err := d.value(key)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	s := string(b)
+	if !utf8.Valid(b) {
+		s = hex.Dump(b)
+	}
+	details := NewDetails(app, "Data", d.path+"::"+key, true).Update(s)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// trimEditorNewline drops the trailing newline editors append on save.
+func trimEditorNewline(orig, edited []byte) []byte {
+	if bytes.HasSuffix(orig, []byte("\n")) {
+		return edited
+	}
+
+	return bytes.TrimSuffix(edited, []byte("\n"))
+}
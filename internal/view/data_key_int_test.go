@@ -0,0 +1,26 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimEditorNewline(t *testing.T) {
+	uu := map[string]struct {
+		orig, edited, e string
+	}{
+		"appended":  {orig: "fred", edited: "blee\n", e: "blee"},
+		"kept":      {orig: "fred\n", edited: "blee\n", e: "blee\n"},
+		"none":      {orig: "fred", edited: "blee", e: "blee"},
+		"multiline": {orig: "a\nb", edited: "a\nb\nc\n", e: "a\nb\nc"},
+		"new":       {edited: "fred\n", e: "fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(trimEditorNewline([]byte(u.orig), []byte(u.edited))))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	debugRetryCount = 60
+	debugRetryDelay = 500 * time.Millisecond
+)
+
+func containerDebug(a *App, comp model.Component, path, co string) error {
+	if co != "" {
+		debugIn(a, comp, path, co)
+		return nil
+	}
+
+	cc, err := fetchContainers(a.factory, path, false)
+	if err != nil {
+		return err
+	}
+	if len(cc) == 1 {
+		debugIn(a, comp, path, cc[0])
+		return nil
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		debugIn(a, comp, path, co)
+	})
+
+	return a.inject(picker)
+}
+
+// debugIn injects an ephemeral debug container targeting the given container
+// and attaches to it once it is running.
+func debugIn(a *App, comp model.Component, path, target string) {
+	d, err := debugger(a)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	cfg := a.Config.K9s.Debugger
+	opts := dao.DebugOptions{Image: cfg.Image, Command: cfg.Command, Target: target}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	co, err := d.Debug(ctx, path, opts)
+	a.audit(dao.NewAuditEntry("debug", "v1/pods", path, err))
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if dao.IsDryRun() {
+		a.Flash().Info(dryRunTag(fmt.Sprintf("Debug container %s added to %s", co, path)))
+		return
+	}
+
+	a.Flash().Infof("Waiting for debug container %s (%s) to start...", co, opts.Image)
+	go func() {
+		if err := waitForDebugger(d, path, co); err != nil {
+			log.Error().Err(err).Msgf("Debug container %s", co)
+			a.QueueUpdateDraw(func() {
+				a.Flash().Err(err)
+			})
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			resumeAttachIn(a, comp, path, co)
+		})
+	}()
+}
+
+func waitForDebugger(d dao.Debugger, path, co string) error {
+	for i := 0; i < debugRetryCount; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), debugRetryDelay)
+		ok, err := d.IsDebuggerRunning(ctx, path, co)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		time.Sleep(debugRetryDelay)
+	}
+
+	return fmt.Errorf("timed out waiting for debug container %s on %s", co, path)
+}
+
+func debugger(a *App) (dao.Debugger, error) {
+	res, err := dao.AccessorFor(a.factory, client.NewGVR("v1/pods"))
+	if err != nil {
+		return nil, err
+	}
+	d, ok := res.(dao.Debugger)
+	if !ok {
+		return nil, fmt.Errorf("expecting a debugger for pods")
+	}
+
+	return d, nil
+}
@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	deprecationsUsage = "Usage: deprecations [TARGET_VERSION] ie deprecations 1.25"
+
+	// deprecationsRefreshRate throttles cluster wide scans.
+	deprecationsRefreshRate = 30 * time.Second
+)
+
+var targetVersionRX = regexp.MustCompile(`^v?\d+\.\d+(\.\d+)?$`)
+
+// Deprecations presents resources written with deprecated or removed api
+// versions.
+type Deprecations struct {
+	ResourceViewer
+
+	query dao.DeprecationQuery
+}
+
+// NewDeprecations returns a new viewer.
+func NewDeprecations(q dao.DeprecationQuery) *Deprecations {
+	d := Deprecations{
+		ResourceViewer: NewBrowser(client.NewGVR("deprecations")),
+		query:          q,
+	}
+	d.GetTable().SetColorerFn(render.Deprecation{}.ColorerFunc())
+	d.AddBindKeysFn(d.bindKeys)
+	d.GetTable().SetSortCol("REMOVED-IN", true)
+	d.SetContextFn(d.deprecationsCtx)
+	d.GetTable().SetEnterFn(d.gotoResource)
+
+	return &d
+}
+
+// Init initializes the view.
+func (d *Deprecations) Init(ctx context.Context) error {
+	if err := d.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	d.GetTable().GetModel().SetRefreshRate(deprecationsRefreshRate)
+
+	return nil
+}
+
+func (d *Deprecations) deprecationsCtx(ctx context.Context) context.Context {
+	if d.query.Target != "" {
+		ctx = context.WithValue(ctx, internal.KeyPath, d.query.Target)
+	}
+	return context.WithValue(ctx, internal.KeyDeprecations, d.query)
+}
+
+func (d *Deprecations) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", d.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Namespace", d.GetTable().SortColCmd("NAMESPACE", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Removed", d.GetTable().SortColCmd("REMOVED-IN", true), false),
+	})
+}
+
+// gotoResource navigates to the selected resource view.
+func (d *Deprecations) gotoResource(app *App, _ ui.Tabular, _, path string) {
+	row, ok := d.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 3 {
+		return
+	}
+	fqn := client.FQN(row.Fields[0], row.Fields[1])
+	if err := app.gotoResource(row.Fields[2], fqn, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// parseDeprecations converts command arguments ie `1.25` to a query.
+func parseDeprecations(args []string) (dao.DeprecationQuery, error) {
+	if len(args) > 1 {
+		return dao.DeprecationQuery{}, errors.New(deprecationsUsage)
+	}
+	if len(args) == 0 {
+		return dao.DeprecationQuery{}, nil
+	}
+	if !targetVersionRX.MatchString(args[0]) {
+		return dao.DeprecationQuery{}, fmt.Errorf("invalid target version %q. %s", args[0], deprecationsUsage)
+	}
+
+	return dao.DeprecationQuery{Target: args[0]}, nil
+}
@@ -0,0 +1,576 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	runewidth "github.com/mattn/go-runewidth"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	diffTitle     = "Diff"
+	diffSeparator = " │ "
+
+	// diffMaxEdits caps the diff computation, beyond it sides are deemed
+	// entirely different.
+	diffMaxEdits = 1000
+)
+
+type diffKind int
+
+const (
+	diffSame diffKind = iota
+	diffDelete
+	diffInsert
+	diffChange
+)
+
+// diffRow represents a side by side diff line. Line numbers are 1 based, 0
+// denotes a blank side.
+type diffRow struct {
+	kind        diffKind
+	left, right string
+	lno, rno    int
+}
+
+// Diff represents a side by side diff viewer.
+type Diff struct {
+	*tview.TextView
+
+	app            *App
+	actions        ui.KeyActions
+	title, subject string
+	from, to       string
+	rows           []diffRow
+	hunks          []int
+	currentHunk    int
+	width          int
+	fullScreen     bool
+}
+
+// NewDiff returns a diff viewer between two documents.
+func NewDiff(app *App, title, subject, from, fromText, to, toText string) *Diff {
+	rows := diffLines(splitLines(fromText), splitLines(toText))
+	return &Diff{
+		TextView: tview.NewTextView(),
+		app:      app,
+		actions:  make(ui.KeyActions),
+		title:    title,
+		subject:  subject,
+		from:     from,
+		to:       to,
+		rows:     rows,
+		hunks:    diffHunks(rows),
+	}
+}
+
+// Init initializes the viewer.
+func (d *Diff) Init(_ context.Context) error {
+	d.SetBorder(true)
+	d.SetScrollable(true).SetWrap(false)
+	d.SetDynamicColors(true)
+	d.SetTitleColor(tcell.ColorAqua)
+	d.SetBorderPadding(0, 0, 1, 1)
+	d.SetInputCapture(d.keyboard)
+	d.bindKeys()
+	d.updateTitle()
+
+	d.app.Styles.AddListener(d)
+	d.StylesChanged(d.app.Styles)
+
+	return nil
+}
+
+// Changed returns true if the documents differ.
+func (d *Diff) Changed() bool {
+	return len(d.hunks) > 0
+}
+
+// Draw renders the diff to fit the view width.
+func (d *Diff) Draw(screen tcell.Screen) {
+	if _, _, w, _ := d.GetInnerRect(); w != d.width {
+		d.width = w
+		d.SetText(renderDiff(d.from, d.to, d.rows, w))
+	}
+	d.TextView.Draw(screen)
+}
+
+// StylesChanged notifies the skin changed.
+func (d *Diff) StylesChanged(s *config.Styles) {
+	d.SetBackgroundColor(s.BgColor())
+	d.SetTextColor(s.FgColor())
+	d.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+}
+
+// Actions returns menu actions.
+func (d *Diff) Actions() ui.KeyActions {
+	return d.actions
+}
+
+// Name returns the component name.
+func (d *Diff) Name() string { return d.title }
+
+// Start starts the view updater.
+func (d *Diff) Start() {}
+
+// Stop terminates the updater.
+func (d *Diff) Stop() {
+	d.app.Styles.RemoveListener(d)
+}
+
+// Hints returns menu hints.
+func (d *Diff) Hints() model.MenuHints {
+	return d.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (d *Diff) ExtraHints() map[string]string {
+	return nil
+}
+
+func (d *Diff) bindKeys() {
+	d.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
+		ui.KeyN:         ui.NewKeyAction("Next Change", d.hunkCmd(1), true),
+		ui.KeyShiftN:    ui.NewKeyAction("Prev Change", d.hunkCmd(-1), true),
+		ui.KeyC:         ui.NewKeyAction("Copy", d.cpCmd, true),
+		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", d.toggleFullScreenCmd, true),
+	})
+}
+
+func (d *Diff) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := d.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (d *Diff) hunkCmd(delta int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if len(d.hunks) == 0 {
+			return nil
+		}
+		d.currentHunk = (d.currentHunk + delta + len(d.hunks)) % len(d.hunks)
+		// Accounts for the header line.
+		d.ScrollTo(d.hunks[d.currentHunk]+1, 0)
+		d.updateTitle()
+
+		return nil
+	}
+}
+
+func (d *Diff) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.app.Flash().Info("Diff copied to clipboard...")
+	if err := copyToClipboard(unifiedDiff(d.rows)); err != nil {
+		d.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (d *Diff) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if d.app.InCmdMode() {
+		return evt
+	}
+
+	d.fullScreen = !d.fullScreen
+	d.SetBorder(!d.fullScreen)
+
+	return nil
+}
+
+func (d *Diff) updateTitle() {
+	fmat := fmt.Sprintf(detailsTitleFmt, d.title, d.subject)
+	if len(d.hunks) > 0 {
+		fmat += fmt.Sprintf("[fg:bg:b]<[hilite:bg:b]%d/%d[fg:bg:b]> ", d.currentHunk+1, len(d.hunks))
+	}
+	d.SetTitle(ui.SkinTitle(fmat, d.app.Styles.Frame()))
+}
+
+// revisionGVRs tracks resources diffed by revisions.
+var revisionGVRs = map[string]struct{}{
+	"apps/v1/replicasets":         {},
+	"apps/v1/controllerrevisions": {},
+}
+
+func (b *Browser) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var (
+		d   *Diff
+		err error
+	)
+	if _, ok := revisionGVRs[b.GVR().String()]; ok {
+		d, err = b.revisionsDiff(path)
+	} else {
+		d, err = b.lastAppliedDiff(path)
+	}
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if !d.Changed() {
+		b.app.Flash().Infof("No differences found for %s", path)
+		return nil
+	}
+	if err := b.app.inject(d); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// lastAppliedDiff diffs a resource last applied configuration against its
+// live state.
+func (b *Browser) lastAppliedDiff(path string) (*Diff, error) {
+	o, err := b.app.factory.Get(b.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	live, applied, err := dao.LastApplied(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDiff(b.app, diffTitle, path, "last-applied", applied, "live", live), nil
+}
+
+// revisionsDiff diffs two marked revisions or the selected revision against
+// its predecessor.
+func (b *Browser) revisionsDiff(path string) (*Diff, error) {
+	oo := make([]runtime.Object, 0, 2)
+	if sels := b.GetSelectedItems(); len(sels) == 2 {
+		for _, sel := range sels {
+			o, err := b.app.factory.Get(b.GVR().String(), sel, true, labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			oo = append(oo, o)
+		}
+	} else {
+		o, err := b.app.factory.Get(b.GVR().String(), path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		ns, _ := client.Namespaced(path)
+		all, err := b.app.factory.List(b.GVR().String(), ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		prev, err := dao.PreviousRevision(all, o)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, prev, o)
+	}
+
+	r1, s1, err := dao.Revision(oo[0])
+	if err != nil {
+		return nil, err
+	}
+	r2, s2, err := dao.Revision(oo[1])
+	if err != nil {
+		return nil, err
+	}
+	n1, n2 := revisionName(oo[0], r1), revisionName(oo[1], r2)
+	if r1 > r2 {
+		n1, s1, n2, s2 = n2, s2, n1, s1
+	}
+
+	return NewDiff(b.app, diffTitle, path, n1, s1, n2, s2), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func revisionName(o runtime.Object, rev int64) string {
+	var n string
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		n = u.GetName()
+	}
+
+	return fmt.Sprintf("%s (rev %d)", n, rev)
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+// diffLines computes a side by side line diff using Myers algorithm.
+func diffLines(aa, bb []string) []diffRow {
+	var head, tail []diffRow
+	for len(aa) > 0 && len(bb) > 0 && aa[0] == bb[0] {
+		n := len(head) + 1
+		head = append(head, diffRow{kind: diffSame, left: aa[0], right: bb[0], lno: n, rno: n})
+		aa, bb = aa[1:], bb[1:]
+	}
+	var tt int
+	for len(aa) > tt && len(bb) > tt && aa[len(aa)-1-tt] == bb[len(bb)-1-tt] {
+		tt++
+	}
+	off := len(head)
+	for i := tt; i > 0; i-- {
+		l, r := aa[len(aa)-i], bb[len(bb)-i]
+		tail = append(tail, diffRow{kind: diffSame, left: l, right: r, lno: off + len(aa) - i + 1, rno: off + len(bb) - i + 1})
+	}
+	aa, bb = aa[:len(aa)-tt], bb[:len(bb)-tt]
+
+	rows := append(head, pairChanges(myers(aa, bb, off))...)
+	return append(rows, tail...)
+}
+
+// myers returns the raw edit script between two sides.
+func myers(aa, bb []string, off int) []diffRow {
+	n, m := len(aa), len(bb)
+	max := n + m
+	if max == 0 {
+		return nil
+	}
+
+	v := make([]int, 2*max+2)
+	trace := make([]vSnapshot, 0, 16)
+	found := false
+	for d := 0; d <= max && !found; d++ {
+		if d > diffMaxEdits {
+			return replaceAll(aa, bb, off)
+		}
+		trace = append(trace, snapshot(v, max, d))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[max+k-1] < v[max+k+1]) {
+				x = v[max+k+1]
+			} else {
+				x = v[max+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && aa[x] == bb[y] {
+				x, y = x+1, y+1
+			}
+			v[max+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	rows := make([]diffRow, 0, max)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v, k := trace[d], x-y
+		var pk int
+		if k == -d || (k != d && v.at(max+k-1) < v.at(max+k+1)) {
+			pk = k + 1
+		} else {
+			pk = k - 1
+		}
+		px := v.at(max + pk)
+		py := px - pk
+		for x > px && y > py {
+			x, y = x-1, y-1
+			rows = append(rows, diffRow{kind: diffSame, left: aa[x], right: bb[y], lno: off + x + 1, rno: off + y + 1})
+		}
+		if d == 0 {
+			break
+		}
+		if x == px {
+			y--
+			rows = append(rows, diffRow{kind: diffInsert, right: bb[y], rno: off + y + 1})
+		} else {
+			x--
+			rows = append(rows, diffRow{kind: diffDelete, left: aa[x], lno: off + x + 1})
+		}
+	}
+	for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+		rows[i], rows[j] = rows[j], rows[i]
+	}
+
+	return rows
+}
+
+// vSnapshot tracks the furthest reaching paths of a Myers iteration, only
+// keeping the diagonals reachable in d edits.
+type vSnapshot struct {
+	base int
+	v    []int
+}
+
+func snapshot(v []int, max, d int) vSnapshot {
+	lo, hi := max-d-1, max+d+2
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(v) {
+		hi = len(v)
+	}
+	s := vSnapshot{base: lo, v: make([]int, hi-lo)}
+	copy(s.v, v[lo:hi])
+
+	return s
+}
+
+func (s vSnapshot) at(i int) int {
+	return s.v[i-s.base]
+}
+
+func replaceAll(aa, bb []string, off int) []diffRow {
+	rows := make([]diffRow, 0, len(aa)+len(bb))
+	for i, l := range aa {
+		rows = append(rows, diffRow{kind: diffDelete, left: l, lno: off + i + 1})
+	}
+	for i, r := range bb {
+		rows = append(rows, diffRow{kind: diffInsert, right: r, rno: off + i + 1})
+	}
+
+	return rows
+}
+
+// pairChanges lines up deletions with the insertions that follow them.
+func pairChanges(rows []diffRow) []diffRow {
+	res := make([]diffRow, 0, len(rows))
+	for i := 0; i < len(rows); {
+		if rows[i].kind == diffSame {
+			res = append(res, rows[i])
+			i++
+			continue
+		}
+		var dels, ins []diffRow
+		for ; i < len(rows) && rows[i].kind == diffDelete; i++ {
+			dels = append(dels, rows[i])
+		}
+		for ; i < len(rows) && rows[i].kind == diffInsert; i++ {
+			ins = append(ins, rows[i])
+		}
+		for j := 0; j < len(dels) || j < len(ins); j++ {
+			switch {
+			case j < len(dels) && j < len(ins):
+				res = append(res, diffRow{kind: diffChange, left: dels[j].left, lno: dels[j].lno, right: ins[j].right, rno: ins[j].rno})
+			case j < len(dels):
+				res = append(res, dels[j])
+			default:
+				res = append(res, ins[j])
+			}
+		}
+	}
+
+	return res
+}
+
+// diffHunks returns the row indexes where changes start.
+func diffHunks(rows []diffRow) []int {
+	var hh []int
+	for i, r := range rows {
+		if r.kind != diffSame && (i == 0 || rows[i-1].kind == diffSame) {
+			hh = append(hh, i)
+		}
+	}
+
+	return hh
+}
+
+func renderDiff(from, to string, rows []diffRow, width int) string {
+	var maxNo int
+	for _, r := range rows {
+		if r.lno > maxNo {
+			maxNo = r.lno
+		}
+		if r.rno > maxNo {
+			maxNo = r.rno
+		}
+	}
+	gutter := len(strconv.Itoa(maxNo))
+	col := (width - runewidth.StringWidth(diffSeparator)) / 2
+	if col < gutter+2 {
+		col = gutter + 2
+	}
+
+	var b strings.Builder
+	b.WriteString("[::b]" + diffCell(from, col) + diffSeparator + diffCell(to, col) + "[::-]\n")
+	for _, r := range rows {
+		lc, rc := "", ""
+		switch r.kind {
+		case diffDelete:
+			lc = "[red]"
+		case diffInsert:
+			rc = "[green]"
+		case diffChange:
+			lc, rc = "[orangered]", "[springgreen]"
+		}
+		b.WriteString(diffSide(lc, r.lno, r.left, gutter, col))
+		b.WriteString(diffSeparator)
+		b.WriteString(diffSide(rc, r.rno, r.right, gutter, col))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func diffSide(color string, no int, s string, gutter, col int) string {
+	if no == 0 {
+		return strings.Repeat(" ", col)
+	}
+	cell := diffCell(fmt.Sprintf("%*d %s", gutter, no, s), col)
+	if color == "" {
+		return cell
+	}
+
+	return color + cell + "[-]"
+}
+
+func diffCell(s string, col int) string {
+	s = strings.Replace(s, "\t", "  ", -1)
+	return tview.Escape(runewidth.FillRight(runewidth.Truncate(s, col, "…"), col))
+}
+
+// auditDiff returns the changed lines between two documents.
+func auditDiff(from, to string) string {
+	rows := diffLines(splitLines(from), splitLines(to))
+	changes := make([]diffRow, 0, len(rows))
+	for _, r := range rows {
+		if r.kind != diffSame {
+			changes = append(changes, r)
+		}
+	}
+
+	return unifiedDiff(changes)
+}
+
+// unifiedDiff returns a plain text diff.
+func unifiedDiff(rows []diffRow) string {
+	var b strings.Builder
+	for _, r := range rows {
+		switch r.kind {
+		case diffSame:
+			b.WriteString("  " + r.left + "\n")
+		case diffDelete:
+			b.WriteString("- " + r.left + "\n")
+		case diffInsert:
+			b.WriteString("+ " + r.right + "\n")
+		case diffChange:
+			b.WriteString("- " + r.left + "\n+ " + r.right + "\n")
+		}
+	}
+
+	return b.String()
+}
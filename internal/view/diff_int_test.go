@@ -0,0 +1,98 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines(t *testing.T) {
+	uu := map[string]struct {
+		a, b string
+		e    string
+	}{
+		"empty": {},
+		"same": {
+			a: "a\nb\n",
+			b: "a\nb\n",
+			e: "  a\n  b\n",
+		},
+		"insert": {
+			a: "a\nc",
+			b: "a\nb\nc",
+			e: "  a\n+ b\n  c\n",
+		},
+		"delete": {
+			a: "a\nb\nc",
+			b: "a\nc",
+			e: "  a\n- b\n  c\n",
+		},
+		"change": {
+			a: "a\nb\nc",
+			b: "a\nB\nc",
+			e: "  a\n- b\n+ B\n  c\n",
+		},
+		"fromEmpty": {
+			b: "a\nb",
+			e: "+ a\n+ b\n",
+		},
+		"toEmpty": {
+			a: "a\nb",
+			e: "- a\n- b\n",
+		},
+		"mixed": {
+			a: "a\nb\nc\nd\ne",
+			b: "x\nb\nd\ne\nf",
+			e: "- a\n+ x\n  b\n- c\n  d\n  e\n+ f\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rows := diffLines(splitLines(u.a), splitLines(u.b))
+			assert.Equal(t, u.e, unifiedDiff(rows))
+		})
+	}
+}
+
+func TestDiffLinesNumbers(t *testing.T) {
+	rows := diffLines(splitLines("a\nb\nc\nd"), splitLines("a\nc\nx\nd"))
+
+	assert.Equal(t, []diffRow{
+		{kind: diffSame, left: "a", right: "a", lno: 1, rno: 1},
+		{kind: diffDelete, left: "b", lno: 2},
+		{kind: diffSame, left: "c", right: "c", lno: 3, rno: 2},
+		{kind: diffInsert, right: "x", rno: 3},
+		{kind: diffSame, left: "d", right: "d", lno: 4, rno: 4},
+	}, rows)
+	assert.Equal(t, []int{1, 3}, diffHunks(rows))
+}
+
+func TestDiffLinesTooManyEdits(t *testing.T) {
+	aa, bb := make([]string, diffMaxEdits), make([]string, diffMaxEdits)
+	for i := range aa {
+		aa[i], bb[i] = "a", "b"
+	}
+	rows := diffLines(aa, bb)
+
+	assert.Equal(t, diffMaxEdits, len(rows))
+	assert.Equal(t, diffChange, rows[0].kind)
+	assert.Equal(t, []int{0}, diffHunks(rows))
+}
+
+func TestRenderDiff(t *testing.T) {
+	rows := diffLines(splitLines("a\nb"), splitLines("a\nc"))
+	lines := strings.Split(renderDiff("live", "applied", rows, 23), "\n")
+
+	assert.Equal(t, 4, len(lines))
+	assert.Equal(t, "[::b]live       │ applied   [::-]", lines[0])
+	assert.Equal(t, "1 a        │ 1 a       ", lines[1])
+	assert.Equal(t, "[orangered]2 b       [-] │ [springgreen]2 c       [-]", lines[2])
+}
+
+func TestAuditDiff(t *testing.T) {
+	assert.Equal(t, "", auditDiff("a\nb", "a\nb"))
+	assert.Equal(t, "- b\n+ B\n+ d\n", auditDiff("a\nb\nc", "a\nB\nc\nd"))
+}
@@ -195,28 +195,15 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
-	if isKustomized(sel) {
-		opts = []string{"-k"}
-	}
 	d.Stop()
 	defer d.Start()
 	{
 		args := make([]string, 0, 10)
 		args = append(args, "apply")
-		args = append(args, opts...)
+		args = append(args, manifestOpts(sel)...)
 		args = append(args, sel)
 		res, err := runKu(d.App(), shellOpts{clear: false, args: args})
-		if err != nil {
-			res = "status:\n  " + err.Error() + "\nmessage:\n" + fmtResults(res)
-		} else {
-			res = "message:\n" + fmtResults(res)
-		}
-
-		details := NewDetails(d.App(), "Applied Manifest", sel, true).Update(res)
+		details := NewDetails(d.App(), "Applied Manifest", sel, true).Update(parseApply(res).report(err))
 		if err := d.App().inject(details); err != nil {
 			d.App().Flash().Err(err)
 		}
@@ -254,6 +241,18 @@ func (d *Dir) delCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func manifestOpts(sel string) []string {
+	if isKustomized(sel) {
+		return []string{"-k"}
+	}
+	opts := []string{"-f"}
+	if containsDir(sel) {
+		opts = append(opts, "-R")
+	}
+
+	return opts
+}
+
 func fmtResults(res string) string {
 	res = strings.TrimSpace(res)
 	lines := strings.Split(res, "\n")
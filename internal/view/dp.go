@@ -19,12 +19,14 @@ type Deploy struct {
 func NewDeploy(gvr client.GVR) ResourceViewer {
 	d := Deploy{
 		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewImageExtender(
-						NewLogsExtender(
-							NewBrowser(gvr),
-							nil,
+			NewRolloutExtender(
+				NewRestartExtender(
+					NewScaleExtender(
+						NewImageExtender(
+							NewLogsExtender(
+								NewBrowser(gvr),
+								nil,
+							),
 						),
 					),
 				),
@@ -9,13 +9,18 @@ import (
 	"github.com/derailed/tview"
 )
 
-const drainKey = "drain"
+const (
+	drainKey       = "drain"
+	drainPlanTitle = "Drain Plan"
+
+	drainBlockedHint = "Drain blocked! Enable Ignore DaemonSets, Delete Local Data or Force to proceed."
+)
 
 // DrainFunc represents a drain callback function.
 type DrainFunc func(v ResourceViewer, path string, opts dao.DrainOptions)
 
 // ShowDrain pops a node drain dialog.
-func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn DrainFunc) {
+func ShowDrain(view ResourceViewer, path, msg string, defaults dao.DrainOptions, okFn DrainFunc) {
 	styles := view.App().Styles
 
 	f := tview.NewForm()
@@ -26,7 +31,7 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 		SetLabelColor(styles.K9s.Info.FgColor.Color()).
 		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
 
-	var opts dao.DrainOptions
+	opts := defaults
 	f.AddInputField("GracePeriod:", strconv.Itoa(defaults.GracePeriodSeconds), 0, nil, func(v string) {
 		a, err := asIntOpt(v)
 		if err != nil {
@@ -54,6 +59,9 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 	f.AddCheckbox("Force:", defaults.Force, func(v bool) {
 		opts.Force = v
 	})
+	f.AddCheckbox("Dry Run:", defaults.DryRun, func(v bool) {
+		opts.DryRun = v
+	})
 
 	pages := view.App().Content.Pages
 	f.AddButton("Cancel", func() {
@@ -65,7 +73,7 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 	})
 
 	modal := tview.NewModalForm("<Drain>", f)
-	modal.SetText(path)
+	modal.SetText(msg)
 	modal.SetDoneFunc(func(_ int, b string) {
 		DismissDrain(view, pages)
 	})
@@ -0,0 +1,111 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const maxDriftLines = 10
+
+// driftCmd reports pods running outdated configmap or secret values and
+// offers to restart their workloads.
+func driftCmd(evt *tcell.EventKey, a *App, t *Table, gvr string) *tcell.EventKey {
+	path := t.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	d, err := dao.FetchConfigDrift(a.factory, gvr, path)
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	if len(d.Pods) == 0 {
+		a.Flash().Warnf("No running pods consume %s", path)
+		return nil
+	}
+
+	report := driftReport(d)
+	owners := d.Owners()
+	if len(owners) == 0 || a.Config.K9s.IsReadOnly() {
+		details := NewDetails(a, "Config Drift", path, true).Update(report)
+		if err := a.inject(details); err != nil {
+			a.Flash().Err(err)
+		}
+		return nil
+	}
+
+	msg := fmt.Sprintf("%s\n\nRestart %d workload(s) running stale values?", report, len(owners))
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Config Drift", msg, func() {
+		a.restartWorkloads(owners)
+	}, func() {})
+
+	return nil
+}
+
+func (a *App) restartWorkloads(owners map[string]string) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+
+	var errs int
+	for _, path := range sortedKeys(owners) {
+		gvr := client.NewGVR(owners[path])
+		err := restartWorkload(ctx, a.factory, gvr, path)
+		a.audit(dao.NewAuditEntry("restart", gvr.String(), path, err))
+		if err != nil {
+			errs++
+			a.Flash().Err(err)
+		}
+	}
+	if errs == 0 {
+		a.Flash().Info(dryRunTag(fmt.Sprintf("Rollout restart in progress for %d workload(s)", len(owners))))
+	}
+}
+
+// Helpers...
+
+func restartWorkload(ctx context.Context, f dao.Factory, gvr client.GVR, path string) error {
+	res, err := dao.AccessorFor(f, gvr)
+	if err != nil {
+		return err
+	}
+	r, ok := res.(dao.Restartable)
+	if !ok {
+		return errors.New("resource is not restartable")
+	}
+
+	return r.Restart(ctx, path)
+}
+
+func driftReport(d *dao.ConfigDrift) string {
+	ss := make([]string, 0, len(d.Pods)+1)
+	ss = append(ss, fmt.Sprintf("Last changed %s ago", time.Since(d.Changed).Round(time.Second)))
+	for i, p := range d.Pods {
+		if i == maxDriftLines {
+			ss = append(ss, fmt.Sprintf("...and %d more", len(d.Pods)-maxDriftLines))
+			break
+		}
+		ss = append(ss, p.String())
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+func sortedKeys(m map[string]string) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
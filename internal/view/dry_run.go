@@ -0,0 +1,122 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const dryRunPrefix = "[dry-run] "
+
+func (a *App) toggleDryRun() {
+	dao.SetDryRun(!dao.IsDryRun())
+	if dao.IsDryRun() {
+		a.Flash().Warn("Dry-run mode on. Mutating actions are no longer persisted")
+		return
+	}
+	a.Flash().Info("Dry-run mode off")
+}
+
+// dryRunTag flags a message when mutating actions are dry-runs.
+func dryRunTag(msg string) string {
+	if dao.IsDryRun() {
+		return dryRunPrefix + msg
+	}
+
+	return msg
+}
+
+// podDryRun returns k9s managed pods mutations dry-run options.
+func podDryRun() []string {
+	if dao.IsDryRun() {
+		return []string{metav1.DryRunAll}
+	}
+
+	return nil
+}
+
+// dryRunEdit edits a local copy of a resource and shows what the server
+// would change if the edit was applied.
+func (b *Browser) dryRunEdit(path string) {
+	file, _, err := b.editCopy(path)
+	if file != "" {
+		defer func() {
+			_ = os.Remove(file)
+		}()
+	}
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	res, changed, err := b.app.diffManifest(file)
+	b.app.audit(dao.NewAuditEntry("edit", b.GVR().String(), path, err))
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if !changed {
+		b.app.Flash().Info(dryRunTag("No changes detected for " + path))
+		return
+	}
+	details := NewDetails(b.app, dryRunTag("Edit"), path, true).Update(res)
+	if err := b.app.inject(details); err != nil {
+		b.app.Flash().Err(err)
+	}
+}
+
+// editCopy edits a local copy of a resource and returns the edited file
+// along with the original manifest.
+func (b *Browser) editCopy(path string) (string, string, error) {
+	raw, err := b.fetchYAML(path)
+	if err != nil {
+		return "", "", err
+	}
+	file, err := b.editBuffer(raw)
+
+	return file, raw, err
+}
+
+// editBuffer edits a manifest in a temporary file and returns the file.
+func (b *Browser) editBuffer(raw string) (string, error) {
+	f, err := ioutil.TempFile("", "k9s-edit-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.WriteString(raw)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return f.Name(), err
+	}
+
+	b.Stop()
+	ok := edit(b.app, shellOpts{clear: true, args: []string{f.Name()}})
+	b.Start()
+	if !ok {
+		return f.Name(), errors.New("Failed to launch editor")
+	}
+
+	return f.Name(), nil
+}
+
+// fetchYAML returns a resource manifest as stored on the server.
+func (b *Browser) fetchYAML(path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	args := []string{"get", b.meta.SingularName}
+	if !client.IsClusterScoped(ns) {
+		args = append(args, "-n", ns)
+	}
+	raw, err := runKu(b.app, shellOpts{clear: false, args: append(args, n, "-o", "yaml")})
+	if err != nil {
+		return "", fmt.Errorf("Unable to get resource %s: %s", path, raw)
+	}
+
+	return raw, nil
+}
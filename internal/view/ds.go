@@ -1,12 +1,22 @@
 package view
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const nodeRestartTimeout = 5 * time.Minute
+
 // DaemonSet represents a daemon set custom viewer.
 type DaemonSet struct {
 	ResourceViewer
@@ -16,9 +26,11 @@ type DaemonSet struct {
 func NewDaemonSet(gvr client.GVR) ResourceViewer {
 	d := DaemonSet{
 		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), nil),
+			NewRolloutExtender(
+				NewRestartExtender(
+					NewImageExtender(
+						NewLogsExtender(NewBrowser(gvr), nil),
+					),
 				),
 			),
 		),
@@ -50,5 +62,82 @@ func (d *DaemonSet) showPods(app *App, model ui.Tabular, _, path string) {
 		return
 	}
 
-	showPodsFromSelector(app, path, ds.Spec.Selector)
+	sel, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	v := NewPod(client.NewGVR("v1/pods"))
+	v.AddBindKeysFn(func(aa ui.KeyActions) {
+		if app.Config.K9s.IsReadOnly() {
+			return
+		}
+		aa.Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Restart On Node", d.restartOnNodeCmd(v, path), true),
+		})
+	})
+	injectPods(app, v, path, sel.String(), "")
+}
+
+// restartOnNodeCmd recreates the selected daemonset pods on their nodes and
+// tracks each replacement until ready.
+func (d *DaemonSet) restartOnNodeCmd(v ResourceViewer, path string) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		pods := v.GetTable().GetSelectedItems()
+		if len(pods) == 0 {
+			return evt
+		}
+
+		a := v.App()
+		subject := bulkSubject(v.GVR(), pods)
+		if nn := podNodes(v.GetTable().GetFilteredData(), pods); len(nn) > 0 {
+			subject += " on node(s) " + strings.Join(nn, ",")
+		}
+		msg := fmt.Sprintf("Restart daemonset %s %s?", path, subject)
+		dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Restart", msg, func() {
+			var res dao.DaemonSet
+			res.Init(a.factory, d.GVR())
+			ctx, cancel := context.WithTimeout(context.Background(), nodeRestartTimeout)
+			runBulk(ctx, a, bulkOp{
+				verb:  "Restart",
+				past:  "restarted",
+				gvr:   v.GVR(),
+				paths: pods,
+				fn: func(ctx context.Context, po string) error {
+					err := res.RestartOnNode(ctx, path, po)
+					a.audit(dao.NewAuditEntry("restart", v.GVR().String(), po, err))
+					return err
+				},
+				done: func() {
+					cancel()
+					v.Refresh()
+				},
+			})
+		}, func() {})
+
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// podNodes returns the nodes hosting the given pods.
+func podNodes(data render.TableData, pods []string) []string {
+	col := data.IndexOfHeader("NODE")
+	if col < 0 {
+		return nil
+	}
+	ids := make(map[string]struct{}, len(pods))
+	for _, p := range pods {
+		ids[p] = struct{}{}
+	}
+	nn := make([]string, 0, len(pods))
+	for _, re := range data.RowEvents {
+		if _, ok := ids[re.Row.ID]; ok && col < len(re.Row.Fields) {
+			nn = append(nn, re.Row.Fields[col])
+		}
+	}
+
+	return nn
 }
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodNodes(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "NODE"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "default/p1", Fields: render.Fields{"p1", "n1"}}},
+			{Row: render.Row{ID: "default/p2", Fields: render.Fields{"p2", "n2"}}},
+			{Row: render.Row{ID: "default/p3", Fields: render.Fields{"p3", "n3"}}},
+		},
+	}
+
+	assert.Equal(t, []string{"n1", "n3"}, podNodes(data, []string{"default/p3", "default/p1"}))
+	assert.Nil(t, podNodes(render.TableData{Header: render.Header{{Name: "NAME"}}}, []string{"default/p1"}))
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const maxSchemaErrors = 10
+
+// localEdit edits a local copy of a resource and saves it using the
+// configured edit mode. The changes are reviewed in a diff first when
+// diffOnEdit is set.
+func (b *Browser) localEdit(path string) {
+	file, original, err := b.editCopy(path)
+	b.review(path, original, file, err)
+}
+
+// review validates an edited copy and saves it. Custom resources are checked
+// against their CRD schema first.
+func (b *Browser) review(path, original, file string, err error) {
+	var raw []byte
+	if file != "" {
+		if err == nil {
+			raw, err = ioutil.ReadFile(file)
+		}
+		_ = os.Remove(file)
+	}
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if original != string(raw) && !b.validSchema(path, original, raw) {
+		return
+	}
+	if !b.app.Config.K9s.DiffOnEdit {
+		if original == string(raw) {
+			b.app.Flash().Info("No changes detected for " + path)
+			return
+		}
+		b.save(path, []byte(original), raw, false, nil)
+		return
+	}
+
+	live, err := b.fetchYAML(path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	d := NewDiff(b.app, diffTitle, path, "server", live, "edit", string(raw))
+	if !d.Changed() {
+		b.app.Flash().Info("No changes detected for " + path)
+		return
+	}
+	d.Actions().Add(ui.KeyActions{
+		tcell.KeyCtrlS: ui.NewKeyAction("Save", func(evt *tcell.EventKey) *tcell.EventKey {
+			b.confirmSave(path, []byte(original), raw)
+			return nil
+		}, true),
+	})
+	if err := b.app.inject(d); err != nil {
+		b.app.Flash().Err(err)
+	}
+}
+
+// validSchema checks an edited manifest against its CRD schema. Structural
+// errors are listed with their line numbers and the edit can be resumed.
+func (b *Browser) validSchema(path, original string, raw []byte) bool {
+	s, ok, err := dao.FetchSchema(b.app.factory, b.GVR())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Schema lookup failed for %s", b.GVR())
+	}
+	if !ok {
+		return true
+	}
+	errs, err := dao.ValidateSchema(s, raw)
+	if err == nil && len(errs) == 0 {
+		return true
+	}
+
+	ee := make([]string, 0, len(errs))
+	if err != nil {
+		ee = append(ee, err.Error())
+	}
+	for _, e := range errs {
+		ee = append(ee, e.Error())
+	}
+	if len(ee) > maxSchemaErrors {
+		ee = append(ee[:maxSchemaErrors], fmt.Sprintf("... and %d more", len(ee)-maxSchemaErrors))
+	}
+	msg := fmt.Sprintf("%s\n\nResume editing?", strings.Join(ee, "\n"))
+	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Schema Validation Failed", msg, func() {
+		file, err := b.editBuffer(string(raw))
+		b.review(path, original, file, err)
+	}, func() {})
+
+	return false
+}
+
+func (b *Browser) confirmSave(path string, original, raw []byte) {
+	msg := fmt.Sprintf("Save changes to %s %s?", b.GVR(), path)
+	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Save", msg, func() {
+		b.save(path, original, raw, false, func() {
+			b.app.Content.Pop()
+		})
+	}, func() {})
+}
+
+// save saves an edited manifest using the configured edit mode. Done gets
+// called once the changes are persisted.
+func (b *Browser) save(path string, original, raw []byte, force bool, done func()) {
+	mode := b.app.Config.K9s.Edit.Mode
+	err := b.saveAs(mode, path, original, raw, force)
+	e := dao.NewAuditEntry("edit", b.GVR().String(), path, err)
+	if mode != config.EditUpdate {
+		e.Details = mode
+	}
+	if b.GVR().String() != "v1/secrets" {
+		e.Diff = auditDiff(string(original), string(raw))
+	}
+	b.app.audit(e)
+	if mode == config.EditApply && !force && kerrors.IsConflict(err) {
+		b.forceApply(path, original, raw, err, done)
+		return
+	}
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	b.app.Flash().Info(dryRunTag(fmt.Sprintf("%s %s saved successfully", b.GVR(), path)))
+	if done != nil {
+		done()
+	}
+}
+
+func (b *Browser) saveAs(mode, path string, original, raw []byte, force bool) error {
+	if mode == config.EditUpdate {
+		return b.replace(raw)
+	}
+	editor, ok := b.accessor.(dao.Editor)
+	if !ok {
+		return fmt.Errorf("resource %s can't be saved using %s", b.GVR(), mode)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+	defer cancel()
+	ctx = b.app.withRetry(ctx)
+	manager := b.app.Config.K9s.Edit.FieldManager
+	if mode == config.EditApply {
+		return editor.Apply(ctx, path, raw, manager, force)
+	}
+	_, err := editor.PatchEdit(ctx, path, original, raw, manager)
+
+	return err
+}
+
+// forceApply offers to take ownership of fields managed by others when an
+// apply conflicts.
+func (b *Browser) forceApply(path string, original, raw []byte, err error, done func()) {
+	msg := fmt.Sprintf("%s\n\nForce apply and take ownership of these fields?", err)
+	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Apply Conflict", msg, func() {
+		b.save(path, original, raw, true, done)
+	}, func() {})
+}
+
+// replace replaces a resource with the given manifest. The manifest resource
+// version guards against overriding concurrent changes.
+func (b *Browser) replace(raw []byte) error {
+	f, err := ioutil.TempFile("", "k9s-save-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.Write(raw)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return err
+	}
+
+	args := []string{"replace", "-f", f.Name()}
+	if dao.IsDryRun() {
+		args = append(args, "--dry-run=server")
+	}
+	if res, err := runKu(b.app, shellOpts{clear: false, args: args}); err != nil {
+		return fmt.Errorf("Save failed: %s", strings.TrimSpace(res))
+	}
+
+	return nil
+}
@@ -15,27 +15,29 @@ var envRX = regexp.MustCompile(`\$(\!?[\w|\d|\-|]+)`)
 
 // Substitute replaces env variable keys from in a string with their corresponding values.
 func (e Env) Substitute(arg string) (string, error) {
-	kk := envRX.FindAllString(arg, -1)
-	if len(kk) == 0 {
-		return arg, nil
-	}
-
-	for _, k := range kk {
+	var err error
+	arg = envRX.ReplaceAllStringFunc(arg, func(k string) string {
 		key, inverse := k[1:], false
 		if key[0] == '!' {
 			key, inverse = key[1:], true
 		}
 		v, ok := e[strings.ToUpper(key)]
 		if !ok {
-			return "", fmt.Errorf("no environment matching key %q:%q", k, key)
+			if err == nil {
+				err = fmt.Errorf("no environment matching key %q:%q", k, key)
+			}
+			return k
 		}
-		if b, err := strconv.ParseBool(v); err == nil {
+		if b, berr := strconv.ParseBool(v); berr == nil {
 			if inverse {
 				b = !b
 			}
 			v = fmt.Sprintf("%t", b)
 		}
-		arg = strings.Replace(arg, k, v, -1)
+		return v
+	})
+	if err != nil {
+		return "", err
 	}
 
 	return arg, nil
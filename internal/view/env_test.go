@@ -23,6 +23,7 @@ func TestEnvReplace(t *testing.T) {
 		"subs":      {arg: `{"spec" : {"suspend" : $COL0 }}`, e: `{"spec" : {"suspend" : fred }}`},
 		"boolean":   {arg: "$COL-BOOL", e: "false"},
 		"invert":    {arg: "$!COL-BOOL", e: "true"},
+		"prefix":    {arg: "$FRED in $FRED-NS?", e: "fred in blee?"},
 	}
 
 	e := Env{
@@ -32,6 +33,7 @@ func TestEnvReplace(t *testing.T) {
 		"FRED":     "fred",
 		"COL-NAME": "zorg",
 		"COL-BOOL": "false",
+		"FRED-NS":  "blee",
 	}
 
 	for k := range uu {
@@ -14,6 +14,8 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/fatih/color"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -25,8 +27,10 @@ import (
 )
 
 const (
-	shellCheck = `command -v bash >/dev/null && exec bash || exec sh`
-	bannerFmt  = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	shellCheck     = `command -v bash >/dev/null && exec bash || exec sh`
+	nodeShellCheck = `command -v nsenter >/dev/null && exec nsenter -t 1 -m -u -i -n -p -- sh -c '` + shellCheck + `' || ` + shellCheck
+	bannerFmt      = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	nodeBannerFmt  = "<<K9s-Shell>> Node: %s \n"
 )
 
 type shellOpts struct {
@@ -66,7 +70,10 @@ func run(a *App, opts shellOpts) bool {
 	defer a.Resume()
 
 	return a.Suspend(func() {
-		if err := execute(opts); err != nil {
+		err := withConsole(func() error {
+			return execute(opts)
+		})
+		if err != nil {
 			a.Flash().Errf("Command exited: %v", err)
 		}
 	})
@@ -76,6 +83,9 @@ func edit(a *App, opts shellOpts) bool {
 	bin, err := exec.LookPath(os.Getenv("K9S_EDITOR"))
 	if err != nil {
 		bin, err = exec.LookPath(os.Getenv("EDITOR"))
+		if err != nil && defaultEditor != "" {
+			bin, err = exec.LookPath(defaultEditor)
+		}
 		if err != nil {
 			log.Error().Err(err).Msgf("K9S_EDITOR|EDITOR not set")
 			return false
@@ -172,7 +182,7 @@ func clearScreen() {
 
 const (
 	k9sShell           = "k9s-shell"
-	k9sShellRetryCount = 10
+	k9sShellRetryCount = 60
 	k9sShellRetryDelay = 500 * time.Millisecond
 )
 
@@ -189,11 +199,21 @@ func ssh(a *App, node string) error {
 		return err
 	}
 	ns := a.Config.K9s.ActiveCluster().ShellPod.Namespace
-	shellIn(a, client.FQN(ns, k9sShellPodName()), k9sShell)
+	nodeShellIn(a, node, client.FQN(ns, k9sShellPodName()))
 
 	return nil
 }
 
+// nodeShellIn enters the node namespaces via the shell pod.
+func nodeShellIn(a *App, node, path string) {
+	args := computeNodeShellArgs(path, a.Conn().Config().Flags().KubeConfig)
+
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(nodeBannerFmt, node), args: args}) {
+		a.Flash().Err(errors.New("Node shell exec failed"))
+	}
+}
+
 func nukeK9sShell(a *App) error {
 	cl := a.Config.K9s.CurrentCluster
 	if !a.Config.K9s.Clusters[cl].FeatureGates.NodeShell {
@@ -201,7 +221,7 @@ func nukeK9sShell(a *App) error {
 	}
 
 	ns := a.Config.K9s.ActiveCluster().ShellPod.Namespace
-	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
 	defer cancel()
 
 	dial, err := a.Conn().Dial()
@@ -209,7 +229,8 @@ func nukeK9sShell(a *App) error {
 		return err
 	}
 
-	err = dial.CoreV1().Pods(ns).Delete(ctx, k9sShellPodName(), metav1.DeleteOptions{})
+	var grace int64
+	err = dial.CoreV1().Pods(ns).Delete(ctx, k9sShellPodName(), metav1.DeleteOptions{GracePeriodSeconds: &grace, DryRun: podDryRun()})
 	if kerrors.IsNotFound(err) {
 		return nil
 	}
@@ -220,7 +241,7 @@ func nukeK9sShell(a *App) error {
 func launchShellPod(a *App, node string) error {
 	ns := a.Config.K9s.ActiveCluster().ShellPod.Namespace
 	spec := k9sShellPod(node, a.Config.K9s.ActiveCluster().ShellPod)
-	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
 	defer cancel()
 
 	dial, err := a.Conn().Dial()
@@ -228,27 +249,42 @@ func launchShellPod(a *App, node string) error {
 		return err
 	}
 	conn := dial.CoreV1().Pods(ns)
-	if _, err := conn.Create(ctx, &spec, metav1.CreateOptions{}); err != nil {
+	if _, err := conn.Create(ctx, &spec, metav1.CreateOptions{DryRun: podDryRun()}); err != nil {
 		return err
 	}
+	if dao.IsDryRun() {
+		return errors.New(dryRunTag("Shell pod not launched on node " + node))
+	}
+	if !waitForPod(a, client.FQN(ns, k9sShellPodName())) {
+		return fmt.Errorf("Unable to launch shell pod on node %s", node)
+	}
+
+	return nil
+}
 
+// waitForPod waits for a pod to be running.
+func waitForPod(a *App, path string) bool {
 	for i := 0; i < k9sShellRetryCount; i++ {
-		o, err := a.factory.Get("v1/pods", client.FQN(ns, k9sShellPodName()), true, labels.Everything())
+		o, err := a.factory.Get("v1/pods", path, true, labels.Everything())
 		if err != nil {
 			time.Sleep(k9sShellRetryDelay)
 			continue
 		}
 		var pod v1.Pod
 		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pod); err != nil {
-			return err
+			log.Error().Err(err).Msgf("Pod conversion failed for %s", path)
+			return false
 		}
-		if pod.Status.Phase == v1.PodRunning {
-			return nil
+		switch pod.Status.Phase {
+		case v1.PodRunning:
+			return true
+		case v1.PodFailed, v1.PodSucceeded:
+			return false
 		}
 		time.Sleep(k9sShellRetryDelay)
 	}
 
-	return fmt.Errorf("Unable to launch shell pod on node %s", node)
+	return false
 }
 
 func k9sShellPodName() string {
@@ -263,6 +299,7 @@ func k9sShellPod(node string, cfg *config.ShellPod) v1.Pod {
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      k9sShellPodName(),
 			Namespace: cfg.Namespace,
+			Labels:    map[string]string{"app": k9sShell},
 		},
 		Spec: v1.PodSpec{
 			NodeName:                      node,
@@ -270,6 +307,9 @@ func k9sShellPod(node string, cfg *config.ShellPod) v1.Pod {
 			HostPID:                       true,
 			HostNetwork:                   true,
 			TerminationGracePeriodSeconds: &grace,
+			Tolerations: []v1.Toleration{
+				{Operator: v1.TolerationOpExists},
+			},
 			Volumes: []v1.Volume{
 				{
 					Name: "root-vol",
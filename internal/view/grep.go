@@ -0,0 +1,93 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	grepUsage = "Usage: grep PATTERN [NAMESPACE]"
+
+	// grepRefreshRate throttles cluster wide scans.
+	grepRefreshRate = 30 * time.Second
+)
+
+// Grep presents resources matching a pattern across all cluster resources.
+type Grep struct {
+	ResourceViewer
+
+	query dao.GrepQuery
+}
+
+// NewGrep returns a new viewer.
+func NewGrep(q dao.GrepQuery) *Grep {
+	g := Grep{
+		ResourceViewer: NewBrowser(client.NewGVR("grep")),
+		query:          q,
+	}
+	g.GetTable().SetColorerFn(render.Grep{}.ColorerFunc())
+	g.AddBindKeysFn(g.bindKeys)
+	g.GetTable().SetSortCol("GVR", true)
+	g.SetContextFn(g.grepCtx)
+	g.GetTable().SetEnterFn(g.gotoResource)
+
+	return &g
+}
+
+// Init initializes the view.
+func (g *Grep) Init(ctx context.Context) error {
+	if err := g.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	g.GetTable().GetModel().SetRefreshRate(grepRefreshRate)
+
+	return nil
+}
+
+func (g *Grep) grepCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, g.query.String())
+	return context.WithValue(ctx, internal.KeyGrep, g.query)
+}
+
+func (g *Grep) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", g.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Namespace", g.GetTable().SortColCmd("NAMESPACE", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort GVR", g.GetTable().SortColCmd("GVR", true), false),
+	})
+}
+
+// gotoResource navigates to the selected resource view.
+func (g *Grep) gotoResource(app *App, _ ui.Tabular, _, path string) {
+	row, ok := g.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 3 {
+		return
+	}
+	fqn := client.FQN(row.Fields[0], row.Fields[1])
+	if err := app.gotoResource(row.Fields[2], fqn, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// parseGrep converts command arguments ie `fred blee` to a query.
+func parseGrep(args []string) (dao.GrepQuery, error) {
+	if len(args) < 1 || len(args) > 2 || strings.TrimSpace(args[0]) == "" {
+		return dao.GrepQuery{}, errors.New(grepUsage)
+	}
+	q := dao.GrepQuery{Pattern: args[0]}
+	if len(args) == 2 {
+		q.Namespace = client.CleanseNamespace(args[1])
+	}
+
+	return q, nil
+}
@@ -0,0 +1,45 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrep(t *testing.T) {
+	uu := map[string]struct {
+		args []string
+		e    dao.GrepQuery
+		err  bool
+	}{
+		"pattern": {
+			args: []string{"fred"},
+			e:    dao.GrepQuery{Pattern: "fred"},
+		},
+		"namespaced": {
+			args: []string{"app=fred", "blee"},
+			e:    dao.GrepQuery{Pattern: "app=fred", Namespace: "blee"},
+		},
+		"allNamespaces": {
+			args: []string{"fred", "all"},
+			e:    dao.GrepQuery{Pattern: "fred"},
+		},
+		"missingPattern": {
+			err: true,
+		},
+		"tooMany": {
+			args: []string{"fred", "blee", "zorg"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := parseGrep(u.args)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, q)
+		})
+	}
+}
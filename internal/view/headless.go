@@ -0,0 +1,144 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+)
+
+// RunHeadless renders a resource table to the given writer and exits without
+// launching the terminal UI. Resources are resolved via K9s aliases and
+// rendered using the custom views columns if any.
+func RunHeadless(cfg *config.Config, cmd, output string, w io.Writer) error {
+	if !render.IsValidOutput(output) {
+		return fmt.Errorf("invalid output format %q", output)
+	}
+	conn := cfg.GetConnection()
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no client connection detected")
+	}
+
+	factory := watch.NewFactory(conn)
+	factory.Start(cfg.ActiveNamespace())
+	defer factory.Terminate()
+
+	alias := dao.NewAlias(factory)
+	if _, err := alias.Ensure(); err != nil {
+		return err
+	}
+	data, err := headlessTable(cfg, factory, alias, tableQuery{
+		cmd:  cmd,
+		ns:   cfg.ActiveNamespace(),
+		wide: output == render.WideOutput,
+	})
+	if err != nil {
+		return err
+	}
+	if output == render.JSONOutput {
+		return render.WriteJSON(w, data)
+	}
+
+	return render.WriteTable(w, data)
+}
+
+// tableQuery represents a headless resource table request.
+type tableQuery struct {
+	cmd, ns, labels string
+	wide            bool
+}
+
+func headlessTable(cfg *config.Config, f *watch.Factory, alias *dao.Alias, q tableQuery) (render.TableData, error) {
+	gvr, ns, err := headlessResource(f, alias, q.cmd, q.ns)
+	if err != nil {
+		return render.TableData{}, err
+	}
+
+	settings := customView(gvr)
+	table := model.NewTable(gvr)
+	table.SetNamespace(ns)
+	table.SetLabelFilter(q.labels)
+	table.SetCustomColumns(ui.CustomColumns(settings.CustomColumns))
+	if err := table.Refresh(headlessContext(cfg, f, gvr, alias, ns)); err != nil {
+		return render.TableData{}, err
+	}
+
+	return headlessData(table, settings.Columns, q.wide, f.Client().HasMetrics()), nil
+}
+
+// headlessResource resolves a command to a resource and the namespace it
+// lists in, waiting on its informer to sync.
+func headlessResource(f *watch.Factory, alias *dao.Alias, cmd, ns string) (client.GVR, string, error) {
+	gvr, ok := alias.AsGVR(cmd)
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("`%s` command not found", cmd)
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	if !dao.IsK9sMeta(meta) {
+		if _, err := f.CanForResource(client.CleanseNamespace(ns), gvr.String(), client.MonitorAccess); err != nil {
+			return client.GVR{}, "", err
+		}
+		f.WaitForCacheSync()
+	}
+
+	return gvr, ns, nil
+}
+
+func headlessContext(cfg *config.Config, f *watch.Factory, gvr client.GVR, alias *dao.Alias, ns string) context.Context {
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyAliases, alias)
+	ctx = context.WithValue(ctx, internal.KeyServerTables, cfg.K9s.ServerTables)
+	if api := cfg.K9s.API; api != nil {
+		ctx = context.WithValue(ctx, internal.KeyRetryPolicy, dao.NewRetryPolicy(api.Retry))
+	}
+
+	return context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(ns))
+}
+
+func headlessData(t *model.Table, cols []string, wide, hasMetrics bool) render.TableData {
+	data := t.Peek()
+	if len(cols) == 0 {
+		cols = data.Header.Columns(wide)
+	}
+	data = data.Customize(cols, wide)
+
+	keep := make([]string, 0, len(data.Header))
+	for _, h := range data.Header {
+		if h.Name == "NAMESPACE" && !t.ClusterWide() {
+			continue
+		}
+		if h.MX && !hasMetrics {
+			continue
+		}
+		keep = append(keep, h.Name)
+	}
+
+	return data.Customize(keep, false)
+}
+
+func customView(gvr client.GVR) config.ViewSetting {
+	views := config.NewCustomView()
+	if err := views.Load(config.K9sViewConfigFile); err != nil {
+		log.Debug().Err(err).Msgf("No custom views found")
+		return config.ViewSetting{}
+	}
+
+	return views.K9s.Views[gvr.String()]
+}
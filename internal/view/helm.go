@@ -2,10 +2,13 @@ package view
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 )
 
@@ -22,6 +25,7 @@ func NewHelm(gvr client.GVR) ResourceViewer {
 	c.GetTable().SetColorerFn(render.Helm{}.ColorerFunc())
 	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
 	c.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	c.GetTable().SetEnterFn(showHelmHistory)
 	c.AddBindKeysFn(c.bindKeys)
 	c.SetContextFn(c.chartContext)
 
@@ -39,4 +43,37 @@ func (c *Helm) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", c.GetTable().SortColCmd(ageCol, true), false),
 	})
+	if !c.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlD: ui.NewKeyAction("Uninstall", c.uninstallCmd, true),
+		})
+	}
+}
+
+func (c *Helm) uninstallCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := c.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Uninstall %s?", bulkSubject(c.GVR(), paths))
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm Uninstall", msg, func() {
+		var helm dao.Helm
+		helm.Init(c.App().factory, c.GVR())
+		runBulk(context.Background(), c.App(), bulkOp{
+			verb:  "Uninstall",
+			past:  "uninstalled",
+			gvr:   c.GVR(),
+			paths: paths,
+			fn: func(_ context.Context, path string) error {
+				err := helm.Uninstall(path, false)
+				c.App().audit(dao.NewAuditEntry("uninstall", c.GVR().String(), path, err))
+				return err
+			},
+			ok:   c.GetTable().DeleteMark,
+			done: c.GetTable().Refresh,
+		})
+	}, func() {})
+
+	return nil
 }
@@ -0,0 +1,202 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+const helmHistoryTitle = "History"
+
+// HelmHistory represents a helm release revisions view.
+type HelmHistory struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewHelmHistory returns a new revisions view for a given release.
+func NewHelmHistory(path string) ResourceViewer {
+	h := HelmHistory{
+		ResourceViewer: NewBrowser(client.NewGVR("helmhistory")),
+		path:           path,
+	}
+	h.GetTable().SetEnterFn(h.viewManifest)
+	h.GetTable().SetColorerFn(render.HelmHistory{}.ColorerFunc())
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSpringGreen, tcell.AttrNone)
+	h.SetContextFn(h.historyContext)
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+// Name returns the component name.
+func (h *HelmHistory) Name() string { return helmHistoryTitle }
+
+func (h *HelmHistory) historyContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, h.path)
+}
+
+func (h *HelmHistory) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftV: ui.NewKeyAction("Diff Manifests", h.diffCmd(false), true),
+		ui.KeyV:      ui.NewKeyAction("Diff Values", h.diffCmd(true), true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", false), false),
+	})
+	if !h.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Rollback", h.rollbackCmd, true),
+		})
+	}
+}
+
+func (h *HelmHistory) helm() *dao.Helm {
+	var helm dao.Helm
+	helm.Init(h.App().factory, client.NewGVR("helm"))
+
+	return &helm
+}
+
+func (h *HelmHistory) viewManifest(app *App, _ ui.Tabular, _, rev string) {
+	rr, err := h.helm().History(h.path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	r, ok := dao.FindRevision(rr, revision(rev))
+	if !ok {
+		app.Flash().Errf("No revision %s found for release %s", rev, h.path)
+		return
+	}
+
+	details := NewDetails(app, "Manifest", fmt.Sprintf("%s@%d", h.path, r.Version), true).Update(r.Manifest)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// diffCmd compares either the two marked revisions or the selected revision
+// and its predecessor.
+func (h *HelmHistory) diffCmd(values bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		sels := h.GetTable().GetSelectedItems()
+		if len(sels) == 0 {
+			return evt
+		}
+		rr, err := h.helm().History(h.path)
+		if err != nil {
+			h.App().Flash().Err(err)
+			return nil
+		}
+		from, to, err := revisionPair(rr, sels)
+		if err != nil {
+			h.App().Flash().Err(err)
+			return nil
+		}
+
+		title, s1, s2 := "Manifests", from.Manifest, to.Manifest
+		if values {
+			title = "Values"
+			if s1, err = dao.ReleaseValues(from); err == nil {
+				s2, err = dao.ReleaseValues(to)
+			}
+			if err != nil {
+				h.App().Flash().Err(err)
+				return nil
+			}
+		}
+		d := NewDiff(h.App(), title+" "+diffTitle, h.path,
+			fmt.Sprintf("revision %d", from.Version), s1,
+			fmt.Sprintf("revision %d", to.Version), s2,
+		)
+		if err := h.App().inject(d); err != nil {
+			h.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+func (h *HelmHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rev := h.GetTable().GetSelectedItem()
+	if rev == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Rollback release %s to revision %s?", h.path, rev)
+	dialog.ShowConfirm(h.App().Styles.Dialog(), h.App().Content.Pages, "Confirm Rollback", msg, func() {
+		h.App().Flash().Infof("Rolling back %s to revision %s...", h.path, rev)
+		go func() {
+			err := h.helm().Rollback(h.path, revision(rev))
+			e := dao.NewAuditEntry("rollback", "helm", h.path, err)
+			e.Details = "revision " + rev
+			h.App().audit(e)
+			h.App().QueueUpdateDraw(func() {
+				if err != nil {
+					h.App().Flash().Errf("Rollback failed for %s: %s", h.path, err)
+					return
+				}
+				h.App().Flash().Info(dryRunTag(fmt.Sprintf("Release %s rolled back to revision %s", h.path, rev)))
+				h.Refresh()
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
+func showHelmHistory(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewHelmHistory(path)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func revision(s string) int {
+	rev, _ := strconv.Atoi(s)
+	return rev
+}
+
+// revisionPair returns the revisions to compare, oldest first.
+func revisionPair(rr []*release.Release, sels []string) (*release.Release, *release.Release, error) {
+	switch len(sels) {
+	case 1:
+		to, ok := dao.FindRevision(rr, revision(sels[0]))
+		if !ok {
+			return nil, nil, fmt.Errorf("no revision %s found", sels[0])
+		}
+		from, ok := dao.PreviousRelease(rr, to.Version)
+		if !ok {
+			return nil, nil, fmt.Errorf("revision %d has no previous revision", to.Version)
+		}
+		return from, to, nil
+	case 2:
+		from, ok := dao.FindRevision(rr, revision(sels[0]))
+		if !ok {
+			return nil, nil, fmt.Errorf("no revision %s found", sels[0])
+		}
+		to, ok := dao.FindRevision(rr, revision(sels[1]))
+		if !ok {
+			return nil, nil, fmt.Errorf("no revision %s found", sels[1])
+		}
+		if from.Version > to.Version {
+			from, to = to, from
+		}
+		return from, to, nil
+	default:
+		return nil, nil, errors.New("mark two revisions to compare")
+	}
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestRevisionPair(t *testing.T) {
+	rr := []*release.Release{{Version: 5}, {Version: 3}, {Version: 2}}
+
+	uu := map[string]struct {
+		sels     []string
+		from, to int
+		err      bool
+	}{
+		"previous": {sels: []string{"5"}, from: 3, to: 5},
+		"gap":      {sels: []string{"3"}, from: 2, to: 3},
+		"first":    {sels: []string{"2"}, err: true},
+		"marked":   {sels: []string{"5", "2"}, from: 2, to: 5},
+		"missing":  {sels: []string{"4"}, err: true},
+		"tooMany":  {sels: []string{"5", "3", "2"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			from, to, err := revisionPair(rr, u.sels)
+			assert.Equal(t, u.err, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, u.from, from.Version)
+			assert.Equal(t, u.to, to.Version)
+		})
+	}
+}
@@ -117,9 +117,30 @@ func (h *Help) build() {
 	if hh, err := h.showHotKeys(); err == nil {
 		h.computeMaxes(hh)
 		h.addSection(col, "HOTKEYS", hh)
+		col += 2
+	}
+	if km, ok := h.app.Content.Top().(keyMapper); ok {
+		chords, conflicts := km.KeyMapHints()
+		for _, s := range []struct {
+			title string
+			hh    model.MenuHints
+		}{{"CHORDS", chords}, {"KEYMAP CONFLICTS", conflicts}} {
+			if len(s.hh) == 0 {
+				continue
+			}
+			h.computeMaxes(s.hh)
+			h.addSection(col, s.title, s.hh)
+			col += 2
+		}
 	}
 }
 
+// keyMapper represents a view with user defined key bindings.
+type keyMapper interface {
+	// KeyMapHints returns the chords and key bindings conflicts.
+	KeyMapHints() (model.MenuHints, model.MenuHints)
+}
+
 func (h *Help) addExtras(extras map[string]string, col, size int) {
 	kk := make([]string, 0, len(extras))
 	for k := range extras {
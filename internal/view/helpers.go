@@ -76,12 +76,16 @@ func showPodsWithLabels(app *App, path string, sel map[string]string) {
 }
 
 func showPods(app *App, path, labelSel, fieldSel string) {
+	injectPods(app, NewPod(client.NewGVR("v1/pods")), path, labelSel, fieldSel)
+}
+
+// injectPods shows a pod viewer scoped to a resource pods.
+func injectPods(app *App, v ResourceViewer, path, labelSel, fieldSel string) {
 	if err := app.switchNS(client.AllNamespaces); err != nil {
 		app.Flash().Err(err)
 		return
 	}
 
-	v := NewPod(client.NewGVR("v1/pods"))
 	v.SetContextFn(podCtx(app, path, labelSel, fieldSel))
 	v.GetTable().SetColorerFn(render.Pod{}.ColorerFunc())
 
@@ -0,0 +1,91 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// HorizontalPodAutoscaler represents an HPA viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HorizontalPodAutoscaler) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyI:      ui.NewKeyAction("Insight", h.insightCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Replicas", h.GetTable().SortColCmd("REPLICAS", false), false),
+	})
+	if h.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyF: ui.NewKeyAction("Freeze/Thaw", h.freezeCmd, true),
+	})
+}
+
+func (h *HorizontalPodAutoscaler) insightCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := h.App().inject(NewHPAInsight(path)); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (h *HorizontalPodAutoscaler) freezeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	h.Stop()
+	defer h.Start()
+	msg := fmt.Sprintf("Toggle autoscaling freeze for %s?\nFreezing pins min and max replicas to the current replicas.", path)
+	dialog.ShowConfirm(h.App().Styles.Dialog(), h.App().Content.Pages, "Confirm Freeze/Thaw", msg, func() {
+		toggleHPAFreeze(h.App(), path)
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toggleHPAFreeze(a *App, path string) {
+	var h dao.HorizontalPodAutoscaler
+	h.Init(a.factory, client.NewGVR(hpaGVR))
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	frozen, err := h.ToggleFreeze(ctx, path)
+	e := dao.NewAuditEntry("freeze", hpaGVR, path, err)
+	e.Details = fmt.Sprintf("frozen=%t", frozen)
+	a.audit(e)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if frozen {
+		a.Flash().Info(dryRunTag(fmt.Sprintf("Autoscaling frozen for %s", path)))
+		return
+	}
+	a.Flash().Info(dryRunTag(fmt.Sprintf("Autoscaling restored for %s", path)))
+}
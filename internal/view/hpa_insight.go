@@ -0,0 +1,218 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	hpaInsightTitle  = "HPA Insight"
+	hpaMetricFmt     = "  %-10s %-30s %15s / %s"
+	hpaRecoFmt       = "  %-20s %-15s %-8s target %s [gray::]lower %s upper %s[-::]\n"
+	hpaReplicasTitle = "replicas"
+)
+
+// HPAInsight presents an autoscaler live metrics against their targets.
+type HPAInsight struct {
+	*tview.Flex
+
+	app      *App
+	path     string
+	text     *tview.TextView
+	replicas *tchart.SparkLine
+	actions  ui.KeyActions
+	cancelFn context.CancelFunc
+}
+
+// NewHPAInsight returns a new autoscaler insight viewer.
+func NewHPAInsight(path string) *HPAInsight {
+	return &HPAInsight{
+		Flex:     tview.NewFlex().SetDirection(tview.FlexRow),
+		path:     path,
+		text:     tview.NewTextView(),
+		replicas: tchart.NewSparkLine(hpaReplicasTitle),
+		actions:  make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (h *HPAInsight) Init(ctx context.Context) error {
+	var err error
+	if h.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	h.SetBorder(true)
+	h.SetBorderPadding(0, 0, 1, 1)
+	h.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, hpaInsightTitle, h.path), h.app.Styles.Frame()))
+	h.text.SetDynamicColors(true)
+	h.text.SetWrap(false)
+	h.text.SetText("Loading autoscaler state...")
+	h.replicas.SetMultiSeries(true)
+	h.replicas.SetLegend(" Replicas [::b]current[::-] vs desired ")
+	h.AddItem(h.text, 0, 2, false)
+	h.AddItem(h.replicas, 0, 1, false)
+	h.SetInputCapture(h.keyboard)
+	h.bindKeys()
+	h.StylesChanged(h.app.Styles)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (h *HPAInsight) StylesChanged(s *config.Styles) {
+	h.SetBackgroundColor(s.Charts().BgColor.Color())
+	h.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	h.text.SetBackgroundColor(s.Charts().BgColor.Color())
+	h.text.SetTextColor(s.Body().FgColor.Color())
+	h.replicas.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+	h.replicas.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+}
+
+// Name returns the component name.
+func (h *HPAInsight) Name() string { return hpaInsightTitle }
+
+// Start starts the insight updater.
+func (h *HPAInsight) Start() {
+	h.Stop()
+	h.app.Styles.AddListener(h)
+
+	var ctx context.Context
+	ctx, h.cancelFn = context.WithCancel(context.Background())
+	go h.updater(ctx)
+}
+
+// Stop terminates the insight updater.
+func (h *HPAInsight) Stop() {
+	if h.cancelFn != nil {
+		h.cancelFn()
+		h.cancelFn = nil
+	}
+	h.app.Styles.RemoveListener(h)
+}
+
+// Hints returns menu hints.
+func (h *HPAInsight) Hints() model.MenuHints {
+	return h.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (h *HPAInsight) ExtraHints() map[string]string {
+	return nil
+}
+
+func (h *HPAInsight) bindKeys() {
+	h.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+	})
+	if !h.app.Config.K9s.IsReadOnly() {
+		h.actions.Add(ui.KeyActions{
+			ui.KeyF: ui.NewKeyAction("Freeze/Thaw", h.freezeCmd, true),
+		})
+	}
+}
+
+func (h *HPAInsight) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := h.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (h *HPAInsight) freezeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	toggleHPAFreeze(h.app, h.path)
+
+	return nil
+}
+
+func (h *HPAInsight) updater(ctx context.Context) {
+	defer log.Debug().Msgf("HPA insight updater canceled -- %q", h.path)
+
+	var dh dao.HorizontalPodAutoscaler
+	dh.Init(h.app.factory, client.NewGVR(hpaGVR))
+	rate := time.Duration(h.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		cctx, cancel := context.WithTimeout(ctx, h.app.Conn().Config().CallTimeout())
+		i, err := dh.Insight(cctx, h.path)
+		cancel()
+		h.app.QueueUpdateDraw(func() {
+			if err != nil {
+				h.text.SetText(fmt.Sprintf("[red::]Autoscaler lookup failed: %s", tview.Escape(err.Error())))
+				return
+			}
+			h.text.SetText(hpaInsightText(i, time.Now()))
+			h.replicas.Add(tchart.Metric{S1: int64(i.Current), S2: int64(i.Desired)})
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// hpaInsightText returns an autoscaler state report.
+func hpaInsightText(i *dao.HPAInsight, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[::b]Target:[::-] %s\n", i.Target)
+	fmt.Fprintf(&b, "[::b]Replicas:[::-] %d current, %d desired (min %d/max %d)", i.Current, i.Desired, i.Min, i.Max)
+	if i.Frozen {
+		b.WriteString(" [orangered::b]FROZEN[-::-]")
+	}
+	b.WriteString("\n")
+	if !i.LastScale.IsZero() {
+		fmt.Fprintf(&b, "[::b]Last Scale:[::-] %s ago\n", now.Sub(i.LastScale).Round(time.Second))
+	}
+
+	b.WriteString("\n[::b]Metrics[::-]\n")
+	if len(i.Metrics) == 0 {
+		b.WriteString("  [gray::]No metrics defined[-::]\n")
+	}
+	for _, m := range i.Metrics {
+		color := "green"
+		if m.Over {
+			color = "orangered"
+		}
+		fmt.Fprintf(&b, "[%s::]"+hpaMetricFmt+"[-::]\n", color, m.Type, tview.Escape(m.Name), m.Current, m.Target)
+	}
+
+	if len(i.Conditions) > 0 {
+		b.WriteString("\n[::b]Conditions[::-]\n")
+		for _, c := range i.Conditions {
+			fmt.Fprintf(&b, "  %s\n", tview.Escape(c))
+		}
+	}
+
+	b.WriteString("\n[::b]Events[::-]\n")
+	if len(i.Events) == 0 {
+		b.WriteString("  [gray::]No recent events[-::]\n")
+	}
+	for _, e := range i.Events {
+		fmt.Fprintf(&b, "  %s\n", tview.Escape(e))
+	}
+
+	if len(i.VPAs) > 0 {
+		b.WriteString("\n[::b]VPA Recommendations[::-]\n")
+		for _, r := range i.VPAs {
+			fmt.Fprintf(&b, hpaRecoFmt, r.VPA, r.Container, r.Mode, r.Target, r.Lower, r.Upper)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,64 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPAInsightText(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		i *dao.HPAInsight
+		e string
+	}{
+		"bare": {
+			i: &dao.HPAInsight{Target: "Deployment/fred", Min: 1, Max: 3, Current: 1, Desired: 1},
+			e: "[::b]Target:[::-] Deployment/fred\n" +
+				"[::b]Replicas:[::-] 1 current, 1 desired (min 1/max 3)\n" +
+				"\n[::b]Metrics[::-]\n" +
+				"  [gray::]No metrics defined[-::]\n" +
+				"\n[::b]Events[::-]\n" +
+				"  [gray::]No recent events[-::]",
+		},
+		"full": {
+			i: &dao.HPAInsight{
+				Target:    "Deployment/fred",
+				Min:       2,
+				Max:       2,
+				Current:   2,
+				Desired:   4,
+				Frozen:    true,
+				LastScale: now.Add(-time.Minute),
+				Metrics: []dao.HPAMetric{
+					{Type: "Resource", Name: "cpu", Current: "75%", Target: "50%", Over: true},
+				},
+				Conditions: []string{"ScalingLimited=True too many replicas"},
+				Events:     []string{"10:00:00 SuccessfulRescale New size: 2"},
+				VPAs: []dao.VPARecommendation{
+					{VPA: "fred", Container: "c1", Mode: "Off", Target: "cpu=1", Lower: "cpu=0", Upper: "cpu=2"},
+				},
+			},
+			e: "[::b]Target:[::-] Deployment/fred\n" +
+				"[::b]Replicas:[::-] 2 current, 4 desired (min 2/max 2) [orangered::b]FROZEN[-::-]\n" +
+				"[::b]Last Scale:[::-] 1m0s ago\n" +
+				"\n[::b]Metrics[::-]\n" +
+				"[orangered::]  Resource   cpu                                        75% / 50%[-::]\n" +
+				"\n[::b]Conditions[::-]\n" +
+				"  ScalingLimited=True too many replicas\n" +
+				"\n[::b]Events[::-]\n" +
+				"  10:00:00 SuccessfulRescale New size: 2\n" +
+				"\n[::b]VPA Recommendations[::-]\n" +
+				"  fred                 c1              Off      target cpu=1 [gray::]lower cpu=0 upper cpu=2[-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hpaInsightText(u.i, now))
+		})
+	}
+}
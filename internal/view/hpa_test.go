@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHPANew(t *testing.T) {
+	v := view.NewHorizontalPodAutoscaler(client.NewGVR("autoscaling/v1/horizontalpodautoscalers"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "HorizontalPodAutoscalers", v.Name())
+	assert.Equal(t, 8, len(v.Hints()))
+}
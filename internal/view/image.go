@@ -0,0 +1,159 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	imageTitle           = "Image"
+	imageInspectTimeout  = 30 * time.Second
+	imageScanTimeout     = 5 * time.Minute
+	imageScanningMsg     = "scanning..."
+	imageVulnsUnknownMsg = "n/a (no trivy server configured)"
+)
+
+// showImage inspects a pod container image and overlays its known
+// vulnerabilities when a Trivy server is configured.
+func showImage(app *App, fqn, co string) {
+	details := NewDetails(app, imageTitle, fqn+":"+co, true).Update("Inspecting image...")
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), imageInspectTimeout)
+		defer cancel()
+		var dc dao.Container
+		dc.Init(app.factory, client.NewGVR("containers"))
+		info, err := dc.Image(ctx, fqn, co)
+		if info == nil {
+			app.QueueUpdateDraw(func() {
+				details.Update("error: " + err.Error())
+			})
+			return
+		}
+		vulns := imageVulnsUnknownMsg
+		if app.vulnCache != nil {
+			vulns = imageScanningMsg
+		}
+		app.QueueUpdateDraw(func() {
+			details.Update(imageDetails(info, vulns, err))
+		})
+		if app.vulnCache == nil {
+			return
+		}
+
+		sctx, scancel := context.WithTimeout(context.Background(), imageScanTimeout)
+		defer scancel()
+		sum, serr := app.vulnCache.Scan(sctx, info.Image)
+		if serr != nil {
+			vulns = "scan failed -- " + serr.Error()
+		} else {
+			info.Vulns, vulns = &sum, sum.String()
+		}
+		app.QueueUpdateDraw(func() {
+			details.Update(imageDetails(info, vulns, err))
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// imageDetails returns a yaml like report of an image inspection.
+func imageDetails(i *dao.ImageInfo, vulns string, err error) string {
+	var b strings.Builder
+	running := i.Running
+	if i.Drifted() {
+		running += " (drifted from registry)"
+	}
+	var created string
+	if !i.Created.IsZero() {
+		created = i.Created.UTC().Format(time.RFC3339)
+	}
+	var size string
+	if len(i.Layers) > 0 {
+		size = imageSize(i.Size())
+	}
+	for _, f := range []struct{ k, v string }{
+		{"image", i.Ref.String()},
+		{"digest", i.Digest},
+		{"running", running},
+		{"platform", i.Platform},
+		{"created", created},
+		{"size", size},
+		{"user", i.User},
+		{"workingDir", i.WorkingDir},
+		{"entrypoint", quoteAll(i.Entrypoint)},
+		{"cmd", quoteAll(i.Cmd)},
+		{"ports", strings.Join(i.Ports, ", ")},
+		{"vulnerabilities", vulns},
+	} {
+		if f.v != "" {
+			fmt.Fprintf(&b, "%s: %s\n", f.k, f.v)
+		}
+	}
+	if i.Vulns != nil && i.Vulns.Critical > 0 {
+		fmt.Fprintf(&b, "warning: %d critical vulnerabilities found\n", i.Vulns.Critical)
+	}
+	if len(i.Labels) > 0 {
+		kk := make([]string, 0, len(i.Labels))
+		for k := range i.Labels {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		b.WriteString("labels:\n")
+		for _, k := range kk {
+			fmt.Fprintf(&b, "  %s: %s\n", k, i.Labels[k])
+		}
+	}
+	if len(i.Layers) > 0 {
+		b.WriteString("layers:\n")
+		for _, l := range i.Layers {
+			fmt.Fprintf(&b, "  - digest: %s\n    size: %s\n", l.Digest, imageSize(l.Size))
+			if l.CreatedBy != "" {
+				fmt.Fprintf(&b, "    createdBy: %s\n", l.CreatedBy)
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(&b, "error: %s\n", err)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func quoteAll(ss []string) string {
+	if len(ss) == 0 {
+		return ""
+	}
+	qq := make([]string, 0, len(ss))
+	for _, s := range ss {
+		qq = append(qq, fmt.Sprintf("%q", s))
+	}
+
+	return "[" + strings.Join(qq, ", ") + "]"
+}
+
+// imageSize returns a human readable size.
+func imageSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit && exp < 2; v /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMG"[exp])
+}
@@ -121,7 +121,8 @@ func (s *ImageExtender) makeSetImageForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 			return
 		}
-		s.App().Flash().Infof("Resource %s:%s image updated successfully", s.GVR(), sel)
+		s.App().Flash().Info(dryRunTag(fmt.Sprintf("Resource %s:%s image updated successfully", s.GVR(), sel)))
+		s.App().trackRollout(s.GVR(), sel)
 	})
 	f.AddButton("Cancel", func() {
 		s.dismissDialog()
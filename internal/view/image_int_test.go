@@ -0,0 +1,82 @@
+package view
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageDetails(t *testing.T) {
+	ref, err := dao.ParseImageRef("fred/blee:1.0")
+	assert.Nil(t, err)
+	i := dao.ImageInfo{
+		Image:      "fred/blee:1.0",
+		Ref:        ref,
+		Digest:     "sha256:new",
+		Running:    "sha256:old",
+		Platform:   "linux/amd64",
+		Created:    time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC),
+		Labels:     map[string]string{"b": "2", "a": "1"},
+		Entrypoint: []string{"/blee", "-v"},
+		Ports:      []string{"80/tcp"},
+		Layers: []dao.ImageLayer{
+			{Digest: "sha256:l1", Size: 3 << 20, CreatedBy: "ADD rootfs"},
+			{Digest: "sha256:l2", Size: 512},
+		},
+		Vulns: &render.VulnSummary{Critical: 2, Low: 1},
+	}
+
+	assert.Equal(t, `image: docker.io/fred/blee:1.0
+digest: sha256:new
+running: sha256:old (drifted from registry)
+platform: linux/amd64
+created: 2020-10-01T10:00:00Z
+size: 3.0MiB
+entrypoint: ["/blee", "-v"]
+ports: 80/tcp
+vulnerabilities: C:2 H:0 M:0 L:1
+warning: 2 critical vulnerabilities found
+labels:
+  a: 1
+  b: 2
+layers:
+  - digest: sha256:l1
+    size: 3.0MiB
+    createdBy: ADD rootfs
+  - digest: sha256:l2
+    size: 512B`, imageDetails(&i, i.Vulns.String(), nil))
+}
+
+func TestImageDetailsUnreachable(t *testing.T) {
+	ref, err := dao.ParseImageRef("nginx")
+	assert.Nil(t, err)
+	i := dao.ImageInfo{Image: "nginx", Ref: ref, Running: "sha256:abc"}
+
+	assert.Equal(t, `image: docker.io/library/nginx:latest
+running: sha256:abc
+vulnerabilities: scanning...
+error: boom`, imageDetails(&i, imageScanningMsg, errors.New("boom")))
+}
+
+func TestImageSize(t *testing.T) {
+	uu := map[string]struct {
+		n int64
+		e string
+	}{
+		"bytes": {n: 10, e: "10B"},
+		"kib":   {n: 1536, e: "1.5KiB"},
+		"mib":   {n: 25 << 20, e: "25.0MiB"},
+		"gib":   {n: 3 << 30, e: "3.0GiB"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, imageSize(u.n))
+		})
+	}
+}
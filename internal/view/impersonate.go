@@ -0,0 +1,66 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const revertIdentity = "-"
+
+// impersonateCmd reconnects as a user and groups ie `:as fred g1,g2`. No user
+// or `-` reverts to the kubeconfig credentials.
+func (a *App) impersonateCmd(args []string) error {
+	user, groups, err := parseImpersonation(args)
+	if err != nil {
+		return err
+	}
+	imp, ok := a.Conn().(client.Impersonator)
+	if !ok {
+		return errors.New("impersonation is not supported by this connection")
+	}
+	id := user
+	if len(groups) > 0 {
+		id += "[" + strings.Join(groups, ",") + "]"
+	}
+	err = imp.Impersonate(user, groups)
+	a.audit(dao.NewAuditEntry("impersonate", "", id, err))
+	if err != nil {
+		return err
+	}
+	if err := a.switchCtx(a.Config.K9s.CurrentContext, false); err != nil {
+		return err
+	}
+	if user == "" {
+		a.Flash().Info("Impersonation off. Using kubeconfig credentials")
+		return nil
+	}
+	a.Flash().Warnf("Acting as %s", id)
+
+	return nil
+}
+
+// parseImpersonation extracts the user and comma separated groups from the
+// command arguments.
+func parseImpersonation(args []string) (string, []string, error) {
+	args = strings.Fields(strings.Join(args, " "))
+	if len(args) == 0 || (len(args) == 1 && args[0] == revertIdentity) {
+		return "", nil, nil
+	}
+	if len(args) > 2 {
+		return "", nil, fmt.Errorf("invalid impersonation %q. Expecting :as USER [GROUP,...]", strings.Join(args, " "))
+	}
+	var gg []string
+	if len(args) == 2 {
+		for _, g := range strings.Split(args[1], ",") {
+			if g = strings.TrimSpace(g); g != "" {
+				gg = append(gg, g)
+			}
+		}
+	}
+
+	return args[0], gg, nil
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImpersonation(t *testing.T) {
+	uu := map[string]struct {
+		args   []string
+		user   string
+		groups []string
+		err    bool
+	}{
+		"revert": {},
+		"dash": {
+			args: []string{"-"},
+		},
+		"user": {
+			args: []string{"fred"},
+			user: "fred",
+		},
+		"serviceaccount": {
+			args: []string{"system:serviceaccount:default:fred"},
+			user: "system:serviceaccount:default:fred",
+		},
+		"groups": {
+			args:   []string{"fred", "g1,,g2"},
+			user:   "fred",
+			groups: []string{"g1", "g2"},
+		},
+		"tooMany": {
+			args: []string{"fred", "g1", "g2"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			user, groups, err := parseImpersonation(u.args)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.user, user)
+			assert.Equal(t, u.groups, groups)
+		})
+	}
+}
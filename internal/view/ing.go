@@ -0,0 +1,42 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Ingress) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Check Connectivity", i.connectivityCmd, true),
+	})
+}
+
+func (i *Ingress) connectivityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Ingress
+	res.Init(i.App().factory, i.GVR())
+	showConnectivity(i.App(), &res, path)
+
+	return nil
+}
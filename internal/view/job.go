@@ -1,9 +1,15 @@
 package view
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -21,10 +27,61 @@ func NewJob(gvr client.GVR) ResourceViewer {
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetColorerFn(render.Job{}.ColorerFunc())
 	j.GetTable().SetSortCol("AGE", true)
+	j.AddBindKeysFn(j.bindKeys)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa ui.KeyActions) {
+	if j.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Rerun", j.rerunCmd, true),
+	})
+}
+
+func (j *Job) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := j.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(j.App().factory, j.GVR())
+	if err != nil {
+		return nil
+	}
+	r, ok := res.(dao.Rerunnable)
+	if !ok {
+		j.App().Flash().Err(fmt.Errorf("expecting a rerunnable resource for %q", j.GVR()))
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rerun %s?", bulkSubject(j.GVR(), paths))
+	dialog.ShowConfirm(j.App().Styles.Dialog(), j.App().Content.Pages, "Confirm Rerun", msg, func() {
+		runBulk(context.Background(), j.App(), bulkOp{
+			verb:  "Rerun",
+			past:  "rerun",
+			gvr:   j.GVR(),
+			paths: paths,
+			fn: func(ctx context.Context, path string) error {
+				ctx, cancel := context.WithTimeout(ctx, j.App().Conn().Config().CallTimeout())
+				defer cancel()
+				clone, err := r.Rerun(ctx, path)
+				e := dao.NewAuditEntry("rerun", j.GVR().String(), path, err)
+				if err == nil {
+					e.Details = "as " + clone
+				}
+				j.App().audit(e)
+				return err
+			},
+			ok: j.GetTable().DeleteMark,
+		})
+	}, func() {})
+
+	return nil
+}
+
 func (*Job) showPods(app *App, model ui.Tabular, gvr, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
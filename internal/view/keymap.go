@@ -0,0 +1,123 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// keyRemap represents an action moved to a new key or chord.
+type keyRemap struct {
+	binding config.KeyBinding
+	keys    []tcell.Key
+	action  ui.KeyAction
+}
+
+// keyMapActions rebinds a view actions per the user key maps. It returns the
+// bindings conflicts if any.
+func keyMapActions(aliases []string, aa ui.KeyActions, cc *ui.KeyChords) model.MenuHints {
+	km := config.NewKeyMaps()
+	if err := km.Load(); err != nil {
+		cc.Clear()
+		return nil
+	}
+
+	return remapKeys(km.For(aliases), aa, cc)
+}
+
+// remapKeys moves actions to their user defined keys or chords. A rebound
+// key wins over the action it was bound to.
+func remapKeys(bb []config.KeyBinding, aa ui.KeyActions, cc *ui.KeyChords) model.MenuHints {
+	cc.Clear()
+	var (
+		conflicts      model.MenuHints
+		singles, multi []keyRemap
+	)
+	conflict := func(keys, msg string) {
+		log.Warn().Msgf("KEYMAP %s: %s", keys, msg)
+		conflicts = append(conflicts, model.MenuHint{Mnemonic: keys, Description: msg})
+	}
+	for _, b := range bb {
+		kk, err := parseKeys(b.Keys)
+		if err != nil {
+			conflict(b.Keys, fmt.Sprintf("%s %s", b.Action, err))
+			continue
+		}
+		a, ok := takeAction(aa, b.Action)
+		if !ok {
+			if b.View != "" {
+				conflict(b.Keys, fmt.Sprintf("no %s action on %s", b.Action, b.View))
+			}
+			continue
+		}
+		r := keyRemap{binding: b, keys: kk, action: a}
+		if len(kk) == 1 {
+			singles = append(singles, r)
+		} else {
+			multi = append(multi, r)
+		}
+	}
+
+	for _, r := range singles {
+		if curr, ok := aa[r.keys[0]]; ok {
+			conflict(r.binding.Keys, fmt.Sprintf("%s shadows %s", r.binding.Action, curr.Description))
+		}
+		aa[r.keys[0]] = r.action
+	}
+	for _, r := range multi {
+		if curr, ok := cc.Get(r.keys); ok {
+			conflict(r.binding.Keys, fmt.Sprintf("%s shadows %s", r.binding.Action, curr.Description))
+		}
+		cc.Add(r.keys, r.action)
+	}
+	for _, r := range multi {
+		if curr, ok := aa[r.keys[0]]; ok {
+			conflict(r.binding.Keys, fmt.Sprintf("%s shadows %s on %s", r.binding.Action, curr.Description, tcell.KeyNames[r.keys[0]]))
+		}
+		if cc.IsPrefix(r.keys) {
+			conflict(r.binding.Keys, fmt.Sprintf("%s hides longer chords", r.binding.Action))
+		}
+	}
+
+	return conflicts
+}
+
+// takeAction unbinds all the keys of a given action.
+func takeAction(aa ui.KeyActions, name string) (ui.KeyAction, bool) {
+	var (
+		a  ui.KeyAction
+		ok bool
+	)
+	for k, v := range aa {
+		if strings.EqualFold(v.Description, name) {
+			a, ok = v, true
+			delete(aa, k)
+		}
+	}
+
+	return a, ok
+}
+
+// parseKeys converts a key or chord spec ie `g d` to keys.
+func parseKeys(spec string) ([]tcell.Key, error) {
+	tokens := strings.Fields(spec)
+	if len(tokens) == 0 {
+		return nil, errors.New("no keys specified")
+	}
+	kk := make([]tcell.Key, 0, len(tokens))
+	for _, t := range tokens {
+		k, err := asKey(t)
+		if err != nil {
+			return nil, err
+		}
+		kk = append(kk, k)
+	}
+
+	return kk, nil
+}
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemapKeys(t *testing.T) {
+	aa := ui.KeyActions{
+		ui.KeyD:        ui.NewKeyAction("Describe", nil, true),
+		ui.KeyL:        ui.NewKeyAction("Logs", nil, true),
+		ui.KeyG:        ui.NewKeyAction("Goto", nil, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", nil, true),
+		ui.KeyE:        ui.NewKeyAction("Edit", nil, true),
+	}
+	cc := ui.NewKeyChords()
+	bb := []config.KeyBinding{
+		{Action: "Bozo", Keys: "x"},
+		{Action: "Cordon", Keys: "c", View: "pods"},
+		{Action: "Delete", Keys: "Shift-X"},
+		{Action: "describe", Keys: "g d"},
+		{Action: "Edit", Keys: "blee"},
+		{Action: "Logs", Keys: "Ctrl-D"},
+	}
+
+	assert.Equal(t, model.MenuHints{
+		{Mnemonic: "c", Description: "no Cordon action on pods"},
+		{Mnemonic: "blee", Description: "Edit No matching key found blee"},
+		{Mnemonic: "g d", Description: "describe shadows Goto on g"},
+	}, remapKeys(bb, aa, cc))
+
+	assert.Equal(t, "Delete", aa[ui.KeyShiftX].Description)
+	assert.Equal(t, "Logs", aa[tcell.KeyCtrlD].Description)
+	assert.Equal(t, "Edit", aa[ui.KeyE].Description)
+	_, ok := aa[ui.KeyD]
+	assert.False(t, ok)
+	_, ok = aa[ui.KeyL]
+	assert.False(t, ok)
+	a, ok := cc.Get([]tcell.Key{ui.KeyG, ui.KeyD})
+	assert.True(t, ok)
+	assert.Equal(t, "Describe", a.Description)
+}
+
+func TestRemapKeysShadows(t *testing.T) {
+	aa := ui.KeyActions{
+		ui.KeyD: ui.NewKeyAction("Describe", nil, true),
+		ui.KeyY: ui.NewKeyAction("YAML", nil, true),
+	}
+	cc := ui.NewKeyChords()
+	bb := []config.KeyBinding{
+		{Action: "Describe", Keys: "y"},
+	}
+
+	assert.Equal(t, model.MenuHints{
+		{Mnemonic: "y", Description: "Describe shadows YAML"},
+	}, remapKeys(bb, aa, cc))
+	assert.Equal(t, 1, len(aa))
+}
+
+func TestParseKeys(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    []tcell.Key
+		err  bool
+	}{
+		"single": {spec: "Shift-L", e: []tcell.Key{ui.KeyShiftL}},
+		"chord":  {spec: " g  d ", e: []tcell.Key{ui.KeyG, ui.KeyD}},
+		"ctrl":   {spec: "Ctrl-K", e: []tcell.Key{tcell.KeyCtrlK}},
+		"empty":  {spec: " ", err: true},
+		"bozo":   {spec: "g blee", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kk, err := parseKeys(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, kk)
+		})
+	}
+}
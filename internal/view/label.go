@@ -0,0 +1,287 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	labelDialogKey = "label"
+
+	// maxMetaLabel tracks the max width of an existing entry form label.
+	maxMetaLabel = 48
+)
+
+// metaFields lists the editable metadata fields.
+var metaFields = []string{dao.LabelsField, dao.AnnotationsField}
+
+type metaUpdate struct {
+	field string
+	ops   []dao.MetaOp
+}
+
+func (b *Browser) labelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := b.GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+	ll, aa, err := b.sharedMeta(selections)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+
+	b.showLabelDialog(selections, ll, aa)
+
+	return nil
+}
+
+// sharedMeta returns the labels and annotations shared by the selected resources.
+func (b *Browser) sharedMeta(selections []string) ([]dao.MetaEntry, []dao.MetaEntry, error) {
+	ll := make([]map[string]string, 0, len(selections))
+	aa := make([]map[string]string, 0, len(selections))
+	for _, path := range selections {
+		o, err := b.app.factory.Get(b.GVR().String(), path, true, labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		m, err := meta.Accessor(o)
+		if err != nil {
+			return nil, nil, err
+		}
+		ll, aa = append(ll, m.GetLabels()), append(aa, m.GetAnnotations())
+	}
+
+	return dao.SharedMeta(ll), dao.SharedMeta(aa), nil
+}
+
+func (b *Browser) showLabelDialog(selections []string, ll, aa []dao.MetaEntry) {
+	styles := b.app.Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	subject := bulkSubject(b.GVR(), selections)
+	modal := tview.NewModalForm("<Label/Annotate>", f)
+	edit := newMetaEdit()
+	refresh := func() {
+		modal.SetText(labelDialogText(subject, edit))
+	}
+
+	for _, g := range []struct {
+		field string
+		ee    []dao.MetaEntry
+	}{
+		{dao.LabelsField, ll},
+		{dao.AnnotationsField, aa},
+	} {
+		g := g
+		for _, e := range g.ee {
+			e := e
+			f.AddCheckbox(metaEntryLabel(g.field, e), true, func(checked bool) {
+				edit.remove(g.field, e.Key, !checked)
+				refresh()
+			})
+		}
+	}
+	field := dao.LabelsField
+	f.AddDropDown("Field:", metaFields, 0, func(option string, _ int) {
+		field = option
+	})
+	key := tview.NewInputField().SetLabel("Key:").SetFieldWidth(40)
+	value := tview.NewInputField().SetLabel("Value:").SetFieldWidth(40)
+	f.AddFormItem(key)
+	f.AddFormItem(value)
+	set := func() bool {
+		if strings.TrimSpace(key.GetText()) == "" {
+			return true
+		}
+		if err := edit.set(field, key.GetText(), value.GetText()); err != nil {
+			b.app.Flash().Err(err)
+			return false
+		}
+		key.SetText("")
+		value.SetText("")
+		refresh()
+		return true
+	}
+	f.AddButton("Add", func() {
+		set()
+	})
+	f.AddButton("OK", func() {
+		if !set() {
+			return
+		}
+		uu, err := edit.updates()
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		b.dismissLabelDialog()
+		b.bulkRelabel(selections, uu)
+	})
+	f.AddButton("Cancel", func() {
+		b.dismissLabelDialog()
+	})
+
+	refresh()
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		b.dismissLabelDialog()
+	})
+	b.app.Content.AddPage(labelDialogKey, modal, false, false)
+	b.app.Content.ShowPage(labelDialogKey)
+}
+
+func (b *Browser) dismissLabelDialog() {
+	b.app.Content.RemovePage(labelDialogKey)
+}
+
+// bulkRelabel patches marked resources labels and annotations using a rate
+// limited worker pool while reporting progress.
+func (b *Browser) bulkRelabel(selections []string, uu []metaUpdate) {
+	labeler, ok := b.accessor.(dao.Labeler)
+	if !ok {
+		b.app.Flash().Errf("Invalid labeler %T", b.accessor)
+		return
+	}
+
+	runBulk(b.defaultContext(), b.app, bulkOp{
+		verb:  "Update",
+		past:  "updated",
+		gvr:   b.GVR(),
+		paths: selections,
+		fn: func(ctx context.Context, path string) error {
+			for _, u := range uu {
+				err := labeler.Relabel(ctx, path, u.field, u.ops)
+				e := dao.NewAuditEntry("relabel", b.GVR().String(), path, err)
+				e.Details = u.field + ":" + opsString(u.ops)
+				b.app.audit(e)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		done: b.refresh,
+	})
+}
+
+// Helpers...
+
+// metaEdit tracks pending labels and annotations changes.
+type metaEdit struct {
+	ops map[string][]dao.MetaOp
+}
+
+func newMetaEdit() *metaEdit {
+	return &metaEdit{ops: make(map[string][]dao.MetaOp)}
+}
+
+// set adds or modifies a key.
+func (e *metaEdit) set(field, key, value string) error {
+	o := dao.MetaOp{Key: strings.TrimSpace(key), Value: strings.TrimSpace(value)}
+	if err := o.Validate(field); err != nil {
+		return err
+	}
+	e.put(field, o)
+
+	return nil
+}
+
+// remove toggles a key removal.
+func (e *metaEdit) remove(field, key string, remove bool) {
+	if remove {
+		e.put(field, dao.MetaOp{Key: key, Remove: true})
+		return
+	}
+	oo := e.ops[field]
+	for i, o := range oo {
+		if o.Key == key && o.Remove {
+			e.ops[field] = append(oo[:i], oo[i+1:]...)
+			return
+		}
+	}
+}
+
+func (e *metaEdit) put(field string, o dao.MetaOp) {
+	oo := e.ops[field]
+	for i := range oo {
+		if oo[i].Key == o.Key {
+			oo[i] = o
+			return
+		}
+	}
+	e.ops[field] = append(oo, o)
+}
+
+// updates returns the pending changes per metadata field.
+func (e *metaEdit) updates() ([]metaUpdate, error) {
+	var uu []metaUpdate
+	for _, f := range metaFields {
+		if oo := e.ops[f]; len(oo) > 0 {
+			uu = append(uu, metaUpdate{field: f, ops: oo})
+		}
+	}
+	if len(uu) == 0 {
+		return nil, fmt.Errorf("no labels or annotations changes specified")
+	}
+
+	return uu, nil
+}
+
+// String returns the pending changes summary.
+func (e *metaEdit) String() string {
+	ss := make([]string, 0, len(metaFields))
+	for _, f := range metaFields {
+		if oo := e.ops[f]; len(oo) > 0 {
+			ss = append(ss, f+": "+opsString(oo))
+		}
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+func labelDialogText(subject string, e *metaEdit) string {
+	s := fmt.Sprintf("Update %s: uncheck to remove, fill in key and value to add or modify", subject)
+	if p := e.String(); p != "" {
+		s += "\n\n" + p
+	}
+
+	return s
+}
+
+// metaEntryLabel returns a form label for an existing label or annotation.
+func metaEntryLabel(field string, e dao.MetaEntry) string {
+	v := e.Value
+	if e.Mixed {
+		v = "*"
+	}
+	s := []rune(strings.TrimSuffix(field, "s") + " " + e.Key + "=" + v)
+	if len(s) > maxMetaLabel {
+		s = append(s[:maxMetaLabel-1], '…')
+	}
+
+	return string(s) + ":"
+}
+
+func opsString(oo []dao.MetaOp) string {
+	ss := make([]string, 0, len(oo))
+	for _, o := range oo {
+		ss = append(ss, o.String())
+	}
+
+	return strings.Join(ss, " ")
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaEdit(t *testing.T) {
+	e := newMetaEdit()
+	_, err := e.updates()
+	assert.Error(t, err)
+
+	assert.NoError(t, e.set(dao.LabelsField, " app ", "fred"))
+	assert.NoError(t, e.set(dao.LabelsField, "app", "blee"))
+	assert.Error(t, e.set(dao.LabelsField, "note", "a/b"))
+	assert.NoError(t, e.set(dao.AnnotationsField, "k9s.io/note", "a/b"))
+	e.remove(dao.LabelsField, "tier", true)
+	e.remove(dao.LabelsField, "debug", true)
+	e.remove(dao.LabelsField, "debug", false)
+
+	uu, err := e.updates()
+	assert.NoError(t, err)
+	assert.Equal(t, []metaUpdate{
+		{field: dao.LabelsField, ops: []dao.MetaOp{{Key: "app", Value: "blee"}, {Key: "tier", Remove: true}}},
+		{field: dao.AnnotationsField, ops: []dao.MetaOp{{Key: "k9s.io/note", Value: "a/b"}}},
+	}, uu)
+	assert.Equal(t, "labels: app=blee tier-\nannotations: k9s.io/note=a/b", e.String())
+}
+
+func TestMetaEntryLabel(t *testing.T) {
+	uu := map[string]struct {
+		field string
+		e     dao.MetaEntry
+		l     string
+	}{
+		"label": {
+			field: dao.LabelsField,
+			e:     dao.MetaEntry{Key: "app", Value: "fred"},
+			l:     "label app=fred:",
+		},
+		"mixed": {
+			field: dao.AnnotationsField,
+			e:     dao.MetaEntry{Key: "note", Mixed: true},
+			l:     "annotation note=*:",
+		},
+		"long": {
+			field: dao.AnnotationsField,
+			e:     dao.MetaEntry{Key: "note", Value: "0123456789012345678901234567890123456789"},
+			l:     "annotation note=0123456789012345678901234567890…:",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.l, metaEntryLabel(u.field, u.e))
+		})
+	}
+}
@@ -6,11 +6,12 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -32,6 +33,7 @@ type LiveView struct {
 	currentRegion, maxRegions int
 	fullScreen                bool
 	managedField              bool
+	format, query             string
 	cancel                    context.CancelFunc
 }
 
@@ -142,6 +144,12 @@ func (v *LiveView) bindKeys() {
 			ui.KeyM: ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true),
 		})
 	}
+	if _, ok := v.model.(model.Formatter); ok {
+		v.actions.Add(ui.KeyActions{
+			ui.KeyO: ui.NewKeyAction("Toggle JSON", v.toggleFormatCmd, true),
+			ui.KeyQ: ui.NewKeyAction("Query", v.queryCmd, true),
+		})
+	}
 }
 
 func (v *LiveView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
@@ -212,6 +220,49 @@ func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (v *LiveView) toggleFormatCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	format := dao.JSONFormat
+	if v.format == dao.JSONFormat {
+		format = dao.YAMLFormat
+	}
+	v.setFormat(format, "")
+
+	return nil
+}
+
+func (v *LiveView) queryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	q := v.query
+	if q == "" {
+		q = ".spec"
+	}
+	dialog.ShowPrompt(v.app.Styles.Dialog(), v.app.Content.Pages, "Query", "JSONPath:", q, func(q string) {
+		v.setFormat(dao.QueryFormat, q)
+	}, func() {})
+
+	return nil
+}
+
+func (v *LiveView) setFormat(format, query string) {
+	f, ok := v.model.(model.Formatter)
+	if !ok {
+		return
+	}
+	if err := f.SetFormat(v.defaultCtx(), format, query); err != nil {
+		v.app.Flash().Err(err)
+		return
+	}
+	v.format, v.query = format, query
+	v.updateTitle()
+}
+
 func (v *LiveView) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.app.InCmdMode() {
 		return evt
@@ -310,18 +361,29 @@ func (v *LiveView) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (v *LiveView) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	v.app.Flash().Info("Content copied to clipboard...")
-	if err := clipboard.WriteAll(v.text.GetText(true)); err != nil {
+	if err := copyToClipboard(v.text.GetText(true)); err != nil {
 		v.app.Flash().Err(err)
 	}
 
 	return nil
 }
 
+func (v *LiveView) formatTitle() string {
+	switch v.format {
+	case dao.JSONFormat:
+		return "JSON"
+	case dao.QueryFormat:
+		return "Query " + tview.Escape(v.query)
+	default:
+		return v.title
+	}
+}
+
 func (v *LiveView) updateTitle() {
 	if v.title == "" {
 		return
 	}
-	fmat := fmt.Sprintf(liveViewTitleFmt, v.title, v.model.GetPath())
+	fmat := fmt.Sprintf(liveViewTitleFmt, v.formatTitle(), v.model.GetPath())
 
 	buff := v.cmdBuff.GetText()
 	if buff == "" {
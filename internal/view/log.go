@@ -6,19 +6,19 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
 
-	"github.com/atotto/clipboard"
-
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -105,6 +105,7 @@ func (l *Log) Init(ctx context.Context) (err error) {
 
 // LogCleared clears the logs.
 func (l *Log) LogCleared() {
+	l.app.rewindLog(l.model.GetPath())
 	l.app.QueueUpdateDraw(func() {
 		l.logs.Clear()
 	})
@@ -125,6 +126,7 @@ func (l *Log) LogFailed(err error) {
 
 // LogChanged updates the logs.
 func (l *Log) LogChanged(lines [][]byte) {
+	l.app.recordLog(l.model.GetPath(), lines)
 	l.app.QueueUpdateDraw(func() {
 		l.Flush(lines)
 	})
@@ -199,7 +201,11 @@ func (l *Log) bindKeys() {
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
+		ui.KeyI:         ui.NewKeyAction("Toggle Interleave", l.toggleInterleaveCmd, true),
+		ui.KeyJ:         ui.NewKeyAction("Toggle JSON", l.toggleJSONCmd, true),
 		tcell.KeyCtrlS:  ui.NewKeyAction("Save", l.SaveCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Save As", l.saveAsCmd, true),
+		ui.KeyShiftP:    ui.NewKeyAction("Pipe", l.pipeCmd, true),
 		ui.KeyC:         ui.NewKeyAction("Copy", l.cpCmd, true),
 	})
 }
@@ -314,9 +320,62 @@ func (l *Log) SaveCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) saveAsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	path := dumpPath(l.app.Config.K9s.CurrentCluster, l.model.GetPath())
+	dialog.ShowPrompt(l.app.Styles.Dialog(), l.app.Content.Pages, "Save Logs As", "Path:", path, func(path string) {
+		if err := writeData(path, l.logs.GetText(true)); err != nil {
+			l.app.Flash().Err(err)
+			return
+		}
+		l.app.Flash().Infof("Log %s saved successfully!", path)
+	}, func() {})
+
+	return nil
+}
+
+func (l *Log) pipeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	dialog.ShowPrompt(l.app.Styles.Dialog(), l.app.Content.Pages, "Pipe Logs", "Command:", "", func(cmd string) {
+		data := l.logs.GetText(true)
+		l.app.Flash().Infof("Piping logs to `%s...", cmd)
+		go func() {
+			out, err := pipeData(cmd, data)
+			l.app.QueueUpdateDraw(func() {
+				if err != nil {
+					l.app.Flash().Errf("Pipe to `%s failed: %s", cmd, err)
+					if out == "" {
+						return
+					}
+				}
+				if err := l.app.inject(NewDetails(l.app, "Pipe", cmd, true).Update(out)); err != nil {
+					l.app.Flash().Err(err)
+				}
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
+// pipeData feeds data to a shell command and returns its output.
+func pipeData(cmd, data string) (string, error) {
+	c := exec.Command("sh", "-c", cmd)
+	c.Stdin = strings.NewReader(data)
+	out, err := c.CombinedOutput()
+
+	return strings.TrimRight(string(out), "\n"), err
+}
+
 func (l *Log) cpCmd(*tcell.EventKey) *tcell.EventKey {
 	l.app.Flash().Info("Content copied to clipboard...")
-	if err := clipboard.WriteAll(l.logs.GetText(true)); err != nil {
+	if err := copyToClipboard(l.logs.GetText(true)); err != nil {
 		l.app.Flash().Err(err)
 	}
 	return nil
@@ -333,36 +392,45 @@ func ensureDir(dir string) error {
 }
 
 func saveData(cluster, name, data string) (string, error) {
-	dir := filepath.Join(config.K9sDumpDir, sanitizeFilename(cluster))
-	if err := ensureDir(dir); err != nil {
+	path := dumpPath(cluster, name)
+	if err := writeData(path, data); err != nil {
 		return "", err
 	}
 
+	return path, nil
+}
+
+// dumpPath returns a new dump file path for the given resource.
+func dumpPath(cluster, name string) string {
 	now := time.Now().UnixNano()
 	fName := fmt.Sprintf("%s-%d.log", sanitizeFilename(name), now)
 
-	path := filepath.Join(dir, fName)
-	mod := os.O_CREATE | os.O_WRONLY
+	return filepath.Join(config.K9sDumpDir, sanitizeFilename(cluster), fName)
+}
+
+func writeData(path, data string) error {
+	if err := ensureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	mod := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
 	file, err := os.OpenFile(path, mod, 0600)
 	if err != nil {
 		log.Error().Err(err).Msgf("LogFile create %s", path)
-		return "", nil
-
+		return err
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
 			log.Error().Err(err).Msg("Closing Log file")
 		}
 	}()
-	if _, err := file.Write([]byte(data)); err != nil {
-		return "", err
-	}
+	_, err = file.Write([]byte(data))
 
-	return path, nil
+	return err
 }
 
 func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
 	l.model.Clear()
+	l.app.recordLogCleared(l.model.GetPath())
 	return nil
 }
 
@@ -393,6 +461,26 @@ func (l *Log) toggleTextWrapCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (l *Log) toggleJSONCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.indicator.ToggleJSON()
+	l.model.ToggleStructured(l.indicator.JSON())
+	return nil
+}
+
+func (l *Log) toggleInterleaveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+
+	l.indicator.ToggleInterleave()
+	l.model.ToggleInterleave(l.indicator.Interleave())
+	return nil
+}
+
 // ToggleAutoScrollCmd toggles autoscroll status.
 func (l *Log) toggleAutoScrollCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
@@ -12,6 +12,8 @@ const (
 	fullscreen = "FullScreen"
 	timestamp  = "Timestamps"
 	wrap       = "Wrap"
+	interleave = "Interleave"
+	jsonMode   = "JSON"
 	on         = "On"
 	off        = "Off"
 	spacer     = "     "
@@ -27,6 +29,8 @@ type LogIndicator struct {
 	fullScreen   bool
 	textWrap     bool
 	showTime     bool
+	interleave   bool
+	json         bool
 }
 
 // NewLogIndicator returns a new indicator.
@@ -73,6 +77,16 @@ func (l *LogIndicator) FullScreen() bool {
 	return l.fullScreen
 }
 
+// Interleave reports the current interleave mode.
+func (l *LogIndicator) Interleave() bool {
+	return l.interleave
+}
+
+// JSON reports the current structured logs mode.
+func (l *LogIndicator) JSON() bool {
+	return l.json
+}
+
 // ToggleTimestamp toggles the current timestamp mode.
 func (l *LogIndicator) ToggleTimestamp() {
 	l.showTime = !l.showTime
@@ -90,6 +104,18 @@ func (l *LogIndicator) ToggleTextWrap() {
 	l.Refresh()
 }
 
+// ToggleInterleave toggles the interleave mode.
+func (l *LogIndicator) ToggleInterleave() {
+	l.interleave = !l.interleave
+	l.Refresh()
+}
+
+// ToggleJSON toggles the structured logs mode.
+func (l *LogIndicator) ToggleJSON() {
+	l.json = !l.json
+	l.Refresh()
+}
+
 // ToggleAutoScroll toggles the scroll mode.
 func (l *LogIndicator) ToggleAutoScroll() {
 	var val int32 = 1
@@ -106,7 +132,9 @@ func (l *LogIndicator) Refresh() {
 	l.update(autoscroll, l.AutoScroll(), spacer)
 	l.update(fullscreen, l.fullScreen, spacer)
 	l.update(timestamp, l.showTime, spacer)
-	l.update(wrap, l.textWrap, "")
+	l.update(wrap, l.textWrap, spacer)
+	l.update(interleave, l.interleave, spacer)
+	l.update(jsonMode, l.json, "")
 }
 
 func (l *LogIndicator) update(title string, state bool, padding string) {
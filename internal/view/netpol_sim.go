@@ -0,0 +1,172 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	netPolSimTitle = "NetPol Simulator"
+	probeSeparator = "->"
+	probeUsage     = "Usage: FROM -> TO:PORT[/PROTOCOL]"
+)
+
+// NetPolSim presents the network policies rules applying to a pod.
+type NetPolSim struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewNetPolSim returns a new viewer.
+func NewNetPolSim(path string) *NetPolSim {
+	n := NetPolSim{
+		ResourceViewer: NewBrowser(client.NewGVR("netpolsim")),
+		path:           path,
+	}
+	n.GetTable().SetColorerFn(render.NetPolSim{}.ColorerFunc())
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetSortCol("DIRECTION", true)
+	n.SetContextFn(n.simCtx)
+	n.GetTable().SetEnterFn(n.showPolicy)
+
+	return &n
+}
+
+func (n *NetPolSim) simCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, n.path)
+}
+
+func (n *NetPolSim) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyT:      ui.NewKeyAction("Test Connection", n.probeCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Direction", n.GetTable().SortColCmd("DIRECTION", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Policy", n.GetTable().SortColCmd("POLICY", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verdict", n.GetTable().SortColCmd("VERDICT", true), false),
+	})
+}
+
+// showPolicy displays the network policy backing the selected rule.
+func (n *NetPolSim) showPolicy(app *App, _ ui.Tabular, _, path string) {
+	row, ok := n.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 2 || row.Fields[1] == "" || strings.Contains(row.Fields[1], ",") {
+		return
+	}
+	v := NewLiveView(app, "YAML", model.NewYAML(client.NewGVR("networking.k8s.io/v1/networkpolicies"), row.Fields[1]))
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (n *NetPolSim) probeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n.App().InCmdMode() {
+		return evt
+	}
+
+	value := fmt.Sprintf("%s %s ", n.path, probeSeparator)
+	dialog.ShowPrompt(n.App().Styles.Dialog(), n.App().Content.Pages, "Test Connection", "Probe:", value, func(s string) {
+		ns, _ := client.Namespaced(n.path)
+		p, err := parseNetPolProbe(s, ns)
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		n.probe(p)
+	}, func() {})
+
+	return nil
+}
+
+func (n *NetPolSim) probe(p dao.NetPolProbe) {
+	var sim dao.NetPolSim
+	sim.Init(n.App().factory, client.NewGVR("netpolsim"))
+	n.App().Flash().Infof("Probing %s...", p)
+	go func() {
+		v, err := sim.Probe(p)
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), netPolSimTitle, p.String(), false).Update(netPolReport(p, v))
+			if err := n.App().inject(details); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// parseNetPolProbe converts a probe ie `default/fe -> db:5432/tcp`. Pods
+// without a namespace default to the given namespace.
+func parseNetPolProbe(s, ns string) (dao.NetPolProbe, error) {
+	tokens := strings.Split(s, probeSeparator)
+	if len(tokens) != 2 {
+		return dao.NetPolProbe{}, errors.New(probeUsage)
+	}
+	from, to := strings.TrimSpace(tokens[0]), strings.TrimSpace(tokens[1])
+	i := strings.LastIndex(to, ":")
+	if from == "" || i <= 0 || i == len(to)-1 {
+		return dao.NetPolProbe{}, errors.New(probeUsage)
+	}
+	p := dao.NetPolProbe{
+		From: probeEndpoint(from, ns),
+		To:   probeEndpoint(to[:i], ns),
+		Port: to[i+1:],
+	}
+	if j := strings.Index(p.Port, "/"); j >= 0 {
+		p.Port, p.Protocol = p.Port[:j], strings.ToUpper(p.Port[j+1:])
+	}
+	if p.Port == "" {
+		return dao.NetPolProbe{}, errors.New(probeUsage)
+	}
+
+	return p, nil
+}
+
+func probeEndpoint(s, ns string) string {
+	if strings.Contains(s, "/") || strings.Count(s, ".") == 3 || strings.Contains(s, ":") {
+		return s
+	}
+
+	return client.FQN(ns, s)
+}
+
+func netPolReport(p dao.NetPolProbe, v dao.NetPolVerdict) string {
+	verdict := "[red::b]DENIED[-::-]"
+	if v.Allowed() {
+		verdict = "[green::b]ALLOWED[-::-]"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Connection %s is %s\n\n", p, verdict)
+	for _, d := range []struct {
+		dir, subject string
+		decision     dao.NetPolDecision
+	}{
+		{dao.EgressDirection, p.From, v.Egress},
+		{dao.IngressDirection, p.To, v.Ingress},
+	} {
+		state := "denied"
+		if d.decision.Allowed {
+			state = "allowed"
+		}
+		fmt.Fprintf(&b, "%s on %s: %s -- %s\n", d.dir, d.subject, state, d.decision.Reason)
+		for _, pol := range d.decision.Policies {
+			fmt.Fprintf(&b, "  %s\n", pol)
+		}
+	}
+
+	return b.String()
+}
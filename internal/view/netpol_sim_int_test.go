@@ -0,0 +1,54 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNetPolProbe(t *testing.T) {
+	uu := map[string]struct {
+		probe string
+		e     dao.NetPolProbe
+		err   bool
+	}{
+		"pods": {
+			probe: "default/fe -> db:5432",
+			e:     dao.NetPolProbe{From: "default/fe", To: "default/db", Port: "5432"},
+		},
+		"protocol": {
+			probe: "fe->ops/dns:53/udp",
+			e:     dao.NetPolProbe{From: "default/fe", To: "ops/dns", Port: "53", Protocol: "UDP"},
+		},
+		"ip": {
+			probe: "default/fe -> 10.0.0.1:https",
+			e:     dao.NetPolProbe{From: "default/fe", To: "10.0.0.1", Port: "https"},
+		},
+		"noPort": {
+			probe: "default/fe -> db",
+			err:   true,
+		},
+		"noTarget": {
+			probe: "default/fe -> ",
+			err:   true,
+		},
+		"noSource": {
+			probe: " -> db:80",
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := parseNetPolProbe(u.probe, "default")
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
@@ -1,10 +1,8 @@
 package view
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -34,9 +32,10 @@ func NewNode(gvr client.GVR) ResourceViewer {
 
 func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyC: ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
-		ui.KeyU: ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
-		ui.KeyR: ui.NewKeyAction("Drain", n.drainCmd, true),
+		ui.KeyC:      ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
+		ui.KeyU:      ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
+		ui.KeyR:      ui.NewKeyAction("Drain", n.drainCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Taints", n.taintCmd, true),
 	})
 	cl := n.App().Config.K9s.CurrentCluster
 	if n.App().Config.K9s.Clusters[cl].FeatureGates.NodeShell {
@@ -47,7 +46,7 @@ func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
 }
 
 func (n *Node) bindKeys(aa ui.KeyActions) {
-	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	aa.Delete(tcell.KeyCtrlD)
 
 	if !n.App().Config.K9s.IsReadOnly() {
 		n.bindDangerousKeys(aa)
@@ -55,9 +54,13 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.yamlCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Top", topCmd(n), true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 	})
+	if !n.App().Config.K9s.IsReadOnly() {
+		n.nodeGroupActions(aa)
+	}
 }
 
 func (n *Node) showPods(a *App, _ ui.Tabular, _, path string) {
@@ -75,53 +78,121 @@ func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 		Timeout:             5 * time.Second,
 		DeleteLocalData:     false,
 		IgnoreAllDaemonSets: false,
+		DryRun:              dao.IsDryRun(),
 	}
-	ShowDrain(n, path, defaults, drainNode)
+	msg := path
+	if r := n.drainImpacts(path); r != "" {
+		msg += "\n\n" + r
+	}
+	ShowDrain(n, path, msg, defaults, drainNode)
 
 	return nil
 }
 
+// drainNode shows the node drain plan. Unless blocked or in dry run mode,
+// the drain can be confirmed from the plan view.
 func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
-	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	m, err := nodeMaintainer(v)
 	if err != nil {
 		v.App().Flash().Err(err)
 		return
 	}
-	m, ok := res.(dao.NodeMaintainer)
-	if !ok {
-		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
+	plan, err := m.DrainPlan(path, opts)
+	if err != nil {
+		v.App().Flash().Err(err)
 		return
 	}
 
-	buff := bytes.NewBufferString("")
-	if err := m.Drain(path, opts, buff); err != nil {
+	title := drainPlanTitle
+	if opts.DryRun {
+		title += " (dry run)"
+	}
+	details := NewDetails(v.App(), title, path, true).Update(drainReport(plan, opts))
+	if !opts.DryRun && len(plan.Blocked()) == 0 {
+		details.Actions().Add(ui.KeyActions{
+			ui.KeyR: ui.NewKeyAction("Drain", func(evt *tcell.EventKey) *tcell.EventKey {
+				confirmDrain(v, m, path, plan, opts)
+				return nil
+			}, true),
+		})
+	}
+	if err := v.App().inject(details); err != nil {
 		v.App().Flash().Err(err)
-		return
 	}
-	lines := strings.Split(buff.String(), "\n")
-	for _, l := range lines {
-		if len(l) > 0 {
-			v.App().Flash().Info(l)
+}
+
+func confirmDrain(v ResourceViewer, m dao.NodeMaintainer, path string, plan dao.DrainPlan, opts dao.DrainOptions) {
+	a := v.App()
+	evictions := plan.Evictions()
+	msg := fmt.Sprintf("Cordon node %s and evict %d pod(s)?", path, len(evictions))
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Drain", msg, func() {
+		a.Content.Pop()
+		err := m.ToggleCordon(path, true)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Cordon %s", path)
+		}
+		a.audit(dao.NewAuditEntry("drain", v.GVR().String(), path, nil))
+		if len(evictions) == 0 {
+			a.Flash().Info(dryRunTag(fmt.Sprintf("Node %s drained!", path)))
+			v.Refresh()
+			return
 		}
+		runBulk(context.Background(), a, bulkOp{
+			verb:  "Evict",
+			past:  "evicted",
+			gvr:   client.NewGVR("v1/pods"),
+			paths: evictions,
+			fn: func(ctx context.Context, po string) error {
+				err := m.Evict(ctx, po, opts)
+				a.audit(dao.NewAuditEntry("evict", "v1/pods", po, err))
+				return err
+			},
+			done: v.Refresh,
+		})
+	}, func() {})
+}
+
+// drainReport returns a drain plan report with the next steps.
+func drainReport(plan dao.DrainPlan, opts dao.DrainOptions) string {
+	var next string
+	switch {
+	case len(plan.Blocked()) > 0:
+		next = drainBlockedHint
+	case opts.DryRun:
+		next = "Dry run. No changes were made."
+	default:
+		next = "Press <r> to cordon the node and evict its pods."
 	}
-	v.Refresh()
+
+	return plan.String() + "\n\n" + next
+}
+
+func nodeMaintainer(v ResourceViewer) (dao.NodeMaintainer, error) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		return nil, fmt.Errorf("expecting a maintainer for %q", v.GVR())
+	}
+
+	return m, nil
 }
 
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
-		path := n.GetTable().GetSelectedItem()
-		if path == "" {
+		paths := n.GetTable().GetSelectedItems()
+		if len(paths) == 0 {
 			return evt
 		}
 
-		title, msg := "Confirm ", ""
+		verb, past, action := "Uncordon", "uncordoned", "uncordon"
 		if cordon {
-			title, msg = title+"Cordon", "Cordon "
-		} else {
-			title, msg = title+"Uncordon", "Uncordon "
+			verb, past, action = "Cordon", "cordoned", "cordon"
 		}
-		msg += path + "?"
-		dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, title, msg, func() {
+		msg := fmt.Sprintf("%s %s?", verb, bulkSubject(n.GVR(), paths))
+		dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, "Confirm "+verb, msg, func() {
 			res, err := dao.AccessorFor(n.App().factory, n.GVR())
 			if err != nil {
 				n.App().Flash().Err(err)
@@ -132,10 +203,18 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
 				return
 			}
-			if err := m.ToggleCordon(path, cordon); err != nil {
-				n.App().Flash().Err(err)
-			}
-			n.Refresh()
+			runBulk(context.Background(), n.App(), bulkOp{
+				verb:  verb,
+				past:  past,
+				gvr:   n.GVR(),
+				paths: paths,
+				fn: func(_ context.Context, path string) error {
+					err := m.ToggleCordon(path, cordon)
+					n.App().audit(dao.NewAuditEntry(action, n.GVR().String(), path, err))
+					return err
+				},
+				done: n.Refresh,
+			})
 		}, func() {})
 
 		return nil
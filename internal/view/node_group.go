@@ -0,0 +1,204 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const nodeGroupDialogKey = "nodeGroup"
+
+func (n *Node) nodeGroupActions(aa ui.KeyActions) {
+	nn := config.NewNodeGroups()
+	if err := nn.Load(); err != nil {
+		return
+	}
+
+	for k, h := range nn.Hook {
+		key, err := asKey(h.ShortCut)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to map node group hook shortcut to a key")
+			continue
+		}
+		if _, ok := aa[key]; ok {
+			log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
+			continue
+		}
+		aa[key] = ui.NewKeyAction(h.Description, n.nodeGroupCmd(h), true)
+	}
+}
+
+func (n *Node) nodeGroupCmd(h config.NodeGroupHook) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := n.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if n.GetTable().EnvFn() == nil {
+			return nil
+		}
+
+		ll, err := n.nodeLabels(path)
+		if err != nil {
+			n.App().Flash().Err(err)
+			return nil
+		}
+		pool, ok := h.PoolFor(ll)
+		if !ok {
+			n.App().Flash().Errf("No node group label found on node %s", path)
+			return nil
+		}
+		env := n.GetTable().EnvFn()()
+		env["NODE"], env["NODE_POOL"] = path, pool
+		if h.Prompt == "" {
+			n.runNodeGroupHook(h, path, pool, env)
+			return nil
+		}
+		n.showNodeGroupPrompt(h, pool, func(input string) {
+			env["INPUT"] = input
+			n.runNodeGroupHook(h, path, pool, env)
+		})
+
+		return nil
+	}
+}
+
+func (n *Node) nodeLabels(path string) (map[string]string, error) {
+	o, err := n.App().factory.Get(n.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u.GetLabels(), nil
+}
+
+func (n *Node) showNodeGroupPrompt(h config.NodeGroupHook, pool string, ok func(string)) {
+	styles := n.App().Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	var input string
+	f.AddInputField(h.Prompt+":", "", 20, nil, func(changed string) {
+		input = changed
+	})
+	f.AddButton("OK", func() {
+		if strings.TrimSpace(input) == "" {
+			n.App().Flash().Errf("%s must be specified", h.Prompt)
+			return
+		}
+		n.dismissNodeGroupPrompt()
+		ok(strings.TrimSpace(input))
+	})
+	f.AddButton("Cancel", func() {
+		n.dismissNodeGroupPrompt()
+	})
+
+	modal := tview.NewModalForm("<"+h.Description+">", f)
+	modal.SetText("Node group " + pool)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		n.dismissNodeGroupPrompt()
+	})
+	n.App().Content.AddPage(nodeGroupDialogKey, modal, false, false)
+	n.App().Content.ShowPage(nodeGroupDialogKey)
+}
+
+func (n *Node) dismissNodeGroupPrompt() {
+	n.App().Content.RemovePage(nodeGroupDialogKey)
+}
+
+func (n *Node) runNodeGroupHook(h config.NodeGroupHook, path, pool string, env Env) {
+	args := make([]string, len(h.Args))
+	for i, a := range h.Args {
+		arg, err := env.Substitute(a)
+		if err != nil {
+			log.Error().Err(err).Msg("Node group hook args match failed")
+			n.App().Flash().Err(err)
+			return
+		}
+		args[i] = arg
+	}
+
+	cb := func() {
+		if dao.IsDryRun() {
+			cmd := strings.TrimSpace(h.Command + " " + strings.Join(args, " "))
+			e := dao.NewAuditEntry("nodegroup", n.GVR().String(), path, nil)
+			e.Details = cmd
+			n.App().audit(e)
+			n.App().Flash().Info(dryRunTag(fmt.Sprintf("Skipped %s on node group %s: %s", h.Description, pool, cmd)))
+			return
+		}
+		n.App().Flash().Infof("Running %s on node group %s...", h.Description, pool)
+		go func() {
+			out, err := oneShoot(shellOpts{binary: h.Command, args: args})
+			n.App().QueueUpdateDraw(func() {
+				n.nodeGroupDone(h, path, pool, args, out, err)
+			})
+		}()
+	}
+	if h.Confirm {
+		msg := fmt.Sprintf("Run?\n%s %s", h.Command, strings.Join(args, " "))
+		dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, "Confirm "+h.Description, msg, cb, func() {})
+		return
+	}
+	cb()
+}
+
+func (n *Node) nodeGroupDone(h config.NodeGroupHook, path, pool string, args []string, out string, err error) {
+	if err != nil && out != "" {
+		err = errors.New(lastLine(out))
+	}
+	e := dao.NewAuditEntry("nodegroup", n.GVR().String(), path, err)
+	e.Details = strings.TrimSpace(h.Command + " " + strings.Join(args, " "))
+	n.App().audit(e)
+
+	status := h.Description + " succeeded"
+	if err != nil {
+		status = fmt.Sprintf("%s failed: %s", h.Description, err)
+	}
+	nt := dao.Notification{
+		Time:    time.Now(),
+		Context: n.App().Config.K9s.CurrentContext,
+		Cluster: n.App().Config.K9s.CurrentCluster,
+		GVR:     "nodegroups",
+		Path:    pool,
+		To:      status,
+	}
+	if err != nil {
+		n.App().Flash().Err(errors.New(nt.Message()))
+	} else {
+		n.App().Flash().Info(nt.Message())
+	}
+	if n.App().notifier != nil {
+		n.App().notifier.Notify(nt)
+	}
+}
+
+// Helpers...
+
+func lastLine(s string) string {
+	ll := strings.Split(strings.TrimSpace(s), "\n")
+
+	return strings.TrimSpace(ll[len(ll)-1])
+}
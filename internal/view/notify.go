@@ -0,0 +1,103 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+func (a *App) initNotifications() {
+	n := a.Config.K9s.Notifications
+	if n == nil {
+		return
+	}
+	a.notifier = dao.NewNotifier(n.Desktop, n.Webhook)
+	a.watches = model.NewWatchList(n.PollInterval(), a.notifyStatusChange)
+
+	w := a.Config.K9s.Watchdog
+	if w == nil || !w.Enabled {
+		return
+	}
+	ss := w.Scopes()
+	scopes := make([]model.ResourceWatch, 0, len(ss))
+	for _, s := range ss {
+		scopes = append(scopes, model.ResourceWatch{
+			GVR:       client.NewGVR("v1/pods"),
+			Namespace: s[0],
+			Selector:  s[1],
+		})
+	}
+	a.watchdog = model.NewWatchdog(scopes, w.AlertRules(), w.PollInterval(), a.notifyAlert)
+	a.watchdog.AddListener(a.clusterInfo())
+}
+
+func (a *App) watchResources(ctx context.Context) {
+	if a.watches == nil {
+		return
+	}
+	ctx = context.WithValue(ctx, internal.KeyFactory, a.factory)
+	if a.mxCache != nil {
+		ctx = context.WithValue(ctx, internal.KeyMetricsCache, a.mxCache)
+	}
+	ctx = context.WithValue(ctx, internal.KeyServerTables, a.Config.K9s.ServerTables)
+	ctx = a.withRetry(ctx)
+	a.watches.Watch(ctx)
+	if a.watchdog != nil {
+		a.watchdog.Watch(ctx)
+	}
+}
+
+func (a *App) notifyStatusChange(c model.StatusChange) {
+	n := dao.Notification{
+		Time:    time.Now(),
+		Context: a.Config.K9s.CurrentContext,
+		Cluster: a.Config.K9s.CurrentCluster,
+		GVR:     c.GVR.String(),
+		Path:    c.Path,
+		From:    c.From,
+		To:      c.To,
+	}
+	a.Flash().Warn(n.Message())
+	a.notifier.Notify(n)
+}
+
+func (a *App) notifyAlert(al render.ClusterAlert) {
+	n := dao.Notification{
+		Time:    al.FirstSeen,
+		Context: a.Config.K9s.CurrentContext,
+		Cluster: a.Config.K9s.CurrentCluster,
+		GVR:     alertGVR(al.Kind),
+		Path:    al.Path,
+		To:      al.Reason,
+	}
+	if al.Subject != "" {
+		n.To = fmt.Sprintf("%s (%s)", al.Reason, al.Subject)
+	}
+	a.Flash().Warnf("%s. Use :alerts to view", n.Message())
+	a.notifier.Notify(n)
+}
+
+func (b *Browser) watchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	w := model.ResourceWatch{GVR: b.GVR(), Namespace: b.GetModel().GetNamespace()}
+	if q := b.CmdBuff().GetText(); ui.IsLabelSelector(q) {
+		w.Selector = ui.TrimLabelSelector(q)
+	} else if w.Path = b.GetSelectedItem(); w.Path == "" {
+		return evt
+	}
+
+	if b.app.watches.Toggle(w) {
+		b.app.Flash().Infof("Watching %s for status changes", w)
+	} else {
+		b.app.Flash().Infof("Stopped watching %s", w)
+	}
+
+	return nil
+}
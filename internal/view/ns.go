@@ -37,7 +37,13 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyQ: ui.NewKeyAction("Quotas", n.quotasCmd, true),
 	})
+	if !n.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyA: ui.NewKeyAction("Create", n.createCmd, true),
+		})
+	}
 }
 
 func (n *Namespace) switchNs(app *App, model ui.Tabular, gvr, path string) {
@@ -57,6 +63,19 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) quotasCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	if err := n.App().inject(NewQuotaPressure(ns)); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if err := n.App().switchNS(ns); err != nil {
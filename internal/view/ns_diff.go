@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	nsDiffUsage = "Usage: nsdiff NAMESPACE1 NAMESPACE2 [RESOURCE,...]"
+
+	// nsDiffRefreshRate throttles namespaces comparisons.
+	nsDiffRefreshRate = 30 * time.Second
+)
+
+// NSDiff presents resources differing between two namespaces.
+type NSDiff struct {
+	ResourceViewer
+
+	query dao.NSDiffQuery
+}
+
+// NewNSDiff returns a new viewer.
+func NewNSDiff(q dao.NSDiffQuery) *NSDiff {
+	n := NSDiff{
+		ResourceViewer: NewBrowser(client.NewGVR("nsdiff")),
+		query:          q,
+	}
+	n.GetTable().SetColorerFn(render.NSDiff{}.ColorerFunc())
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetSortCol("GVR", true)
+	n.SetContextFn(n.nsDiffCtx)
+	n.GetTable().SetEnterFn(n.showDiff)
+
+	return &n
+}
+
+// Init initializes the view.
+func (n *NSDiff) Init(ctx context.Context) error {
+	if err := n.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	n.GetTable().GetModel().SetRefreshRate(nsDiffRefreshRate)
+
+	return nil
+}
+
+func (n *NSDiff) nsDiffCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, n.query.String())
+	return context.WithValue(ctx, internal.KeyNSDiff, n.query)
+}
+
+func (n *NSDiff) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", n.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort GVR", n.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+// showDiff compares the selected resource manifests side by side.
+func (n *NSDiff) showDiff(app *App, _ ui.Tabular, gvr, path string) {
+	row, ok := n.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 2 {
+		return
+	}
+	var d dao.NSDiff
+	d.Init(app.factory, client.NewGVR(gvr))
+	ctx, cancel := context.WithTimeout(context.Background(), 2*app.Conn().Config().CallTimeout())
+	defer cancel()
+	l, r, err := d.Manifests(ctx, client.NewGVR(row.Fields[1]), row.Fields[0], n.query.Left, n.query.Right)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	v := NewDiff(app, diffTitle, row.Fields[1]+" "+row.Fields[0], n.query.Left, l, n.query.Right, r)
+	if err := app.inject(v); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// parseNSDiff converts command arguments ie `dev prod dp,cm` to a query.
+// Resources are resolved using the command aliases.
+func parseNSDiff(args []string, gvrFn func(string) (client.GVR, bool)) (dao.NSDiffQuery, error) {
+	if len(args) < 2 {
+		return dao.NSDiffQuery{}, errors.New(nsDiffUsage)
+	}
+	q := dao.NSDiffQuery{
+		Left:  client.CleanseNamespace(args[0]),
+		Right: client.CleanseNamespace(args[1]),
+	}
+	if q.Left == "" || q.Right == "" || q.Left == q.Right {
+		return dao.NSDiffQuery{}, errors.New("two distinct namespaces are required. " + nsDiffUsage)
+	}
+	for _, res := range strings.FieldsFunc(strings.Join(args[2:], ","), func(r rune) bool { return r == ',' || r == ' ' }) {
+		gvr, ok := gvrFn(res)
+		if !ok {
+			return dao.NSDiffQuery{}, errors.New("`" + res + "` resource not found. " + nsDiffUsage)
+		}
+		q.GVRs = append(q.GVRs, gvr)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,78 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNSDiff(t *testing.T) {
+	gvrFn := func(s string) (client.GVR, bool) {
+		switch s {
+		case "dp":
+			return client.NewGVR("apps/v1/deployments"), true
+		case "cm":
+			return client.NewGVR("v1/configmaps"), true
+		default:
+			return client.GVR{}, false
+		}
+	}
+
+	uu := map[string]struct {
+		args []string
+		q    dao.NSDiffQuery
+		err  string
+	}{
+		"defaults": {
+			args: []string{"dev", "prod"},
+			q:    dao.NSDiffQuery{Left: "dev", Right: "prod"},
+		},
+		"resources": {
+			args: []string{"dev", "prod", "dp,cm"},
+			q: dao.NSDiffQuery{
+				Left:  "dev",
+				Right: "prod",
+				GVRs:  client.GVRs{client.NewGVR("apps/v1/deployments"), client.NewGVR("v1/configmaps")},
+			},
+		},
+		"spaced": {
+			args: []string{"dev", "prod", "dp", "cm"},
+			q: dao.NSDiffQuery{
+				Left:  "dev",
+				Right: "prod",
+				GVRs:  client.GVRs{client.NewGVR("apps/v1/deployments"), client.NewGVR("v1/configmaps")},
+			},
+		},
+		"missingNS": {
+			args: []string{"dev"},
+			err:  nsDiffUsage,
+		},
+		"sameNS": {
+			args: []string{"dev", "dev"},
+			err:  "two distinct namespaces are required. " + nsDiffUsage,
+		},
+		"allNS": {
+			args: []string{"dev", "all"},
+			err:  "two distinct namespaces are required. " + nsDiffUsage,
+		},
+		"unknownResource": {
+			args: []string{"dev", "prod", "blee"},
+			err:  "`blee` resource not found. " + nsDiffUsage,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := parseNSDiff(u.args, gvrFn)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.q, q)
+		})
+	}
+}
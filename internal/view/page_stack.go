@@ -34,6 +34,7 @@ func (p *PageStack) Init(ctx context.Context) (err error) {
 // StackPushed notifies a new page was added.
 func (p *PageStack) StackPushed(c model.Component) {
 	c.Start()
+	p.app.claimFocus()
 	p.app.SetFocus(c)
 }
 
@@ -49,5 +50,6 @@ func (p *PageStack) StackTop(top model.Component) {
 		return
 	}
 	top.Start()
+	p.app.claimFocus()
 	p.app.SetFocus(top)
 }
@@ -0,0 +1,47 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	deleteExceeded = "availability drops below budget!"
+	drainExceeded  = "drain blocks until budget allows!"
+)
+
+// deleteImpacts returns a disruption budgets report for the deleted resources
+// or blank if no budgets are affected.
+func (b *Browser) deleteImpacts(selections []string) string {
+	p, ok := b.accessor.(dao.DisruptionPreviewer)
+	if !ok {
+		return ""
+	}
+	ii, err := p.DeleteImpacts(selections)
+	if err != nil {
+		log.Warn().Err(err).Msgf("PDB impacts failed")
+		return ""
+	}
+
+	return dao.ImpactsString(ii, deleteExceeded)
+}
+
+// drainImpacts returns a disruption budgets report for a node drain or blank
+// if no budgets are affected.
+func (n *Node) drainImpacts(path string) string {
+	res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	if err != nil {
+		return ""
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		return ""
+	}
+	ii, err := m.DrainImpacts(path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("PDB impacts failed")
+		return ""
+	}
+
+	return dao.ImpactsString(ii, drainExceeded)
+}
@@ -138,20 +138,31 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	path := p.GetTable().GetSelectedItem()
-	if path == "" {
+	paths := p.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return nil
 	}
 
-	showModal(p.App().Content.Pages, fmt.Sprintf("Delete PortForward `%s?", path), func() {
+	gvr := client.NewGVR("portforwards")
+	showModal(p.App().Content.Pages, fmt.Sprintf("Delete %s?", bulkSubject(gvr, paths)), func() {
 		var pf dao.PortForward
-		pf.Init(p.App().factory, client.NewGVR("portforwards"))
-		if err := pf.Delete(path, true, true); err != nil {
-			p.App().Flash().Err(err)
-			return
-		}
-		p.App().Flash().Infof("PortForward %s deleted!", path)
-		p.GetTable().Refresh()
+		pf.Init(p.App().factory, gvr)
+		runBulk(context.Background(), p.App(), bulkOp{
+			verb:  "Delete",
+			past:  "deleted",
+			gvr:   gvr,
+			paths: paths,
+			fn: func(_ context.Context, path string) error {
+				err := pf.Delete(path, true, true)
+				p.App().audit(dao.NewAuditEntry("delete", gvr.String(), path, err))
+				return err
+			},
+			ok: p.GetTable().DeleteMark,
+			done: func() {
+				p.App().QueueUpdateDraw(p.App().savePortForwards)
+				p.GetTable().Refresh()
+			},
+		})
 	})
 
 	return nil
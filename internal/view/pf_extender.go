@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
@@ -84,49 +85,105 @@ func tryListenPort(address, port string) error {
 	return server.Close()
 }
 
-func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForwarder) {
-	v.App().factory.AddForwarder(pf)
-
-	v.App().QueueUpdateDraw(func() {
-		v.App().Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
-		DismissPortForwards(v, v.App().Content.Pages)
-	})
+// runForward streams a port-forward. Forwards following a selector get
+// re-established on a replacement pod when their pod goes away.
+func (a *App) runForward(pf *dao.PortForwarder, f *portforward.PortForwarder) {
+	for {
+		if err := f.ForwardPorts(); err != nil {
+			a.QueueUpdateDraw(func() {
+				a.Flash().Err(err)
+			})
+			break
+		}
+		if pf.Stopped() || pf.Selector() == "" {
+			break
+		}
 
-	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
-		v.App().Flash().Err(err)
+		path := pf.Path()
+		var err error
+		if f, err = pf.Reconnect(); err != nil {
+			if pf.Stopped() {
+				return
+			}
+			a.QueueUpdateDraw(func() {
+				a.Flash().Err(err)
+			})
+			break
+		}
+		a.QueueUpdateDraw(func() {
+			a.factory.UpdateForwarder(path, pf)
+			a.Flash().Infof("PortForward reconnected %s:%s", pf.Path(), pf.Ports()[0])
+			a.savePortForwards()
+		})
+	}
+	if pf.Stopped() {
 		return
 	}
 
-	v.App().QueueUpdateDraw(func() {
-		v.App().factory.DeleteForwarder(pf.FQN())
+	a.QueueUpdateDraw(func() {
+		a.factory.DeleteForwarder(pf.FQN())
 		pf.SetActive(false)
+		a.savePortForwards()
 	})
 }
 
-func startFwdCB(v ResourceViewer, path, co string, tt []client.PortTunnel) {
+// startForward initiates a port-forward on a pod container. Unless a selector
+// is given, forwards on pods managed by a controller follow the controller
+// pods.
+func (a *App) startForward(path, co, sel string, tt []client.PortTunnel) error {
 	for _, t := range tt {
-		err := tryListenPort(t.Address, t.LocalPort)
-		if err != nil {
-			v.App().Flash().Err(err)
-			return
+		if err := tryListenPort(t.Address, t.LocalPort); err != nil {
+			return err
 		}
 	}
 
-	if _, ok := v.App().factory.ForwarderFor(dao.PortForwardID(path, co)); ok {
-		v.App().Flash().Err(errors.New("A port-forward is already active on this pod"))
-		return
+	if _, ok := a.factory.ForwarderFor(dao.PortForwardID(path, co)); ok {
+		return errors.New("A port-forward is already active on this pod")
 	}
 
-	pf := dao.NewPortForwarder(v.App().factory)
+	pf := dao.NewPortForwarder(a.factory)
+	if sel == "" {
+		var err error
+		if sel, err = dao.PodSelector(a.factory, path); err != nil {
+			log.Warn().Err(err).Msgf("Unable to resolve pod selector for %q", path)
+		}
+	}
+	pf.SetSelector(sel)
 	fwd, err := pf.Start(path, co, tt)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().Msgf(">>> Starting port forward %q %#v", path, tt)
+	pf.SetActive(true)
+	a.factory.AddForwarder(pf)
+	go a.runForward(pf, fwd)
+	a.Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+
+	return nil
+}
+
+func startFwdCB(v ResourceViewer, path, co string, tt []client.PortTunnel) {
+	err := v.App().startForward(path, co, "", tt)
+	e := dao.NewAuditEntry("port-forward", "v1/pods", path, err)
+	e.Details = fwdTunnels(co, tt)
+	v.App().audit(e)
 	if err != nil {
 		v.App().Flash().Err(err)
 		return
 	}
+	v.App().savePortForwards()
+	DismissPortForwards(v, v.App().Content.Pages)
+}
 
-	log.Debug().Msgf(">>> Starting port forward %q %#v", path, tt)
-	go runForward(v, pf, fwd)
+// fwdTunnels returns a container port mappings ie nginx 8080:80.
+func fwdTunnels(co string, tt []client.PortTunnel) string {
+	mm := make([]string, 0, len(tt))
+	for _, t := range tt {
+		mm = append(mm, t.PortMap())
+	}
+
+	return co + " " + strings.Join(mm, ",")
 }
 
 func showFwdDialog(v ResourceViewer, path string, cb PortForwardCB) error {
@@ -0,0 +1,88 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// PluginJob presents the plugins background jobs.
+type PluginJob struct {
+	ResourceViewer
+}
+
+// NewPluginJob returns a new viewer.
+func NewPluginJob(gvr client.GVR) ResourceViewer {
+	p := PluginJob{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.PluginJob{}.ColorerFunc())
+	p.GetTable().SetSortCol(ageCol, true)
+	p.GetTable().SetEnterFn(func(_ *App, _ ui.Tabular, _, path string) { p.showOutput(path) })
+	p.SetContextFn(p.jobContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PluginJob) jobContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPluginJobs, p.App().pluginJobs)
+}
+
+func (p *PluginJob) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE, ui.KeyY, ui.KeyD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Logs", p.logsCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
+	})
+	if !p.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
+		})
+	}
+}
+
+func (p *PluginJob) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	p.showOutput(id)
+
+	return nil
+}
+
+func (p *PluginJob) showOutput(id string) {
+	app := p.App()
+	out, err := app.pluginJobs.Output(id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if out == "" {
+		out = "No output yet..."
+	}
+	details := NewDetails(app, "Plugin Job", id, true).Update(out)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (p *PluginJob) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := p.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	if err := p.App().pluginJobs.Cancel(id); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	p.App().Flash().Infof("Plugin job %s canceled", id)
+	p.Refresh()
+
+	return nil
+}
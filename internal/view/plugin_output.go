@@ -0,0 +1,39 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// PluginOutput presents a plugin columnar output as a table.
+type PluginOutput struct {
+	ResourceViewer
+
+	title, raw string
+}
+
+// NewPluginOutput returns a new viewer.
+func NewPluginOutput(title, raw string) ResourceViewer {
+	p := PluginOutput{
+		ResourceViewer: NewBrowser(client.NewGVR("pluginoutput")),
+		title:          title,
+		raw:            raw,
+	}
+	p.SetContextFn(p.outputContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PluginOutput) outputContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, p.title)
+	return context.WithValue(ctx, internal.KeyPluginOut, p.raw)
+}
+
+func (p *PluginOutput) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftN, ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyEnter)
+}
@@ -59,6 +59,7 @@ func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
 		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
 		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
 		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		ui.KeyShiftD:   ui.NewKeyAction("Debug", p.debugCmd, true),
 	})
 }
 
@@ -69,6 +70,8 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Top", topCmd(p), true),
+		ui.KeyN:      ui.NewKeyAction("NetPol Simulator", p.netPolSimCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
@@ -78,6 +81,18 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 	aa.Add(resourceSorters(p.GetTable()))
 }
 
+func (p *Pod) netPolSimCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := p.App().inject(NewNetPolSim(path)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) selectedContainer() string {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -150,15 +165,22 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 	p.GetTable().ShowDeleted()
-	for _, res := range sels {
-		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, true); err != nil {
-			p.App().Flash().Errf("Delete failed with %s", err)
-		} else {
-			p.App().factory.DeleteForwarder(res)
-		}
-	}
-	p.Refresh()
+	runBulk(context.Background(), p.App(), bulkOp{
+		verb:  "Kill",
+		past:  "killed",
+		gvr:   p.GVR(),
+		paths: sels,
+		fn: func(_ context.Context, path string) error {
+			err := nuker.Delete(path, true, true)
+			p.App().audit(dao.NewAuditEntry("kill", p.GVR().String(), path, err))
+			return err
+		},
+		ok: func(path string) {
+			p.App().factory.DeleteForwarder(path)
+			p.GetTable().DeleteMark(path)
+		},
+		done: p.Refresh,
+	})
 
 	return nil
 }
@@ -199,6 +221,24 @@ func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+
+	if err := containerDebug(p.App(), p, path, ""); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -290,6 +330,11 @@ func computeShellArgs(path, co string, kcfg *string) []string {
 	return append(args, "--", "sh", "-c", shellCheck)
 }
 
+func computeNodeShellArgs(path string, kcfg *string) []string {
+	args := buildShellArgs("exec", path, k9sShell, kcfg)
+	return append(args, "--", "sh", "-c", nodeShellCheck)
+}
+
 func buildShellArgs(cmd, path, co string, kcfg *string) []string {
 	args := make([]string, 0, 15)
 	args = append(args, cmd, "-it")
@@ -4,7 +4,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestComputeShellArgs(t *testing.T) {
@@ -49,3 +51,44 @@ func TestComputeShellArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeNodeShellArgs(t *testing.T) {
+	config := "coolConfig"
+	uu := map[string]struct {
+		cfg *string
+		e   string
+	}{
+		"config": {
+			&config,
+			"exec -it -n default k9s-shell-1 --kubeconfig coolConfig -c k9s-shell -- sh -c " + nodeShellCheck,
+		},
+		"noconfig": {
+			nil,
+			"exec -it -n default k9s-shell-1 -c k9s-shell -- sh -c " + nodeShellCheck,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			args := computeNodeShellArgs("default/k9s-shell-1", u.cfg)
+
+			assert.Equal(t, u.e, strings.Join(args, " "))
+		})
+	}
+}
+
+func TestK9sShellPod(t *testing.T) {
+	cfg := config.NewShellPod()
+	cfg.Namespace = "fred"
+	po := k9sShellPod("n1", cfg)
+
+	assert.Equal(t, "fred", po.Namespace)
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.True(t, po.Spec.HostPID)
+	assert.True(t, po.Spec.HostNetwork)
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, po.Spec.Tolerations)
+	assert.Equal(t, 1, len(po.Spec.Containers))
+	assert.Equal(t, cfg.Image, po.Spec.Containers[0].Image)
+	assert.True(t, *po.Spec.Containers[0].SecurityContext.Privileged)
+}
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	probesTitle   = "Probes"
+	probesTimeout = 2 * time.Minute
+)
+
+// showProbes runs a container probes on demand and reports their outcome.
+func showProbes(app *App, fqn, co string) {
+	details := NewDetails(app, probesTitle, fqn+":"+co, true).Update("Running probes...")
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), probesTimeout)
+		defer cancel()
+		var dc dao.Container
+		dc.Init(app.factory, client.NewGVR("containers"))
+		rr, err := dc.Probes(ctx, fqn, co)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				details.Update("error: " + err.Error())
+				return
+			}
+			details.Update(probesReport(rr))
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// probesReport returns a yaml like report of probes runs.
+func probesReport(rr []dao.ProbeResult) string {
+	var b strings.Builder
+	for _, r := range rr {
+		status := "success"
+		if !r.Success {
+			status = "failure"
+		}
+		fmt.Fprintf(&b, "- probe: %s\n  handler: %s\n  status: %s\n", r.Type, r.Handler, status)
+		if r.Code != 0 {
+			fmt.Fprintf(&b, "  code: %d\n", r.Code)
+		}
+		if r.Latency > 0 {
+			fmt.Fprintf(&b, "  latency: %s\n", r.Latency.Round(time.Millisecond))
+		}
+		if r.Err != nil {
+			fmt.Fprintf(&b, "  error: %s\n", r.Err)
+		}
+		if body := strings.TrimSpace(r.Body); body != "" {
+			b.WriteString("  body: |\n")
+			for _, l := range strings.Split(body, "\n") {
+				fmt.Fprintf(&b, "    %s\n", l)
+			}
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbesReport(t *testing.T) {
+	rr := []dao.ProbeResult{
+		{
+			Type:    "readiness",
+			Handler: "GET http://:8080/healthz",
+			Success: true,
+			Code:    200,
+			Body:    "ok\n",
+			Latency: 12300 * time.Microsecond,
+		},
+		{
+			Type:    "liveness",
+			Handler: "exec cat /tmp/healthy",
+			Code:    1,
+			Body:    "cat: /tmp/healthy: No such file\nor directory",
+			Latency: 250 * time.Millisecond,
+		},
+		{
+			Type:    "startup",
+			Handler: "TCP :5432",
+			Err:     errors.New("connection refused by container"),
+		},
+	}
+
+	assert.Equal(t, `- probe: readiness
+  handler: GET http://:8080/healthz
+  status: success
+  code: 200
+  latency: 12ms
+  body: |
+    ok
+- probe: liveness
+  handler: exec cat /tmp/healthy
+  status: failure
+  code: 1
+  latency: 250ms
+  body: |
+    cat: /tmp/healthy: No such file
+    or directory
+- probe: startup
+  handler: TCP :5432
+  status: failure
+  error: connection refused by container`, probesReport(rr))
+}
@@ -0,0 +1,108 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	promChartPrefix = "prom:"
+
+	// promScale keeps fractional rates visible once charted as integers.
+	promScale = 1000
+)
+
+// promPanels returns the current cluster Prometheus client and the panels
+// defined for a resource if a data source is configured.
+func (a *App) promPanels(gvr string) (*dao.PromClient, []config.PromPanel, bool) {
+	cl := a.Config.CurrentCluster()
+	if cl == nil || !cl.Prometheus.IsEnabled() {
+		return nil, nil, false
+	}
+	c, err := dao.NewPromClient(cl.Prometheus)
+	if err != nil {
+		log.Error().Err(err).Msgf("Prometheus client init failed")
+		return nil, nil, false
+	}
+	pp := config.NewPulses()
+	if err := pp.Load(config.K9sPulsesFile); err != nil && !os.IsNotExist(err) {
+		log.Warn().Err(err).Msgf("Unable to load pulses panels %q", config.K9sPulsesFile)
+	}
+	panels := pp.PanelsFor(gvr)
+
+	return c, panels, len(panels) > 0
+}
+
+// pollPanels periodically evaluates panels queries and hands off the results
+// on the ui thread.
+func pollPanels(ctx context.Context, app *App, c *dao.PromClient, pp []config.PromPanel, ns, n string, fn func([]dao.PromResult)) {
+	rate := time.Duration(app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		cctx, cancel := context.WithTimeout(ctx, app.Conn().Config().CallTimeout())
+		rr := c.QueryPanels(cctx, pp, ns, n)
+		cancel()
+		app.QueueUpdateDraw(func() {
+			fn(rr)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+// addPromResult charts a panel value and updates its legend.
+func addPromResult(g Grapheable, r dao.PromResult) {
+	g.SetLegend(promLegend(r, g.GetSeriesColorNames()[0]))
+	if r.Err != nil {
+		log.Warn().Err(r.Err).Msgf("Prometheus panel %q failed", r.Panel.Name)
+		return
+	}
+	if r.OK {
+		g.Add(tchart.Metric{S1: int64(math.Round(r.Value * promScale))})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func promChartID(p config.PromPanel) string {
+	return promChartPrefix + p.Name
+}
+
+func promLegend(r dao.PromResult, color string) string {
+	title := tview.Escape(r.Panel.Name)
+	switch {
+	case r.Err != nil:
+		return fmt.Sprintf(" %s [red::]failed[-::] ", title)
+	case !r.OK:
+		return fmt.Sprintf(" %s [gray::]n/a[-::] ", title)
+	default:
+		var unit string
+		if r.Panel.Unit != "" {
+			unit = " " + tview.Escape(r.Panel.Unit)
+		}
+		return fmt.Sprintf(" %s [%s::b]%s%s[-::-] ", title, color, promFmt(r.Value), unit)
+	}
+}
+
+// promFmt returns a human readable panel value.
+func promFmt(v float64) string {
+	if math.Abs(v) >= 100 {
+		return render.AsThousands(int64(math.Round(v)))
+	}
+
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"errors"
+	"image"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromFmt(t *testing.T) {
+	uu := map[string]struct {
+		v float64
+		e string
+	}{
+		"zero":     {e: "0"},
+		"fraction": {v: 0.12345, e: "0.12"},
+		"small":    {v: 12.5, e: "12.5"},
+		"large":    {v: 12345.6, e: "12,346"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, promFmt(u.v))
+		})
+	}
+}
+
+func TestPromLegend(t *testing.T) {
+	p := config.PromPanel{Name: "Requests", Unit: "req/s"}
+	uu := map[string]struct {
+		r dao.PromResult
+		e string
+	}{
+		"ok": {
+			r: dao.PromResult{Panel: p, Value: 1.5, OK: true},
+			e: " Requests [blue::b]1.5 req/s[-::-] ",
+		},
+		"noData": {
+			r: dao.PromResult{Panel: p},
+			e: " Requests [gray::]n/a[-::] ",
+		},
+		"failed": {
+			r: dao.PromResult{Panel: p, Err: errors.New("boom")},
+			e: " Requests [red::]failed[-::] ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, promLegend(u.r, "blue"))
+		})
+	}
+}
+
+func TestPromPanelLayout(t *testing.T) {
+	loc, span := promPanelLayout(0)
+	assert.Equal(t, image.Point{X: 0, Y: 8}, loc)
+	assert.Equal(t, image.Point{X: 2, Y: 2}, span)
+
+	loc, span = promPanelLayout(4)
+	assert.Equal(t, image.Point{X: 2, Y: 10}, loc)
+	assert.Equal(t, image.Point{X: 3, Y: 2}, span)
+}
+
+func TestChartTitle(t *testing.T) {
+	assert.Equal(t, "Deployments", chartTitle("apps/v1/deployments"))
+	assert.Equal(t, "API Errors", chartTitle(promChartPrefix+"API Errors"))
+}
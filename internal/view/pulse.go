@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/health"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
@@ -61,6 +62,8 @@ type Pulse struct {
 	cancelFn context.CancelFunc
 	actions  ui.KeyActions
 	charts   []Grapheable
+	prom     *dao.PromClient
+	panels   []config.PromPanel
 }
 
 // NewPulse returns a new alias view.
@@ -99,6 +102,13 @@ func (p *Pulse) Init(ctx context.Context) error {
 			p.makeSP(image.Point{X: 5, Y: 4}, image.Point{X: 2, Y: 4}, "mem"),
 		)
 	}
+	if c, pp, ok := p.app.promPanels(pulseTitle); ok {
+		p.prom, p.panels = c, pp
+		for i, panel := range pp {
+			loc, span := promPanelLayout(i)
+			p.charts = append(p.charts, p.makePromSP(loc, span, panel))
+		}
+	}
 	p.bindKeys()
 	p.model.AddListener(p)
 	p.app.SetFocus(p.charts[0])
@@ -188,6 +198,15 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 	v.Add(tchart.Metric{S1: c.Tally(health.S1), S2: c.Tally(health.S2)})
 }
 
+// promChanged notifies the Prometheus panels were evaluated.
+func (p *Pulse) promChanged(rr []dao.PromResult) {
+	for _, r := range rr {
+		if i, ok := findIndexGVR(p.charts, promChartID(r.Panel)); ok {
+			addPromResult(p.charts[i], r)
+		}
+	}
+}
+
 // PulseFailed notifies the load failed.
 func (p *Pulse) PulseFailed(err error) {
 	p.app.Flash().Err(err)
@@ -201,8 +220,10 @@ func (p *Pulse) bindKeys() {
 	})
 
 	for i, v := range p.charts {
-		t := strings.Title(client.NewGVR(v.(Grapheable).ID()).R())
-		p.actions[tcell.Key(ui.NumKeys[i])] = ui.NewKeyAction(t, p.sparkFocusCmd(i), true)
+		if i >= len(ui.NumKeys) {
+			break
+		}
+		p.actions[tcell.Key(ui.NumKeys[i])] = ui.NewKeyAction(chartTitle(v.ID()), p.sparkFocusCmd(i), true)
 	}
 }
 
@@ -229,6 +250,9 @@ func (p *Pulse) Start() {
 	ctx := p.defaultContext()
 	ctx, p.cancelFn = context.WithCancel(ctx)
 	p.model.Watch(ctx)
+	if p.prom != nil {
+		go pollPanels(ctx, p.app, p.prom, p.panels, p.model.GetNamespace(), "", p.promChanged)
+	}
 }
 
 // Stop terminates watch loop.
@@ -303,6 +327,14 @@ func (p *Pulse) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !ok {
 		return nil
 	}
+	if strings.HasPrefix(s.ID(), promChartPrefix) {
+		for _, panel := range p.panels {
+			if promChartID(panel) == s.ID() {
+				p.App().Flash().Info(panel.Expand(p.model.GetNamespace(), ""))
+			}
+		}
+		return nil
+	}
 	res := client.NewGVR(s.ID()).R()
 	if res == "cpu" || res == "mem" {
 		res = "pod"
@@ -345,6 +377,19 @@ func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.Sp
 	return s
 }
 
+func (p *Pulse) makePromSP(loc image.Point, span image.Point, panel config.PromPanel) *tchart.SparkLine {
+	s := tchart.NewSparkLine(promChartID(panel))
+	s.SetBackgroundColor(p.app.Styles.Charts().BgColor.Color())
+	s.SetBorderPadding(0, 1, 0, 1)
+	s.SetSeriesColors(p.app.Styles.Charts().DefaultChartColors.Colors()...)
+	s.SetLegend(fmt.Sprintf(" %s ", tview.Escape(panel.Name)))
+	s.SetInputCapture(p.keyboard)
+	s.SetMultiSeries(false)
+	p.AddItem(s, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
+
+	return s
+}
+
 func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Gauge {
 	g := tchart.NewGauge(gvr)
 	// g.SetResolution(3)
@@ -365,6 +410,23 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 // ----------------------------------------------------------------------------
 // Helpers
 
+// promPanelLayout lays out Prometheus panels three per row below the
+// resources charts, aligned on their columns.
+func promPanelLayout(i int) (image.Point, image.Point) {
+	xx, ww := []int{0, 2, 5}, []int{2, 3, 2}
+	col := i % len(xx)
+
+	return image.Point{X: xx[col], Y: 8 + (i/len(xx))*2}, image.Point{X: ww[col], Y: 2}
+}
+
+func chartTitle(id string) string {
+	if strings.HasPrefix(id, promChartPrefix) {
+		return strings.TrimPrefix(id, promChartPrefix)
+	}
+
+	return strings.Title(client.NewGVR(id).R())
+}
+
 func nextFocus(pp []Grapheable, index int) (int, tview.Primitive) {
 	if index >= len(pp) {
 		return 0, pp[0]
@@ -26,6 +26,7 @@ func NewPersistentVolumeClaim(gvr client.GVR) ResourceViewer {
 func (p *PersistentVolumeClaim) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU:      ui.NewKeyAction("UsedBy", p.refCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Browse", p.browseCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
 		ui.KeyShiftV: ui.NewKeyAction("Sort Volume", p.GetTable().SortColCmd("VOLUME", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort StorageClass", p.GetTable().SortColCmd("STORAGECLASS", true), false),
@@ -36,3 +37,15 @@ func (p *PersistentVolumeClaim) bindKeys(aa ui.KeyActions) {
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return scanRefs(evt, p.App(), p.GetTable(), "v1/persistentvolumeclaims")
 }
+
+func (p *PersistentVolumeClaim) browseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := p.App().inject(NewPVCBrowser(path)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	pvcBrowserTitle = "PVC Browser"
+	pvcBrowser      = "k9s-pvc"
+	pvcBrowserMount = "/data"
+
+	// pvcBrowserTTL bounds the browser pod lifetime should k9s fail to clean it up.
+	pvcBrowserTTL = 3600
+
+	// pvcMaxEntries caps the listed files count.
+	pvcMaxEntries = 5000
+)
+
+var _ model.StackListener = (*PVCBrowser)(nil)
+
+// PVCBrowser browses the files of a persistent volume claim using a
+// temporary pod mounting the claim. The pod is deleted once the view closes.
+type PVCBrowser struct {
+	*ui.Tree
+
+	app      *App
+	claim    string
+	pod      string
+	launched bool
+	closed   bool
+	mx       sync.Mutex
+}
+
+// NewPVCBrowser returns a new claim browser.
+func NewPVCBrowser(claim string) *PVCBrowser {
+	ns, n := client.Namespaced(claim)
+
+	return &PVCBrowser{
+		Tree:  ui.NewTree(),
+		claim: claim,
+		pod:   client.FQN(ns, pvcPodName(n)),
+	}
+}
+
+// Init initializes the view.
+func (p *PVCBrowser) Init(ctx context.Context) error {
+	var err error
+	if p.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	if err := p.Tree.Init(ctx); err != nil {
+		return err
+	}
+
+	p.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, pvcBrowserTitle, p.claim), p.app.Styles.Frame()))
+	p.SetBackgroundColor(p.app.Styles.Xray().BgColor.Color())
+	p.SetBorderColor(p.app.Styles.Xray().FgColor.Color())
+	p.SetBorderFocusColor(p.app.Styles.Frame().Border.FocusColor.Color())
+	p.SetGraphicsColor(p.app.Styles.Xray().GraphicColor.Color())
+	p.setStatus("Launching browser pod...")
+	p.SetChangedFunc(func(n *tview.TreeNode) {
+		if ref, ok := n.GetReference().(string); ok {
+			p.SetSelectedItem(ref)
+		}
+	})
+	p.bindKeys()
+	p.app.Content.Stack.AddListener(p)
+
+	return nil
+}
+
+// Name returns the component name.
+func (p *PVCBrowser) Name() string { return pvcBrowserTitle }
+
+// Start launches the browser pod once.
+func (p *PVCBrowser) Start() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if p.launched {
+		return
+	}
+	p.launched = true
+	go p.launch()
+}
+
+// Stop terminates the view.
+func (p *PVCBrowser) Stop() {}
+
+// StackPushed notifies a component was pushed.
+func (p *PVCBrowser) StackPushed(model.Component) {}
+
+// StackTop notifies the top component.
+func (p *PVCBrowser) StackTop(model.Component) {}
+
+// StackPopped cleans up the browser pod once the view is closed.
+func (p *PVCBrowser) StackPopped(old, _ model.Component) {
+	if old != p {
+		return
+	}
+	p.mx.Lock()
+	p.closed = true
+	p.mx.Unlock()
+	go func() {
+		p.app.QueueUpdate(func() {
+			p.app.Content.Stack.RemoveListener(p)
+		})
+		if err := nukePVCPod(p.app, p.pod); err != nil {
+			log.Error().Err(err).Msgf("Deleting pvc browser pod %s", p.pod)
+		}
+	}()
+}
+
+func (p *PVCBrowser) bindKeys() {
+	aa := ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.app.PrevCmd, false),
+		ui.KeyD:         ui.NewKeyAction("Download", p.downloadCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", p.refreshCmd, true),
+	}
+	if !p.app.Config.K9s.IsReadOnly() {
+		aa[ui.KeyU] = ui.NewKeyAction("Upload", p.uploadCmd, true)
+	}
+	p.Actions().Add(aa)
+}
+
+func (p *PVCBrowser) launch() {
+	err := launchPVCPod(p.app, p.claim, p.pod)
+	p.mx.Lock()
+	closed := p.closed
+	p.mx.Unlock()
+	if closed {
+		if err := nukePVCPod(p.app, p.pod); err != nil {
+			log.Error().Err(err).Msgf("Deleting pvc browser pod %s", p.pod)
+		}
+		return
+	}
+	if err != nil {
+		p.app.QueueUpdateDraw(func() {
+			p.setStatus(err.Error())
+			p.app.Flash().Err(err)
+		})
+		return
+	}
+	p.list()
+}
+
+func (p *PVCBrowser) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.app.Flash().Info("Refreshing files listing...")
+	go p.list()
+
+	return nil
+}
+
+func (p *PVCBrowser) list() {
+	ns, n := client.Namespaced(p.pod)
+	cmd := fmt.Sprintf(
+		"{ find %[1]s -mindepth 1 -type d | sed 's|$|/|'; find %[1]s -mindepth 1 ! -type d; } | head -n %[2]d",
+		pvcBrowserMount,
+		pvcMaxEntries,
+	)
+	out, err := runKu(p.app, shellOpts{args: []string{"exec", "-n", ns, n, "-c", pvcBrowser, "--", "sh", "-c", cmd}})
+	p.app.QueueUpdateDraw(func() {
+		if err != nil {
+			p.app.Flash().Errf("Listing failed: %s", out)
+			return
+		}
+		p.hydrate(parseListing(out, pvcBrowserMount))
+	})
+}
+
+func (p *PVCBrowser) setStatus(msg string) {
+	root := tview.NewTreeNode(fmt.Sprintf("%s/ [gray::-]%s", pvcBrowserMount, tview.Escape(msg)))
+	root.SetReference(pvcBrowserMount + "/")
+	p.SetRoot(root)
+	p.SetCurrentNode(root)
+}
+
+func (p *PVCBrowser) hydrate(ee []string) {
+	root := tview.NewTreeNode(pvcBrowserMount + "/").SetReference(pvcBrowserMount + "/")
+	root.SetColor(tcell.ColorDodgerBlue)
+	nodes := map[string]*tview.TreeNode{pvcBrowserMount + "/": root}
+
+	var parent func(string) *tview.TreeNode
+	parent = func(dir string) *tview.TreeNode {
+		if n, ok := nodes[dir]; ok {
+			return n
+		}
+		n := tview.NewTreeNode(path.Base(dir) + "/").SetReference(dir).SetColor(tcell.ColorDodgerBlue)
+		parent(path.Dir(strings.TrimSuffix(dir, "/")) + "/").AddChild(n)
+		nodes[dir] = n
+		return n
+	}
+	for _, e := range ee {
+		dir := strings.HasSuffix(e, "/")
+		if dir {
+			parent(e)
+			continue
+		}
+		n := tview.NewTreeNode(path.Base(e)).SetReference(e)
+		n.SetColor(p.app.Styles.Xray().FgColor.Color())
+		parent(path.Dir(e) + "/").AddChild(n)
+	}
+	if len(ee) == 0 {
+		root.SetText(pvcBrowserMount + "/ [gray::-](empty)")
+	}
+	if len(ee) >= pvcMaxEntries {
+		p.app.Flash().Warnf("Listing truncated to %d entries", pvcMaxEntries)
+	}
+
+	p.SetRoot(root)
+	p.SetCurrentNode(root)
+	p.SetSelectedItem(pvcBrowserMount + "/")
+}
+
+func (p *PVCBrowser) downloadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	src := p.GetSelectedItem()
+	if src == "" || p.app.Prompt().InCmdMode() {
+		return evt
+	}
+
+	_, claim := client.Namespaced(p.claim)
+	dst := filepath.Join(
+		config.K9sDumpDir,
+		sanitizeFilename(p.app.Config.K9s.CurrentCluster),
+		sanitizeFilename(claim),
+		path.Base(strings.TrimSuffix(src, "/")),
+	)
+	dialog.ShowPrompt(p.app.Styles.Dialog(), p.app.Content.Pages, "Download "+src, "Local Path:", dst, func(dst string) {
+		if err := ensureDir(filepath.Dir(dst)); err != nil {
+			p.app.Flash().Err(err)
+			return
+		}
+		p.copy(p.pod+":"+strings.TrimSuffix(src, "/"), dst, false)
+	}, func() {})
+
+	return nil
+}
+
+func (p *PVCBrowser) uploadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	dir := p.GetSelectedItem()
+	if dir == "" || p.app.Prompt().InCmdMode() {
+		return evt
+	}
+	if dao.IsDryRun() {
+		p.app.Flash().Warn(dryRunTag("Uploads are disabled"))
+		return nil
+	}
+	if !strings.HasSuffix(dir, "/") {
+		dir = path.Dir(dir) + "/"
+	}
+
+	dialog.ShowPrompt(p.app.Styles.Dialog(), p.app.Content.Pages, "Upload To "+dir, "Local Path:", "", func(src string) {
+		if _, err := os.Stat(src); err != nil {
+			p.app.Flash().Err(err)
+			return
+		}
+		p.copy(src, p.pod+":"+dir+filepath.Base(src), true)
+	}, func() {})
+
+	return nil
+}
+
+// copy copies files to or from the claim using kubectl cp.
+func (p *PVCBrowser) copy(src, dst string, upload bool) {
+	p.app.Flash().Infof("Copying %s to %s...", src, dst)
+	go func() {
+		out, err := runKu(p.app, shellOpts{args: []string{"cp", src, dst, "-c", pvcBrowser}})
+		p.app.QueueUpdateDraw(func() {
+			if upload {
+				p.app.audit(dao.NewAuditEntry("upload", "v1/persistentvolumeclaims", p.claim, err))
+			}
+			if err != nil {
+				p.app.Flash().Errf("Copy failed: %s", out)
+				return
+			}
+			p.app.Flash().Infof("Copied %s to %s", src, dst)
+			if upload {
+				go p.list()
+			}
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseListing returns the sorted claim files. Directories are suffixed with a slash.
+func parseListing(out, mount string) []string {
+	ee := make([]string, 0, 100)
+	for _, l := range strings.Split(out, "\n") {
+		l = strings.TrimSpace(l)
+		if !strings.HasPrefix(l, mount+"/") || l == mount+"/" {
+			continue
+		}
+		ee = append(ee, l)
+	}
+	sort.Strings(ee)
+
+	return ee
+}
+
+func pvcPodName(claim string) string {
+	n := fmt.Sprintf("%s-%d-%s", pvcBrowser, os.Getpid(), claim)
+	if len(n) > 63 {
+		n = n[:63]
+	}
+
+	return strings.TrimRight(n, "-.")
+}
+
+// claimNode returns the node of a running pod mounting the claim if any so
+// single node volumes can be attached.
+func claimNode(pods []v1.Pod, claim string) string {
+	for _, po := range pods {
+		if po.Status.Phase != v1.PodRunning || po.Spec.NodeName == "" {
+			continue
+		}
+		for _, v := range po.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == claim {
+				return po.Spec.NodeName
+			}
+		}
+	}
+
+	return ""
+}
+
+func launchPVCPod(a *App, claim, fqn string) error {
+	ns, n := client.Namespaced(claim)
+	oo, err := a.factory.List("v1/pods", ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err == nil {
+			pods = append(pods, po)
+		}
+	}
+
+	_, name := client.Namespaced(fqn)
+	spec := k9sPVCPod(name, ns, n, claimNode(pods, n), a.Config.K9s.ActiveCluster().ShellPod)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	dial, err := a.Conn().Dial()
+	if err != nil {
+		return err
+	}
+	if _, err := dial.CoreV1().Pods(ns).Create(ctx, &spec, metav1.CreateOptions{DryRun: podDryRun()}); err != nil && !kerrors.IsAlreadyExists(err) {
+		return err
+	}
+	if dao.IsDryRun() {
+		return errors.New(dryRunTag("Browser pod not launched for claim " + claim))
+	}
+	if !waitForPod(a, fqn) {
+		return fmt.Errorf("Unable to launch browser pod for claim %s", claim)
+	}
+
+	return nil
+}
+
+func nukePVCPod(a *App, fqn string) error {
+	ns, n := client.Namespaced(fqn)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	dial, err := a.Conn().Dial()
+	if err != nil {
+		return err
+	}
+
+	var grace int64
+	err = dial.CoreV1().Pods(ns).Delete(ctx, n, metav1.DeleteOptions{GracePeriodSeconds: &grace, DryRun: podDryRun()})
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func k9sPVCPod(name, ns, claim, node string, cfg *config.ShellPod) v1.Pod {
+	var grace int64
+	ttl := int64(pvcBrowserTTL)
+
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels:    map[string]string{"app": pvcBrowser},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: &grace,
+			ActiveDeadlineSeconds:         &ttl,
+			Volumes: []v1.Volume{
+				{
+					Name: "claim",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+					},
+				},
+			},
+			Containers: []v1.Container{
+				{
+					Name:      pvcBrowser,
+					Image:     cfg.Image,
+					Command:   []string{"sh", "-c", fmt.Sprintf("sleep %d", pvcBrowserTTL)},
+					Resources: asResource(cfg.Limits),
+					VolumeMounts: []v1.VolumeMount{
+						{Name: "claim", MountPath: pvcBrowserMount},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestParseListing(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		e   []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"files": {
+			out: "/data/b/\n/data/a/\n/data/b/c.txt\n/data/z.log\n/data/a/d\n",
+			e:   []string{"/data/a/", "/data/a/d", "/data/b/", "/data/b/c.txt", "/data/z.log"},
+		},
+		"noise": {
+			out: "find: ./lost+found: Permission denied\n/data/\n  /data/a  \n",
+			e:   []string{"/data/a"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, parseListing(u.out, pvcBrowserMount))
+		})
+	}
+}
+
+func TestPVCPodName(t *testing.T) {
+	uu := map[string]struct {
+		claim string
+	}{
+		"short": {claim: "data"},
+		"long":  {claim: strings.Repeat("a", 40) + "-" + strings.Repeat("b", 40)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n := pvcPodName(u.claim)
+			assert.True(t, strings.HasPrefix(n, pvcBrowser+"-"))
+			assert.True(t, len(n) <= 63)
+			assert.False(t, strings.HasSuffix(n, "-"))
+		})
+	}
+}
+
+func TestClaimNode(t *testing.T) {
+	uu := map[string]struct {
+		pods  []v1.Pod
+		claim string
+		e     string
+	}{
+		"none": {
+			claim: "data",
+		},
+		"mounted": {
+			pods: []v1.Pod{
+				makeClaimPod("n1", "logs", v1.PodRunning),
+				makeClaimPod("n2", "data", v1.PodRunning),
+			},
+			claim: "data",
+			e:     "n2",
+		},
+		"notRunning": {
+			pods:  []v1.Pod{makeClaimPod("n1", "data", v1.PodSucceeded)},
+			claim: "data",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, claimNode(u.pods, u.claim))
+		})
+	}
+}
+
+func TestK9sPVCPod(t *testing.T) {
+	cfg := config.NewShellPod()
+	po := k9sPVCPod("k9s-pvc-1-data", "fred", "data", "n1", cfg)
+
+	assert.Equal(t, "fred", po.Namespace)
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.Equal(t, "data", po.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, pvcBrowserMount, po.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Equal(t, int64(pvcBrowserTTL), *po.Spec.ActiveDeadlineSeconds)
+}
+
+// Helpers...
+
+func makeClaimPod(node, claim string, phase v1.PodPhase) v1.Pod {
+	return v1.Pod{
+		Spec: v1.PodSpec{
+			NodeName: node,
+			Volumes: []v1.Volume{
+				{
+					Name: "v1",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+					},
+				},
+			},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
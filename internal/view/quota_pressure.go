@@ -0,0 +1,218 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	quotaPressureTitle = "Quota Pressure"
+	quotaBarWidth      = 20
+	quotaWarnRatio     = 0.75
+	quotaCritRatio     = 0.9
+	quotaUsageFmt      = "    %-24s %s %4.0f%%  %s/%s\n"
+	quotaLimitFmt      = "  %-16s %-22s %-10s %s\n"
+)
+
+// QuotaPressure presents a namespace quotas consumption, limit ranges and
+// workloads blocked by quotas.
+type QuotaPressure struct {
+	*tview.TextView
+
+	app      *App
+	ns       string
+	actions  ui.KeyActions
+	cancelFn context.CancelFunc
+}
+
+// NewQuotaPressure returns a new quota pressure viewer.
+func NewQuotaPressure(ns string) *QuotaPressure {
+	return &QuotaPressure{
+		TextView: tview.NewTextView(),
+		ns:       ns,
+		actions:  make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (q *QuotaPressure) Init(ctx context.Context) error {
+	var err error
+	if q.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	q.SetBorder(true)
+	q.SetBorderPadding(0, 0, 1, 1)
+	q.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, quotaPressureTitle, q.ns), q.app.Styles.Frame()))
+	q.SetDynamicColors(true)
+	q.SetWrap(false)
+	q.SetText("Loading quotas...")
+	q.SetInputCapture(q.keyboard)
+	q.bindKeys()
+	q.StylesChanged(q.app.Styles)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (q *QuotaPressure) StylesChanged(s *config.Styles) {
+	q.SetBackgroundColor(s.Body().BgColor.Color())
+	q.SetTextColor(s.Body().FgColor.Color())
+	q.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+}
+
+// Name returns the component name.
+func (q *QuotaPressure) Name() string { return quotaPressureTitle }
+
+// Start starts the pressure updater.
+func (q *QuotaPressure) Start() {
+	q.Stop()
+	q.app.Styles.AddListener(q)
+
+	var ctx context.Context
+	ctx, q.cancelFn = context.WithCancel(context.Background())
+	go q.updater(ctx)
+}
+
+// Stop terminates the pressure updater.
+func (q *QuotaPressure) Stop() {
+	if q.cancelFn != nil {
+		q.cancelFn()
+		q.cancelFn = nil
+	}
+	q.app.Styles.RemoveListener(q)
+}
+
+// Hints returns menu hints.
+func (q *QuotaPressure) Hints() model.MenuHints {
+	return q.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (q *QuotaPressure) ExtraHints() map[string]string {
+	return nil
+}
+
+func (q *QuotaPressure) bindKeys() {
+	q.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", q.app.PrevCmd, false),
+	})
+}
+
+func (q *QuotaPressure) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := q.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (q *QuotaPressure) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Quota pressure updater canceled -- %q", q.ns)
+
+	rate := time.Duration(q.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		cctx, cancel := context.WithTimeout(ctx, q.app.Conn().Config().CallTimeout())
+		p, err := dao.FetchQuotaPressure(cctx, q.app.factory, q.ns)
+		cancel()
+		q.app.QueueUpdateDraw(func() {
+			if err != nil {
+				q.SetText(fmt.Sprintf("[red::]Quotas lookup failed: %s", tview.Escape(err.Error())))
+				return
+			}
+			q.SetText(quotaPressureText(p, time.Now()))
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// quotaPressureText returns a namespace quotas report.
+func quotaPressureText(p *dao.QuotaPressure, now time.Time) string {
+	var b strings.Builder
+
+	b.WriteString("[::b]Resource Quotas[::-]\n")
+	if len(p.Usages) == 0 {
+		b.WriteString("  [gray::]No resource quotas defined[-::]\n")
+	}
+	var quota string
+	for _, u := range p.Usages {
+		if u.Quota != quota {
+			quota = u.Quota
+			fmt.Fprintf(&b, "  %s\n", tview.Escape(quota))
+		}
+		fmt.Fprintf(&b, quotaUsageFmt, u.Resource, quotaBar(u.Ratio), u.Ratio*100, u.Used, u.Hard)
+	}
+
+	b.WriteString("\n[::b]Limit Ranges[::-]\n")
+	if len(p.Limits) == 0 {
+		b.WriteString("  [gray::]No limit ranges defined[-::]\n")
+	}
+	for _, l := range p.Limits {
+		fmt.Fprintf(&b, quotaLimitFmt, tview.Escape(l.LimitRange), l.Type, l.Resource, limitSpecs(l))
+	}
+
+	b.WriteString("\n[::b]Blocked Workloads[::-]\n")
+	if len(p.Blocked) == 0 {
+		b.WriteString("  [gray::]No workloads blocked by quotas[-::]\n")
+	}
+	for _, bl := range p.Blocked {
+		fmt.Fprintf(&b, "  [orangered::]%s[-::] quota %s (x%d, %s ago)\n",
+			tview.Escape(bl.Object), tview.Escape(bl.Quota), bl.Count, now.Sub(bl.Last).Round(time.Second))
+		if bl.Details != "" {
+			fmt.Fprintf(&b, "    [gray::]%s[-::]\n", tview.Escape(bl.Details))
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// quotaBar returns a colored consumption bar.
+func quotaBar(ratio float64) string {
+	n := int(ratio*quotaBarWidth + 0.5)
+	if n > quotaBarWidth {
+		n = quotaBarWidth
+	}
+	color := "green"
+	switch {
+	case ratio >= quotaCritRatio:
+		color = "red"
+	case ratio >= quotaWarnRatio:
+		color = "orange"
+	}
+
+	return fmt.Sprintf("[%s::]%s[gray::]%s[-::]", color, strings.Repeat("█", n), strings.Repeat("░", quotaBarWidth-n))
+}
+
+func limitSpecs(l dao.LimitDefault) string {
+	ss := make([]string, 0, 5)
+	for _, f := range []struct{ k, v string }{
+		{"default", l.Default},
+		{"request", l.DefaultRequest},
+		{"min", l.Min},
+		{"max", l.Max},
+		{"ratio", l.MaxRatio},
+	} {
+		if f.v != "" {
+			ss = append(ss, f.k+" "+f.v)
+		}
+	}
+
+	return strings.Join(ss, "  ")
+}
@@ -0,0 +1,68 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaPressureText(t *testing.T) {
+	now := time.Date(2020, 10, 1, 10, 5, 0, 0, time.UTC)
+	p := dao.QuotaPressure{
+		Namespace: "fred",
+		Usages: []dao.QuotaUsage{
+			{Quota: "compute", Resource: "limits.cpu", Used: "3", Hard: "4", Ratio: 0.75},
+			{Quota: "compute", Resource: "pods", Used: "1", Hard: "10", Ratio: 0.1},
+		},
+		Limits: []dao.LimitDefault{
+			{LimitRange: "limits", Type: "Container", Resource: "cpu", Default: "500m", DefaultRequest: "250m"},
+		},
+		Blocked: []dao.QuotaBlock{
+			{Object: "ReplicaSet/fe-1", Quota: "compute", Details: "must specify limits.cpu", Count: 3, Last: now.Add(-time.Minute)},
+		},
+	}
+
+	assert.Equal(t, `[::b]Resource Quotas[::-]
+  compute
+    limits.cpu               `+quotaBar(0.75)+`   75%  3/4
+    pods                     `+quotaBar(0.1)+`   10%  1/10
+
+[::b]Limit Ranges[::-]
+  limits           Container              cpu        default 500m  request 250m
+
+[::b]Blocked Workloads[::-]
+  [orangered::]ReplicaSet/fe-1[-::] quota compute (x3, 1m0s ago)
+    [gray::]must specify limits.cpu[-::]`, quotaPressureText(&p, now))
+}
+
+func TestQuotaPressureTextEmpty(t *testing.T) {
+	assert.Equal(t, `[::b]Resource Quotas[::-]
+  [gray::]No resource quotas defined[-::]
+
+[::b]Limit Ranges[::-]
+  [gray::]No limit ranges defined[-::]
+
+[::b]Blocked Workloads[::-]
+  [gray::]No workloads blocked by quotas[-::]`, quotaPressureText(&dao.QuotaPressure{}, time.Now()))
+}
+
+func TestQuotaBar(t *testing.T) {
+	uu := map[string]struct {
+		r float64
+		e string
+	}{
+		"empty": {r: 0, e: "[green::][gray::]░░░░░░░░░░░░░░░░░░░░[-::]"},
+		"half":  {r: 0.5, e: "[green::]██████████[gray::]░░░░░░░░░░[-::]"},
+		"warn":  {r: 0.8, e: "[orange::]████████████████[gray::]░░░░[-::]"},
+		"over":  {r: 1.5, e: "[red::]████████████████████[gray::][-::]"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, quotaBar(u.r))
+		})
+	}
+}
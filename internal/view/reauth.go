@@ -0,0 +1,113 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const credsExpiredMsg = "Credentials expired. Use :reauth to re-authenticate"
+
+// credentialsExpired pauses the current view once the api server rejects the
+// connection credentials and prompts for a re-authentication.
+func (a *App) credentialsExpired(c model.Component) {
+	if !atomic.CompareAndSwapInt32(&a.credsExpired, 0, 1) {
+		return
+	}
+	log.Warn().Msgf("Credentials rejected for context %q", a.Config.K9s.CurrentContext)
+	if c != nil {
+		c.Stop()
+	}
+	a.Status(model.FlashWarn, credsExpiredMsg)
+	a.QueueUpdateDraw(a.showReauth)
+}
+
+// showReauth prompts to re-authenticate with the current context.
+func (a *App) showReauth() {
+	msg := fmt.Sprintf("Credentials for context %q were rejected by the api server.\n\n", a.Config.K9s.CurrentContext)
+	if x, ok := a.Conn().Config().ExecCredential(); ok {
+		msg += fmt.Sprintf("Re-run %q and reconnect?", execCredentialCmd(x))
+	} else {
+		msg += "Reload your kubeconfig and reconnect?"
+	}
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Credentials Expired", msg, func() {
+		if err := a.reauthenticate(); err != nil {
+			a.Flash().Err(err)
+		}
+	}, func() {
+		a.Flash().Warn(credsExpiredMsg)
+	})
+}
+
+// reauthenticate re-runs the kubeconfig exec credential plugin if any and
+// rebuilds the client factory.
+func (a *App) reauthenticate() error {
+	auth, ok := a.Conn().(client.Reauthenticator)
+	if !ok {
+		return errors.New("re-authentication is not supported by this connection")
+	}
+	if x, ok := a.Conn().Config().ExecCredential(); ok {
+		if err := a.runCredentialPlugin(x); err != nil {
+			return err
+		}
+	}
+	err := auth.Reauthenticate()
+	a.audit(dao.NewAuditEntry("reauth", "", a.Config.K9s.CurrentContext, err))
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt32(&a.credsExpired, 0)
+	if err := a.switchCtx(a.Config.K9s.CurrentContext, false); err != nil {
+		return err
+	}
+	a.Flash().Infof("Re-authenticated with context %s", a.Config.K9s.CurrentContext)
+
+	return nil
+}
+
+// runCredentialPlugin runs an exec credential plugin in the foreground so
+// interactive logins can complete. The issued credential is discarded, the
+// client asks the plugin again once reconnecting.
+func (a *App) runCredentialPlugin(x *clientcmdapi.ExecConfig) error {
+	a.Halt()
+	defer a.Resume()
+
+	var err error
+	a.Suspend(func() {
+		err = withConsole(func() error {
+			clearScreen()
+			defer clearScreen()
+			fmt.Fprintf(os.Stderr, "<<K9s-Reauth>> Running %s...\n", execCredentialCmd(x))
+			cmd := exec.Command(x.Command, x.Args...)
+			cmd.Env = os.Environ()
+			for _, e := range x.Env {
+				cmd.Env = append(cmd.Env, e.Name+"="+e.Value)
+			}
+			cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, ioutil.Discard, os.Stderr
+			return cmd.Run()
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("credential plugin %s failed: %w", x.Command, err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func execCredentialCmd(x *clientcmdapi.ExecConfig) string {
+	return strings.TrimSpace(x.Command + " " + strings.Join(x.Args, " "))
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// RecentErrors tracks the most recent errors reported during a session.
+var RecentErrors = model.NewErrorLog(model.DefaultErrorLogSize)
+
+// SaveRecovery dumps the application state after a crash so it can be
+// resumed on the next launch.
+func SaveRecovery(a *App, cause interface{}, stack []byte) error {
+	r := config.Recovery{
+		Time:   time.Now(),
+		Panic:  fmt.Sprintf("%v", cause),
+		Stack:  string(stack),
+		Errors: RecentErrors.List(),
+	}
+	if a != nil {
+		r.Version = a.version
+		r.Commands = a.cmdHistory.List()
+		r.Session = safeCaptureSession(a)
+	}
+
+	return r.Save(config.K9sRecoveryFile)
+}
+
+// offerRecovery prompts to resume from a crash recovery state if any.
+// Returns true if a recovery was found.
+func (a *App) offerRecovery() bool {
+	r, err := config.LoadRecovery(config.K9sRecoveryFile)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Recovery load failed")
+	}
+	if r == nil {
+		return false
+	}
+	if err := config.ArchiveRecovery(config.K9sRecoveryFile, config.K9sLastRecoveryFile); err != nil {
+		log.Error().Err(err).Msgf("Recovery archive failed")
+	}
+
+	s := r.Session
+	if s == nil || s.Empty() || s.Context != a.Config.K9s.CurrentContext {
+		a.Flash().Warnf("K9s crashed on %s. Run `k9s bugreport` to create a bug report", r.Time.Format(time.RFC822))
+		return true
+	}
+	msg := fmt.Sprintf("K9s crashed on %s.\nResume %d view(s) and %d port-forward(s)?", r.Time.Format(time.RFC822), len(s.Views), len(s.Forwards))
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Crash Recovery", msg, func() {
+		a.restoreSession(s)
+	}, func() {})
+
+	return true
+}
+
+// Helpers...
+
+func safeCaptureSession(a *App) (s *config.Session) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error().Msgf("Session capture failed %v", err)
+			s = nil
+		}
+	}()
+	if a.factory == nil || a.Content == nil {
+		return nil
+	}
+
+	return a.captureSession()
+}
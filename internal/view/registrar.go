@@ -15,7 +15,9 @@ func loadCustomViewers() MetaViewers {
 	rbacViewers(m)
 	batchViewers(m)
 	extViewers(m)
+	networkingViewers(m)
 	helmViewers(m)
+	autoscalingViewers(m)
 
 	return m
 }
@@ -26,6 +28,18 @@ func helmViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	for _, gvr := range []string{
+		"autoscaling/v1/horizontalpodautoscalers",
+		"autoscaling/v2beta1/horizontalpodautoscalers",
+		"autoscaling/v2beta2/horizontalpodautoscalers",
+	} {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			viewerFn: NewHorizontalPodAutoscaler,
+		}
+	}
+}
+
 func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/namespaces")] = MetaViewer{
 		viewerFn: NewNamespace,
@@ -72,6 +86,18 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("screendumps")] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.NewGVR("alerts")] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.NewGVR("pluginjobs")] = MetaViewer{
+		viewerFn: NewPluginJob,
+	}
+	vv[client.NewGVR("audits")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
+	vv[client.NewGVR("stats")] = MetaViewer{
+		viewerFn: NewStat,
+	}
 	vv[client.NewGVR("benchmarks")] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
@@ -152,6 +178,17 @@ func extViewers(vv MetaViewers) {
 	}
 }
 
+func networkingViewers(vv MetaViewers) {
+	for _, gvr := range []string{
+		"extensions/v1beta1/ingresses",
+		"networking.k8s.io/v1beta1/ingresses",
+	} {
+		vv[client.NewGVR(gvr)] = MetaViewer{
+			viewerFn: NewIngress,
+		}
+	}
+}
+
 func showCRD(app *App, _ ui.Tabular, _, path string) {
 	_, crdGVR := client.Namespaced(path)
 	tokens := strings.Split(crdGVR, ".")
@@ -0,0 +1,174 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const replayHelp = "n/→ next • p/← prev • ]/[ next/prev command • g/G first/last • q quit"
+
+// RunReplay steps through a recorded session. No cluster connection is
+// required.
+func RunReplay(path string) error {
+	ee, err := dao.LoadRecording(path)
+	if err != nil {
+		return err
+	}
+	if len(ee) == 0 {
+		return fmt.Errorf("no entries recorded in %s", path)
+	}
+	styles := config.NewStyles()
+	if err := styles.Load(config.K9sStylesFile); err != nil {
+		log.Debug().Err(err).Msgf("No skin found, using stock one")
+	}
+
+	app, r := tview.NewApplication(), newReplay(filepath.Base(path), ee, styles)
+	app.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if evt.Key() == tcell.KeyCtrlC || evt.Rune() == 'q' {
+			app.Stop()
+			return nil
+		}
+		if r.keyboard(evt) {
+			return nil
+		}
+		return evt
+	})
+
+	return app.SetRoot(r, true).Run()
+}
+
+// replay represents a session recording viewer.
+type replay struct {
+	*tview.Flex
+
+	name    string
+	entries []dao.RecordEntry
+	index   int
+	info    *tview.TextView
+	body    *tview.TextView
+	logs    io.Writer
+}
+
+func newReplay(name string, ee []dao.RecordEntry, styles *config.Styles) *replay {
+	r := replay{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		name:    name,
+		entries: ee,
+		info:    tview.NewTextView(),
+		body:    tview.NewTextView(),
+	}
+	r.info.SetTextColor(styles.Frame().Title.HighlightColor.Color())
+	r.info.SetBackgroundColor(styles.BgColor())
+	r.body.SetDynamicColors(true)
+	r.body.SetTextColor(styles.FgColor())
+	r.body.SetBackgroundColor(styles.BgColor())
+	r.logs = tview.ANSIWriter(r.body, styles.Views().Log.FgColor.String(), styles.Views().Log.BgColor.String())
+	r.body.SetBorder(true)
+	r.body.SetBorderColor(styles.Frame().Border.FocusColor.Color())
+	r.body.SetTitleColor(styles.Frame().Title.FgColor.Color())
+	r.AddItem(r.info, 1, 1, false)
+	r.AddItem(r.body, 0, 1, true)
+	r.show()
+
+	return &r
+}
+
+// keyboard steps through the recording. It returns true if the key was
+// handled.
+func (r *replay) keyboard(evt *tcell.EventKey) bool {
+	switch evt.Key() {
+	case tcell.KeyRight:
+		r.step(1)
+	case tcell.KeyLeft:
+		r.step(-1)
+	case tcell.KeyHome:
+		r.jump(0)
+	case tcell.KeyEnd:
+		r.jump(len(r.entries) - 1)
+	case tcell.KeyRune:
+		switch evt.Rune() {
+		case 'n', ' ':
+			r.step(1)
+		case 'p':
+			r.step(-1)
+		case ']':
+			r.stepKind(dao.RecordCommand, 1)
+		case '[':
+			r.stepKind(dao.RecordCommand, -1)
+		case 'g':
+			r.jump(0)
+		case 'G':
+			r.jump(len(r.entries) - 1)
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (r *replay) step(delta int) {
+	r.jump(r.index + delta)
+}
+
+// stepKind moves to the next entry of the given kind in the given direction.
+func (r *replay) stepKind(kind string, delta int) {
+	for i := r.index + delta; i >= 0 && i < len(r.entries); i += delta {
+		if r.entries[i].Kind == kind {
+			r.jump(i)
+			return
+		}
+	}
+}
+
+func (r *replay) jump(i int) {
+	if i < 0 || i >= len(r.entries) {
+		return
+	}
+	r.index = i
+	r.show()
+}
+
+func (r *replay) show() {
+	e := r.entries[r.index]
+	r.info.SetText(fmt.Sprintf(" %s %d/%d -- %s", r.name, r.index+1, len(r.entries), replayHelp))
+	r.body.SetTitle(" " + tview.Escape(e.String()) + " ")
+	r.body.Clear()
+	if e.Kind == dao.RecordLog {
+		if _, err := r.logs.Write([]byte(e.Text)); err != nil {
+			log.Error().Err(err).Msgf("Replay log write failed")
+		}
+	} else {
+		r.body.SetText(tview.Escape(replayText(e)))
+	}
+	r.body.ScrollToBeginning()
+}
+
+// replayText returns an entry display text.
+func replayText(e dao.RecordEntry) string {
+	if e.Kind == dao.RecordLogClear {
+		return "<logs cleared>"
+	}
+	if e.Kind != dao.RecordCommand {
+		return e.Text
+	}
+	ss := []string{":" + e.View}
+	if e.Text != "" {
+		ss = append(ss, "path: "+e.Text)
+	}
+	if e.Error != "" {
+		ss = append(ss, "error: "+e.Error)
+	}
+
+	return strings.Join(ss, "\n")
+}
@@ -0,0 +1,65 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaySteps(t *testing.T) {
+	r := newReplay("rec.jsonl", []dao.RecordEntry{
+		{Kind: dao.RecordCommand, View: "po"},
+		{Kind: dao.RecordTable, View: "v1/pods"},
+		{Kind: dao.RecordLog, View: "default/fred"},
+		{Kind: dao.RecordCommand, View: "dp"},
+		{Kind: dao.RecordTable, View: "apps/v1/deployments"},
+	}, config.NewStyles())
+
+	r.step(-1)
+	assert.Equal(t, 0, r.index)
+	assert.True(t, r.keyboard(tcell.NewEventKey(tcell.KeyRune, ']', tcell.ModNone)))
+	assert.Equal(t, 3, r.index)
+	r.stepKind(dao.RecordCommand, 1)
+	assert.Equal(t, 3, r.index)
+	assert.True(t, r.keyboard(tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModNone)))
+	assert.Equal(t, 2, r.index)
+	assert.True(t, r.keyboard(tcell.NewEventKey(tcell.KeyRune, 'G', tcell.ModNone)))
+	assert.Equal(t, 4, r.index)
+	r.step(1)
+	assert.Equal(t, 4, r.index)
+	assert.False(t, r.keyboard(tcell.NewEventKey(tcell.KeyRune, 'z', tcell.ModNone)))
+}
+
+func TestReplayText(t *testing.T) {
+	uu := map[string]struct {
+		e dao.RecordEntry
+		s string
+	}{
+		"table": {
+			e: dao.RecordEntry{Kind: dao.RecordTable, Text: "NAME\nfred"},
+			s: "NAME\nfred",
+		},
+		"command": {
+			e: dao.RecordEntry{Kind: dao.RecordCommand, View: "po", Text: "default/fred"},
+			s: ":po\npath: default/fred",
+		},
+		"clear": {
+			e: dao.RecordEntry{Kind: dao.RecordLogClear, View: "default/fred"},
+			s: "<logs cleared>",
+		},
+		"failed": {
+			e: dao.RecordEntry{Kind: dao.RecordCommand, View: "blee", Error: "`blee` Command not found"},
+			s: ":blee\nerror: `blee` Command not found",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.s, replayText(u.e))
+		})
+	}
+}
@@ -42,20 +42,26 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	r.Stop()
 	defer r.Start()
-	msg := fmt.Sprintf("Restart deployment %s?", paths[0])
-	if len(paths) > 1 {
-		msg = fmt.Sprintf("Restart %d deployments?", len(paths))
-	}
+	msg := fmt.Sprintf("Restart %s?", bulkSubject(r.GVR(), paths))
 	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Restart", msg, func() {
-		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, path := range paths {
-			if err := r.restartRollout(ctx, path); err != nil {
-				r.App().Flash().Err(err)
-			} else {
-				r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
-			}
-		}
+		runBulk(context.Background(), r.App(), bulkOp{
+			verb:  "Restart",
+			past:  "restarted",
+			gvr:   r.GVR(),
+			paths: paths,
+			fn: func(ctx context.Context, path string) error {
+				ctx, cancel := context.WithTimeout(ctx, r.App().Conn().Config().CallTimeout())
+				defer cancel()
+				err := r.restartRollout(ctx, path)
+				r.App().audit(dao.NewAuditEntry("restart", r.GVR().String(), path, err))
+				return err
+			},
+			ok: func(path string) {
+				if len(paths) == 1 {
+					r.App().trackRollout(r.GVR(), path)
+				}
+			},
+		})
 	}, func() {})
 
 	return nil
@@ -0,0 +1,95 @@
+package view
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+// rewinder periodically snapshots a view and steps through its past states.
+type rewinder struct {
+	dirFn     func() string
+	interval  time.Duration
+	retention time.Duration
+	last      time.Time
+	snaps     []dao.SnapshotInfo
+	index     int
+	active    bool
+	saving    int32
+}
+
+func newRewinder(dirFn func() string, cfg *config.Snapshots) *rewinder {
+	return &rewinder{
+		dirFn:     dirFn,
+		interval:  cfg.Interval(),
+		retention: cfg.Retention(),
+	}
+}
+
+// record persists a snapshot when one is due.
+func (r *rewinder) record(data render.TableData, now time.Time) {
+	if r.active || now.Sub(r.last) < r.interval {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&r.saving, 0, 1) {
+		return
+	}
+	r.last = now
+
+	go func(dir string) {
+		defer atomic.StoreInt32(&r.saving, 0)
+		if _, err := dao.SaveSnapshot(dir, data, now); err != nil {
+			log.Error().Err(err).Msgf("Snapshot save failed")
+			return
+		}
+		if err := dao.PruneSnapshots(dir, r.retention, now); err != nil {
+			log.Error().Err(err).Msgf("Snapshot prune failed")
+		}
+	}(r.dirFn())
+}
+
+// start enters rewind mode on the most recent snapshot.
+func (r *rewinder) start() error {
+	ss, err := dao.ListSnapshots(r.dirFn())
+	if err != nil {
+		return err
+	}
+	if len(ss) == 0 {
+		return errors.New("no snapshots available for this view yet")
+	}
+	r.snaps, r.index, r.active = ss, len(ss)-1, true
+
+	return nil
+}
+
+// stop exits rewind mode.
+func (r *rewinder) stop() {
+	r.snaps, r.index, r.active = nil, 0, false
+}
+
+// step moves through snapshots. It returns false when no more snapshots exist.
+func (r *rewinder) step(delta int) bool {
+	i := r.index + delta
+	if !r.active || i < 0 || i >= len(r.snaps) {
+		return false
+	}
+	r.index = i
+
+	return true
+}
+
+// current loads the active snapshot.
+func (r *rewinder) current() (render.TableData, dao.SnapshotInfo, error) {
+	if !r.active {
+		return render.TableData{}, dao.SnapshotInfo{}, errors.New("rewind is not active")
+	}
+	info := r.snaps[r.index]
+	data, err := dao.LoadSnapshot(info.Path)
+
+	return data, info, err
+}
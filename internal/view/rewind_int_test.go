@@ -0,0 +1,58 @@
+package view
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewinder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-rewind")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	r := newRewinder(func() string { return dir }, config.NewSnapshots())
+	assert.Error(t, r.start())
+
+	now := time.Now()
+	for i, n := range []string{"a", "b"} {
+		data := render.TableData{
+			RowEvents: render.RowEvents{render.NewRowEvent(render.EventAdd, render.Row{ID: n, Fields: render.Fields{n}})},
+		}
+		_, err := dao.SaveSnapshot(dir, data, now.Add(time.Duration(i)*time.Minute))
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, r.start())
+	assert.True(t, r.active)
+	data, _, err := r.current()
+	assert.Nil(t, err)
+	assert.Equal(t, "b", data.RowEvents[0].Row.ID)
+
+	assert.False(t, r.step(1))
+	assert.True(t, r.step(-1))
+	data, _, err = r.current()
+	assert.Nil(t, err)
+	assert.Equal(t, "a", data.RowEvents[0].Row.ID)
+	assert.False(t, r.step(-1))
+
+	r.stop()
+	assert.False(t, r.active)
+	_, _, err = r.current()
+	assert.Error(t, err)
+}
+
+func TestRewinderRecordSkipsWhenActive(t *testing.T) {
+	r := newRewinder(func() string { return "/tmp/k9s-rewind-none" }, config.NewSnapshots())
+	r.active = true
+	now := time.Now()
+	r.record(render.TableData{}, now)
+
+	assert.True(t, r.last.IsZero())
+}
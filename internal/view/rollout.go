@@ -0,0 +1,42 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+)
+
+const rolloutTimeout = 15 * time.Minute
+
+// rolloutGVRs tracks resources supporting rollout progress.
+var rolloutGVRs = map[string]struct{}{
+	"apps/v1/deployments":  {},
+	"apps/v1/statefulsets": {},
+	"apps/v1/daemonsets":   {},
+}
+
+// trackRollout pops a live rollout progress dialog for a workload.
+func (a *App) trackRollout(gvr client.GVR, path string) {
+	if _, ok := rolloutGVRs[gvr.String()]; !ok || dao.IsDryRun() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rolloutTimeout)
+	ctx = context.WithValue(ctx, internal.KeyFactory, a.factory)
+	d := dialog.ShowRollout(a.Styles.Dialog(), a.Content.Pages, path, func() { cancel() })
+	rate := time.Duration(a.Config.K9s.GetRefreshRate()) * time.Second
+	t := model.NewRolloutTracker(gvr, path, rate, func(s model.RolloutStatus, err error) {
+		a.QueueUpdateDraw(func() {
+			d.Update(s, err)
+			if err == nil && s.Done() {
+				a.Flash().Infof("Rollout completed for `%s", path)
+			}
+		})
+	})
+	t.Track(ctx)
+}
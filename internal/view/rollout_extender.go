@@ -0,0 +1,220 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const maxUndoRevisions = 10
+
+// RolloutExtender adds rollout management to workload views.
+type RolloutExtender struct {
+	ResourceViewer
+}
+
+// NewRolloutExtender returns a new extender.
+func NewRolloutExtender(v ResourceViewer) ResourceViewer {
+	r := RolloutExtender{ResourceViewer: v}
+	v.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *RolloutExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Rollout Status", r.statusCmd, true),
+	})
+	if r.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	if r.accessor() == nil {
+		return
+	}
+	if _, ok := r.accessor().(dao.Pausable); ok {
+		aa.Add(ui.KeyActions{
+			ui.KeyZ: ui.NewKeyAction("Pause/Resume", r.pauseCmd, true),
+		})
+	}
+	if _, ok := r.accessor().(dao.Undoable); ok {
+		aa.Add(ui.KeyActions{
+			ui.KeyU: ui.NewKeyAction("Undo", r.undoCmd, true),
+		})
+	}
+}
+
+func (r *RolloutExtender) accessor() dao.Accessor {
+	res, err := dao.AccessorFor(r.App().factory, r.GVR())
+	if err != nil {
+		return nil
+	}
+
+	return res
+}
+
+func (r *RolloutExtender) statusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	r.App().trackRollout(r.GVR(), path)
+
+	return nil
+}
+
+func (r *RolloutExtender) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	p, ok := r.accessor().(dao.Pausable)
+	if !ok {
+		r.App().Flash().Errf("%s rollouts can not be paused", r.GVR())
+		return nil
+	}
+	paused, err := r.isPaused(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+
+	verb, fn := "pause", p.Pause
+	if paused {
+		verb, fn = "resume", p.Resume
+	}
+	r.Stop()
+	defer r.Start()
+	msg := fmt.Sprintf("%s rollout of %s %s?", strings.Title(verb), r.GVR().R(), path)
+	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+strings.Title(verb), msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		err := fn(ctx, path)
+		r.App().audit(dao.NewAuditEntry(verb, r.GVR().String(), path, err))
+		if err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Info(dryRunTag(fmt.Sprintf("Rollout %sd for %s", verb, path)))
+		if paused {
+			r.App().trackRollout(r.GVR(), path)
+		}
+	}, func() {})
+
+	return nil
+}
+
+func (r *RolloutExtender) isPaused(path string) (bool, error) {
+	o, err := r.App().factory.Get(r.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return false, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	paused, _, err := unstructured.NestedBool(u.Object, "spec", "paused")
+
+	return paused, err
+}
+
+func (r *RolloutExtender) undoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	u, ok := r.accessor().(dao.Undoable)
+	if !ok {
+		r.App().Flash().Errf("%s rollouts can not be undone", r.GVR())
+		return nil
+	}
+	rr, err := u.Revisions(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	if len(rr) < 2 {
+		r.App().Flash().Warnf("No previous revision found for %s", path)
+		return nil
+	}
+
+	r.Stop()
+	defer r.Start()
+	dialog.ShowPromptMsg(
+		r.App().Styles.Dialog(),
+		r.App().Content.Pages,
+		"Undo Rollout "+path,
+		revisionsMsg(rr),
+		"Revision:",
+		strconv.FormatInt(rr[1].Number, 10),
+		func(s string) {
+			rev, err := pickRevision(rr, s)
+			if err != nil {
+				r.App().Flash().Err(err)
+				return
+			}
+			err = u.Undo(path, rev)
+			r.App().audit(dao.NewAuditEntry("undo", r.GVR().String(), path, err))
+			if err != nil {
+				r.App().Flash().Errf("Undo failed for %s: %s", path, err)
+				return
+			}
+			r.App().Flash().Info(dryRunTag(fmt.Sprintf("Rolled %s back to revision %d", path, rev)))
+			r.App().trackRollout(r.GVR(), path)
+		},
+		func() {},
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// revisionsMsg lists the most recent revisions to pick from.
+func revisionsMsg(rr []dao.RolloutRevision) string {
+	ss := make([]string, 0, maxUndoRevisions)
+	for i, r := range rr {
+		if i >= maxUndoRevisions {
+			ss = append(ss, fmt.Sprintf("... %d more", len(rr)-maxUndoRevisions))
+			break
+		}
+		s := fmt.Sprintf("#%d %s", r.Number, strings.Join(r.Images, ","))
+		if r.Current {
+			s += " (current)"
+		}
+		if r.ChangeCause != "" {
+			s += " " + r.ChangeCause
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+// pickRevision validates a revision number against a rollout history.
+func pickRevision(rr []dao.RolloutRevision, s string) (int64, error) {
+	rev, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimSpace(s), "#"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid revision %q", s)
+	}
+	for _, r := range rr {
+		if r.Number != rev {
+			continue
+		}
+		if r.Current {
+			return 0, errors.New("revision is already current")
+		}
+		return rev, nil
+	}
+
+	return 0, fmt.Errorf("unknown revision %d", rev)
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionsMsg(t *testing.T) {
+	rr := []dao.RolloutRevision{
+		{Number: 3, Images: []string{"nginx:1.20", "envoy"}, Current: true},
+		{Number: 2, Images: []string{"nginx:1.19"}, ChangeCause: "kubectl set image"},
+		{Number: 1, Images: []string{"nginx:1.18"}},
+	}
+
+	assert.Equal(t, "#3 nginx:1.20,envoy (current)\n#2 nginx:1.19 kubectl set image\n#1 nginx:1.18", revisionsMsg(rr))
+}
+
+func TestPickRevision(t *testing.T) {
+	rr := []dao.RolloutRevision{
+		{Number: 3, Current: true},
+		{Number: 2},
+		{Number: 1},
+	}
+	uu := map[string]struct {
+		s   string
+		e   int64
+		err bool
+	}{
+		"plain":   {s: "2", e: 2},
+		"hash":    {s: " #1 ", e: 1},
+		"current": {s: "3", err: true},
+		"unknown": {s: "5", err: true},
+		"toast":   {s: "fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rev, err := pickRevision(rr, u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, rev)
+		})
+	}
+}
@@ -19,7 +19,7 @@ type ReplicaSet struct {
 // NewReplicaSet returns a new viewer.
 func NewReplicaSet(gvr client.GVR) ResourceViewer {
 	r := ReplicaSet{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewScaleExtender(NewBrowser(gvr)),
 	}
 	r.AddBindKeysFn(r.bindKeys)
 	r.GetTable().SetEnterFn(r.showPods)
@@ -66,7 +66,7 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if err := drs.Rollback(path); err != nil {
 			r.App().Flash().Err(err)
 		} else {
-			r.App().Flash().Infof("%s successfully rolled back", path)
+			r.App().Flash().Info(dryRunTag(path + " successfully rolled back"))
 		}
 		r.Refresh()
 	})
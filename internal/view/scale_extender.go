@@ -6,13 +6,17 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 )
 
+const hpaGVR = "autoscaling/v1/horizontalpodautoscalers"
+
 // ScaleExtender adds scaling extensions.
 type ScaleExtender struct {
 	ResourceViewer
@@ -49,8 +53,15 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (s *ScaleExtender) showScaleDialog(path string) {
-	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path))
-	confirm.SetText(fmt.Sprintf("Scale %s %s", s.GVR(), path))
+	var kind string
+	if meta, err := dao.MetaAccess.MetaFor(s.GVR()); err == nil {
+		kind = meta.Kind
+	}
+	hpa := s.hpaFor(kind, path)
+	st := s.scaleStatus(kind, path, hpa)
+	blocked := hpa != nil && s.App().Config.K9s.Scale.IsBlockingOnHPA()
+	confirm := tview.NewModalForm("<Scale>", s.makeScaleForm(path, hpa, st, blocked))
+	confirm.SetText(scaleDialogText(s.GVR().String(), path, st, hpa, blocked))
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -58,34 +69,69 @@ func (s *ScaleExtender) showScaleDialog(path string) {
 	s.App().Content.ShowPage(scaleDialogKey)
 }
 
-func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
+func (s *ScaleExtender) makeScaleForm(sel string, hpa *autoscalingv1.HorizontalPodAutoscaler, st *dao.ScaleStatus, blocked bool) *tview.Form {
 	f := s.makeStyledForm()
-	replicas := strings.TrimSpace(s.GetTable().GetCell(s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1).Text)
-	tokens := strings.Split(replicas, "/")
-	replicas = strings.TrimRight(tokens[1], ui.DeltaSign)
-	f.AddInputField("Replicas:", replicas, 4, func(textToCheck string, lastChar rune) bool {
-		_, err := strconv.Atoi(textToCheck)
-		return err == nil
-	}, func(changed string) {
+	replicas := s.selectedReplicas()
+	if st != nil {
+		replicas = strconv.Itoa(int(st.Desired))
+	}
+	f.AddInputField("Replicas:", replicas, 4, isNumeric, func(changed string) {
 		replicas = changed
 	})
+	var min, max string
+	if hpa != nil {
+		min, max = strconv.Itoa(int(hpaMin(hpa))), strconv.Itoa(int(hpa.Spec.MaxReplicas))
+		f.AddInputField("HPA Min:", min, 4, isNumeric, func(changed string) {
+			min = changed
+		})
+		f.AddInputField("HPA Max:", max, 4, isNumeric, func(changed string) {
+			max = changed
+		})
+	}
 
-	f.AddButton("OK", func() {
-		defer s.dismissDialog()
-		count, err := strconv.Atoi(replicas)
-		if err != nil {
-			s.App().Flash().Err(err)
-			return
-		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		if err := s.scale(ctx, sel, count); err != nil {
-			log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-			s.App().Flash().Err(err)
-		} else {
-			s.App().Flash().Infof("Resource %s:%s scaled successfully", s.GVR(), sel)
-		}
-	})
+	if !blocked {
+		f.AddButton("OK", func() {
+			defer s.dismissDialog()
+			count, err := strconv.Atoi(replicas)
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+			defer cancel()
+			err = s.scale(s.App().withRetry(ctx), sel, count)
+			e := dao.NewAuditEntry("scale", s.GVR().String(), sel, err)
+			e.Details = fmt.Sprintf("replicas=%d", count)
+			s.App().audit(e)
+			if err != nil {
+				log.Error().Err(err).Msgf("DP %s scaling failed", sel)
+				s.App().Flash().Err(err)
+			} else {
+				s.App().Flash().Info(dryRunTag(fmt.Sprintf("Resource %s:%s scaled successfully", s.GVR(), sel)))
+			}
+		})
+	}
+
+	if hpa != nil {
+		hpaPath := client.FQN(hpa.Namespace, hpa.Name)
+		f.AddButton("Set HPA", func() {
+			lo, err1 := strconv.Atoi(min)
+			hi, err2 := strconv.Atoi(max)
+			if err1 != nil || err2 != nil {
+				s.App().Flash().Errf("Invalid HPA bounds %s/%s", min, max)
+				return
+			}
+			s.setHPABounds(hpaPath, lo, hi)
+		})
+		f.AddButton("Pin HPA", func() {
+			count, err := strconv.Atoi(replicas)
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.setHPABounds(hpaPath, count, count)
+		})
+	}
 
 	f.AddButton("Cancel", func() {
 		s.dismissDialog()
@@ -94,6 +140,66 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 	return f
 }
 
+// selectedReplicas returns the desired replicas from the selected row, either
+// the ready/desired or the desired column.
+func (s *ScaleExtender) selectedReplicas() string {
+	replicas := strings.TrimSpace(s.GetTable().GetCell(s.GetTable().GetSelectedRowIndex(), s.GetTable().NameColIndex()+1).Text)
+	tokens := strings.Split(replicas, "/")
+
+	return strings.TrimRight(tokens[len(tokens)-1], ui.DeltaSign)
+}
+
+func (s *ScaleExtender) hpaFor(kind, path string) *autoscalingv1.HorizontalPodAutoscaler {
+	if kind == "" {
+		return nil
+	}
+	var h dao.HorizontalPodAutoscaler
+	h.Init(s.App().factory, client.NewGVR(hpaGVR))
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	hpa, err := h.ScalerFor(ctx, kind, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("HPA lookup failed for %s", path)
+		return nil
+	}
+
+	return hpa
+}
+
+func (s *ScaleExtender) scaleStatus(kind, path string, hpa *autoscalingv1.HorizontalPodAutoscaler) *dao.ScaleStatus {
+	var name string
+	if hpa != nil {
+		name = hpa.Name
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	st, err := dao.FetchScaleStatus(ctx, s.App().factory, s.GVR(), kind, path, name)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Scale status lookup failed for %s", path)
+		return nil
+	}
+
+	return st
+}
+
+func (s *ScaleExtender) setHPABounds(path string, min, max int) {
+	defer s.dismissDialog()
+
+	var h dao.HorizontalPodAutoscaler
+	h.Init(s.App().factory, client.NewGVR(hpaGVR))
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	err := h.SetBounds(ctx, path, int32(min), int32(max))
+	e := dao.NewAuditEntry("scale", hpaGVR, path, err)
+	e.Details = fmt.Sprintf("minReplicas=%d maxReplicas=%d", min, max)
+	s.App().audit(e)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	s.App().Flash().Info(dryRunTag(fmt.Sprintf("HPA %s bounds set to %d/%d", path, min, max)))
+}
+
 func (s *ScaleExtender) dismissDialog() {
 	s.App().Content.RemovePage(scaleDialogKey)
 }
@@ -122,3 +228,44 @@ func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int) er
 
 	return scaler.Scale(ctx, path, int32(replicas))
 }
+
+// Helpers...
+
+// scaleDialogText returns the scale dialog message detailing the workload
+// replicas, its recent scaling events and any controller overriding a manual scale.
+func scaleDialogText(gvr, path string, st *dao.ScaleStatus, hpa *autoscalingv1.HorizontalPodAutoscaler, blocked bool) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Scale %s %s", gvr, path)
+	if st != nil {
+		fmt.Fprintf(&b, "\nReplicas: %d current, %d desired, %d ready", st.Current, st.Desired, st.Ready)
+		if len(st.Events) > 0 {
+			b.WriteString("\nRecent scaling:")
+			for _, e := range st.Events {
+				b.WriteString("\n  " + e)
+			}
+		}
+		if st.Owner != "" && hpa == nil {
+			fmt.Fprintf(&b, "\nOwned by %s which will revert a manual scale.", st.Owner)
+		}
+	}
+	switch {
+	case hpa != nil && blocked:
+		fmt.Fprintf(&b, "\nManaged by HPA %s (min %d/max %d). Manual scaling is disabled, adjust or pin the HPA bounds instead.", hpa.Name, hpaMin(hpa), hpa.Spec.MaxReplicas)
+	case hpa != nil:
+		fmt.Fprintf(&b, "\nManaged by HPA %s (min %d/max %d) which will revert a manual scale. Adjust or pin the HPA bounds instead.", hpa.Name, hpaMin(hpa), hpa.Spec.MaxReplicas)
+	}
+
+	return b.String()
+}
+
+func isNumeric(textToCheck string, _ rune) bool {
+	_, err := strconv.Atoi(textToCheck)
+	return err == nil
+}
+
+func hpaMin(hpa *autoscalingv1.HorizontalPodAutoscaler) int32 {
+	if hpa.Spec.MinReplicas == nil {
+		return 1
+	}
+	return *hpa.Spec.MinReplicas
+}
@@ -0,0 +1,66 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestScaleDialogText(t *testing.T) {
+	min := int32(2)
+	hpa := autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred"},
+		Spec:       autoscalingv1.HorizontalPodAutoscalerSpec{MinReplicas: &min, MaxReplicas: 5},
+	}
+	st := dao.ScaleStatus{
+		Desired: 3,
+		Current: 2,
+		Ready:   1,
+		Events:  []string{"10:00:00 ScalingReplicaSet Scaled up replica set fred-123 to 3"},
+	}
+
+	uu := map[string]struct {
+		st      *dao.ScaleStatus
+		hpa     *autoscalingv1.HorizontalPodAutoscaler
+		blocked bool
+		e       string
+	}{
+		"noStatus": {
+			e: "Scale apps/v1/deployments default/fred",
+		},
+		"status": {
+			st: &st,
+			e: "Scale apps/v1/deployments default/fred\n" +
+				"Replicas: 2 current, 3 desired, 1 ready\n" +
+				"Recent scaling:\n" +
+				"  10:00:00 ScalingReplicaSet Scaled up replica set fred-123 to 3",
+		},
+		"owned": {
+			st: &dao.ScaleStatus{Desired: 1, Current: 1, Ready: 1, Owner: "Deployment/fred"},
+			e: "Scale apps/v1/deployments default/fred\n" +
+				"Replicas: 1 current, 1 desired, 1 ready\n" +
+				"Owned by Deployment/fred which will revert a manual scale.",
+		},
+		"hpa": {
+			hpa: &hpa,
+			e: "Scale apps/v1/deployments default/fred\n" +
+				"Managed by HPA fred (min 2/max 5) which will revert a manual scale. Adjust or pin the HPA bounds instead.",
+		},
+		"blocked": {
+			hpa:     &hpa,
+			blocked: true,
+			e: "Scale apps/v1/deployments default/fred\n" +
+				"Managed by HPA fred (min 2/max 5). Manual scaling is disabled, adjust or pin the HPA bounds instead.",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, scaleDialogText("apps/v1/deployments", "default/fred", u.st, u.hpa, u.blocked))
+		})
+	}
+}
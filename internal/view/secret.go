@@ -4,6 +4,8 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	v1 "k8s.io/api/core/v1"
@@ -29,9 +31,33 @@ func NewSecret(gvr client.GVR) ResourceViewer {
 
 func (s *Secret) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true),
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Decode", s.decodeCmd, true),
+		ui.KeyU:      ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Keys", s.keysCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Drift", s.driftCmd, true),
 	})
+	if !s.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyA: ui.NewKeyAction("Create", s.createCmd, true),
+		})
+	}
+	if _, ok := aa[ui.KeyE]; ok {
+		aa[ui.KeyE] = ui.NewKeyAction("Edit", s.editCmd, true)
+	}
+}
+
+func (s *Secret) keysCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showDataKeys(s.App(), s.GVR(), path)
+
+	return nil
+}
+
+func (s *Secret) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return driftCmd(evt, s.App(), s.GetTable(), "v1/secrets")
 }
 
 func (s *Secret) refCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -50,8 +76,19 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		s.App().Flash().Errf("Expecting unstructured but got %T", o)
+		return nil
+	}
+	if dao.DataSize(u, "data") > render.LargeValueSize {
+		s.App().Flash().Warnf("Secret %s is large. Decoding values on demand...", path)
+		showDataKeys(s.App(), s.GVR(), path)
+		return nil
+	}
+
 	var secret v1.Secret
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &secret)
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &secret)
 	if err != nil {
 		s.App().Flash().Err(err)
 		return nil
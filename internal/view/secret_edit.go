@@ -0,0 +1,95 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/gdamore/tcell"
+)
+
+// editCmd edits a secret with its values decoded. Values are re-encoded on save.
+func (s *Secret) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	e, err := s.secretEditor()
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := e.EditBuffer(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	edited, err := editTempBuffer(s.App(), s, "k9s-secret-*.yaml", raw)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if bytes.Equal(raw, edited) {
+		s.App().Flash().Info("No changes detected for " + path)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	err = e.SaveBuffer(ctx, path, edited)
+	s.App().audit(dao.NewAuditEntry("edit", s.GVR().String(), path, err))
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	s.App().Flash().Info(dryRunTag(fmt.Sprintf("Secret %s updated", path)))
+
+	return nil
+}
+
+// editTempBuffer opens a buffer in the editor and returns the edited content.
+// The buffer is removed once the editor exits since it may hold decoded values.
+func editTempBuffer(app *App, v model.Igniter, pattern string, raw []byte) ([]byte, error) {
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.Write(raw)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	v.Stop()
+	ok := edit(app, shellOpts{clear: true, args: []string{f.Name()}})
+	v.Start()
+	if !ok {
+		return nil, errors.New("Failed to launch editor")
+	}
+
+	return ioutil.ReadFile(f.Name())
+}
+
+func (s *Secret) secretEditor() (dao.SecretEditor, error) {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return nil, err
+	}
+	e, ok := res.(dao.SecretEditor)
+	if !ok {
+		return nil, fmt.Errorf("expecting a secret editor for %s but got %T", s.GVR(), res)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,431 @@
+package view
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	serveHost            = "localhost"
+	serveShutdownTimeout = 2 * time.Second
+	// serveLogIdle tracks how long a log dump waits for more lines before
+	// returning when not following.
+	serveLogIdle = 2 * time.Second
+	// serveTokenSize tracks the api token size in bytes.
+	serveTokenSize = 32
+)
+
+// serveResource represents a resource available via the api.
+type serveResource struct {
+	GVR        string   `json:"gvr"`
+	Aliases    []string `json:"aliases"`
+	Namespaced bool     `json:"namespaced"`
+}
+
+// apiServer exposes the K9s data layer over REST.
+type apiServer struct {
+	cfg     *config.Config
+	factory *watch.Factory
+	alias   *dao.Alias
+}
+
+// RunServer exposes resources list, describe and logs for the current context
+// over a local REST api until interrupted. Resources are resolved via K9s
+// aliases and rendered using the custom views columns if any. Requests must
+// carry the bearer token generated for this run.
+func RunServer(cfg *config.Config, addr string, w io.Writer) error {
+	listen, err := serveAddr(addr)
+	if err != nil {
+		return err
+	}
+	conn := cfg.GetConnection()
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no client connection detected")
+	}
+
+	factory := watch.NewFactory(conn)
+	factory.Start(cfg.ActiveNamespace())
+	defer factory.Terminate()
+
+	alias := dao.NewAlias(factory)
+	if _, err := alias.Ensure(); err != nil {
+		return err
+	}
+	s := apiServer{cfg: cfg, factory: factory, alias: alias}
+	token, err := serveToken()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := http.Server{
+		Addr:        listen,
+		Handler:     checkHost(checkToken(token, s.routes())),
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+	fmt.Fprintf(w, "K9s api listening on http://%s\n", server.Addr)
+	fmt.Fprintf(w, "Authorization: Bearer %s\n", token)
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+	select {
+	case err := <-errs:
+		return err
+	case <-sig:
+	}
+
+	cancel()
+	sctx, scancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer scancel()
+
+	return server.Shutdown(sctx)
+}
+
+func (s *apiServer) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/resources", s.resourcesHandler)
+	mux.HandleFunc("/api/v1/list", s.listHandler)
+	mux.HandleFunc("/api/v1/describe", s.describeHandler)
+	mux.HandleFunc("/api/v1/logs", s.logsHandler)
+
+	return mux
+}
+
+func (s *apiServer) resourcesHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(serveResources(s.alias.ShortNames())); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *apiServer) listHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	q := r.URL.Query()
+	cmd, err := requiredParam(q, "resource")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	ns := q.Get("namespace")
+	if ns == "" {
+		ns = s.cfg.ActiveNamespace()
+	}
+	wide, err := boolParam(q, "wide")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := headlessTable(s.cfg, s.factory, s.alias, tableQuery{
+		cmd:    cmd,
+		ns:     ns,
+		labels: q.Get("labels"),
+		wide:   wide,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := render.WriteJSON(w, data); err != nil {
+		log.Error().Err(err).Msgf("Api list %s failed", cmd)
+	}
+}
+
+func (s *apiServer) describeHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	gvr, path, err := s.resourcePath(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	desc, err := dao.Describe(s.factory.Client(), gvr, path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := io.WriteString(w, desc); err != nil {
+		log.Error().Err(err).Msgf("Api describe %s failed", path)
+	}
+}
+
+func (s *apiServer) logsHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	q := r.URL.Query()
+	gvr, path, err := s.resourcePath(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	opts, follow, err := logQuery(q, path, s.cfg.K9s.Logger.TailCount)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	accessor, err := dao.AccessorFor(s.factory, gvr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	logger, ok := accessor.(dao.Loggable)
+	if !ok {
+		http.Error(w, fmt.Sprintf("resource %s is not loggable", gvr), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.WithValue(r.Context(), internal.KeyFactory, s.factory))
+	defer cancel()
+	c := make(dao.LogChan, 10)
+	if err := logger.TailLogs(ctx, c, opts); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	streamLogs(ctx, w, c, follow)
+}
+
+// resourcePath resolves the requested resource and instance path.
+func (s *apiServer) resourcePath(q url.Values) (client.GVR, string, error) {
+	cmd, err := requiredParam(q, "resource")
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	path, err := requiredParam(q, "path")
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	gvr, ok := s.alias.AsGVR(cmd)
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("`%s` command not found", cmd)
+	}
+
+	return gvr, path, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// streamLogs writes log lines as they come in. Unless following, the dump
+// ends once no new lines showed up for a while.
+func streamLogs(ctx context.Context, w io.Writer, c dao.LogChan, follow bool) {
+	f, _ := w.(http.Flusher)
+	for {
+		var idle <-chan time.Time
+		if !follow {
+			idle = time.After(serveLogIdle)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-idle:
+			return
+		case item, ok := <-c:
+			if !ok {
+				return
+			}
+			if _, err := w.Write(logLine(item)); err != nil {
+				return
+			}
+			if f != nil {
+				f.Flush()
+			}
+		}
+	}
+}
+
+// logLine renders a log line without colors.
+func logLine(item *dao.LogItem) []byte {
+	bb := make([]byte, 0, len(item.Bytes)+50)
+	if item.Pod != "" {
+		bb = append(bb, item.Pod...)
+		bb = append(bb, ':')
+	}
+	if !item.SingleContainer && item.Container != "" {
+		bb = append(bb, item.Container...)
+		bb = append(bb, ' ')
+	}
+	bb = append(bb, item.Bytes...)
+
+	return append(bb, '\n')
+}
+
+// logQuery returns log options and whether to follow the logs.
+func logQuery(q url.Values, path string, tail int64) (dao.LogOptions, bool, error) {
+	opts := dao.LogOptions{
+		Path:      path,
+		Container: q.Get("container"),
+		Lines:     tail,
+	}
+	if t := q.Get("tail"); t != "" {
+		n, err := strconv.ParseInt(t, 10, 64)
+		if err != nil || n <= 0 {
+			return opts, false, fmt.Errorf("invalid tail %q", t)
+		}
+		opts.Lines = n
+	}
+	if opts.Lines > config.MaxLogThreshold {
+		opts.Lines = config.MaxLogThreshold
+	}
+	var err error
+	if opts.Previous, err = boolParam(q, "previous"); err != nil {
+		return opts, false, err
+	}
+	follow, err := boolParam(q, "follow")
+
+	return opts, follow, err
+}
+
+func serveResources(m config.ShortNames) []serveResource {
+	rr := make([]serveResource, 0, len(m))
+	for gvr, aliases := range m {
+		meta, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr))
+		if err != nil {
+			continue
+		}
+		sort.Strings(aliases)
+		rr = append(rr, serveResource{GVR: gvr, Aliases: aliases, Namespaced: meta.Namespaced})
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].GVR < rr[j].GVR
+	})
+
+	return rr
+}
+
+func requiredParam(q url.Values, k string) (string, error) {
+	v := q.Get(k)
+	if v == "" {
+		return "", fmt.Errorf("missing %q parameter", k)
+	}
+
+	return v, nil
+}
+
+func boolParam(q url.Values, k string) (bool, error) {
+	v := q.Get(k)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q", k, v)
+	}
+
+	return b, nil
+}
+
+func allowGet(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet {
+		return true
+	}
+	w.Header().Set("Allow", http.MethodGet)
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+	return false
+}
+
+// serveAddr returns the api server listening address. Only loopback
+// addresses are allowed.
+func serveAddr(addr string) (string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		host, port = "", addr
+	}
+	if host == "" {
+		host = serveHost
+	}
+	if !isLoopback(host) {
+		return "", fmt.Errorf("api server must listen on a loopback address but got %q", host)
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+// checkHost rejects requests not addressed to a loopback host so pages
+// rebinding their domain to the local address can not reach the api.
+func checkHost(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if !isLoopback(host) {
+			http.Error(w, "invalid host", http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// checkToken rejects requests not carrying the api bearer token.
+func checkToken(token string, h http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), want) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "invalid or missing bearer token", http.StatusUnauthorized)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// serveToken returns a new random api token.
+func serveToken() (string, error) {
+	b := make([]byte, serveTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func isLoopback(host string) bool {
+	if host == serveHost {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogQuery(t *testing.T) {
+	uu := map[string]struct {
+		q      string
+		opts   dao.LogOptions
+		follow bool
+		err    bool
+	}{
+		"defaults": {
+			opts: dao.LogOptions{Path: "fred/blee", Lines: 100},
+		},
+		"full": {
+			q:      "container=c1&tail=20&previous=true&follow=true",
+			opts:   dao.LogOptions{Path: "fred/blee", Container: "c1", Lines: 20, Previous: true},
+			follow: true,
+		},
+		"capped": {
+			q:    "tail=100000",
+			opts: dao.LogOptions{Path: "fred/blee", Lines: 5000},
+		},
+		"bad-tail": {
+			q:   "tail=-1",
+			err: true,
+		},
+		"bad-follow": {
+			q:   "follow=blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := url.ParseQuery(u.q)
+			assert.Nil(t, err)
+			opts, follow, err := logQuery(q, "fred/blee", 100)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.opts, opts)
+			assert.Equal(t, u.follow, follow)
+		})
+	}
+}
+
+func TestLogLine(t *testing.T) {
+	uu := map[string]struct {
+		item dao.LogItem
+		e    string
+	}{
+		"single": {
+			item: dao.LogItem{Container: "c1", SingleContainer: true, Bytes: []byte("hello")},
+			e:    "hello\n",
+		},
+		"multi-containers": {
+			item: dao.LogItem{Container: "c1", Bytes: []byte("hello")},
+			e:    "c1 hello\n",
+		},
+		"multi-pods": {
+			item: dao.LogItem{Pod: "p1", Container: "c1", Bytes: []byte("hello")},
+			e:    "p1:c1 hello\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(logLine(&u.item)))
+		})
+	}
+}
+
+func TestStreamLogs(t *testing.T) {
+	c := make(dao.LogChan, 2)
+	c <- &dao.LogItem{SingleContainer: true, Bytes: []byte("l1")}
+	c <- &dao.LogItem{SingleContainer: true, Bytes: []byte("l2")}
+
+	var buff bytes.Buffer
+	streamLogs(context.Background(), &buff, c, false)
+	assert.Equal(t, "l1\nl2\n", buff.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	buff.Reset()
+	streamLogs(ctx, &buff, make(dao.LogChan), true)
+	assert.Equal(t, "", buff.String())
+}
+
+func TestAllowGet(t *testing.T) {
+	w := httptest.NewRecorder()
+	assert.True(t, allowGet(w, httptest.NewRequest(http.MethodGet, "/api/v1/list", nil)))
+
+	w = httptest.NewRecorder()
+	assert.False(t, allowGet(w, httptest.NewRequest(http.MethodPost, "/api/v1/list", nil)))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, http.MethodGet, w.Header().Get("Allow"))
+}
+
+func TestServeAddr(t *testing.T) {
+	uu := map[string]struct {
+		addr, e string
+		err     bool
+	}{
+		"port":  {addr: "8765", e: "localhost:8765"},
+		"local": {addr: "localhost:9000", e: "localhost:9000"},
+		"ipv4":  {addr: "127.0.0.1:9000", e: "127.0.0.1:9000"},
+		"ipv6":  {addr: "[::1]:9000", e: "[::1]:9000"},
+		"empty": {addr: ":9000", e: "localhost:9000"},
+		"any":   {addr: "0.0.0.0:9000", err: true},
+		"host":  {addr: "fred.io:9000", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			addr, err := serveAddr(u.addr)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, addr)
+		})
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	uu := map[string]struct {
+		host string
+		e    int
+	}{
+		"localhost": {host: "localhost:8765", e: http.StatusOK},
+		"ipv4":      {host: "127.0.0.1:8765", e: http.StatusOK},
+		"ipv6":      {host: "[::1]:8765", e: http.StatusOK},
+		"no-port":   {host: "localhost", e: http.StatusOK},
+		"rebind":    {host: "fred.io:8765", e: http.StatusForbidden},
+		"any":       {host: "0.0.0.0:8765", e: http.StatusForbidden},
+	}
+
+	h := checkHost(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/resources", nil)
+			r.Host = u.host
+			h.ServeHTTP(w, r)
+			assert.Equal(t, u.e, w.Code)
+		})
+	}
+}
+
+func TestCheckToken(t *testing.T) {
+	uu := map[string]struct {
+		auth string
+		e    int
+	}{
+		"valid":   {auth: "Bearer fred", e: http.StatusOK},
+		"missing": {e: http.StatusUnauthorized},
+		"wrong":   {auth: "Bearer blee", e: http.StatusUnauthorized},
+		"scheme":  {auth: "Basic fred", e: http.StatusUnauthorized},
+		"raw":     {auth: "fred", e: http.StatusUnauthorized},
+	}
+
+	h := checkToken("fred", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/resources", nil)
+			if u.auth != "" {
+				r.Header.Set("Authorization", u.auth)
+			}
+			h.ServeHTTP(w, r)
+			assert.Equal(t, u.e, w.Code)
+		})
+	}
+}
+
+func TestServeToken(t *testing.T) {
+	t1, err := serveToken()
+	assert.Nil(t, err)
+	t2, err := serveToken()
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2*serveTokenSize, len(t1))
+	assert.NotEqual(t, t1, t2)
+}
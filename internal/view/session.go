@@ -0,0 +1,225 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type addressable interface {
+	Address() string
+}
+
+// captureSession snapshots the current views and active port-forwards.
+func (a *App) captureSession() *config.Session {
+	s := config.Session{
+		Context:   a.Config.K9s.CurrentContext,
+		Namespace: a.Config.ActiveNamespace(),
+	}
+	for _, c := range a.Content.Stack.Peek() {
+		v, ok := c.(ResourceViewer)
+		if !ok || v.GetTable().Path != "" {
+			continue
+		}
+		if meta, err := dao.MetaAccess.MetaFor(v.GVR()); err != nil || dao.IsK9sMeta(meta) {
+			continue
+		}
+		col, asc := v.GetTable().SortCol()
+		s.Views = append(s.Views, config.SessionView{
+			Command: sessionCmd(v.GVR(), v.GetTable().GetModel().GetNamespace()),
+			Filter:  v.GetTable().CmdBuff().GetText(),
+			SortCol: col,
+			SortAsc: asc,
+		})
+	}
+	for _, f := range a.factory.Forwarders() {
+		if !f.Active() {
+			continue
+		}
+		s.Forwards = append(s.Forwards, forwardSpec(f))
+	}
+
+	return &s
+}
+
+// savePortForwards persists the current port-forwards for the active context
+// so they get re-established on the next launch.
+func (a *App) savePortForwards() {
+	if a.factory == nil || a.Config.K9s.CurrentContext == "" {
+		return
+	}
+	pp := config.PortForwards{Context: a.Config.K9s.CurrentContext}
+	for _, f := range a.factory.Forwarders() {
+		pp.Forwards = append(pp.Forwards, forwardSpec(f))
+	}
+	sort.Slice(pp.Forwards, func(i, j int) bool {
+		return pp.Forwards[i].Path < pp.Forwards[j].Path
+	})
+	if err := pp.Save(config.PortForwardsFile(pp.Context)); err != nil {
+		log.Error().Err(err).Msgf("PortForwards save failed")
+	}
+}
+
+// restorePortForwards re-establishes the port-forwards saved for the active
+// context. Forwards whose pod is gone land on a replacement pod if any.
+func (a *App) restorePortForwards() {
+	ctx := a.Config.K9s.CurrentContext
+	pp, err := config.LoadPortForwards(config.PortForwardsFile(ctx))
+	if err != nil {
+		log.Warn().Err(err).Msgf("PortForwards load failed")
+		return
+	}
+	if pp == nil || pp.Context != ctx {
+		return
+	}
+
+	var count int
+	for _, f := range pp.Forwards {
+		tt := sessionTunnels(f)
+		if len(tt) == 0 {
+			continue
+		}
+		path := f.Path
+		if _, err := a.factory.Get("v1/pods", path, true, labels.Everything()); err != nil && f.Selector != "" {
+			ns, _ := client.Namespaced(path)
+			if path, err = dao.ReadyPod(a.factory, ns, f.Selector); err != nil {
+				log.Warn().Err(err).Msgf("No replacement pod for port-forward %s", f.Path)
+				continue
+			}
+		}
+		if err := a.startForward(path, f.Container, f.Selector, tt); err != nil {
+			log.Warn().Err(err).Msgf("Unable to restore port-forward %s", f.Path)
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		a.Flash().Infof("Restored %d port-forward(s)", count)
+	}
+}
+
+// saveSession persists the current session for the active context.
+func (a *App) saveSession() {
+	if a.factory == nil || a.Config.K9s.CurrentContext == "" {
+		return
+	}
+	s := a.captureSession()
+	if err := s.Save(config.SessionFile(s.Context)); err != nil {
+		log.Error().Err(err).Msgf("Session save failed")
+	}
+}
+
+// offerSessionRestore prompts to restore the last session for the active
+// context. Crash recovery states take precedence.
+func (a *App) offerSessionRestore() {
+	if a.offerRecovery() {
+		return
+	}
+	ctx := a.Config.K9s.CurrentContext
+	s, err := config.LoadSession(config.SessionFile(ctx))
+	if err != nil {
+		log.Warn().Err(err).Msgf("Session load failed")
+		return
+	}
+	if s == nil || s.Empty() || s.Context != ctx {
+		return
+	}
+
+	msg := fmt.Sprintf("Restore %d view(s) and %d port-forward(s) from your last session?", len(s.Views), len(s.Forwards))
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Restore Session", msg, func() {
+		a.restoreSession(s)
+	}, func() {})
+}
+
+func (a *App) restoreSession(s *config.Session) {
+	for i, sv := range s.Views {
+		if err := a.gotoResource(sv.Command, "", i == 0); err != nil {
+			a.Flash().Err(err)
+			continue
+		}
+		v, ok := a.Content.Top().(ResourceViewer)
+		if !ok {
+			continue
+		}
+		if sv.SortCol != "" {
+			v.GetTable().SetSortCol(sv.SortCol, sv.SortAsc)
+		}
+		if sv.Filter != "" {
+			v.GetTable().CmdBuff().SetText(sv.Filter)
+		}
+		v.Refresh()
+	}
+
+	var restored bool
+	for _, f := range s.Forwards {
+		if a.factory.Forwarders().IsContainerForwarded(f.Path, f.Container) {
+			continue
+		}
+		tt := sessionTunnels(f)
+		if len(tt) == 0 {
+			continue
+		}
+		if err := a.startForward(f.Path, f.Container, f.Selector, tt); err != nil {
+			a.Flash().Err(err)
+			continue
+		}
+		restored = true
+	}
+	if restored {
+		a.savePortForwards()
+	}
+}
+
+// Helpers...
+
+func forwardSpec(f watch.Forwarder) config.SessionForward {
+	fwd := config.SessionForward{
+		Path:      strings.TrimSuffix(f.Path(), ":"+f.Container()),
+		Container: f.Container(),
+		Ports:     f.Ports(),
+		Selector:  f.Selector(),
+	}
+	if addr, ok := f.(addressable); ok && addr.Address() != "" {
+		fwd.Address = addr.Address()
+	} else {
+		fwd.Address = config.DefaultPFAddress
+	}
+
+	return fwd
+}
+
+func sessionTunnels(f config.SessionForward) []client.PortTunnel {
+	tt := make([]client.PortTunnel, 0, len(f.Ports))
+	for _, p := range f.Ports {
+		tokens := strings.Split(p, ":")
+		if len(tokens) != 2 {
+			continue
+		}
+		tt = append(tt, client.PortTunnel{
+			Address:       f.Address,
+			LocalPort:     tokens[0],
+			ContainerPort: tokens[1],
+		})
+	}
+
+	return tt
+}
+
+func sessionCmd(gvr client.GVR, ns string) string {
+	switch {
+	case client.IsClusterScoped(ns):
+		return gvr.String()
+	case client.IsAllNamespaces(ns):
+		return gvr.String() + " " + client.NamespaceAll
+	default:
+		return gvr.String() + " " + ns
+	}
+}
@@ -0,0 +1,292 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// splitColumns lays panes side by side ie `:ctx prod | :ctx staging`.
+	splitColumns = " | "
+	// splitRows stacks panes ie `:ctx prod - :ctx staging`.
+	splitRows = " - "
+)
+
+// split tracks a second cluster pane displayed alongside the main one.
+type split struct {
+	peer    *App
+	columns bool
+	focused bool
+	labels  [2]*tview.TextView
+	panes   [2]*tview.Flex
+}
+
+// parseSplit checks for a split command and returns the contexts to display.
+func parseSplit(cmd string) (string, string, bool, bool) {
+	for _, sep := range []string{splitColumns, splitRows} {
+		tokens := strings.Split(cmd, sep)
+		if len(tokens) != 2 {
+			continue
+		}
+		l, ok := splitContext(tokens[0])
+		if !ok {
+			return "", "", false, false
+		}
+		r, ok := splitContext(tokens[1])
+		if !ok {
+			return "", "", false, false
+		}
+		return l, r, sep == splitColumns, true
+	}
+
+	return "", "", false, false
+}
+
+func splitContext(s string) (string, bool) {
+	tokens := strings.Fields(strings.TrimPrefix(strings.TrimSpace(s), ":"))
+	if len(tokens) != 2 || !isContextCmd(tokens[0]) {
+		return "", false
+	}
+
+	return tokens[1], true
+}
+
+// IsPeer returns true if the app is a secondary split pane.
+func (a *App) IsPeer() bool {
+	return a.primary != nil
+}
+
+// activePane returns the app owning the focused pane.
+func (a *App) activePane() *App {
+	if a.split != nil && a.split.focused {
+		return a.split.peer
+	}
+
+	return a
+}
+
+// splitCtx displays two contexts side by side or stacked.
+func (a *App) splitCtx(left, right string, columns bool) error {
+	if a.IsPeer() {
+		return a.primary.splitCtx(left, right, columns)
+	}
+	if left != a.Config.K9s.CurrentContext {
+		if err := useContext(a, left); err != nil {
+			return err
+		}
+	}
+
+	return a.openPeer(right, columns)
+}
+
+// openPeer displays the given context in the secondary pane.
+func (a *App) openPeer(name string, columns bool) error {
+	if a.IsPeer() {
+		return a.primary.openPeer(name, a.primary.split.columns)
+	}
+	cfg, err := a.Conn().Config().ForContext(name)
+	if err != nil {
+		return err
+	}
+	conn, err := client.InitConnection(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to connect to context %s: %w", name, err)
+	}
+	if !conn.ConnectionOK() {
+		return fmt.Errorf("unable to connect to context %s", name)
+	}
+	peer, err := a.newPeer(name, conn)
+	if err != nil {
+		return err
+	}
+
+	a.closeSplit()
+	a.split = &split{peer: peer, columns: columns}
+	a.layoutPanes()
+	peer.Resume()
+	a.Flash().Infof("Split with context %s", name)
+
+	return peer.gotoResource(a.Config.ActiveView(), "", true)
+}
+
+func (a *App) newPeer(name string, conn client.Connection) (*App, error) {
+	cluster, err := conn.Config().CurrentClusterName()
+	if err != nil {
+		return nil, err
+	}
+	peer := App{
+		version:       a.version,
+		App:           a.App.Fork(a.Config.ForContext(name, cluster, conn)),
+		Content:       NewPageStack(),
+		primary:       a,
+		auditor:       a.auditor,
+		recorder:      a.recorder,
+		vulnCache:     a.vulnCache,
+		cmdHistory:    a.cmdHistory,
+		filterHistory: a.filterHistory,
+		showHeader:    a.showHeader,
+		showCrumbs:    a.showCrumbs,
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyApp, &peer)
+	if err := peer.Content.Init(ctx); err != nil {
+		return nil, err
+	}
+	peer.Content.Stack.AddListener(peer.Menu())
+
+	ns, err := conn.Config().CurrentNamespaceName()
+	if err != nil {
+		log.Info().Msgf("No namespace specified for context %q using cluster default namespace", name)
+	} else if err = peer.Config.SetActiveNamespace(ns); err != nil {
+		log.Error().Err(err).Msgf("Fail to set active namespace to %q", ns)
+	}
+	peer.factory = watch.NewFactory(conn)
+	peer.initFactory(ns)
+	peer.mxCache = model.NewMetricsCache(conn)
+	peer.command = a.command.fork(&peer)
+	peer.bindPeerKeys()
+
+	return &peer, nil
+}
+
+func (a *App) bindPeerKeys() {
+	a.AddActions(ui.KeyActions{
+		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+	})
+}
+
+// closeSplit tears down the secondary pane.
+func (a *App) closeSplit() {
+	if a.IsPeer() {
+		a.primary.closeSplit()
+		return
+	}
+	if a.split == nil {
+		return
+	}
+	peer := a.split.peer
+	a.split = nil
+	a.layoutPanes()
+	peer.Halt()
+	peer.Content.Stack.Clear()
+	peer.factory.Terminate()
+	a.focusPane()
+}
+
+// layoutPanes lays out the main view stack and the peer pane if any.
+func (a *App) layoutPanes() {
+	for _, p := range a.paneItems {
+		a.panes.RemoveItem(p)
+	}
+	a.paneItems = a.paneItems[:0]
+	if a.split == nil {
+		a.addPane(a.Content, true)
+		return
+	}
+
+	s := a.split
+	if s.columns {
+		a.panes.SetDirection(tview.FlexColumn)
+	} else {
+		a.panes.SetDirection(tview.FlexRow)
+	}
+	for i, p := range []*App{a, s.peer} {
+		if s.labels[i] == nil {
+			s.labels[i] = tview.NewTextView()
+			s.labels[i].SetDynamicColors(true)
+			s.panes[i] = tview.NewFlex().SetDirection(tview.FlexRow)
+			s.panes[i].AddItem(s.labels[i], 1, 1, false)
+			s.panes[i].AddItem(p.Content, 0, 1, true)
+		}
+		a.addPane(s.panes[i], (i == 1) == s.focused)
+	}
+	a.refreshLabels()
+}
+
+func (a *App) addPane(p tview.Primitive, focus bool) {
+	a.panes.AddItem(p, 0, 1, focus)
+	a.paneItems = append(a.paneItems, p)
+}
+
+func (a *App) refreshLabels() {
+	if a.split == nil {
+		return
+	}
+	for i, p := range []*App{a, a.split.peer} {
+		bg := a.Styles.Frame().Crumb.BgColor
+		if (i == 1) == a.split.focused {
+			bg = a.Styles.Frame().Crumb.ActiveColor
+		}
+		s := a.split.labels[i]
+		s.Clear()
+		s.SetBackgroundColor(a.Styles.BgColor())
+		fmt.Fprintf(s, "[%s:%s:b] %s [-:%s:-]",
+			a.Styles.Frame().Crumb.FgColor,
+			bg,
+			tview.Escape(p.Config.K9s.CurrentContext),
+			a.Styles.Body().BgColor,
+		)
+	}
+}
+
+// claimFocus marks the app pane as the active one when split.
+func (a *App) claimFocus() {
+	main := a
+	if a.IsPeer() {
+		main = a.primary
+	}
+	if main.split == nil || main.split.focused == a.IsPeer() {
+		return
+	}
+	main.split.focused = a.IsPeer()
+	main.layoutPanes()
+}
+
+// focusPane moves the focus to the active pane top view.
+func (a *App) focusPane() {
+	p := a.activePane()
+	top := p.Content.Top()
+	if top == nil {
+		return
+	}
+	a.SetFocus(top)
+	a.Menu().HydrateMenu(top.Hints())
+}
+
+func (a *App) nextPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.split == nil {
+		return evt
+	}
+	if a.CmdBuff().InCmdMode() {
+		return evt
+	}
+	a.split.focused = !a.split.focused
+	a.layoutPanes()
+	a.focusPane()
+
+	return nil
+}
+
+func (a *App) unsplitCmd() error {
+	if a.IsPeer() {
+		return a.primary.unsplitCmd()
+	}
+	if a.split == nil {
+		return errors.New("No split view to close")
+	}
+	a.closeSplit()
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSplit(t *testing.T) {
+	uu := map[string]struct {
+		cmd           string
+		left, right   string
+		columns, isOK bool
+	}{
+		"columns": {
+			cmd:     "ctx prod | :ctx staging",
+			left:    "prod",
+			right:   "staging",
+			columns: true,
+			isOK:    true,
+		},
+		"rows": {
+			cmd:   "context prod - context staging",
+			left:  "prod",
+			right: "staging",
+			isOK:  true,
+		},
+		"arn": {
+			cmd:     "ctx arn:aws:eks:us-east-1:123:cluster/prod | ctx kind-staging",
+			left:    "arn:aws:eks:us-east-1:123:cluster/prod",
+			right:   "kind-staging",
+			columns: true,
+			isOK:    true,
+		},
+		"noSplit": {
+			cmd: "ctx prod",
+		},
+		"notContexts": {
+			cmd: "po fred | ctx staging",
+		},
+		"missingContext": {
+			cmd: "ctx | ctx staging",
+		},
+		"tooMany": {
+			cmd: "ctx a | ctx b | ctx c",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, r, columns, ok := parseSplit(u.cmd)
+			assert.Equal(t, u.isOK, ok)
+			assert.Equal(t, u.left, l)
+			assert.Equal(t, u.right, r)
+			assert.Equal(t, u.columns, columns)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Stat presents a session stats viewer.
+type Stat struct {
+	ResourceViewer
+}
+
+// NewStat returns a new viewer.
+func NewStat(gvr client.GVR) ResourceViewer {
+	s := Stat{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetColorerFn(render.Stat{}.ColorerFunc())
+	s.GetTable().SetSortCol("CALLS", false)
+	s.SetContextFn(s.statContext)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *Stat) statContext(ctx context.Context) context.Context {
+	if s.App().stats == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, internal.KeyStats, s.App().stats)
+}
+
+func (s *Stat) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace, tcell.KeyCtrlD, ui.KeyE, ui.KeyY, ui.KeyD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Calls", s.GetTable().SortColCmd("CALLS", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Errors", s.GetTable().SortColCmd("ERRORS", false), false),
+	})
+}
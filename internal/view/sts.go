@@ -19,10 +19,12 @@ type StatefulSet struct {
 func NewStatefulSet(gvr client.GVR) ResourceViewer {
 	s := StatefulSet{
 		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewScaleExtender(
-					NewImageExtender(
-						NewLogsExtender(NewBrowser(gvr), nil),
+			NewRolloutExtender(
+				NewRestartExtender(
+					NewScaleExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), nil),
+						),
 					),
 				),
 			),
@@ -47,6 +47,7 @@ func (s *Service) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlL: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyR:        ui.NewKeyAction("Check Connectivity", s.connectivityCmd, true),
 	})
 }
 
@@ -63,6 +64,19 @@ func (s *Service) showPods(a *App, _ ui.Tabular, gvr, path string) {
 	showPodsWithLabels(a, path, svc.Spec.Selector)
 }
 
+func (s *Service) connectivityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Service
+	res.Init(s.App().factory, s.GVR())
+	showConnectivity(s.App(), &res, path)
+
+	return nil
+}
+
 func (s *Service) checkSvc(svc *v1.Service) error {
 	if svc.Spec.Type != "NodePort" && svc.Spec.Type != "LoadBalancer" {
 		return errors.New("You must select a reachable service")
@@ -155,6 +155,14 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta("autoscaling/v1/horizontalpodautoscalers", metav1.APIResource{
+		Name:         "horizontalpodautoscalers",
+		SingularName: "horizontalpodautoscaler",
+		Namespaced:   true,
+		Kind:         "HorizontalPodAutoscalers",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 }
 
 func TestServiceNew(t *testing.T) {
@@ -162,5 +170,5 @@ func TestServiceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "Services", s.Name())
-	assert.Equal(t, 10, len(s.Hints()))
+	assert.Equal(t, 11, len(s.Hints()))
 }
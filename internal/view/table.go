@@ -4,10 +4,10 @@ import (
 	"context"
 	"time"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -21,12 +21,15 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn []BindKeysFunc
+	chords     *ui.KeyChords
+	conflicts  model.MenuHints
 }
 
 // NewTable returns a new viewer.
 func NewTable(gvr client.GVR) *Table {
 	t := Table{
-		Table: ui.NewTable(gvr),
+		Table:  ui.NewTable(gvr),
+		chords: ui.NewKeyChords(),
 	}
 	t.envFn = t.defaultEnv
 
@@ -44,6 +47,14 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, t.app.CustomView)
 	t.Table.Init(ctx)
+	if t.app.Config.K9s.ColorBlind != "" {
+		t.SetStatusGlyphs(render.StatusGlyphs)
+	}
+	if t.app.Config.K9s.IsScreenReader() {
+		t.SetAccessible(func(s string) {
+			t.app.Flash().Info(s)
+		})
+	}
 	t.SetInputCapture(t.keyboard)
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
@@ -63,13 +74,34 @@ func (t *Table) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	if a, ok := t.Actions()[ui.AsKey(evt)]; ok && !t.app.Content.IsTopDialog() {
+	if t.app.Content.IsTopDialog() {
+		return evt
+	}
+	if a, ok, consumed := t.chords.Dispatch(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	} else if consumed {
+		if p := t.chords.Pending(); p != "" {
+			t.app.Flash().Info(p + " …")
+		}
+		return nil
+	}
+	if a, ok := t.Actions()[ui.AsKey(evt)]; ok {
 		return a.Action(evt)
 	}
 
 	return evt
 }
 
+// KeyMapHints returns the view chords and key bindings conflicts.
+func (t *Table) KeyMapHints() (model.MenuHints, model.MenuHints) {
+	return t.chords.Hints(), t.conflicts
+}
+
+// applyKeyMaps applies the user key maps to the view actions.
+func (t *Table) applyKeyMaps(aliases []string) {
+	t.conflicts = keyMapActions(aliases, t.Actions(), t.chords)
+}
+
 // Name returns the table name.
 func (t *Table) Name() string { return t.GVR().R() }
 
@@ -189,7 +221,7 @@ func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	_, n := client.Namespaced(path)
 	log.Debug().Msgf("Copied selection to clipboard %q", n)
 	t.app.Flash().Info("Current selection copied to clipboard...")
-	if err := clipboard.WriteAll(n); err != nil {
+	if err := copyToClipboard(n); err != nil {
 		t.app.Flash().Err(err)
 	}
 
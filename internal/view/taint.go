@@ -0,0 +1,148 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const taintDialogKey = "taint"
+
+func (n *Node) taintCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	t, err := n.tainter()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	tt, err := t.Taints(ctx, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	n.showTaintDialog(path, tt)
+
+	return nil
+}
+
+func (n *Node) tainter() (dao.Tainter, error) {
+	res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	if err != nil {
+		return nil, err
+	}
+	t, ok := res.(dao.Tainter)
+	if !ok {
+		return nil, fmt.Errorf("expecting a tainter for %q", n.GVR())
+	}
+
+	return t, nil
+}
+
+func (n *Node) showTaintDialog(path string, tt []v1.Taint) {
+	styles := n.App().Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	keep := make([]bool, len(tt))
+	for i, t := range tt {
+		i := i
+		keep[i] = true
+		f.AddCheckbox(dao.TaintString(t)+":", true, func(checked bool) {
+			keep[i] = checked
+		})
+	}
+	var key, value string
+	effect := dao.TaintEffects[0]
+	f.AddInputField("Key:", "", 40, nil, func(changed string) {
+		key = changed
+	})
+	f.AddInputField("Value:", "", 40, nil, func(changed string) {
+		value = changed
+	})
+	f.AddDropDown("Effect:", dao.TaintEffects, 0, func(option string, _ int) {
+		effect = option
+	})
+	f.AddButton("OK", func() {
+		res := make([]v1.Taint, 0, len(tt)+1)
+		for i, t := range tt {
+			if keep[i] {
+				res = append(res, t)
+			}
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			t := v1.Taint{Key: key, Value: strings.TrimSpace(value), Effect: v1.TaintEffect(effect)}
+			if err := dao.ValidateTaint(t); err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			res = dao.AddTaint(res, t)
+		}
+		n.dismissTaintDialog()
+		n.setTaints(path, res)
+	})
+	f.AddButton("Cancel", func() {
+		n.dismissTaintDialog()
+	})
+
+	modal := tview.NewModalForm("<Taints>", f)
+	modal.SetText(fmt.Sprintf("Node %s: uncheck to remove, fill in key to add", path))
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		n.dismissTaintDialog()
+	})
+	n.App().Content.AddPage(taintDialogKey, modal, false, false)
+	n.App().Content.ShowPage(taintDialogKey)
+}
+
+func (n *Node) dismissTaintDialog() {
+	n.App().Content.RemovePage(taintDialogKey)
+}
+
+func (n *Node) setTaints(path string, tt []v1.Taint) {
+	t, err := n.tainter()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	err = t.SetTaints(n.App().withRetry(ctx), path, tt)
+	e := dao.NewAuditEntry("taint", n.GVR().String(), path, err)
+	e.Details = taintsString(tt)
+	n.App().audit(e)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	n.App().Flash().Info(dryRunTag(fmt.Sprintf("Node %s taints updated", path)))
+	n.Refresh()
+}
+
+// Helpers...
+
+func taintsString(tt []v1.Taint) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		ss = append(ss, dao.TaintString(t))
+	}
+
+	return strings.Join(ss, ",")
+}
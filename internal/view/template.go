@@ -0,0 +1,160 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+)
+
+const templateDialogKey = "template"
+
+// newCmd creates a resource from a manifest template. Template variables are
+// prompted for, the rendered manifest is opened in the editor and created
+// on confirmation.
+func (a *App) newCmd(kind string) error {
+	if kind == "" {
+		return fmt.Errorf("You must specify a kind. Available: %s", strings.Join(config.TemplateKinds(config.K9sTemplates), ", "))
+	}
+	if a.Config.K9s.IsReadOnly() {
+		return errors.New("Resource creation is disabled in read-only mode")
+	}
+	if gvr, ok := a.command.aliases().AsGVR(kind); ok {
+		if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && meta.SingularName != "" {
+			kind = meta.SingularName
+		}
+	}
+	tpl, err := config.LoadTemplate(config.K9sTemplates, kind)
+	if err != nil {
+		return err
+	}
+
+	vv := tpl.Vars()
+	if len(vv) == 0 {
+		a.editTemplate(tpl, nil)
+		return nil
+	}
+	a.showTemplateDialog(tpl, vv)
+
+	return nil
+}
+
+func (a *App) showTemplateDialog(tpl *config.Template, vv []string) {
+	styles := a.Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	values := make(map[string]string, len(vv))
+	if ns := a.Config.ActiveNamespace(); !client.IsAllNamespaces(ns) {
+		values["NAMESPACE"] = ns
+	}
+	for _, v := range vv {
+		v := v
+		f.AddInputField(v+":", values[v], 40, nil, func(changed string) {
+			values[v] = strings.TrimSpace(changed)
+		})
+	}
+	f.AddButton("OK", func() {
+		if _, err := tpl.Render(values); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.dismissTemplateDialog()
+		a.editTemplate(tpl, values)
+	})
+	f.AddButton("Cancel", func() {
+		a.dismissTemplateDialog()
+	})
+
+	modal := tview.NewModalForm("<New "+tpl.Kind+">", f)
+	modal.SetText("Fill in the " + tpl.Kind + " template values")
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		a.dismissTemplateDialog()
+	})
+	a.Content.AddPage(templateDialogKey, modal, false, false)
+	a.Content.ShowPage(templateDialogKey)
+}
+
+func (a *App) dismissTemplateDialog() {
+	a.Content.RemovePage(templateDialogKey)
+}
+
+// editTemplate renders a template to a temporary manifest, opens it in the
+// editor and offers to create the resulting resources.
+func (a *App) editTemplate(tpl *config.Template, values map[string]string) {
+	raw, err := tpl.Render(values)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	f, err := ioutil.TempFile("", "k9s-new-"+tpl.Kind+"-*.yaml")
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	_, err = f.WriteString(raw)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		a.removeManifest(f.Name())
+		a.Flash().Err(err)
+		return
+	}
+
+	if !edit(a, shellOpts{clear: true, args: []string{f.Name()}}) {
+		a.removeManifest(f.Name())
+		a.Flash().Err(errors.New("Failed to launch editor"))
+		return
+	}
+	bb, err := ioutil.ReadFile(f.Name())
+	if err != nil || strings.TrimSpace(string(bb)) == "" {
+		a.removeManifest(f.Name())
+		a.Flash().Info("Empty manifest. Creation canceled")
+		return
+	}
+
+	msg := fmt.Sprintf("Create %s %s?", tpl.Kind, values["NAME"])
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Create", msg, func() {
+		defer a.removeManifest(f.Name())
+		a.createManifest(tpl.Kind, f.Name())
+	}, func() {
+		a.removeManifest(f.Name())
+	})
+}
+
+func (a *App) createManifest(kind, path string) {
+	args := []string{"create", "-f", path}
+	if dao.IsDryRun() {
+		args = append(args, "--dry-run=server")
+	}
+	res, err := runKu(a, shellOpts{clear: false, args: args})
+	e := dao.NewAuditEntry("create", "", kind, err)
+	e.Details = res
+	a.audit(e)
+	if err != nil {
+		a.Flash().Errf("Create failed: %s", lastLine(res))
+		return
+	}
+	a.Flash().Info(dryRunTag(lastLine(res)))
+}
+
+func (a *App) removeManifest(path string) {
+	if err := os.Remove(path); err != nil {
+		a.Flash().Err(err)
+	}
+}
@@ -0,0 +1,238 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	timelineTitle = "Timeline"
+
+	// timelineGap flags quiet periods between events.
+	timelineGap = time.Minute
+)
+
+// Timeline presents the events involving a resource and its children in
+// chronological order.
+type Timeline struct {
+	*tview.TextView
+
+	app      *App
+	gvr      client.GVR
+	path     string
+	actions  ui.KeyActions
+	last     string
+	cancelFn context.CancelFunc
+}
+
+// NewTimeline returns a new events timeline viewer.
+func NewTimeline(gvr client.GVR, path string) *Timeline {
+	return &Timeline{
+		TextView: tview.NewTextView(),
+		gvr:      gvr,
+		path:     path,
+		actions:  make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (t *Timeline) Init(ctx context.Context) error {
+	var err error
+	if t.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetScrollable(true).SetWrap(true)
+	t.SetDynamicColors(true)
+	t.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, timelineTitle, t.path), t.app.Styles.Frame()))
+	t.SetInputCapture(t.keyboard)
+	t.bindKeys()
+	t.StylesChanged(t.app.Styles)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (t *Timeline) StylesChanged(s *config.Styles) {
+	t.SetBackgroundColor(s.BgColor())
+	t.SetTextColor(s.FgColor())
+	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	t.last = ""
+}
+
+// Name returns the component name.
+func (t *Timeline) Name() string { return timelineTitle }
+
+// Start starts the timeline updater.
+func (t *Timeline) Start() {
+	t.Stop()
+	t.app.Styles.AddListener(t)
+
+	var ctx context.Context
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	go t.updater(ctx)
+}
+
+// Stop terminates the timeline updater.
+func (t *Timeline) Stop() {
+	if t.cancelFn != nil {
+		t.cancelFn()
+		t.cancelFn = nil
+	}
+	t.app.Styles.RemoveListener(t)
+}
+
+// Hints returns menu hints.
+func (t *Timeline) Hints() model.MenuHints {
+	return t.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (t *Timeline) ExtraHints() map[string]string {
+	return nil
+}
+
+func (t *Timeline) bindKeys() {
+	t.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, false),
+		ui.KeyG:         ui.NewKeyAction("Top", t.scrollCmd(true), false),
+		ui.KeyShiftG:    ui.NewKeyAction("Bottom", t.scrollCmd(false), false),
+	})
+}
+
+func (t *Timeline) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := t.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (t *Timeline) scrollCmd(top bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if top {
+			t.ScrollToBeginning()
+		} else {
+			t.ScrollToEnd()
+		}
+		return nil
+	}
+}
+
+func (t *Timeline) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Timeline updater canceled -- %q", t.path)
+
+	rate := time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second
+	for {
+		ee, err := dao.Timeline(t.app.factory, t.gvr.String(), t.path)
+		t.app.QueueUpdateDraw(func() {
+			t.update(ee, err)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rate):
+		}
+	}
+}
+
+func (t *Timeline) update(ee dao.TimelineEvents, err error) {
+	if err != nil {
+		t.app.Flash().Err(err)
+		return
+	}
+	text := renderTimeline(ee, time.Now(), t.app.Styles.Frame().Status)
+	if text == t.last {
+		return
+	}
+	first := t.last == ""
+	row, _ := t.GetScrollOffset()
+	_, _, _, h := t.GetInnerRect()
+	atEnd := row+h >= strings.Count(t.last, "\n")
+	t.last = text
+	t.SetText(text)
+	// Follow new events unless the user scrolled back.
+	if first || atEnd {
+		t.ScrollToEnd()
+	}
+}
+
+// renderTimeline lays out events oldest first, flagging day changes and
+// quiet periods.
+func renderTimeline(ee dao.TimelineEvents, now time.Time, st config.Status) string {
+	if len(ee) == 0 {
+		return "[gray::]No events found. Events expire after an hour by default.[-::]"
+	}
+
+	var (
+		b    strings.Builder
+		prev time.Time
+	)
+	for i, e := range ee {
+		at := e.At.Local()
+		if i == 0 || at.YearDay() != prev.YearDay() || at.Year() != prev.Year() {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "[gray::b]── %s ──[-::-]\n", at.Format("Mon Jan 2 2006"))
+		} else if gap := at.Sub(prev); gap >= timelineGap {
+			fmt.Fprintf(&b, "[gray::]%8s │ %s later[-::]\n", "", duration.HumanDuration(gap))
+		}
+		prev = at
+
+		color := timelineColor(e, st)
+		fmt.Fprintf(&b, "[gray::]%s[-::] [%s::b]●[-::-] [%s::b]%s[-::-] [gray::]%s/%s[-::] %s",
+			at.Format("15:04:05"),
+			color,
+			color, tview.Escape(e.Reason),
+			e.Kind, tview.Escape(e.Name),
+			tview.Escape(strings.TrimSpace(e.Message)),
+		)
+		if e.Count > 1 {
+			fmt.Fprintf(&b, " [gray::](x%d)[-::]", e.Count)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "[gray::]%8s │ last event %s ago[-::]", "", duration.HumanDuration(now.Sub(prev)))
+
+	return b.String()
+}
+
+func timelineColor(e dao.TimelineEvent, st config.Status) config.Color {
+	switch {
+	case e.IsWarning():
+		return st.ErrorColor
+	case e.Reason == "Killing":
+		return st.KillColor
+	default:
+		return st.NewColor
+	}
+}
+
+func timelineCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewTimeline(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTimeline(t *testing.T) {
+	at := time.Date(2020, 9, 1, 10, 0, 0, 0, time.Local)
+	st := config.Status{NewColor: "blue", ErrorColor: "red", KillColor: "purple"}
+	ee := dao.TimelineEvents{
+		{At: at, Type: "Normal", Reason: "Scheduled", Kind: "Pod", Name: "p1", Message: "Assigned"},
+		{At: at.Add(10 * time.Second), Type: "Normal", Reason: "Killing", Kind: "Pod", Name: "p1", Message: "Stopping"},
+		{At: at.Add(5 * time.Minute), Type: "Warning", Reason: "BackOff", Kind: "Pod", Name: "p1", Message: "Back-off", Count: 3},
+	}
+
+	lines := strings.Split(renderTimeline(ee, at.Add(7*time.Minute), st), "\n")
+
+	assert.Equal(t, 6, len(lines))
+	assert.Equal(t, "[gray::b]── Tue Sep 1 2020 ──[-::-]", lines[0])
+	assert.Equal(t, "[gray::]10:00:00[-::] [blue::b]●[-::-] [blue::b]Scheduled[-::-] [gray::]Pod/p1[-::] Assigned", lines[1])
+	assert.Contains(t, lines[2], "[purple::b]Killing")
+	assert.Equal(t, "[gray::]         │ 4m50s later[-::]", lines[3])
+	assert.Equal(t, "[gray::]10:05:00[-::] [red::b]●[-::-] [red::b]BackOff[-::-] [gray::]Pod/p1[-::] Back-off [gray::](x3)[-::]", lines[4])
+	assert.Equal(t, "[gray::]         │ last event 2m ago[-::]", lines[5])
+}
+
+func TestRenderTimelineEmpty(t *testing.T) {
+	assert.Contains(t, renderTimeline(nil, time.Now(), config.Status{}), "No events found")
+}
@@ -0,0 +1,245 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	topTitle       = "Top"
+	topRefreshRate = time.Second
+	topLegendFmt   = " %s [%s::b]%s%s[white::-](avg [%s::]%s%s[white::] max [%s::]%s%s[white::]) "
+)
+
+// Top presents the recent cpu and memory usage of a pod or a node along with
+// its Prometheus panels if any.
+type Top struct {
+	*tview.Flex
+
+	app        *App
+	gvr        client.GVR
+	path       string
+	cpu, mem   *tchart.SparkLine
+	hasMX      bool
+	prom       *dao.PromClient
+	panels     []config.PromPanel
+	promCharts []*tchart.SparkLine
+	last       time.Time
+	actions    ui.KeyActions
+	cancelFn   context.CancelFunc
+}
+
+// NewTop returns a new usage graphs viewer.
+func NewTop(gvr client.GVR, path string) *Top {
+	return &Top{
+		Flex:    tview.NewFlex().SetDirection(tview.FlexRow),
+		gvr:     gvr,
+		path:    path,
+		cpu:     tchart.NewSparkLine("cpu"),
+		mem:     tchart.NewSparkLine("mem"),
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (t *Top) Init(ctx context.Context) error {
+	var err error
+	if t.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+	t.hasMX = t.app.mxCache != nil && t.app.Conn() != nil && t.app.Conn().HasMetrics()
+	t.prom, t.panels, _ = t.app.promPanels(t.gvr.String())
+	if !t.hasMX && len(t.panels) == 0 {
+		return fmt.Errorf("no metrics available for %s", t.path)
+	}
+
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, topTitle, t.path), t.app.Styles.Frame()))
+	t.cpu.SetMultiSeries(false)
+	t.mem.SetMultiSeries(false)
+	if t.hasMX {
+		t.AddItem(t.cpu, 0, 1, false)
+		t.AddItem(t.mem, 0, 1, false)
+	}
+	for _, p := range t.panels {
+		c := tchart.NewSparkLine(promChartID(p))
+		c.SetMultiSeries(false)
+		c.SetLegend(fmt.Sprintf(" %s ", tview.Escape(p.Name)))
+		t.promCharts = append(t.promCharts, c)
+		t.AddItem(c, 0, 1, false)
+	}
+	t.SetInputCapture(t.keyboard)
+	t.bindKeys()
+	t.StylesChanged(t.app.Styles)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (t *Top) StylesChanged(s *config.Styles) {
+	t.SetBackgroundColor(s.Charts().BgColor.Color())
+	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	for _, c := range append([]*tchart.SparkLine{t.cpu, t.mem}, t.promCharts...) {
+		c.SetBackgroundColor(s.Charts().ChartBgColor.Color())
+		c.SetSeriesColors(s.Charts().DefaultChartColors.Colors()...)
+		if ss, ok := s.Charts().ResourceColors[c.ID()]; ok {
+			c.SetSeriesColors(ss.Colors()...)
+		}
+	}
+}
+
+// Name returns the component name.
+func (t *Top) Name() string { return topTitle }
+
+// Start starts the graphs updater.
+func (t *Top) Start() {
+	t.Stop()
+	t.app.Styles.AddListener(t)
+
+	var ctx context.Context
+	ctx, t.cancelFn = context.WithCancel(context.Background())
+	if t.hasMX {
+		go t.updater(ctx)
+	}
+	if t.prom != nil {
+		ns, n := client.Namespaced(t.path)
+		go pollPanels(ctx, t.app, t.prom, t.panels, ns, n, t.promChanged)
+	}
+}
+
+// Stop terminates the graphs updater.
+func (t *Top) Stop() {
+	if t.cancelFn != nil {
+		t.cancelFn()
+		t.cancelFn = nil
+	}
+	t.app.Styles.RemoveListener(t)
+}
+
+// Hints returns menu hints.
+func (t *Top) Hints() model.MenuHints {
+	return t.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (t *Top) ExtraHints() map[string]string {
+	return nil
+}
+
+func (t *Top) bindKeys() {
+	t.actions.Set(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", t.app.PrevCmd, false),
+	})
+}
+
+func (t *Top) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := t.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (t *Top) updater(ctx context.Context) {
+	defer log.Debug().Msgf("Top updater canceled -- %q", t.path)
+
+	for {
+		ss := t.history()
+		t.app.QueueUpdateDraw(func() {
+			t.update(ss)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(topRefreshRate):
+		}
+	}
+}
+
+// history returns the resource usage samples. The backing metrics get polled
+// from now on.
+func (t *Top) history() []model.MetricsSample {
+	if t.gvr.String() == "v1/nodes" {
+		t.app.mxCache.NodesMetrics()
+		return t.app.mxCache.NodeHistory(t.path)
+	}
+	ns, _ := client.Namespaced(t.path)
+	t.app.mxCache.PodsMetrics(ns)
+
+	return t.app.mxCache.PodHistory(t.path)
+}
+
+func (t *Top) update(ss []model.MetricsSample) {
+	for _, s := range ss {
+		if !s.At.After(t.last) {
+			continue
+		}
+		t.cpu.Add(tchart.Metric{S1: s.CPU})
+		t.mem.Add(tchart.Metric{S1: s.MEM})
+		t.last = s.At
+	}
+
+	cpu, mem := make([]int64, 0, len(ss)), make([]int64, 0, len(ss))
+	for _, s := range ss {
+		cpu, mem = append(cpu, s.CPU), append(mem, s.MEM)
+	}
+	t.cpu.SetLegend(topLegend("CPU", "m", cpu, t.cpu.GetSeriesColorNames()[0]))
+	t.mem.SetLegend(topLegend("MEM", "Mi", mem, t.mem.GetSeriesColorNames()[0]))
+}
+
+func (t *Top) promChanged(rr []dao.PromResult) {
+	for i, r := range rr {
+		if i < len(t.promCharts) {
+			addPromResult(t.promCharts[i], r)
+		}
+	}
+}
+
+// topLegend returns a graph legend with the latest, average and max values.
+func topLegend(title, unit string, vv []int64, color string) string {
+	if len(vv) == 0 {
+		return fmt.Sprintf(" %s [gray::]n/a[-::] ", title)
+	}
+
+	var sum, max int64
+	for _, v := range vv {
+		sum += v
+		if v > max {
+			max = v
+		}
+	}
+
+	return fmt.Sprintf(topLegendFmt,
+		title,
+		color, render.AsThousands(vv[len(vv)-1]), unit,
+		color, render.AsThousands(sum/int64(len(vv))), unit,
+		color, render.AsThousands(max), unit,
+	)
+}
+
+func topCmd(v ResourceViewer) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewTop(v.GVR(), path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopLegend(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		e  string
+	}{
+		"empty": {
+			e: " CPU [gray::]n/a[-::] ",
+		},
+		"single": {
+			vv: []int64{10},
+			e:  " CPU [green::b]10m[white::-](avg [green::]10m[white::] max [green::]10m[white::]) ",
+		},
+		"many": {
+			vv: []int64{10, 3000, 20},
+			e:  " CPU [green::b]20m[white::-](avg [green::]1,010m[white::] max [green::]3,000m[white::]) ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, topLegend("CPU", "m", u.vv, "green"))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// viewStates returns the view states of the active context.
+func (a *App) viewStates() *config.ViewStates {
+	ctx := a.Config.K9s.CurrentContext
+	if a.states != nil && a.states.Context == ctx {
+		return a.states
+	}
+	a.saveViewStates()
+
+	s, err := config.LoadViewStates(config.ViewStatesFile(ctx))
+	if err != nil {
+		log.Warn().Err(err).Msgf("View states load failed")
+	}
+	if s == nil || s.Context != ctx {
+		s = config.NewViewStates(ctx)
+	}
+	a.states = s
+
+	return s
+}
+
+// saveViewStates persists the view states if they changed.
+func (a *App) saveViewStates() {
+	if a.states == nil || !a.states.Dirty() || a.states.Context == "" {
+		return
+	}
+	if err := a.states.Save(config.ViewStatesFile(a.states.Context)); err != nil {
+		log.Error().Err(err).Msgf("View states save failed")
+	}
+}
+
+// restoreState restores the last sort column, wide mode and filter used on
+// this resource in the active context.
+func (b *Browser) restoreState() {
+	if !b.persistable() {
+		return
+	}
+	b.stateCtx = b.app.Config.K9s.CurrentContext
+	st, ok := b.app.viewStates().Get(b.GVR().String())
+	if !ok {
+		return
+	}
+	if st.SortCol != "" {
+		b.SetSortCol(st.SortCol, st.SortAsc)
+	}
+	b.SetWide(st.Wide)
+	if st.Filter != "" {
+		b.CmdBuff().SetText(st.Filter)
+		b.BufferCompleted(st.Filter)
+	}
+}
+
+// saveState records the browser sort column, wide mode and filter.
+func (b *Browser) saveState() {
+	if !b.persistable() || b.stateCtx != b.app.Config.K9s.CurrentContext {
+		return
+	}
+	col, asc := b.SortCol()
+	if col == "" {
+		return
+	}
+	b.app.viewStates().Set(b.GVR().String(), config.ViewState{
+		SortCol: col,
+		SortAsc: asc,
+		Wide:    b.IsWide(),
+		Filter:  b.CmdBuff().GetText(),
+	})
+}
+
+// persistable returns true for top level resource views. Views scoped to
+// another resource are not tracked.
+func (b *Browser) persistable() bool {
+	return b.app != nil && b.app.Config != nil && b.app.Config.K9s.CurrentContext != "" && b.contextFn == nil && b.Path == ""
+}
@@ -0,0 +1,98 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const whoCanUsage = "Usage: who-can VERB RESOURCE [NAMESPACE]"
+
+// WhoCan presents the subjects allowed to perform an action on a resource.
+type WhoCan struct {
+	ResourceViewer
+
+	query dao.WhoCanQuery
+}
+
+// NewWhoCan returns a new viewer.
+func NewWhoCan(app *App, q dao.WhoCanQuery) *WhoCan {
+	w := WhoCan{
+		ResourceViewer: NewBrowser(client.NewGVR("whocan")),
+		query:          q,
+	}
+	w.GetTable().SetColorerFn(render.WhoCan{}.ColorerFunc())
+	w.AddBindKeysFn(w.bindKeys)
+	w.GetTable().SetSortCol(nameCol, true)
+	w.SetContextFn(w.whoCanCtx)
+	w.GetTable().SetEnterFn(w.showPolicy)
+
+	return &w
+}
+
+func (w *WhoCan) whoCanCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, w.query.String())
+	return context.WithValue(ctx, internal.KeyWhoCan, w.query)
+}
+
+func (w *WhoCan) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", w.GetTable().SortColCmd(nameCol, true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Scope", w.GetTable().SortColCmd("SCOPE", true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Binding", w.GetTable().SortColCmd("BINDING", true), false),
+	})
+}
+
+// showPolicy displays all the selected subject permissions.
+func (w *WhoCan) showPolicy(app *App, _ ui.Tabular, _, path string) {
+	row, ok := w.GetTable().GetSelectedRow(path)
+	if !ok || len(row.Fields) < 2 {
+		return
+	}
+	if err := app.inject(NewPolicy(app, row.Fields[1], row.Fields[0])); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// parseWhoCan converts command arguments ie `get po fred` to a query.
+func parseWhoCan(args []string, gvrFn func(string) (client.GVR, bool)) (dao.WhoCanQuery, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return dao.WhoCanQuery{}, errors.New(whoCanUsage)
+	}
+	q := dao.WhoCanQuery{Verb: args[0]}
+	if len(args) == 3 {
+		q.Namespace = client.CleanseNamespace(args[2])
+	}
+
+	res := args[1]
+	if res == "*" {
+		q.Group, q.Resource = "*", "*"
+		return q, nil
+	}
+	var sub string
+	gvr, ok := gvrFn(res)
+	if !ok {
+		if i := strings.LastIndex(res, "/"); i > 0 {
+			gvr, ok = gvrFn(res[:i])
+			sub = res[i+1:]
+		}
+	}
+	if !ok {
+		return dao.WhoCanQuery{}, errors.New("`" + res + "` resource not found. " + whoCanUsage)
+	}
+	q.Group, q.Resource = gvr.G(), gvr.R()
+	if sub != "" {
+		q.Resource += "/" + sub
+	}
+
+	return q, nil
+}
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWhoCan(t *testing.T) {
+	aliases := map[string]string{
+		"po":      "v1/pods",
+		"dp":      "apps/v1/deployments",
+		"v1/pods": "v1/pods",
+	}
+	gvrFn := func(s string) (client.GVR, bool) {
+		gvr, ok := aliases[s]
+		return client.NewGVR(gvr), ok
+	}
+
+	uu := map[string]struct {
+		args []string
+		e    dao.WhoCanQuery
+		err  bool
+	}{
+		"core": {
+			args: []string{"get", "po"},
+			e:    dao.WhoCanQuery{Verb: "get", Resource: "pods"},
+		},
+		"group": {
+			args: []string{"delete", "dp", "fred"},
+			e:    dao.WhoCanQuery{Verb: "delete", Group: "apps", Resource: "deployments", Namespace: "fred"},
+		},
+		"allNamespaces": {
+			args: []string{"delete", "dp", "all"},
+			e:    dao.WhoCanQuery{Verb: "delete", Group: "apps", Resource: "deployments"},
+		},
+		"subresource": {
+			args: []string{"create", "v1/pods/exec"},
+			e:    dao.WhoCanQuery{Verb: "create", Resource: "pods/exec"},
+		},
+		"wildcard": {
+			args: []string{"*", "*"},
+			e:    dao.WhoCanQuery{Verb: "*", Group: "*", Resource: "*"},
+		},
+		"unknown": {
+			args: []string{"get", "blee"},
+			err:  true,
+		},
+		"missingResource": {
+			args: []string{"get"},
+			err:  true,
+		},
+		"tooMany": {
+			args: []string{"get", "po", "fred", "blee"},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := parseWhoCan(u.args, gvrFn)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, q)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const wizardKey = "wizard"
+
+func (n *Namespace) createCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a := n.App()
+	f := a.wizardForm()
+	var name, labels string
+	f.AddInputField("Name:", "", 40, nil, func(changed string) {
+		name = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Labels:", "", 40, nil, func(changed string) {
+		labels = changed
+	})
+	f.AddButton("OK", func() {
+		ll, err := dao.ParseLiterals(labels)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		o, err := dao.NewNamespaceObject(name, ll)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.dismissWizard()
+		a.createResource(n.GVR(), name, o)
+	})
+	a.showWizard("New Namespace", "Labels are specified as key1=value1,key2=value2", f)
+
+	return nil
+}
+
+func (c *ConfigMap) createCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a := c.App()
+	f := a.wizardForm()
+	ns, name, literals := a.wizardNamespace(), "", ""
+	f.AddInputField("Namespace:", ns, 40, nil, func(changed string) {
+		ns = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Name:", "", 40, nil, func(changed string) {
+		name = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Literals:", "", 40, nil, func(changed string) {
+		literals = changed
+	})
+	files := a.addFileField(f, "Files:", true)
+	f.AddButton("OK", func() {
+		ll, err := dao.ParseLiterals(literals)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		ff, err := dao.LoadFiles(files.GetText())
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		o, err := dao.NewConfigMapObject(ns, name, ll, ff)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.dismissWizard()
+		a.createResource(c.GVR(), client.FQN(ns, name), o)
+	})
+	a.showWizard("New ConfigMap", "Literals as key=value,... Files as path or key=path,... (ctrl-f to browse)", f)
+
+	return nil
+}
+
+func (s *Secret) createCmd(evt *tcell.EventKey) *tcell.EventKey {
+	a := s.App()
+	f := a.wizardForm()
+	for _, t := range dao.SecretTypes {
+		t := t
+		f.AddButton(t, func() {
+			a.dismissWizard()
+			s.showSecretWizard(t)
+		})
+	}
+	a.showWizard("New Secret", "Select a secret type", f)
+
+	return nil
+}
+
+func (s *Secret) showSecretWizard(kind string) {
+	a := s.App()
+	f := a.wizardForm()
+	ns, name := a.wizardNamespace(), ""
+	f.AddInputField("Namespace:", ns, 40, nil, func(changed string) {
+		ns = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Name:", "", 40, nil, func(changed string) {
+		name = strings.TrimSpace(changed)
+	})
+
+	var build func() (runtime.Object, error)
+	switch kind {
+	case dao.DockerRegistrySecret:
+		var auth dao.RegistryAuth
+		f.AddInputField("Server:", "", 40, nil, func(changed string) {
+			auth.Server = strings.TrimSpace(changed)
+		})
+		f.AddInputField("Username:", "", 40, nil, func(changed string) {
+			auth.Username = strings.TrimSpace(changed)
+		})
+		f.AddPasswordField("Password:", "", 40, '*', func(changed string) {
+			auth.Password = changed
+		})
+		f.AddInputField("Email:", "", 40, nil, func(changed string) {
+			auth.Email = strings.TrimSpace(changed)
+		})
+		build = func() (runtime.Object, error) {
+			return dao.NewRegistrySecretObject(ns, name, auth)
+		}
+	case dao.TLSSecret:
+		cert, key := a.addFileField(f, "Cert:", false), a.addFileField(f, "Key:", false)
+		build = func() (runtime.Object, error) {
+			c, err := ioutil.ReadFile(cert.GetText())
+			if err != nil {
+				return nil, err
+			}
+			k, err := ioutil.ReadFile(key.GetText())
+			if err != nil {
+				return nil, err
+			}
+			return dao.NewTLSSecretObject(ns, name, c, k)
+		}
+	default:
+		var literals string
+		f.AddInputField("Literals:", "", 40, nil, func(changed string) {
+			literals = changed
+		})
+		files := a.addFileField(f, "Files:", true)
+		build = func() (runtime.Object, error) {
+			ll, err := dao.ParseLiterals(literals)
+			if err != nil {
+				return nil, err
+			}
+			ff, err := dao.LoadFiles(files.GetText())
+			if err != nil {
+				return nil, err
+			}
+			return dao.NewGenericSecretObject(ns, name, ll, ff)
+		}
+	}
+
+	f.AddButton("OK", func() {
+		o, err := build()
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.dismissWizard()
+		a.createResource(s.GVR(), client.FQN(ns, name), o)
+	})
+	a.showWizard("New "+kind+" Secret", "File fields support ctrl-f to browse", f)
+}
+
+// Helpers...
+
+func (a *App) wizardNamespace() string {
+	if ns := a.Config.ActiveNamespace(); !client.IsAllNamespaces(ns) {
+		return ns
+	}
+
+	return ""
+}
+
+func (a *App) wizardForm() *tview.Form {
+	styles := a.Styles.Dialog()
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	return f
+}
+
+// addFileField adds a file path input field. Ctrl-f pops a file picker.
+// Multiple files are comma separated.
+func (a *App) addFileField(f *tview.Form, label string, multi bool) *tview.InputField {
+	in := tview.NewInputField().SetLabel(label).SetFieldWidth(40)
+	in.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if evt.Key() != tcell.KeyCtrlF {
+			return evt
+		}
+		dir, err := os.Getwd()
+		if err != nil {
+			dir = os.TempDir()
+		}
+		_, err = dialog.ShowFilePicker(a.Styles.Dialog(), a.Content.Pages, dir, func(path string) {
+			if t := in.GetText(); multi && t != "" {
+				path = t + "," + path
+			}
+			in.SetText(path)
+		}, func() {})
+		if err != nil {
+			a.Flash().Err(err)
+		}
+		return nil
+	})
+	f.AddFormItem(in)
+
+	return in
+}
+
+func (a *App) showWizard(title, msg string, f *tview.Form) {
+	styles := a.Styles.Dialog()
+	f.AddButton("Cancel", func() {
+		a.dismissWizard()
+	})
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetBackgroundColor(styles.BgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		a.dismissWizard()
+	})
+	a.Content.AddPage(wizardKey, modal, false, false)
+	a.Content.ShowPage(wizardKey)
+}
+
+func (a *App) dismissWizard() {
+	a.Content.RemovePage(wizardKey)
+}
+
+func (a *App) createResource(gvr client.GVR, path string, o runtime.Object) {
+	res, err := dao.AccessorFor(a.factory, gvr)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	c, ok := res.(dao.Creator)
+	if !ok {
+		a.Flash().Errf("Resource %s does not support creation", gvr)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+
+	err = c.Create(ctx, o)
+	a.audit(dao.NewAuditEntry("create", gvr.String(), path, err))
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Info(dryRunTag(fmt.Sprintf("%s %s created", gvr.R(), path)))
+}
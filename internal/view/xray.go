@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -94,7 +95,7 @@ func (x *Xray) Init(ctx context.Context) error {
 
 // ExtraHints returns additional hints.
 func (x *Xray) ExtraHints() map[string]string {
-	if x.app.Config.K9s.NoIcons {
+	if x.app.Config.K9s.IsNoIcons() {
 		return nil
 	}
 	return xray.EmojiInfo()
@@ -108,9 +109,34 @@ func (x *Xray) bindKeys() {
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false),
 		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", x.resetCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("Goto", x.gotoCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Export", x.exportCmd, true),
 	})
 }
 
+// exportCmd saves the current tree as a Graphviz DOT or JSON file based on
+// the file extension.
+func (x *Xray) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if x.app.InCmdMode() {
+		return evt
+	}
+
+	name := fmt.Sprintf("xray-%s-%d.%s", x.gvr.R(), time.Now().UnixNano(), xray.DOTFormat)
+	path := filepath.Join(config.K9sDumpDir, sanitizeFilename(x.app.Config.K9s.CurrentCluster), name)
+	dialog.ShowPrompt(x.app.Styles.Dialog(), x.app.Content.Pages, "Export Xray As", "Path:", path, func(path string) {
+		data, err := xray.Export(x.filter(x.model.Peek()), xray.FormatFor(path))
+		if err == nil {
+			err = writeData(path, data)
+		}
+		if err != nil {
+			x.app.Flash().Err(err)
+			return
+		}
+		x.app.Flash().Infof("Xray %s exported successfully!", path)
+	}, func() {})
+
+	return nil
+}
+
 func (x *Xray) keyEntered() {
 	x.ClearSelection()
 	x.update(x.filter(x.model.Peek()))
@@ -489,7 +515,7 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.IsNoIcons(), x.app.Styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -536,7 +562,7 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 }
 
 func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.IsNoIcons(), x.app.Styles)
 	for _, c := range n.Children {
 		x.hydrate(node, c)
 	}
@@ -665,8 +691,10 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 		if err := nuker.Delete(spec.Path(), true, true); err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
-			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
-			x.app.factory.DeleteForwarder(spec.Path())
+			x.app.Flash().Info(dryRunTag(fmt.Sprintf("%s `%s deleted successfully", x.GVR(), spec.Path())))
+			if !dao.IsDryRun() {
+				x.app.factory.DeleteForwarder(spec.Path())
+			}
 		}
 		x.Refresh()
 	}, func() {})
@@ -12,19 +12,26 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	defaultResync   = 10 * time.Minute
 	defaultWaitTime = 250 * time.Millisecond
+
+	// DefaultLinger tracks how long an unused informer is kept around.
+	DefaultLinger = 30 * time.Second
 )
 
-// Factory tracks various resource informers.
+// Factory tracks various resource informers. Informers are shared across
+// all views watching the same resource and torn down once no longer in use.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
+	informers  map[string]map[string]*sharedInformer
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	schemas    map[string]map[string]interface{}
+	linger     time.Duration
 	mx         sync.RWMutex
 }
 
@@ -32,8 +39,10 @@ type Factory struct {
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]map[string]*sharedInformer),
 		forwarders: NewForwarders(),
+		schemas:    make(map[string]map[string]interface{}),
+		linger:     DefaultLinger,
 	}
 }
 
@@ -44,10 +53,6 @@ func (f *Factory) Start(ns string) {
 
 	log.Debug().Msgf("Factory START with ns `%q", ns)
 	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
-	}
 }
 
 // Terminate terminates all watchers and forwards.
@@ -59,10 +64,95 @@ func (f *Factory) Terminate() {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for ns, gvrs := range f.informers {
+		for _, inf := range gvrs {
+			inf.stop()
+		}
+		delete(f.informers, ns)
 	}
 	f.forwarders.DeleteAll()
+	f.schemas = make(map[string]map[string]interface{})
+}
+
+// Schema returns a cached resource schema.
+func (f *Factory) Schema(gvr string) (map[string]interface{}, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	s, ok := f.schemas[gvr]
+	return s, ok
+}
+
+// SetSchema caches a resource schema until the factory terminates.
+func (f *Factory) SetSchema(gvr string, s map[string]interface{}) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.schemas[gvr] = s
+}
+
+// SetLinger sets how long unused informers are kept around.
+func (f *Factory) SetLinger(d time.Duration) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.linger = d
+}
+
+// Acquire pins an existing informer so it stays up while in use. It returns
+// false if no informer is running for the given resource.
+func (f *Factory) Acquire(ns, gvr string) bool {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	inf, ok := f.lookup(normalizeNS(ns), gvr)
+	if !ok {
+		return false
+	}
+	inf.refs++
+
+	return true
+}
+
+// Release unpins an informer. Unused informers are torn down once the
+// linger period expires so quick navigation back and forth reuses them.
+func (f *Factory) Release(ns, gvr string) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	inf, ok := f.lookup(normalizeNS(ns), gvr)
+	if !ok || inf.refs == 0 {
+		return
+	}
+	inf.refs--
+	f.touch(inf)
+}
+
+// Subscribe registers a callback fired when a resource changes. It returns
+// false if no informer is running for the given resource.
+func (f *Factory) Subscribe(ns, gvr string, fn func()) (func(), bool) {
+	f.mx.RLock()
+	inf, ok := f.lookup(normalizeNS(ns), gvr)
+	f.mx.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	id := inf.subscribe(fn)
+
+	return func() { inf.unsubscribe(id) }, true
+}
+
+// InformerCounts returns the number of active informers per namespace.
+func (f *Factory) InformerCounts() map[string]int {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	cc := make(map[string]int, len(f.informers))
+	for ns, gvrs := range f.informers {
+		cc[ns] = len(gvrs)
+	}
+
+	return cc
 }
 
 // List returns a resource collection.
@@ -85,7 +175,7 @@ func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]run
 		return oo, err
 	}
 
-	f.waitForCacheSync(ns)
+	f.waitForCacheSync(ns, gvr)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().List(labels)
 	}
@@ -119,21 +209,18 @@ func (f *Factory) Get(gvr, fqn string, wait bool, sel labels.Selector) (runtime.
 		return o, err
 	}
 
-	f.waitForCacheSync(ns)
+	f.waitForCacheSync(ns, gvr)
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().Get(n)
 	}
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if client.IsClusterWide(ns) {
-		ns = client.AllNamespaces
-	}
-
+// waitForCacheSync waits a bit for a given resource informer to sync.
+func (f *Factory) waitForCacheSync(ns, gvr string) {
 	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fac, ok := f.factories[ns]
+	inf, ok := f.lookup(normalizeNS(ns), gvr)
+	f.mx.RUnlock()
 	if !ok {
 		return
 	}
@@ -144,17 +231,24 @@ func (f *Factory) waitForCacheSync(ns string) {
 		<-time.After(defaultWaitTime)
 		close(c)
 	}(c)
-	_ = fac.WaitForCacheSync(c)
+	_ = cache.WaitForCacheSync(c, inf.Informer().HasSynced)
 }
 
-// WaitForCacheSync waits for all factories to update their cache.
+// WaitForCacheSync waits for all informers to update their cache.
 func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
+	f.mx.RLock()
+	stop := f.stopChan
+	synced := make(map[string]cache.InformerSynced)
+	for ns, gvrs := range f.informers {
+		for gvr, inf := range gvrs {
+			synced[ns+":"+gvr] = inf.Informer().HasSynced
 		}
 	}
+	f.mx.RUnlock()
+
+	for k, s := range synced {
+		log.Debug().Msgf("CACHE `%q Loaded %t", k, cache.WaitForCacheSync(stop, s))
+	}
 }
 
 // Client return the factory connection.
@@ -162,28 +256,12 @@ func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
-
 // SetActiveNS sets the active namespace.
 func (f *Factory) SetActiveNS(ns string) error {
-	if f.isClusterWide() {
-		return nil
-	}
-	_, err := f.ensureFactory(ns)
+	_, err := f.client.DynDial()
 	return err
 }
 
-func (f *Factory) isClusterWide() bool {
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-	_, ok := f.factories[client.AllNamespaces]
-
-	return ok
-}
-
 // CanForResource return an informer is user has access.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
 	auth, err := f.Client().CanI(ns, gvr, verbs)
@@ -197,47 +275,90 @@ func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.Gene
 	return f.ForResource(ns, gvr)
 }
 
-// ForResource returns an informer for a given resource.
+// ForResource returns an informer for a given resource. Cluster wide
+// informers are shared with namespaced requests for the same resource.
 func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error) {
-	fact, err := f.ensureFactory(ns)
-	if err != nil {
-		return nil, err
-	}
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf, nil
-	}
+	ns = normalizeNS(ns)
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fact.Start(f.stopChan)
-
-	return inf, nil
-}
-
-func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
-	if client.IsClusterWide(ns) {
-		ns = client.AllNamespaces
-	}
 	f.mx.Lock()
 	defer f.mx.Unlock()
-	if fac, ok := f.factories[ns]; ok {
-		return fac, nil
+	if inf, ok := f.lookup(ns, gvr); ok {
+		f.touch(inf)
+		return inf, nil
 	}
 
 	dial, err := f.client.DynDial()
 	if err != nil {
 		return nil, err
 	}
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
+	inf := newSharedInformer(di.NewFilteredDynamicInformer(
 		dial,
-		defaultResync,
+		toGVR(gvr),
 		ns,
+		defaultResync,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
 		nil,
-	)
+	))
+	if _, ok := f.informers[ns]; !ok {
+		f.informers[ns] = make(map[string]*sharedInformer)
+	}
+	f.informers[ns][gvr] = inf
+	inf.Informer().AddEventHandler(inf.handler())
+	go inf.Informer().Run(inf.done)
+	f.touch(inf)
+
+	return inf, nil
+}
+
+// lookup returns a running informer. Must be called while holding the lock.
+func (f *Factory) lookup(ns, gvr string) (*sharedInformer, bool) {
+	if inf, ok := f.informers[ns][gvr]; ok {
+		return inf, true
+	}
+	if ns == client.AllNamespaces {
+		return nil, false
+	}
+	inf, ok := f.informers[client.AllNamespaces][gvr]
+
+	return inf, ok
+}
+
+// touch schedules an unused informer for teardown. Must be called while
+// holding the lock.
+func (f *Factory) touch(inf *sharedInformer) {
+	inf.lastUsed = time.Now()
+	if inf.refs > 0 || inf.reaper != nil {
+		return
+	}
+	inf.reaper = time.AfterFunc(f.linger, func() { f.reap(inf) })
+}
+
+func (f *Factory) reap(inf *sharedInformer) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
 
-	return f.factories[ns], nil
+	inf.reaper = nil
+	if inf.refs > 0 {
+		return
+	}
+	if idle := time.Since(inf.lastUsed); idle < f.linger {
+		inf.reaper = time.AfterFunc(f.linger-idle, func() { f.reap(inf) })
+		return
+	}
+	for ns, gvrs := range f.informers {
+		for gvr, i := range gvrs {
+			if i != inf {
+				continue
+			}
+			log.Debug().Msgf("Releasing informer %q:%q", ns, gvr)
+			inf.stop()
+			delete(gvrs, gvr)
+			if len(gvrs) == 0 {
+				delete(f.informers, ns)
+			}
+			return
+		}
+	}
 }
 
 // AddForwarder registers a new portforward for a given container.
@@ -251,6 +372,14 @@ func (f *Factory) AddForwarder(pf Forwarder) {
 	}
 }
 
+// UpdateForwarder re-registers a portforward whose pod got replaced.
+func (f *Factory) UpdateForwarder(oldPath string, pf Forwarder) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	delete(f.forwarders, oldPath)
+	f.forwarders[pf.Path()] = pf
+}
+
 // DeleteForwarder deletes portforward for a given container.
 func (f *Factory) DeleteForwarder(path string) {
 	count := f.forwarders.Kill(path)
@@ -278,8 +407,12 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 }
 
 // ValidatePortForwards check if pods are still around for portforwards.
+// Forwards following a selector get reconnected instead.
 func (f *Factory) ValidatePortForwards() {
 	for k, fwd := range f.forwarders {
+		if fwd.Selector() != "" {
+			continue
+		}
 		tokens := strings.Split(k, ":")
 		_, err := f.Get("v1/pods", tokens[0], false, labels.Everything())
 		if err != nil {
@@ -0,0 +1,68 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestFactoryWaitForCacheSync(t *testing.T) {
+	f := NewFactory(nil)
+	f.informers[client.AllNamespaces] = map[string]*sharedInformer{
+		"v1/pods": newSharedInformer(newTestInformer()),
+	}
+
+	uu := map[string]struct {
+		ns, gvr string
+		wait    bool
+	}{
+		"namespaced": {
+			ns:   "fred",
+			gvr:  "v1/pods",
+			wait: true,
+		},
+		"allNS": {
+			ns:   client.AllNamespaces,
+			gvr:  "v1/pods",
+			wait: true,
+		},
+		"untracked": {
+			ns:  "fred",
+			gvr: "v1/services",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t0 := time.Now()
+			f.waitForCacheSync(u.ns, u.gvr)
+			assert.Equal(t, u.wait, time.Since(t0) >= defaultWaitTime)
+		})
+	}
+}
+
+// Helpers...
+
+type testInformer struct {
+	inf cache.SharedIndexInformer
+}
+
+func newTestInformer() testInformer {
+	return testInformer{
+		inf: cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{}),
+	}
+}
+
+func (i testInformer) Informer() cache.SharedIndexInformer {
+	return i.inf
+}
+
+func (i testInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(i.inf.GetIndexer(), schema.GroupResource{Resource: "pods"})
+}
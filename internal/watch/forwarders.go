@@ -37,6 +37,15 @@ type Forwarder interface {
 	// Age returns forwarder age.
 	Age() string
 
+	// Status returns the forwarder streaming status.
+	Status() string
+
+	// Retries returns the number of reconnect attempts.
+	Retries() int
+
+	// Selector returns the labels matching replacement pods.
+	Selector() string
+
 	// HasPortMapping returns true if port mapping exists.
 	HasPortMapping(string) bool
 }
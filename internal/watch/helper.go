@@ -30,8 +30,10 @@ func namespaced(n string) (string, string) {
 // DumpFactory for debug.
 func DumpFactory(f *Factory) {
 	log.Debug().Msgf("----------- FACTORIES -------------")
-	for ns := range f.factories {
-		log.Debug().Msgf("  Factory for NS %q", ns)
+	for ns, gvrs := range f.informers {
+		for gvr, inf := range gvrs {
+			log.Debug().Msgf("  Informer for %q:%q (%d refs)", ns, gvr, inf.refs)
+		}
 	}
 	log.Debug().Msgf("-----------------------------------")
 }
@@ -39,11 +41,10 @@ func DumpFactory(f *Factory) {
 // DebugFactory for debug.
 func DebugFactory(f *Factory, ns string, gvr string) {
 	log.Debug().Msgf("----------- DEBUG FACTORY (%s) -------------", gvr)
-	fac, ok := f.factories[ns]
+	inf, ok := f.informers[ns][gvr]
 	if !ok {
 		return
 	}
-	inf := fac.ForResource(toGVR(gvr))
 	for i, k := range inf.Informer().GetStore().ListKeys() {
 		log.Debug().Msgf("%d -- %s", i, k)
 	}
@@ -0,0 +1,90 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// sharedInformer tracks an informer shared across views.
+type sharedInformer struct {
+	informers.GenericInformer
+
+	done     chan struct{}
+	once     sync.Once
+	refs     int
+	lastUsed time.Time
+	reaper   *time.Timer
+	subs     map[int]func()
+	subID    int
+	smx      sync.RWMutex
+}
+
+func newSharedInformer(inf informers.GenericInformer) *sharedInformer {
+	return &sharedInformer{
+		GenericInformer: inf,
+		done:            make(chan struct{}),
+		subs:            make(map[int]func()),
+	}
+}
+
+// subscribe registers a change callback and returns its id.
+func (s *sharedInformer) subscribe(fn func()) int {
+	s.smx.Lock()
+	defer s.smx.Unlock()
+
+	s.subID++
+	s.subs[s.subID] = fn
+
+	return s.subID
+}
+
+func (s *sharedInformer) unsubscribe(id int) {
+	s.smx.Lock()
+	defer s.smx.Unlock()
+
+	delete(s.subs, id)
+}
+
+func (s *sharedInformer) notify() {
+	s.smx.RLock()
+	defer s.smx.RUnlock()
+
+	for _, fn := range s.subs {
+		fn()
+	}
+}
+
+// handler fans out informer events to subscribers.
+func (s *sharedInformer) handler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { s.notify() },
+		UpdateFunc: func(interface{}, interface{}) { s.notify() },
+		DeleteFunc: func(interface{}) { s.notify() },
+	}
+}
+
+// Done returns a channel closed once the informer stops.
+func (s *sharedInformer) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *sharedInformer) stop() {
+	s.once.Do(func() {
+		if s.reaper != nil {
+			s.reaper.Stop()
+		}
+		close(s.done)
+	})
+}
+
+func normalizeNS(ns string) string {
+	if client.IsClusterWide(ns) {
+		return client.AllNamespaces
+	}
+
+	return ns
+}
@@ -0,0 +1,134 @@
+package xray
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Tree export formats.
+const (
+	DOTFormat  = "dot"
+	JSONFormat = "json"
+)
+
+var dotColors = map[string]string{
+	OkStatus:         "darkgreen",
+	ToastStatus:      "red",
+	CompletedStatus:  "gray",
+	MissingRefStatus: "orange",
+}
+
+// ExportNode represents a serialized tree node.
+type ExportNode struct {
+	Kind     string        `json:"kind"`
+	GVR      string        `json:"gvr"`
+	ID       string        `json:"id"`
+	Status   string        `json:"status,omitempty"`
+	Info     string        `json:"info,omitempty"`
+	Children []*ExportNode `json:"children,omitempty"`
+}
+
+// FormatFor returns the export format matching a file extension. Defaults to DOT.
+func FormatFor(path string) string {
+	if strings.EqualFold(filepath.Ext(path), "."+JSONFormat) {
+		return JSONFormat
+	}
+
+	return DOTFormat
+}
+
+// Export serializes a tree in the given format.
+func Export(root *TreeNode, format string) (string, error) {
+	if root == nil {
+		return "", errors.New("no tree to export")
+	}
+	switch format {
+	case JSONFormat:
+		return ToJSON(root)
+	case DOTFormat:
+		return ToDOT(root), nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+// ToJSON serializes a tree to JSON.
+func ToJSON(root *TreeNode) (string, error) {
+	raw, err := json.MarshalIndent(toExportNode(root), "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func toExportNode(n *TreeNode) *ExportNode {
+	e := ExportNode{
+		Kind:   nodeKind(n.GVR),
+		GVR:    n.GVR,
+		ID:     n.ID,
+		Status: n.Extras[StatusKey],
+		Info:   n.Extras[InfoKey],
+	}
+	for _, c := range n.Children {
+		e.Children = append(e.Children, toExportNode(c))
+	}
+
+	return &e
+}
+
+// ToDOT serializes a tree to a Graphviz digraph.
+func ToDOT(root *TreeNode) string {
+	var b strings.Builder
+	b.WriteString("digraph xray {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded, fontname=Helvetica];\n")
+	var id int
+	writeDOT(&b, root, &id)
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func writeDOT(b *strings.Builder, n *TreeNode, id *int) string {
+	name := "n" + strconv.Itoa(*id)
+	*id++
+
+	attrs := []string{"label=" + dotQuote(nodeKind(n.GVR), n.ID)}
+	if c, ok := dotColors[n.Extras[StatusKey]]; ok {
+		attrs = append(attrs, "color="+c)
+	}
+	if n.Extras[StatusKey] == MissingRefStatus {
+		attrs = append(attrs, `style="rounded,dashed"`)
+	}
+	fmt.Fprintf(b, "  %s [%s];\n", name, strings.Join(attrs, ", "))
+	for _, c := range n.Children {
+		fmt.Fprintf(b, "  %s -> %s;\n", name, writeDOT(b, c, id))
+	}
+
+	return name
+}
+
+// dotQuote returns a quoted DOT label with one line per value.
+func dotQuote(ss ...string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	for i := range ss {
+		ss[i] = r.Replace(ss[i])
+	}
+
+	return `"` + strings.Join(ss, `\n`) + `"`
+}
+
+func nodeKind(gvr string) string {
+	if k := category(gvr); k != "" {
+		return k
+	}
+
+	return client.NewGVR(gvr).R()
+}
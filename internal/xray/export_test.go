@@ -0,0 +1,93 @@
+package xray_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportDOT(t *testing.T) {
+	s, err := xray.Export(exportTree(), xray.DOTFormat)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `digraph xray {
+  rankdir=LR;
+  node [shape=box, style=rounded, fontname=Helvetica];
+  n0 [label="freds\nfreds", color=darkgreen];
+  n1 [label="freds\ndefault/fred", color=red];
+  n2 [label="blees\ndefault/\"blee\"", color=orange, style="rounded,dashed"];
+  n1 -> n2;
+  n0 -> n1;
+}
+`, s)
+}
+
+func TestExportJSON(t *testing.T) {
+	s, err := xray.Export(exportTree(), xray.JSONFormat)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{
+  "kind": "freds",
+  "gvr": "fred.io/v1/freds",
+  "id": "freds",
+  "status": "ok",
+  "children": [
+    {
+      "kind": "freds",
+      "gvr": "fred.io/v1/freds",
+      "id": "default/fred",
+      "status": "toast",
+      "info": "not ready",
+      "children": [
+        {
+          "kind": "blees",
+          "gvr": "v1/blees",
+          "id": "default/\"blee\"",
+          "status": "noref"
+        }
+      ]
+    }
+  ]
+}`, s)
+}
+
+func TestExportFails(t *testing.T) {
+	_, err := xray.Export(exportTree(), "bozo")
+	assert.Error(t, err)
+
+	_, err = xray.Export(nil, xray.DOTFormat)
+	assert.Error(t, err)
+}
+
+func TestFormatFor(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+	}{
+		"dot":     {path: "/tmp/fred.dot", e: xray.DOTFormat},
+		"json":    {path: "/tmp/fred.JSON", e: xray.JSONFormat},
+		"default": {path: "/tmp/fred", e: xray.DOTFormat},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, xray.FormatFor(u.path))
+		})
+	}
+}
+
+// Helpers...
+
+func exportTree() *xray.TreeNode {
+	root := xray.NewTreeNode("fred.io/v1/freds", "freds")
+	fred := xray.NewTreeNode("fred.io/v1/freds", "default/fred")
+	fred.Extras[xray.StatusKey] = xray.ToastStatus
+	fred.Extras[xray.InfoKey] = "not ready"
+	blee := xray.NewTreeNode("v1/blees", `default/"blee"`)
+	blee.Extras[xray.StatusKey] = xray.MissingRefStatus
+	fred.Add(blee)
+	root.Add(fred)
+
+	return root
+}